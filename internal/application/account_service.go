@@ -0,0 +1,189 @@
+package application
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_application/account_service.go . AccountService
+
+import (
+	"context"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// AccountService handles the requests users make about their own account data.
+type AccountService interface {
+	Delete(ctx context.Context, userID, password string) error
+	Export(ctx context.Context, userID string) (*domain.AccountExport, error)
+}
+
+// accountGroupsPageSize is how many groups are loaded per page while looking up the groups of a user.
+const accountGroupsPageSize = 100
+
+type accountService struct {
+	userRepository        domain.UserRepository
+	groupRepository       domain.GroupRepository
+	groupInviteRepository domain.GroupInviteRepository
+	sessionRepository     domain.SessionRepository
+	passwordManager       domain.PasswordManager
+	blobStorage           domain.BlobStorage
+}
+
+func NewAccountService(userRepository domain.UserRepository, groupRepository domain.GroupRepository, groupInviteRepository domain.GroupInviteRepository, sessionRepository domain.SessionRepository, passwordManager domain.PasswordManager, blobStorage domain.BlobStorage) AccountService {
+	return &accountService{
+		userRepository:        userRepository,
+		groupRepository:       groupRepository,
+		groupInviteRepository: groupInviteRepository,
+		sessionRepository:     sessionRepository,
+		passwordManager:       passwordManager,
+		blobStorage:           blobStorage,
+	}
+}
+
+// Delete removes the user from their groups and anonymises the account. The groups are updated first, so a
+// failed deletion can simply be retried: the account stays usable until the last step succeeds.
+func (s *accountService) Delete(ctx context.Context, userID, password string) error {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := user.CheckPassword(s.passwordManager, password); err != nil {
+		return err
+	}
+
+	groupIDs, err := s.memberGroupIDs(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, groupID := range groupIDs {
+		group, err := s.groupRepository.GetByID(ctx, groupID)
+		if err != nil {
+			return err
+		}
+
+		previousOwnerID := group.OwnerID
+
+		if err := group.RemoveDeletedUser(userID); err != nil {
+			return err
+		}
+
+		if group.OwnerID != previousOwnerID {
+			takenNames, err := s.ownedGroupNames(ctx, group.OwnerID, group.Name)
+			if err != nil {
+				return err
+			}
+
+			group.RenameApartFrom(takenNames)
+		}
+
+		if err := s.groupRepository.Update(ctx, *group); err != nil {
+			return err
+		}
+	}
+
+	previousUser := *user
+	user.Anonymize()
+
+	if err := s.userRepository.Anonymize(ctx, *user); err != nil {
+		return err
+	}
+
+	deleteAvatar(ctx, s.blobStorage, previousUser)
+
+	return nil
+}
+
+// Export gathers everything stored about the user: the profile, the groups they are a member of, the invites
+// of the groups they own, the receivers they were matched with and the sessions they logged in with.
+func (s *accountService) Export(ctx context.Context, userID string) (*domain.AccountExport, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs, err := s.memberGroupIDs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]domain.Group, 0, len(groupIDs))
+	invites := []domain.GroupInvite{}
+
+	for _, groupID := range groupIDs {
+		group, err := s.groupRepository.GetByID(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, *group)
+
+		if group.OwnerID != userID {
+			continue
+		}
+
+		groupInvites, err := s.groupInviteRepository.ListByGroupID(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+
+		invites = append(invites, groupInvites...)
+	}
+
+	sessions, err := s.sessionRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewAccountExport(*user, groups, invites, sessions)
+}
+
+// ownedGroupNames lists the names of the groups owned by the user that contain the given name, which covers
+// every name a group called that way could clash with once it is handed over to the user.
+func (s *accountService) ownedGroupNames(ctx context.Context, ownerID, name string) ([]string, error) {
+	var names []string
+
+	for offset := 0; ; offset += accountGroupsPageSize {
+		filters, err := domain.NewGroupFilters(name, ownerID, "", nil, accountGroupsPageSize, offset, "", "")
+		if err != nil {
+			return nil, err
+		}
+
+		searchResult, err := s.groupRepository.Search(ctx, *filters)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range searchResult.Result {
+			names = append(names, group.Name)
+		}
+
+		if len(searchResult.Result) < accountGroupsPageSize {
+			return names, nil
+		}
+	}
+}
+
+// memberGroupIDs lists every group the user is a member of. All pages are read before any group is changed,
+// since removing the user from a group would shift the pages still to be read.
+func (s *accountService) memberGroupIDs(ctx context.Context, userID string) ([]string, error) {
+	var groupIDs []string
+
+	for offset := 0; ; offset += accountGroupsPageSize {
+		filters, err := domain.NewGroupFilters("", "", userID, nil, accountGroupsPageSize, offset, "", "")
+		if err != nil {
+			return nil, err
+		}
+
+		searchResult, err := s.groupRepository.Search(ctx, *filters)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range searchResult.Result {
+			groupIDs = append(groupIDs, group.ID)
+		}
+
+		if len(searchResult.Result) < accountGroupsPageSize {
+			return groupIDs, nil
+		}
+	}
+}
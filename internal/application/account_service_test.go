@@ -0,0 +1,359 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_accountService_Delete(t *testing.T) {
+	t.Run("should remove the user from their groups and anonymise the account", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("hashed").Build()
+		member := build_domain.NewUserBuilder().Build()
+		ownedGroup := build_domain.NewGroupBuilder().
+			WithOwnerID(user.ID).
+			WithUsers([]domain.User{user, member}).
+			WithStatus(domain.GroupStatusOpen).
+			Build()
+		joinedGroup := build_domain.NewGroupBuilder().
+			WithOwnerID(member.ID).
+			WithUsers([]domain.User{member, user}).
+			WithStatus(domain.GroupStatusOpen).
+			Build()
+
+		filters := build_domain.NewGroupFiltersBuilder().
+			WithUserID(user.ID).
+			WithLimit(100).
+			WithOffset(0).
+			Build()
+		searchResult := build_domain.NewSearchResultBuilder[domain.GroupSummary]().
+			WithResult([]domain.GroupSummary{
+				build_domain.NewGroupSummaryBuilder().WithID(ownedGroup.ID).Build(),
+				build_domain.NewGroupSummaryBuilder().WithID(joinedGroup.ID).Build(),
+			}).
+			WithLimit(100).
+			WithTotal(2).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("hashed", "password").Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Anonymize(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, anonymizedUser domain.User) error {
+			assert.Equal(t, user.ID, anonymizedUser.ID)
+			assert.True(t, anonymizedUser.IsDeleted())
+			assert.Equal(t, domain.DeletedUserName, anonymizedUser.Name)
+			return nil
+		})
+
+		ownedNamesFilters := build_domain.NewGroupFiltersBuilder().
+			WithName(ownedGroup.Name).
+			WithOwnerID(member.ID).
+			WithLimit(100).
+			WithOffset(0).
+			Build()
+		emptyResult := build_domain.NewSearchResultBuilder[domain.GroupSummary]().WithResult([]domain.GroupSummary{}).Build()
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().Search(gomock.Any(), filters).Return(&searchResult, nil)
+		mockedGroupRepository.EXPECT().Search(gomock.Any(), ownedNamesFilters).Return(&emptyResult, nil)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), ownedGroup.ID).Return(&ownedGroup, nil)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), joinedGroup.ID).Return(&joinedGroup, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, group domain.Group) error {
+			assert.False(t, group.IsMember(user.ID))
+			assert.Equal(t, member.ID, group.OwnerID)
+			return nil
+		}).Times(2)
+
+		accountService := application.NewAccountService(mockedUserRepository, mockedGroupRepository, nil, nil, mockedPasswordManager, nil)
+
+		// when
+		err := accountService.Delete(context.Background(), user.ID, "password")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should rename a handed over group when the new owner already has a group with the same name", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("hashed").Build()
+		member := build_domain.NewUserBuilder().Build()
+		ownedGroup := build_domain.NewGroupBuilder().
+			WithName("Secret Santa").
+			WithOwnerID(user.ID).
+			WithUsers([]domain.User{user, member}).
+			WithStatus(domain.GroupStatusOpen).
+			Build()
+
+		memberGroupsResult := build_domain.NewSearchResultBuilder[domain.GroupSummary]().
+			WithResult([]domain.GroupSummary{build_domain.NewGroupSummaryBuilder().WithID(ownedGroup.ID).Build()}).
+			WithLimit(100).
+			WithTotal(1).
+			Build()
+		ownedNamesResult := build_domain.NewSearchResultBuilder[domain.GroupSummary]().
+			WithResult([]domain.GroupSummary{
+				build_domain.NewGroupSummaryBuilder().WithName("Secret Santa").WithOwnerID(member.ID).Build(),
+				build_domain.NewGroupSummaryBuilder().WithName("Secret Santa (2)").WithOwnerID(member.ID).Build(),
+			}).
+			WithLimit(100).
+			WithTotal(2).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("hashed", "password").Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Anonymize(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, filters domain.GroupFilters) (*domain.SearchResult[domain.GroupSummary], error) {
+			if filters.OwnerID == member.ID {
+				assert.Equal(t, "Secret Santa", filters.Name)
+				return &ownedNamesResult, nil
+			}
+			return &memberGroupsResult, nil
+		}).Times(2)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), ownedGroup.ID).Return(&ownedGroup, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, group domain.Group) error {
+			assert.Equal(t, member.ID, group.OwnerID)
+			assert.Equal(t, "Secret Santa (3)", group.Name)
+			return nil
+		})
+
+		accountService := application.NewAccountService(mockedUserRepository, mockedGroupRepository, nil, nil, mockedPasswordManager, nil)
+
+		// when
+		err := accountService.Delete(context.Background(), user.ID, "password")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should delete the avatar thumbnails once the account is anonymised", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("hashed").WithAvatarID("some-avatar-id").Build()
+		emptyResult := build_domain.NewSearchResultBuilder[domain.GroupSummary]().WithResult([]domain.GroupSummary{}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("hashed", "password").Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Anonymize(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&emptyResult, nil)
+
+		mockedBlobStorage := mock_domain.NewMockBlobStorage(mockCtrl)
+		for _, size := range domain.AvatarSizes {
+			mockedBlobStorage.EXPECT().Delete(gomock.Any(), domain.AvatarKey(user.ID, "some-avatar-id", size)).Return(nil)
+		}
+
+		accountService := application.NewAccountService(mockedUserRepository, mockedGroupRepository, nil, nil, mockedPasswordManager, mockedBlobStorage)
+
+		// when
+		err := accountService.Delete(context.Background(), user.ID, "password")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return validation error and keep the account when the password is incorrect", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("hashed").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("hashed", "wrong-password").Return(assert.AnError)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		accountService := application.NewAccountService(mockedUserRepository, nil, nil, nil, mockedPasswordManager, nil)
+
+		// when
+		err := accountService.Delete(context.Background(), user.ID, "wrong-password")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Password", Error: "Password is incorrect"})
+	})
+
+	t.Run("should not anonymise the account when a group cannot be updated", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("hashed").Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(user.ID).
+			WithUsers([]domain.User{user}).
+			WithStatus(domain.GroupStatusOpen).
+			Build()
+
+		searchResult := build_domain.NewSearchResultBuilder[domain.GroupSummary]().
+			WithResult([]domain.GroupSummary{build_domain.NewGroupSummaryBuilder().WithID(group.ID).Build()}).
+			WithLimit(100).
+			WithTotal(1).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("hashed", "password").Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&searchResult, nil)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		accountService := application.NewAccountService(mockedUserRepository, mockedGroupRepository, nil, nil, mockedPasswordManager, nil)
+
+		// when
+		err := accountService.Delete(context.Background(), user.ID, "password")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return not found error when the user does not exist", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), "some-user-id").Return(nil, domain.NewResourceNotFoundError("user not found"))
+
+		accountService := application.NewAccountService(mockedUserRepository, nil, nil, nil, nil, nil)
+
+		// when
+		err := accountService.Delete(context.Background(), "some-user-id", "password")
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_accountService_Export(t *testing.T) {
+	t.Run("should export the user data with the invites of the owned groups only", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		other := build_domain.NewUserBuilder().Build()
+		ownedGroup := build_domain.NewGroupBuilder().
+			WithOwnerID(user.ID).
+			WithUsers([]domain.User{user, other}).
+			Build()
+		joinedGroup := build_domain.NewGroupBuilder().
+			WithOwnerID(other.ID).
+			WithUsers([]domain.User{other, user}).
+			Build()
+		invite := build_domain.NewGroupInviteBuilder().WithGroupID(ownedGroup.ID).Build()
+		session := build_domain.NewSessionBuilder().WithUserID(user.ID).Build()
+
+		searchResult := build_domain.NewSearchResultBuilder[domain.GroupSummary]().
+			WithResult([]domain.GroupSummary{
+				build_domain.NewGroupSummaryBuilder().WithID(ownedGroup.ID).Build(),
+				build_domain.NewGroupSummaryBuilder().WithID(joinedGroup.ID).Build(),
+			}).
+			WithLimit(100).
+			WithTotal(2).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&searchResult, nil)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), ownedGroup.ID).Return(&ownedGroup, nil)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), joinedGroup.ID).Return(&joinedGroup, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListByGroupID(gomock.Any(), ownedGroup.ID).Return([]domain.GroupInvite{invite}, nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().ListByUserID(gomock.Any(), user.ID).Return([]domain.Session{session}, nil)
+
+		accountService := application.NewAccountService(mockedUserRepository, mockedGroupRepository, mockedGroupInviteRepository, mockedSessionRepository, nil, nil)
+
+		// when
+		export, err := accountService.Export(context.Background(), user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, user, export.User)
+		assert.Len(t, export.Memberships, 2)
+		assert.Equal(t, []domain.Group{ownedGroup}, export.OwnedGroups)
+		assert.Equal(t, []domain.GroupInvite{invite}, export.Invites)
+		assert.Equal(t, []domain.Session{session}, export.Sessions)
+	})
+
+	t.Run("should read every page of groups", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		firstPage := make([]domain.GroupSummary, 100)
+		for i := range firstPage {
+			firstPage[i] = build_domain.NewGroupSummaryBuilder().Build()
+		}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		gomock.InOrder(
+			mockedGroupRepository.EXPECT().Search(gomock.Any(), build_domain.NewGroupFiltersBuilder().WithUserID(user.ID).WithLimit(100).WithOffset(0).Build()).
+				Return(&domain.SearchResult[domain.GroupSummary]{Result: firstPage, Paging: domain.Paging{Total: 101, Limit: 100}}, nil),
+			mockedGroupRepository.EXPECT().Search(gomock.Any(), build_domain.NewGroupFiltersBuilder().WithUserID(user.ID).WithLimit(100).WithOffset(100).Build()).
+				Return(&domain.SearchResult[domain.GroupSummary]{Result: []domain.GroupSummary{}, Paging: domain.Paging{Total: 101, Limit: 100, Offset: 100}}, nil),
+		)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+		accountService := application.NewAccountService(mockedUserRepository, mockedGroupRepository, nil, nil, nil, nil)
+
+		// when
+		export, err := accountService.Export(context.Background(), user.ID)
+
+		// then
+		assert.Nil(t, export)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return error when the user cannot be loaded", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), "some-user-id").Return(nil, assert.AnError)
+
+		accountService := application.NewAccountService(mockedUserRepository, nil, nil, nil, nil, nil)
+
+		// when
+		export, err := accountService.Export(context.Background(), "some-user-id")
+
+		// then
+		assert.Nil(t, export)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,125 @@
+package application
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_application/attempt_limiter.go . AttemptLimiter
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// AttemptLimiter slows down the guessing of passwords and invite codes, and the sending of emails on request. Attempts
+// are counted under keys, such as the client address and the targeted account, and an attempt is refused while any of
+// its keys has to wait.
+type AttemptLimiter interface {
+	// Reserve counts an attempt under every key before it is verified, so concurrent attempts cannot all be verified
+	// before the first failure is recorded. It fails with a TooManyRequestsError, counting nothing, while any of the
+	// keys has to wait. A reserved attempt counts as a failure unless it is released.
+	Reserve(ctx context.Context, keys ...string) (AttemptReservation, error)
+	// Release takes back a reservation whose attempt did not fail.
+	Release(ctx context.Context, reservation AttemptReservation)
+	Reset(ctx context.Context, keys ...string)
+}
+
+// AttemptReservation is an attempt counted under its keys while it is being verified. The zero value reserves
+// nothing.
+type AttemptReservation struct {
+	reservedAt time.Time
+	previous   map[string]domain.FailedAttempts
+}
+
+type attemptLimiter struct {
+	attemptStore domain.AttemptStore
+	policy       domain.AttemptPolicy
+}
+
+func NewAttemptLimiter(attemptStore domain.AttemptStore, policy domain.AttemptPolicy) AttemptLimiter {
+	return &attemptLimiter{
+		attemptStore: attemptStore,
+		policy:       policy,
+	}
+}
+
+func (l *attemptLimiter) Reserve(ctx context.Context, keys ...string) (AttemptReservation, error) {
+	now := time.Now()
+	reservation := AttemptReservation{reservedAt: now, previous: make(map[string]domain.FailedAttempts, len(keys))}
+
+	var retryAfter time.Duration
+	for _, key := range keys {
+		attempts, err := l.attemptStore.Reserve(ctx, key, now, l.policy.Retention())
+		if err != nil {
+			l.Release(ctx, reservation)
+			return AttemptReservation{}, err
+		}
+
+		reservation.previous[key] = attempts
+		retryAfter = max(retryAfter, l.policy.RetryAfter(attempts, now))
+	}
+
+	if retryAfter > 0 {
+		l.Release(ctx, reservation)
+		return AttemptReservation{}, domain.NewTooManyRequestsError("too many failed attempts, try again later", retryAfter)
+	}
+
+	return reservation, nil
+}
+
+// Release is best effort, so a failing store never hides the outcome of the attempt itself.
+func (l *attemptLimiter) Release(ctx context.Context, reservation AttemptReservation) {
+	for key, previous := range reservation.previous {
+		if err := l.attemptStore.Release(ctx, key, reservation.reservedAt, previous); err != nil {
+			log.Println("error releasing attempt:", err)
+		}
+	}
+}
+
+func (l *attemptLimiter) Reset(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := l.attemptStore.Reset(ctx, key); err != nil {
+			log.Println("error resetting failed attempts:", err)
+		}
+	}
+}
+
+func loginClientKey(ipAddress string) string {
+	return "login:ip:" + ipAddress
+}
+
+func loginAccountKey(email string) string {
+	return "login:account:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func joinClientKey(ipAddress string) string {
+	return "join:ip:" + ipAddress
+}
+
+func joinAccountKey(userID string) string {
+	return "join:account:" + userID
+}
+
+func joinInviteKey(invite string) string {
+	return "join:invite:" + invite
+}
+
+func twoFactorAccountKey(userID string) string {
+	return "two-factor:account:" + userID
+}
+
+func passwordResetClientKey(ipAddress string) string {
+	return "password-reset:ip:" + ipAddress
+}
+
+func passwordResetEmailKey(email string) string {
+	return "password-reset:email:" + strings.ToLower(strings.TrimSpace(email))
+}
+
+func emailVerificationClientKey(ipAddress string) string {
+	return "email-verification:ip:" + ipAddress
+}
+
+func emailVerificationEmailKey(email string) string {
+	return "email-verification:email:" + strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,131 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_attemptLimiter_Reserve(t *testing.T) {
+	policy, _ := domain.NewAttemptPolicy(3, time.Second, time.Minute, 5, 15*time.Minute)
+
+	t.Run("should reserve the attempt under every key when no key has to wait", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptStore := mock_domain.NewMockAttemptStore(mockCtrl)
+		mockedAttemptStore.EXPECT().Reserve(gomock.Any(), "login:ip:203.0.113.10", gomock.Any(), 15*time.Minute).Return(domain.FailedAttempts{Count: 2, LastFailureAt: time.Now()}, nil)
+		mockedAttemptStore.EXPECT().Reserve(gomock.Any(), "login:account:test@mail.com", gomock.Any(), 15*time.Minute).Return(domain.FailedAttempts{}, nil)
+		mockedAttemptStore.EXPECT().Release(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		attemptLimiter := application.NewAttemptLimiter(mockedAttemptStore, policy)
+
+		// when
+		_, err := attemptLimiter.Reserve(context.Background(), "login:ip:203.0.113.10", "login:account:test@mail.com")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return too many requests error with the longest wait of the keys and take the attempt back", func(t *testing.T) {
+		// given
+		ipAttempts := domain.FailedAttempts{Count: 3, LastFailureAt: time.Now()}
+		accountAttempts := domain.FailedAttempts{Count: 5, LastFailureAt: time.Now()}
+
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptStore := mock_domain.NewMockAttemptStore(mockCtrl)
+		mockedAttemptStore.EXPECT().Reserve(gomock.Any(), "login:ip:203.0.113.10", gomock.Any(), gomock.Any()).Return(ipAttempts, nil)
+		mockedAttemptStore.EXPECT().Reserve(gomock.Any(), "login:account:test@mail.com", gomock.Any(), gomock.Any()).Return(accountAttempts, nil)
+		mockedAttemptStore.EXPECT().Release(gomock.Any(), "login:ip:203.0.113.10", gomock.Any(), ipAttempts).Return(nil)
+		mockedAttemptStore.EXPECT().Release(gomock.Any(), "login:account:test@mail.com", gomock.Any(), accountAttempts).Return(nil)
+
+		attemptLimiter := application.NewAttemptLimiter(mockedAttemptStore, policy)
+
+		// when
+		_, err := attemptLimiter.Reserve(context.Background(), "login:ip:203.0.113.10", "login:account:test@mail.com")
+
+		// then
+		var tooManyRequestsErr *domain.TooManyRequestsError
+		assert.ErrorAs(t, err, &tooManyRequestsErr)
+		assert.EqualError(t, tooManyRequestsErr, "too many failed attempts, try again later")
+		assert.InDelta(t, 15*time.Minute, tooManyRequestsErr.RetryAfter, float64(time.Second))
+	})
+
+	t.Run("should return error and take back the keys already reserved when the store fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptStore := mock_domain.NewMockAttemptStore(mockCtrl)
+		mockedAttemptStore.EXPECT().Reserve(gomock.Any(), "login:ip:203.0.113.10", gomock.Any(), gomock.Any()).Return(domain.FailedAttempts{}, nil)
+		mockedAttemptStore.EXPECT().Reserve(gomock.Any(), "login:account:test@mail.com", gomock.Any(), gomock.Any()).Return(domain.FailedAttempts{}, assert.AnError)
+		mockedAttemptStore.EXPECT().Release(gomock.Any(), "login:ip:203.0.113.10", gomock.Any(), domain.FailedAttempts{}).Return(nil)
+
+		attemptLimiter := application.NewAttemptLimiter(mockedAttemptStore, policy)
+
+		// when
+		_, err := attemptLimiter.Reserve(context.Background(), "login:ip:203.0.113.10", "login:account:test@mail.com")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_attemptLimiter_Release(t *testing.T) {
+	t.Run("should take back the attempt under every key at the time it was reserved", func(t *testing.T) {
+		// given
+		policy, _ := domain.NewAttemptPolicy(3, time.Second, time.Minute, 5, 15*time.Minute)
+		previous := domain.FailedAttempts{Count: 1, LastFailureAt: time.Now().Add(-time.Minute)}
+
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptStore := mock_domain.NewMockAttemptStore(mockCtrl)
+
+		var reservedAt time.Time
+		mockedAttemptStore.EXPECT().Reserve(gomock.Any(), "join:ip:203.0.113.10", gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, key string, at time.Time, ttl time.Duration) (domain.FailedAttempts, error) {
+			reservedAt = at
+			return previous, nil
+		})
+		mockedAttemptStore.EXPECT().Reserve(gomock.Any(), "join:invite:ABCD2345", gomock.Any(), gomock.Any()).Return(domain.FailedAttempts{}, nil)
+		mockedAttemptStore.EXPECT().Release(gomock.Any(), "join:ip:203.0.113.10", gomock.Any(), previous).DoAndReturn(func(ctx context.Context, key string, at time.Time, previous domain.FailedAttempts) error {
+			assert.Equal(t, reservedAt, at)
+			return assert.AnError
+		})
+		mockedAttemptStore.EXPECT().Release(gomock.Any(), "join:invite:ABCD2345", gomock.Any(), domain.FailedAttempts{}).Return(nil)
+
+		attemptLimiter := application.NewAttemptLimiter(mockedAttemptStore, policy)
+
+		reservation, err := attemptLimiter.Reserve(context.Background(), "join:ip:203.0.113.10", "join:invite:ABCD2345")
+		assert.NoError(t, err)
+
+		// when
+		attemptLimiter.Release(context.Background(), reservation)
+	})
+
+	t.Run("should do nothing for an empty reservation", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptStore := mock_domain.NewMockAttemptStore(mockCtrl)
+
+		attemptLimiter := application.NewAttemptLimiter(mockedAttemptStore, domain.AttemptPolicy{})
+
+		// when
+		attemptLimiter.Release(context.Background(), application.AttemptReservation{})
+	})
+}
+
+func Test_attemptLimiter_Reset(t *testing.T) {
+	t.Run("should reset every key", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptStore := mock_domain.NewMockAttemptStore(mockCtrl)
+		mockedAttemptStore.EXPECT().Reset(gomock.Any(), "login:account:test@mail.com").Return(nil)
+
+		attemptLimiter := application.NewAttemptLimiter(mockedAttemptStore, domain.AttemptPolicy{})
+
+		// when
+		attemptLimiter.Reset(context.Background(), "login:account:test@mail.com")
+	})
+}
@@ -4,36 +4,88 @@ package application
 
 import (
 	"context"
+	"errors"
+	"log"
 	"time"
 
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 )
 
 type AuthService interface {
-	Login(ctx context.Context, credentials domain.Credentials) (*domain.AuthSession, error)
+	Login(ctx context.Context, credentials domain.Credentials, device domain.Device) (*domain.LoginResult, error)
+	VerifyTwoFactor(ctx context.Context, challengeToken, code string, device domain.Device) (*domain.AuthSession, error)
+	// CompleteLogin logs in a user who was already authenticated by other means, such as single sign-on, still asking
+	// for the second factor when it is enabled.
+	CompleteLogin(ctx context.Context, user domain.User, device domain.Device) (*domain.LoginResult, error)
+	ChangePassword(ctx context.Context, userID, currentPassword, newPassword string, device domain.Device) (*domain.AuthSession, error)
+	ValidateSession(ctx context.Context, userID, sessionID string, issuedAt time.Time, device domain.Device) error
+	Refresh(ctx context.Context, refreshToken string) (*domain.AuthSession, error)
+	Logout(ctx context.Context, refreshToken, userID, sessionID string) error
 }
 
 type authService struct {
-	sessionDuration  time.Duration
-	userRepository   domain.UserRepository
-	passwordManager  domain.PasswordManager
-	authTokenManager domain.AuthTokenManager
+	sessionDuration        time.Duration
+	refreshTokenDuration   time.Duration
+	userRepository         domain.UserRepository
+	sessionRepository      domain.SessionRepository
+	refreshTokenRepository domain.RefreshTokenRepository
+	passwordManager        domain.PasswordManager
+	authTokenManager       domain.AuthTokenManager
+	identityGenerator      domain.IdentityGenerator
+	secretTokenGenerator   domain.SecretTokenGenerator
+	attemptLimiter         AttemptLimiter
+	twoFactorService       TwoFactorService
+	challengeRepository    domain.TwoFactorChallengeRepository
 }
 
-func NewAuthService(sessionDuration time.Duration, userRepository domain.UserRepository, passwordManager domain.PasswordManager, authTokenManager domain.AuthTokenManager) AuthService {
+func NewAuthService(
+	sessionDuration time.Duration,
+	refreshTokenDuration time.Duration,
+	userRepository domain.UserRepository,
+	sessionRepository domain.SessionRepository,
+	refreshTokenRepository domain.RefreshTokenRepository,
+	passwordManager domain.PasswordManager,
+	authTokenManager domain.AuthTokenManager,
+	identityGenerator domain.IdentityGenerator,
+	secretTokenGenerator domain.SecretTokenGenerator,
+	attemptLimiter AttemptLimiter,
+	twoFactorService TwoFactorService,
+	challengeRepository domain.TwoFactorChallengeRepository,
+) AuthService {
 	return &authService{
-		sessionDuration:  sessionDuration,
-		userRepository:   userRepository,
-		passwordManager:  passwordManager,
-		authTokenManager: authTokenManager,
+		sessionDuration:        sessionDuration,
+		refreshTokenDuration:   refreshTokenDuration,
+		userRepository:         userRepository,
+		sessionRepository:      sessionRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		passwordManager:        passwordManager,
+		authTokenManager:       authTokenManager,
+		identityGenerator:      identityGenerator,
+		secretTokenGenerator:   secretTokenGenerator,
+		attemptLimiter:         attemptLimiter,
+		twoFactorService:       twoFactorService,
+		challengeRepository:    challengeRepository,
 	}
 }
 
-func (s *authService) Login(ctx context.Context, credentials domain.Credentials) (*domain.AuthSession, error) {
+// Login throttles failures per client address and per account, so passwords cannot be guessed from one place
+// nor spread across many. The attempt is reserved before the password is compared, so parallel guesses are throttled
+// too. A successful login takes its own attempt back and clears the account counter, but keeps the earlier failures
+// of the address, otherwise an attacker could clear them by logging into an account of their own between guesses;
+// clients sharing an address, such as behind a NAT, therefore share its counter until the failures expire. Users with
+// two-factor authentication get a challenge instead of a session, to be answered with a code in VerifyTwoFactor.
+func (s *authService) Login(ctx context.Context, credentials domain.Credentials, device domain.Device) (*domain.LoginResult, error) {
 	if err := credentials.Validate(); err != nil {
 		return nil, err
 	}
 
+	accountKey := loginAccountKey(credentials.Email)
+
+	reservation, err := s.attemptLimiter.Reserve(ctx, loginClientKey(device.IPAddress), accountKey)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepository.GetByEmail(ctx, credentials.Email)
 	if err != nil {
 		return nil, domain.NewUnauthorizedError("invalid credentials")
@@ -43,12 +95,308 @@ func (s *authService) Login(ctx context.Context, credentials domain.Credentials)
 		return nil, domain.NewUnauthorizedError("invalid credentials")
 	}
 
+	s.attemptLimiter.Release(ctx, reservation)
+	s.attemptLimiter.Reset(ctx, accountKey)
+
+	return s.CompleteLogin(ctx, *user, device)
+}
+
+func (s *authService) CompleteLogin(ctx context.Context, user domain.User, device domain.Device) (*domain.LoginResult, error) {
+	twoFactorEnabled, err := s.twoFactorService.IsEnabled(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if twoFactorEnabled {
+		return s.createTwoFactorChallenge(ctx, user)
+	}
+
+	authSession, err := s.createSession(ctx, user, device)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResult{Session: authSession}, nil
+}
+
+// VerifyTwoFactor finishes a login whose password was accepted, exchanging the challenge and a code from the
+// authenticator app, or a recovery code, for a session. A wrong code leaves the challenge usable until it expires.
+func (s *authService) VerifyTwoFactor(ctx context.Context, challengeToken, code string, device domain.Device) (*domain.AuthSession, error) {
+	challenge, err := s.challengeRepository.GetByTokenHash(ctx, domain.HashSecretToken(challengeToken))
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, domain.NewUnauthorizedError("invalid or expired two-factor challenge")
+		}
+		return nil, err
+	}
+
+	if !challenge.IsUsable() {
+		return nil, domain.NewUnauthorizedError("invalid or expired two-factor challenge")
+	}
+
+	if err := s.twoFactorService.Verify(ctx, challenge.UserID, code); err != nil {
+		return nil, err
+	}
+
+	if err := s.challengeRepository.Redeem(ctx, challenge.ID); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createSession(ctx, *user, device)
+}
+
+// ChangePassword replaces the user's password and returns a new session, since every session started before the change is revoked.
+func (s *authService) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string, device domain.Device) (*domain.AuthSession, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.ChangePassword(s.passwordManager, currentPassword, newPassword); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepository.Update(ctx, *user); err != nil {
+		return nil, err
+	}
+
+	// the old sessions are already rejected for starting before the change, revoking them only keeps the records accurate
+	if err := s.sessionRepository.RevokeAllByUserID(ctx, user.ID); err != nil {
+		log.Println("error revoking sessions after password change:", err)
+	}
+
+	return s.createSession(ctx, *user, device)
+}
+
+// ValidateSession checks that a token, already verified by its signature, still belongs to a live session, and notes
+// the device the session was just used from. Tokens issued before sessions were recorded carry no session ID and are
+// only checked against the user.
+func (s *authService) ValidateSession(ctx context.Context, userID, sessionID string, issuedAt time.Time, device domain.Device) error {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return domain.NewUnauthorizedError("invalid token")
+		}
+		return err
+	}
+
+	if user.IsDeleted() {
+		return domain.NewUnauthorizedError("invalid token")
+	}
+
+	if user.IssuedBeforePasswordChange(issuedAt) {
+		return domain.NewUnauthorizedError("session has been revoked")
+	}
+
+	if sessionID == "" {
+		return nil
+	}
+
+	session, err := s.sessionRepository.GetByID(ctx, sessionID)
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return domain.NewUnauthorizedError("invalid token")
+		}
+		return err
+	}
+
+	if !session.BelongsTo(userID) {
+		return domain.NewUnauthorizedError("invalid token")
+	}
+
+	if session.IsRevoked() {
+		return domain.NewUnauthorizedError("session has been revoked")
+	}
+
+	// failing to record the activity must not reject a valid token
+	if session.RecordActivity(device) {
+		if err := s.sessionRepository.UpdateActivity(ctx, *session); err != nil {
+			log.Println("error recording session activity:", err)
+		}
+	}
+
+	return nil
+}
+
+// Refresh exchanges a refresh token for a new access token and replaces it. A token that was already exchanged means
+// that a copy of it was stolen, so its whole session is revoked and both the client and the attacker must log in again.
+func (s *authService) Refresh(ctx context.Context, token string) (*domain.AuthSession, error) {
+	refreshToken, err := s.getRefreshToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if refreshToken == nil {
+		return nil, domain.NewUnauthorizedError("invalid refresh token")
+	}
+
+	if refreshToken.IsUsed() {
+		return nil, s.revokeReusedSession(ctx, *refreshToken)
+	}
+
+	if !refreshToken.IsUsable() {
+		return nil, domain.NewUnauthorizedError("invalid refresh token")
+	}
+
+	user, err := s.userRepository.GetByID(ctx, refreshToken.UserID)
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, domain.NewUnauthorizedError("invalid refresh token")
+		}
+		return nil, err
+	}
+
+	if user.IsDeleted() || user.IssuedBeforePasswordChange(refreshToken.CreatedAt) {
+		return nil, domain.NewUnauthorizedError("session has been revoked")
+	}
+
+	newToken, err := s.secretTokenGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	replacement, err := refreshToken.Rotate(s.identityGenerator, newToken, s.refreshTokenDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.refreshTokenRepository.Rotate(ctx, refreshToken.ID, *replacement); err != nil {
+		// a concurrent request exchanged the same token first, which is also a reuse
+		var conflictErr *domain.ConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, s.revokeReusedSession(ctx, *refreshToken)
+		}
+		return nil, err
+	}
+
+	return s.newAuthSession(*user, refreshToken.SessionID, newToken, *replacement)
+}
+
+// Logout revokes the session of the refresh token and the session of the user the access token was issued for, which
+// rejects every token of those sessions. Unknown tokens and sessions of other users are ignored, since there is
+// nothing of the caller left to revoke.
+func (s *authService) Logout(ctx context.Context, token, userID, sessionID string) error {
+	refreshToken, err := s.getRefreshToken(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if refreshToken != nil {
+		if err := s.sessionRepository.Revoke(ctx, refreshToken.SessionID); err != nil {
+			return err
+		}
+
+		if refreshToken.SessionID == sessionID {
+			return nil
+		}
+	}
+
+	if sessionID == "" {
+		return nil
+	}
+
+	session, err := s.sessionRepository.GetByID(ctx, sessionID)
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return err
+	}
+
+	if !session.BelongsTo(userID) || session.IsRevoked() {
+		return nil
+	}
+
+	return s.sessionRepository.Revoke(ctx, session.ID)
+}
+
+// getRefreshToken returns nil without error when no token was given or no token matches it.
+func (s *authService) getRefreshToken(ctx context.Context, token string) (*domain.RefreshToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	refreshToken, err := s.refreshTokenRepository.GetByTokenHash(ctx, domain.HashSecretToken(token))
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return refreshToken, nil
+}
+
+func (s *authService) revokeReusedSession(ctx context.Context, refreshToken domain.RefreshToken) error {
+	if err := s.sessionRepository.Revoke(ctx, refreshToken.SessionID); err != nil {
+		return err
+	}
+
+	return domain.NewUnauthorizedError("refresh token has already been used")
+}
+
+func (s *authService) createSession(ctx context.Context, user domain.User, device domain.Device) (*domain.AuthSession, error) {
+	session, err := domain.NewSession(s.identityGenerator, user.ID, device, s.refreshTokenDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.secretTokenGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := domain.NewRefreshToken(s.identityGenerator, *session, token, s.refreshTokenDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sessionRepository.Create(ctx, *session, *refreshToken); err != nil {
+		return nil, err
+	}
+
+	return s.newAuthSession(user, session.ID, token, *refreshToken)
+}
+
+func (s *authService) newAuthSession(user domain.User, sessionID, token string, refreshToken domain.RefreshToken) (*domain.AuthSession, error) {
 	expiresIn := time.Now().Add(s.sessionDuration).Unix()
 
-	token, err := s.authTokenManager.Create(user.ID, expiresIn)
+	accessToken, err := s.authTokenManager.Create(user.ID, sessionID, expiresIn)
 	if err != nil {
 		return nil, err
 	}
 
-	return domain.NewAuthSession(*user, token, s.authTokenManager.GetTokenType(), expiresIn)
+	return domain.NewAuthSession(user, accessToken, s.authTokenManager.GetTokenType(), expiresIn, token, refreshToken.ExpiresAt.Unix())
+}
+
+func (s *authService) createTwoFactorChallenge(ctx context.Context, user domain.User) (*domain.LoginResult, error) {
+	token, err := s.secretTokenGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := domain.NewTwoFactorChallenge(s.identityGenerator, user.ID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.challengeRepository.Create(ctx, *challenge); err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResult{
+		ChallengeToken:     token,
+		ChallengeExpiresIn: challenge.ExpiresAt.Unix(),
+	}, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
@@ -14,6 +15,10 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+const refreshTokenDuration = 30 * 24 * time.Hour
+
+var device = domain.NewDevice("Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0", "203.0.113.10")
+
 func Test_authService_Login(t *testing.T) {
 	t.Run("should return a session successfully", func(t *testing.T) {
 		// given
@@ -26,7 +31,17 @@ func Test_authService_Login(t *testing.T) {
 		credentials := build_domain.NewCredentialsBuilder().WithEmail(email).WithPassword(password).Build()
 		user := build_domain.NewUserBuilder().WithEmail(email).WithPassword(hashedPassword).Build()
 
-		authSession := build_domain.NewAuthSessionBuilder().WithUser(user).WithAccessToken(token).WithTokenType(tokenType).WithExpiresIn(time.Now().Add(sessionDuration).Unix()).Build()
+		sessionID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		refreshTokenID := "0195c1a4-9a1b-7c3d-8e4f-5a6b7c8d9e0f"
+
+		authSession := build_domain.NewAuthSessionBuilder().
+			WithUser(user).
+			WithAccessToken(token).
+			WithTokenType(tokenType).
+			WithExpiresIn(time.Now().Add(sessionDuration).Unix()).
+			WithRefreshToken("some_refresh_token").
+			WithRefreshTokenExpiresIn(time.Now().Add(refreshTokenDuration).Unix()).
+			Build()
 
 		mockCtrl := gomock.NewController(t)
 		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
@@ -36,17 +51,45 @@ func Test_authService_Login(t *testing.T) {
 		mockedPasswordManager.EXPECT().Compare(user.Password, credentials.Password).Return(nil)
 
 		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
-		mockedAuthTokenManager.EXPECT().Create(user.ID, gomock.Any()).Return(token, nil)
+		mockedAuthTokenManager.EXPECT().Create(user.ID, sessionID, gomock.Any()).Return(token, nil)
 		mockedAuthTokenManager.EXPECT().GetTokenType().Return(tokenType)
 
-		authService := application.NewAuthService(sessionDuration, mockedUserRepository, mockedPasswordManager, mockedAuthTokenManager)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(sessionID, nil)
+		mockedIdentityGenerator.EXPECT().Generate().Return(refreshTokenID, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("some_refresh_token", nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, session domain.Session, refreshToken domain.RefreshToken) error {
+			assert.Equal(t, sessionID, session.ID)
+			assert.Equal(t, user.ID, session.UserID)
+			assert.Equal(t, device.IPAddress, session.IPAddress)
+			assert.Equal(t, refreshTokenID, refreshToken.ID)
+			assert.Equal(t, sessionID, refreshToken.SessionID)
+			assert.Equal(t, user.ID, refreshToken.UserID)
+			assert.Equal(t, domain.HashSecretToken("some_refresh_token"), refreshToken.TokenHash)
+			return nil
+		})
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "login:ip:"+device.IPAddress, "login:account:"+email).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+		mockedAttemptLimiter.EXPECT().Reset(gomock.Any(), "login:account:"+email)
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().IsEnabled(gomock.Any(), user.ID).Return(false, nil)
+
+		authService := application.NewAuthService(sessionDuration, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, nil, mockedPasswordManager, mockedAuthTokenManager, mockedIdentityGenerator, mockedSecretTokenGenerator, mockedAttemptLimiter, mockedTwoFactorService, nil)
 
 		// when
-		result, err := authService.Login(context.Background(), credentials)
+		result, err := authService.Login(context.Background(), credentials, device)
 
 		// then
 		assert.NoError(t, err)
-		assert.Equal(t, authSession, *result)
+		assert.False(t, result.RequiresTwoFactor())
+		assert.Equal(t, authSession, *result.Session)
 	})
 
 	t.Run("should return an error when token creation fails", func(t *testing.T) {
@@ -55,6 +98,8 @@ func Test_authService_Login(t *testing.T) {
 		password := "some_password"
 		hashedPassword := "some_hashed_password"
 		sessionDuration := time.Hour
+		sessionID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		refreshTokenID := "0195c1a4-9a1b-7c3d-8e4f-5a6b7c8d9e0f"
 		credentials := build_domain.NewCredentialsBuilder().WithEmail(email).WithPassword(password).Build()
 		user := build_domain.NewUserBuilder().WithEmail(email).WithPassword(hashedPassword).Build()
 
@@ -66,12 +111,39 @@ func Test_authService_Login(t *testing.T) {
 		mockedPasswordManager.EXPECT().Compare(user.Password, credentials.Password).Return(nil)
 
 		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
-		mockedAuthTokenManager.EXPECT().Create(user.ID, gomock.Any()).Return("", assert.AnError)
+		mockedAuthTokenManager.EXPECT().Create(user.ID, sessionID, gomock.Any()).Return("", assert.AnError)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(sessionID, nil)
+		mockedIdentityGenerator.EXPECT().Generate().Return(refreshTokenID, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("some_refresh_token", nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, session domain.Session, refreshToken domain.RefreshToken) error {
+			assert.Equal(t, sessionID, session.ID)
+			assert.Equal(t, user.ID, session.UserID)
+			assert.Equal(t, device.IPAddress, session.IPAddress)
+			assert.Equal(t, refreshTokenID, refreshToken.ID)
+			assert.Equal(t, sessionID, refreshToken.SessionID)
+			assert.Equal(t, user.ID, refreshToken.UserID)
+			assert.Equal(t, domain.HashSecretToken("some_refresh_token"), refreshToken.TokenHash)
+			return nil
+		})
 
-		authService := application.NewAuthService(sessionDuration, mockedUserRepository, mockedPasswordManager, mockedAuthTokenManager)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "login:ip:"+device.IPAddress, "login:account:"+email).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+		mockedAttemptLimiter.EXPECT().Reset(gomock.Any(), "login:account:"+email)
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().IsEnabled(gomock.Any(), user.ID).Return(false, nil)
+
+		authService := application.NewAuthService(sessionDuration, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, nil, mockedPasswordManager, mockedAuthTokenManager, mockedIdentityGenerator, mockedSecretTokenGenerator, mockedAttemptLimiter, mockedTwoFactorService, nil)
 
 		// when
-		result, err := authService.Login(context.Background(), credentials)
+		result, err := authService.Login(context.Background(), credentials, device)
 
 		// then
 		assert.Nil(t, result)
@@ -95,10 +167,14 @@ func Test_authService_Login(t *testing.T) {
 		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
 		mockedPasswordManager.EXPECT().Compare(user.Password, credentials.Password).Return(assert.AnError)
 
-		authService := application.NewAuthService(sessionDuration, mockedUserRepository, mockedPasswordManager, nil)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any()).Times(0)
+
+		authService := application.NewAuthService(sessionDuration, refreshTokenDuration, mockedUserRepository, nil, nil, mockedPasswordManager, nil, nil, nil, mockedAttemptLimiter, nil, nil)
 
 		// when
-		result, err := authService.Login(context.Background(), credentials)
+		result, err := authService.Login(context.Background(), credentials, device)
 
 		// then
 		assert.Nil(t, result)
@@ -119,10 +195,14 @@ func Test_authService_Login(t *testing.T) {
 		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
 		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), credentials.Email).Return(nil, assert.AnError)
 
-		authService := application.NewAuthService(sessionDuration, mockedUserRepository, nil, nil)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any()).Times(0)
+
+		authService := application.NewAuthService(sessionDuration, refreshTokenDuration, mockedUserRepository, nil, nil, nil, nil, nil, nil, mockedAttemptLimiter, nil, nil)
 
 		// when
-		result, err := authService.Login(context.Background(), credentials)
+		result, err := authService.Login(context.Background(), credentials, device)
 
 		// then
 		assert.Nil(t, result)
@@ -137,10 +217,10 @@ func Test_authService_Login(t *testing.T) {
 		sessionDuration := time.Hour
 		credentials := build_domain.NewCredentialsBuilder().WithEmail("").WithPassword("").Build()
 
-		authService := application.NewAuthService(sessionDuration, nil, nil, nil)
+		authService := application.NewAuthService(sessionDuration, refreshTokenDuration, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
-		result, err := authService.Login(context.Background(), credentials)
+		result, err := authService.Login(context.Background(), credentials, device)
 
 		// then
 		assert.Nil(t, result)
@@ -153,4 +233,806 @@ func Test_authService_Login(t *testing.T) {
 		assert.Contains(t, errors, validator.FieldError{Field: "Email", Error: "Email is a required field"})
 		assert.Contains(t, errors, validator.FieldError{Field: "Password", Error: "Password is a required field"})
 	})
+
+	t.Run("should return too many requests error without looking up the user while the client has to wait", func(t *testing.T) {
+		// given
+		credentials := build_domain.NewCredentialsBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, domain.NewTooManyRequestsError("too many failed attempts, try again later", time.Minute))
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, nil, nil, nil, nil, nil, mockedAttemptLimiter, nil, nil)
+
+		// when
+		result, err := authService.Login(context.Background(), credentials, device)
+
+		// then
+		assert.Nil(t, result)
+		var tooManyRequestsErr *domain.TooManyRequestsError
+		assert.ErrorAs(t, err, &tooManyRequestsErr)
+		assert.Equal(t, time.Minute, tooManyRequestsErr.RetryAfter)
+	})
+
+	t.Run("should return a two-factor challenge instead of a session when the user enabled it", func(t *testing.T) {
+		// given
+		credentials := build_domain.NewCredentialsBuilder().Build()
+		user := build_domain.NewUserBuilder().WithEmail(credentials.Email).Build()
+		challengeID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), credentials.Email).Return(&user, nil)
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare(user.Password, credentials.Password).Return(nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+		mockedAttemptLimiter.EXPECT().Reset(gomock.Any(), gomock.Any())
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().IsEnabled(gomock.Any(), user.ID).Return(true, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("some_challenge_token", nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(challengeID, nil)
+
+		mockedChallengeRepository := mock_domain.NewMockTwoFactorChallengeRepository(mockCtrl)
+		mockedChallengeRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, challenge domain.TwoFactorChallenge) error {
+			assert.Equal(t, challengeID, challenge.ID)
+			assert.Equal(t, user.ID, challenge.UserID)
+			assert.Equal(t, domain.HashSecretToken("some_challenge_token"), challenge.TokenHash)
+			return nil
+		})
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, nil, nil, mockedPasswordManager, nil, mockedIdentityGenerator, mockedSecretTokenGenerator, mockedAttemptLimiter, mockedTwoFactorService, mockedChallengeRepository)
+
+		// when
+		result, err := authService.Login(context.Background(), credentials, device)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, result.RequiresTwoFactor())
+		assert.Nil(t, result.Session)
+		assert.Equal(t, "some_challenge_token", result.ChallengeToken)
+		assert.InDelta(t, time.Now().Add(domain.TwoFactorChallengeDuration).Unix(), result.ChallengeExpiresIn, 1)
+	})
+}
+
+func Test_authService_CompleteLogin(t *testing.T) {
+	t.Run("should create a session without asking for the password", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		sessionID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		refreshTokenID := "0195c1a4-9a1b-7c3d-8e4f-5a6b7c8d9e0f"
+
+		mockCtrl := gomock.NewController(t)
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().IsEnabled(gomock.Any(), user.ID).Return(false, nil)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().Create(user.ID, sessionID, gomock.Any()).Return("some_token", nil)
+		mockedAuthTokenManager.EXPECT().GetTokenType().Return("Bearer")
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(sessionID, nil)
+		mockedIdentityGenerator.EXPECT().Generate().Return(refreshTokenID, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("some_refresh_token", nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, mockedSessionRepository, nil, nil, mockedAuthTokenManager, mockedIdentityGenerator, mockedSecretTokenGenerator, nil, mockedTwoFactorService, nil)
+
+		// when
+		result, err := authService.CompleteLogin(context.Background(), user, device)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, result.RequiresTwoFactor())
+		assert.Equal(t, "some_token", result.Session.AccessToken)
+		assert.Equal(t, "some_refresh_token", result.Session.RefreshToken)
+	})
+
+	t.Run("should return the error when checking two-factor authentication fails", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().IsEnabled(gomock.Any(), user.ID).Return(false, assert.AnError)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, nil, nil, nil, nil, nil, nil, mockedTwoFactorService, nil)
+
+		// when
+		result, err := authService.CompleteLogin(context.Background(), user, device)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_authService_ChangePassword(t *testing.T) {
+	t.Run("should change the password, revoke the other sessions and return a new session", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("old_hash").Build()
+		sessionDuration := time.Hour
+		sessionID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		refreshTokenID := "0195c1a4-9a1b-7c3d-8e4f-5a6b7c8d9e0f"
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedUser domain.User) error {
+			assert.Equal(t, "new_hash", updatedUser.Password)
+			assert.NotNil(t, updatedUser.PasswordChangedAt)
+			return nil
+		})
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("old_hash", "current-password").Return(nil)
+		mockedPasswordManager.EXPECT().Hash("new-password").Return("new_hash", nil)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().Create(user.ID, sessionID, gomock.Any()).Return("new_token", nil)
+		mockedAuthTokenManager.EXPECT().GetTokenType().Return("Bearer")
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(sessionID, nil)
+		mockedIdentityGenerator.EXPECT().Generate().Return(refreshTokenID, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("some_refresh_token", nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().RevokeAllByUserID(gomock.Any(), user.ID).Return(nil)
+		mockedSessionRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, session domain.Session, refreshToken domain.RefreshToken) error {
+			assert.Equal(t, sessionID, session.ID)
+			assert.Equal(t, user.ID, session.UserID)
+			assert.Equal(t, device.IPAddress, session.IPAddress)
+			assert.Equal(t, refreshTokenID, refreshToken.ID)
+			assert.Equal(t, sessionID, refreshToken.SessionID)
+			assert.Equal(t, user.ID, refreshToken.UserID)
+			assert.Equal(t, domain.HashSecretToken("some_refresh_token"), refreshToken.TokenHash)
+			return nil
+		})
+
+		authService := application.NewAuthService(sessionDuration, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, nil, mockedPasswordManager, mockedAuthTokenManager, mockedIdentityGenerator, mockedSecretTokenGenerator, nil, nil, nil)
+
+		// when
+		result, err := authService.ChangePassword(context.Background(), user.ID, "current-password", "new-password", device)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "new_token", result.AccessToken)
+		assert.Equal(t, "some_refresh_token", result.RefreshToken)
+		assert.Equal(t, user.ID, result.User.ID)
+	})
+
+	t.Run("should return a validation error without saving when the current password is wrong", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("old_hash").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("old_hash", "wrong-password").Return(assert.AnError)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, nil, nil, mockedPasswordManager, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := authService.ChangePassword(context.Background(), user.ID, "wrong-password", "new-password", device)
+
+		// then
+		assert.Nil(t, result)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should return an error when saving the user fails", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("old_hash").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("old_hash", "current-password").Return(nil)
+		mockedPasswordManager.EXPECT().Hash("new-password").Return("new_hash", nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, nil, nil, mockedPasswordManager, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := authService.ChangePassword(context.Background(), user.ID, "current-password", "new-password", device)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_authService_ValidateSession(t *testing.T) {
+	t.Run("should accept a token without session started after the last password change", func(t *testing.T) {
+		// given
+		changedAt := time.Now().Add(-time.Hour)
+		user := build_domain.NewUserBuilder().WithPasswordChangedAt(&changedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.ValidateSession(context.Background(), user.ID, "", time.Now(), device)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should accept a token of a live session", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		session := build_domain.NewSessionBuilder().WithUserID(user.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), session.ID).Return(&session, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.ValidateSession(context.Background(), user.ID, session.ID, time.Now(), device)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should record the activity of a session not seen for a while", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		session := build_domain.NewSessionBuilder().WithUserID(user.ID).WithLastSeenAt(time.Now().Add(-time.Hour)).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), session.ID).Return(&session, nil)
+		mockedSessionRepository.EXPECT().UpdateActivity(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedSession domain.Session) error {
+			assert.Equal(t, session.ID, updatedSession.ID)
+			assert.WithinDuration(t, time.Now(), updatedSession.LastSeenAt, time.Second)
+			return assert.AnError
+		})
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.ValidateSession(context.Background(), user.ID, session.ID, time.Now(), device)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return unauthorized error when the session has been revoked", func(t *testing.T) {
+		// given
+		revokedAt := time.Now().Add(-time.Minute)
+		user := build_domain.NewUserBuilder().Build()
+		session := build_domain.NewSessionBuilder().WithUserID(user.ID).WithRevokedAt(&revokedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), session.ID).Return(&session, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.ValidateSession(context.Background(), user.ID, session.ID, time.Now(), device)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "session has been revoked")
+	})
+
+	t.Run("should return unauthorized error when the session belongs to another user", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		session := build_domain.NewSessionBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), session.ID).Return(&session, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.ValidateSession(context.Background(), user.ID, session.ID, time.Now(), device)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid token")
+	})
+
+	t.Run("should return unauthorized error when the session does not exist", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), "some-session-id").Return(nil, domain.NewResourceNotFoundError("session not found"))
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.ValidateSession(context.Background(), user.ID, "some-session-id", time.Now(), device)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+
+	t.Run("should return unauthorized error for a session started before the password change", func(t *testing.T) {
+		// given
+		changedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithPasswordChangedAt(&changedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.ValidateSession(context.Background(), user.ID, "", changedAt.Add(-time.Hour), device)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "session has been revoked")
+	})
+
+	t.Run("should return unauthorized error when the account was deleted", func(t *testing.T) {
+		// given
+		deletedAt := time.Now().Add(-time.Hour)
+		user := build_domain.NewUserBuilder().WithDeletedAt(&deletedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.ValidateSession(context.Background(), user.ID, "", time.Now(), device)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid token")
+	})
+
+	t.Run("should return unauthorized error when the user no longer exists", func(t *testing.T) {
+		// given
+		userID := "some-user-id"
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), userID).Return(nil, domain.NewResourceNotFoundError("user not found"))
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.ValidateSession(context.Background(), userID, "", time.Now(), device)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+}
+
+func Test_authService_Refresh(t *testing.T) {
+	t.Run("should replace the refresh token and return a new session", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithUserID(user.ID).WithTokenHash(domain.HashSecretToken("old_refresh_token")).Build()
+		replacementID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+
+		mockCtrl := gomock.NewController(t)
+		mockedRefreshTokenRepository := mock_domain.NewMockRefreshTokenRepository(mockCtrl)
+		mockedRefreshTokenRepository.EXPECT().GetByTokenHash(gomock.Any(), domain.HashSecretToken("old_refresh_token")).Return(&refreshToken, nil)
+		mockedRefreshTokenRepository.EXPECT().Rotate(gomock.Any(), refreshToken.ID, gomock.Any()).DoAndReturn(func(ctx context.Context, tokenID string, replacement domain.RefreshToken) error {
+			assert.Equal(t, replacementID, replacement.ID)
+			assert.Equal(t, refreshToken.SessionID, replacement.SessionID)
+			assert.Equal(t, domain.HashSecretToken("new_refresh_token"), replacement.TokenHash)
+			return nil
+		})
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(replacementID, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("new_refresh_token", nil)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().Create(user.ID, refreshToken.SessionID, gomock.Any()).Return("new_token", nil)
+		mockedAuthTokenManager.EXPECT().GetTokenType().Return("Bearer")
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, nil, mockedRefreshTokenRepository, nil, mockedAuthTokenManager, mockedIdentityGenerator, mockedSecretTokenGenerator, nil, nil, nil)
+
+		// when
+		result, err := authService.Refresh(context.Background(), "old_refresh_token")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID, result.User.ID)
+		assert.Equal(t, "new_token", result.AccessToken)
+		assert.Equal(t, "new_refresh_token", result.RefreshToken)
+	})
+
+	t.Run("should revoke the whole session when a used token is presented again", func(t *testing.T) {
+		// given
+		usedAt := time.Now().Add(-time.Minute)
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithUsedAt(&usedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedRefreshTokenRepository := mock_domain.NewMockRefreshTokenRepository(mockCtrl)
+		mockedRefreshTokenRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&refreshToken, nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().Revoke(gomock.Any(), refreshToken.SessionID).Return(nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, mockedSessionRepository, mockedRefreshTokenRepository, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := authService.Refresh(context.Background(), "stolen_refresh_token")
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "refresh token has already been used")
+	})
+
+	t.Run("should revoke the whole session when a concurrent request exchanged the token first", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithUserID(user.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedRefreshTokenRepository := mock_domain.NewMockRefreshTokenRepository(mockCtrl)
+		mockedRefreshTokenRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&refreshToken, nil)
+		mockedRefreshTokenRepository.EXPECT().Rotate(gomock.Any(), refreshToken.ID, gomock.Any()).Return(domain.NewConflictError("refresh token has already been used, has been revoked or has expired"))
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().Revoke(gomock.Any(), refreshToken.SessionID).Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("new_refresh_token", nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, mockedRefreshTokenRepository, nil, nil, mockedIdentityGenerator, mockedSecretTokenGenerator, nil, nil, nil)
+
+		// when
+		result, err := authService.Refresh(context.Background(), "some_refresh_token")
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+
+	t.Run("should return unauthorized error when the token is unknown", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedRefreshTokenRepository := mock_domain.NewMockRefreshTokenRepository(mockCtrl)
+		mockedRefreshTokenRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(nil, domain.NewResourceNotFoundError("refresh token not found"))
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, mockedRefreshTokenRepository, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := authService.Refresh(context.Background(), "unknown_refresh_token")
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid refresh token")
+	})
+
+	t.Run("should return unauthorized error without revoking the session when the token has expired", func(t *testing.T) {
+		// given
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithExpiresAt(time.Now().Add(-time.Minute)).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedRefreshTokenRepository := mock_domain.NewMockRefreshTokenRepository(mockCtrl)
+		mockedRefreshTokenRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&refreshToken, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, mockedRefreshTokenRepository, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := authService.Refresh(context.Background(), "expired_refresh_token")
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid refresh token")
+	})
+
+	t.Run("should return unauthorized error when the token was issued before the password change", func(t *testing.T) {
+		// given
+		changedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithPasswordChangedAt(&changedAt).Build()
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithUserID(user.ID).WithCreatedAt(changedAt.Add(-time.Hour)).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedRefreshTokenRepository := mock_domain.NewMockRefreshTokenRepository(mockCtrl)
+		mockedRefreshTokenRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&refreshToken, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, nil, mockedRefreshTokenRepository, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := authService.Refresh(context.Background(), "some_refresh_token")
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "session has been revoked")
+	})
+
+	t.Run("should return unauthorized error when no token is given", func(t *testing.T) {
+		// given
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := authService.Refresh(context.Background(), "")
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+}
+
+func Test_authService_Logout(t *testing.T) {
+	t.Run("should revoke the session of the refresh token", func(t *testing.T) {
+		// given
+		refreshToken := build_domain.NewRefreshTokenBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedRefreshTokenRepository := mock_domain.NewMockRefreshTokenRepository(mockCtrl)
+		mockedRefreshTokenRepository.EXPECT().GetByTokenHash(gomock.Any(), domain.HashSecretToken("some_refresh_token")).Return(&refreshToken, nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().Revoke(gomock.Any(), refreshToken.SessionID).Return(nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, mockedSessionRepository, mockedRefreshTokenRepository, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.Logout(context.Background(), "some_refresh_token", "", "")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should ignore an unknown refresh token", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedRefreshTokenRepository := mock_domain.NewMockRefreshTokenRepository(mockCtrl)
+		mockedRefreshTokenRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(nil, domain.NewResourceNotFoundError("refresh token not found"))
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, mockedRefreshTokenRepository, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.Logout(context.Background(), "unknown_refresh_token", "", "")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should do nothing when no refresh token is given", func(t *testing.T) {
+		// given
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.Logout(context.Background(), "", "", "")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should revoke the session of the access token when no refresh token is given", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), session.ID).Return(&session, nil)
+		mockedSessionRepository.EXPECT().Revoke(gomock.Any(), session.ID).Return(nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, mockedSessionRepository, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.Logout(context.Background(), "", session.UserID, session.ID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should revoke the session of the refresh token only once when the access token names the same session", func(t *testing.T) {
+		// given
+		refreshToken := build_domain.NewRefreshTokenBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedRefreshTokenRepository := mock_domain.NewMockRefreshTokenRepository(mockCtrl)
+		mockedRefreshTokenRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&refreshToken, nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().Revoke(gomock.Any(), refreshToken.SessionID).Return(nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, mockedSessionRepository, mockedRefreshTokenRepository, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.Logout(context.Background(), "some_refresh_token", refreshToken.UserID, refreshToken.SessionID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not revoke a session of another user", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), session.ID).Return(&session, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, mockedSessionRepository, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := authService.Logout(context.Background(), "", "another-user-id", session.ID)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func Test_authService_VerifyTwoFactor(t *testing.T) {
+	t.Run("should exchange the challenge and a valid code for a session", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		challenge := build_domain.NewTwoFactorChallengeBuilder().WithUserID(user.ID).WithTokenHash(domain.HashSecretToken("some_challenge_token")).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedChallengeRepository := mock_domain.NewMockTwoFactorChallengeRepository(mockCtrl)
+		mockedChallengeRepository.EXPECT().GetByTokenHash(gomock.Any(), challenge.TokenHash).Return(&challenge, nil)
+		mockedChallengeRepository.EXPECT().Redeem(gomock.Any(), challenge.ID).Return(nil)
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().Verify(gomock.Any(), user.ID, "123456").Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-9a1b-7c3d-8e4f-5a6b7c8d9e0f", nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("some_refresh_token", nil)
+
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().Create(user.ID, "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", gomock.Any()).Return("some_token", nil)
+		mockedAuthTokenManager.EXPECT().GetTokenType().Return("Bearer")
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, mockedUserRepository, mockedSessionRepository, nil, nil, mockedAuthTokenManager, mockedIdentityGenerator, mockedSecretTokenGenerator, nil, mockedTwoFactorService, mockedChallengeRepository)
+
+		// when
+		result, err := authService.VerifyTwoFactor(context.Background(), "some_challenge_token", "123456", device)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID, result.User.ID)
+		assert.Equal(t, "some_token", result.AccessToken)
+		assert.Equal(t, "some_refresh_token", result.RefreshToken)
+	})
+
+	t.Run("should return unauthorized error when the challenge does not exist", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedChallengeRepository := mock_domain.NewMockTwoFactorChallengeRepository(mockCtrl)
+		mockedChallengeRepository.EXPECT().GetByTokenHash(gomock.Any(), domain.HashSecretToken("some_challenge_token")).Return(nil, domain.NewResourceNotFoundError("two-factor challenge not found"))
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockedChallengeRepository)
+
+		// when
+		result, err := authService.VerifyTwoFactor(context.Background(), "some_challenge_token", "123456", device)
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid or expired two-factor challenge")
+	})
+
+	t.Run("should return unauthorized error without checking the code when the challenge has expired", func(t *testing.T) {
+		// given
+		challenge := build_domain.NewTwoFactorChallengeBuilder().WithExpiresAt(time.Now().Add(-time.Second)).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedChallengeRepository := mock_domain.NewMockTwoFactorChallengeRepository(mockCtrl)
+		mockedChallengeRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&challenge, nil)
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockedChallengeRepository)
+
+		// when
+		result, err := authService.VerifyTwoFactor(context.Background(), "some_challenge_token", "123456", device)
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+
+	t.Run("should keep the challenge usable when the code is wrong", func(t *testing.T) {
+		// given
+		challenge := build_domain.NewTwoFactorChallengeBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedChallengeRepository := mock_domain.NewMockTwoFactorChallengeRepository(mockCtrl)
+		mockedChallengeRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&challenge, nil)
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().Verify(gomock.Any(), challenge.UserID, "000000").Return(domain.NewValidationError(validator.ValidationErrors{{Field: "Code", Error: "Code is incorrect"}}))
+
+		authService := application.NewAuthService(time.Hour, refreshTokenDuration, nil, nil, nil, nil, nil, nil, nil, nil, mockedTwoFactorService, mockedChallengeRepository)
+
+		// when
+		result, err := authService.VerifyTwoFactor(context.Background(), "some_challenge_token", "000000", device)
+
+		// then
+		assert.Nil(t, result)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
 }
@@ -0,0 +1,135 @@
+package application
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_application/avatar_service.go . AvatarService
+
+import (
+	"context"
+	"log"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type AvatarService interface {
+	Upload(ctx context.Context, userID string, content []byte) (*domain.User, error)
+	Remove(ctx context.Context, userID string) (*domain.User, error)
+	Get(ctx context.Context, userID string, size domain.AvatarSize) (*domain.Blob, error)
+}
+
+type avatarService struct {
+	userRepository    domain.UserRepository
+	blobStorage       domain.BlobStorage
+	imageProcessor    domain.ImageProcessor
+	identityGenerator domain.IdentityGenerator
+	maxUploadSize     int
+}
+
+func NewAvatarService(
+	userRepository domain.UserRepository,
+	blobStorage domain.BlobStorage,
+	imageProcessor domain.ImageProcessor,
+	identityGenerator domain.IdentityGenerator,
+	maxUploadSize int,
+) AvatarService {
+	return &avatarService{
+		userRepository:    userRepository,
+		blobStorage:       blobStorage,
+		imageProcessor:    imageProcessor,
+		identityGenerator: identityGenerator,
+		maxUploadSize:     maxUploadSize,
+	}
+}
+
+// Upload stores a thumbnail of every size of the picture and makes it the avatar of the user. The thumbnails of
+// the previous avatar are only deleted once the user points to the new ones.
+func (s *avatarService) Upload(ctx context.Context, userID string, content []byte) (*domain.User, error) {
+	if err := domain.ValidateAvatarUpload(content, s.maxUploadSize); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	avatarID, err := s.identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	pixels := make([]int, 0, len(domain.AvatarSizes))
+	for _, size := range domain.AvatarSizes {
+		pixels = append(pixels, size.Pixels())
+	}
+
+	thumbnails, err := s.imageProcessor.Thumbnails(content, pixels)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, size := range domain.AvatarSizes {
+		blob := domain.Blob{Content: thumbnails[i], ContentType: domain.AvatarContentType}
+		if err := s.blobStorage.Put(ctx, domain.AvatarKey(user.ID, avatarID, size), blob); err != nil {
+			return nil, err
+		}
+	}
+
+	previousUser := *user
+	user.SetAvatar(avatarID)
+
+	if err := s.userRepository.Update(ctx, *user); err != nil {
+		return nil, err
+	}
+
+	deleteAvatar(ctx, s.blobStorage, previousUser)
+
+	return user, nil
+}
+
+func (s *avatarService) Remove(ctx context.Context, userID string) (*domain.User, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasAvatar() {
+		return user, nil
+	}
+
+	previousUser := *user
+	user.RemoveAvatar()
+
+	if err := s.userRepository.Update(ctx, *user); err != nil {
+		return nil, err
+	}
+
+	deleteAvatar(ctx, s.blobStorage, previousUser)
+
+	return user, nil
+}
+
+// Get returns the thumbnail of the current avatar of the user. Avatars are public, so no requester is checked.
+func (s *avatarService) Get(ctx context.Context, userID string, size domain.AvatarSize) (*domain.Blob, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasAvatar() {
+		return nil, domain.NewResourceNotFoundError("avatar not found")
+	}
+
+	return s.blobStorage.Get(ctx, user.AvatarKey(size))
+}
+
+// deleteAvatar is best effort: the user no longer points to the thumbnails, so a failure only leaves unused files behind.
+func deleteAvatar(ctx context.Context, blobStorage domain.BlobStorage, user domain.User) {
+	if !user.HasAvatar() {
+		return
+	}
+
+	for _, size := range domain.AvatarSizes {
+		if err := blobStorage.Delete(ctx, user.AvatarKey(size)); err != nil {
+			log.Println("error deleting avatar:", err)
+		}
+	}
+}
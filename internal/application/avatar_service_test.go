@@ -0,0 +1,196 @@
+package application_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+const avatarMaxUploadSize = 1024 * 1024
+
+func buildAvatarPicture(t *testing.T) []byte {
+	var picture bytes.Buffer
+	if err := png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatal(err)
+	}
+	return picture.Bytes()
+}
+
+func Test_avatarService_Upload(t *testing.T) {
+	t.Run("should store every thumbnail and replace the previous avatar", func(t *testing.T) {
+		// given
+		picture := buildAvatarPicture(t)
+		user := build_domain.NewUserBuilder().WithAvatarID("old-avatar-id").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedUser domain.User) error {
+			assert.Equal(t, "new-avatar-id", updatedUser.AvatarID)
+			return nil
+		})
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("new-avatar-id", nil)
+
+		mockedImageProcessor := mock_domain.NewMockImageProcessor(mockCtrl)
+		mockedImageProcessor.EXPECT().Thumbnails(picture, []int{64, 128, 256}).Return([][]byte{[]byte("small"), []byte("medium"), []byte("large")}, nil)
+
+		mockedBlobStorage := mock_domain.NewMockBlobStorage(mockCtrl)
+		for _, size := range domain.AvatarSizes {
+			thumbnail := []byte(string(size))
+			mockedBlobStorage.EXPECT().Put(gomock.Any(), domain.AvatarKey(user.ID, "new-avatar-id", size), domain.Blob{Content: thumbnail, ContentType: "image/png"}).Return(nil)
+			mockedBlobStorage.EXPECT().Delete(gomock.Any(), domain.AvatarKey(user.ID, "old-avatar-id", size)).Return(nil)
+		}
+
+		avatarService := application.NewAvatarService(mockedUserRepository, mockedBlobStorage, mockedImageProcessor, mockedIdentityGenerator, avatarMaxUploadSize)
+
+		// when
+		result, err := avatarService.Upload(context.Background(), user.ID, picture)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "new-avatar-id", result.AvatarID)
+	})
+
+	t.Run("should keep the previous avatar when a thumbnail cannot be rendered", func(t *testing.T) {
+		// given
+		picture := buildAvatarPicture(t)
+		user := build_domain.NewUserBuilder().WithAvatarID("old-avatar-id").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("new-avatar-id", nil)
+
+		mockedImageProcessor := mock_domain.NewMockImageProcessor(mockCtrl)
+		mockedImageProcessor.EXPECT().Thumbnails(picture, gomock.Any()).Return(nil, assert.AnError)
+
+		avatarService := application.NewAvatarService(mockedUserRepository, nil, mockedImageProcessor, mockedIdentityGenerator, avatarMaxUploadSize)
+
+		// when
+		result, err := avatarService.Upload(context.Background(), user.ID, picture)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return validation error before loading the user when the upload is not a picture", func(t *testing.T) {
+		// given
+		avatarService := application.NewAvatarService(nil, nil, nil, nil, avatarMaxUploadSize)
+
+		// when
+		result, err := avatarService.Upload(context.Background(), "some-user-id", []byte("not a picture"))
+
+		// then
+		assert.Nil(t, result)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_avatarService_Remove(t *testing.T) {
+	t.Run("should remove the avatar and delete its thumbnails", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithAvatarID("some-avatar-id").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedUser domain.User) error {
+			assert.False(t, updatedUser.HasAvatar())
+			return nil
+		})
+
+		mockedBlobStorage := mock_domain.NewMockBlobStorage(mockCtrl)
+		mockedBlobStorage.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(assert.AnError).Times(len(domain.AvatarSizes))
+
+		avatarService := application.NewAvatarService(mockedUserRepository, mockedBlobStorage, nil, nil, avatarMaxUploadSize)
+
+		// when
+		result, err := avatarService.Remove(context.Background(), user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, result.HasAvatar())
+	})
+
+	t.Run("should do nothing when the user has no avatar", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		avatarService := application.NewAvatarService(mockedUserRepository, nil, nil, nil, avatarMaxUploadSize)
+
+		// when
+		result, err := avatarService.Remove(context.Background(), user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, user, *result)
+	})
+}
+
+func Test_avatarService_Get(t *testing.T) {
+	t.Run("should return the thumbnail of the current avatar", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithAvatarID("some-avatar-id").Build()
+		blob := domain.Blob{Content: []byte("thumbnail"), ContentType: "image/png"}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedBlobStorage := mock_domain.NewMockBlobStorage(mockCtrl)
+		mockedBlobStorage.EXPECT().Get(gomock.Any(), user.AvatarKey(domain.AvatarSizeLarge)).Return(&blob, nil)
+
+		avatarService := application.NewAvatarService(mockedUserRepository, mockedBlobStorage, nil, nil, avatarMaxUploadSize)
+
+		// when
+		result, err := avatarService.Get(context.Background(), user.ID, domain.AvatarSizeLarge)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, &blob, result)
+	})
+
+	t.Run("should return not found error when the user has no avatar", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		avatarService := application.NewAvatarService(mockedUserRepository, nil, nil, nil, avatarMaxUploadSize)
+
+		// when
+		result, err := avatarService.Get(context.Background(), user.ID, domain.AvatarSizeSmall)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
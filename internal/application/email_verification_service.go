@@ -0,0 +1,157 @@
+package application
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_application/email_verification_service.go . EmailVerificationService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+type EmailVerificationService interface {
+	SendVerification(ctx context.Context, userID, ipAddress string) error
+	Confirm(ctx context.Context, token string) error
+}
+
+const emailVerificationEmailSubject = "Confirm your Mystery Gifter email address"
+
+type emailVerificationService struct {
+	emailVerificationRepository domain.EmailVerificationRepository
+	userRepository              domain.UserRepository
+	groupInviteService          GroupInviteService
+	identityGenerator           domain.IdentityGenerator
+	secretTokenGenerator        domain.SecretTokenGenerator
+	mailer                      domain.Mailer
+	attemptLimiter              AttemptLimiter
+	tokenExpiration             time.Duration
+	verificationBaseURL         string
+}
+
+func NewEmailVerificationService(
+	emailVerificationRepository domain.EmailVerificationRepository,
+	userRepository domain.UserRepository,
+	groupInviteService GroupInviteService,
+	identityGenerator domain.IdentityGenerator,
+	secretTokenGenerator domain.SecretTokenGenerator,
+	mailer domain.Mailer,
+	attemptLimiter AttemptLimiter,
+	tokenExpiration time.Duration,
+	verificationBaseURL string,
+) EmailVerificationService {
+	return &emailVerificationService{
+		emailVerificationRepository: emailVerificationRepository,
+		userRepository:              userRepository,
+		groupInviteService:          groupInviteService,
+		identityGenerator:           identityGenerator,
+		secretTokenGenerator:        secretTokenGenerator,
+		mailer:                      mailer,
+		attemptLimiter:              attemptLimiter,
+		tokenExpiration:             tokenExpiration,
+		verificationBaseURL:         verificationBaseURL,
+	}
+}
+
+// SendVerification emails a verification link to the current address of the user. Every link counts against the
+// address and, when given, the client address; the links sent on sign-up and on email change have no client address
+// and count against the email only. A throttled link is silently not sent, like a reset link, so the answer is the
+// same either way.
+func (s *emailVerificationService) SendVerification(ctx context.Context, userID, ipAddress string) error {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.IsEmailVerified() {
+		return domain.NewConflictError("email address is already verified")
+	}
+
+	keys := []string{emailVerificationEmailKey(user.Email)}
+	if ipAddress != "" {
+		keys = append(keys, emailVerificationClientKey(ipAddress))
+	}
+
+	if _, err := s.attemptLimiter.Reserve(ctx, keys...); err != nil {
+		var tooManyRequestsErr *domain.TooManyRequestsError
+		if errors.As(err, &tooManyRequestsErr) {
+			log.Println("email verification throttled:", err)
+			return nil
+		}
+		return err
+	}
+
+	token, err := s.secretTokenGenerator.Generate()
+	if err != nil {
+		return err
+	}
+
+	emailVerificationToken, err := domain.NewEmailVerificationToken(s.identityGenerator, *user, token, s.tokenExpiration)
+	if err != nil {
+		return err
+	}
+
+	if err := s.emailVerificationRepository.Create(ctx, *emailVerificationToken); err != nil {
+		return err
+	}
+
+	verificationEmail, err := domain.NewEmail(user.Email, emailVerificationEmailSubject, s.buildVerificationEmailBody(*user, token))
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, *verificationEmail)
+}
+
+// Confirm marks the email of the token owner as verified, as long as it is still the address the token was sent to,
+// and then accepts the personal invites that were waiting for it. Accepting the invites is best effort, since the email
+// is verified either way.
+func (s *emailVerificationService) Confirm(ctx context.Context, token string) error {
+	emailVerificationToken, err := s.emailVerificationRepository.GetByTokenHash(ctx, domain.HashSecretToken(token))
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return invalidEmailVerificationTokenError()
+		}
+		return err
+	}
+
+	user, err := s.userRepository.GetByID(ctx, emailVerificationToken.UserID)
+	if err != nil {
+		return err
+	}
+
+	if !emailVerificationToken.IsUsableFor(*user) {
+		return invalidEmailVerificationTokenError()
+	}
+
+	user.VerifyEmail()
+
+	if err := s.emailVerificationRepository.Redeem(ctx, emailVerificationToken.ID, *user); err != nil {
+		return err
+	}
+
+	if err := s.groupInviteService.AcceptPendingPersonal(ctx, user.ID); err != nil {
+		log.Println("error accepting pending group invites:", err)
+	}
+
+	return nil
+}
+
+func (s *emailVerificationService) buildVerificationEmailBody(user domain.User, token string) string {
+	verificationURL := strings.TrimSuffix(s.verificationBaseURL, "/") + "?token=" + url.QueryEscape(token)
+
+	return fmt.Sprintf(
+		"Hi %s,\n\nPlease confirm that this is your email address by opening the link below. It expires in %s.\n\n%s\n\nIf you did not create a Mystery Gifter account, you can ignore this email.\n",
+		user.Name, s.tokenExpiration, verificationURL,
+	)
+}
+
+func invalidEmailVerificationTokenError() error {
+	return domain.NewValidationError(validator.ValidationErrors{{Field: "Token", Error: "Token is invalid or has expired"}})
+}
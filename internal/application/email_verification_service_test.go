@@ -0,0 +1,294 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+const verificationBaseURL = "http://localhost:3000/verify-email"
+
+func Test_emailVerificationService_SendVerification(t *testing.T) {
+	ipAddress := "203.0.113.7"
+
+	t.Run("should store the hashed token and email the verification link", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		tokenID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("secret-token", nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(tokenID, nil)
+
+		mockedEmailVerificationRepository := mock_domain.NewMockEmailVerificationRepository(mockCtrl)
+		mockedEmailVerificationRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, emailVerificationToken domain.EmailVerificationToken) error {
+			assert.Equal(t, tokenID, emailVerificationToken.ID)
+			assert.Equal(t, user.Email, emailVerificationToken.Email)
+			assert.Equal(t, domain.HashSecretToken("secret-token"), emailVerificationToken.TokenHash)
+			return nil
+		})
+
+		mockedMailer := mock_domain.NewMockMailer(mockCtrl)
+		mockedMailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, email domain.Email) error {
+			assert.Equal(t, user.Email, email.To)
+			assert.Contains(t, email.Body, verificationBaseURL+"?token=secret-token")
+			return nil
+		})
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "email-verification:email:"+user.Email, "email-verification:ip:"+ipAddress).Return(application.AttemptReservation{}, nil)
+
+		emailVerificationService := application.NewEmailVerificationService(mockedEmailVerificationRepository, mockedUserRepository, nil, mockedIdentityGenerator, mockedSecretTokenGenerator, mockedMailer, mockedAttemptLimiter, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.SendVerification(context.Background(), user.ID, ipAddress)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when the email is already verified", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithEmailVerifiedAt(&verifiedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		emailVerificationService := application.NewEmailVerificationService(nil, mockedUserRepository, nil, nil, nil, nil, nil, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.SendVerification(context.Background(), user.ID, ipAddress)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+
+	t.Run("should return error when the email cannot be sent", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("secret-token", nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		mockedEmailVerificationRepository := mock_domain.NewMockEmailVerificationRepository(mockCtrl)
+		mockedEmailVerificationRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedMailer := mock_domain.NewMockMailer(mockCtrl)
+		mockedMailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "email-verification:email:"+user.Email, "email-verification:ip:"+ipAddress).Return(application.AttemptReservation{}, nil)
+
+		emailVerificationService := application.NewEmailVerificationService(mockedEmailVerificationRepository, mockedUserRepository, nil, mockedIdentityGenerator, mockedSecretTokenGenerator, mockedMailer, mockedAttemptLimiter, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.SendVerification(context.Background(), user.ID, ipAddress)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should count the link against the email only when there is no client address", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("secret-token", nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		mockedEmailVerificationRepository := mock_domain.NewMockEmailVerificationRepository(mockCtrl)
+		mockedEmailVerificationRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedMailer := mock_domain.NewMockMailer(mockCtrl)
+		mockedMailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "email-verification:email:"+user.Email).Return(application.AttemptReservation{}, nil)
+
+		emailVerificationService := application.NewEmailVerificationService(mockedEmailVerificationRepository, mockedUserRepository, nil, mockedIdentityGenerator, mockedSecretTokenGenerator, mockedMailer, mockedAttemptLimiter, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.SendVerification(context.Background(), user.ID, "")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should silently send nothing when the links are throttled", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "email-verification:email:"+user.Email, "email-verification:ip:"+ipAddress).Return(application.AttemptReservation{}, domain.NewTooManyRequestsError("too many failed attempts, try again later", time.Minute))
+
+		emailVerificationService := application.NewEmailVerificationService(nil, mockedUserRepository, nil, nil, nil, nil, mockedAttemptLimiter, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.SendVerification(context.Background(), user.ID, ipAddress)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func Test_emailVerificationService_Confirm(t *testing.T) {
+	t.Run("should verify the email, redeem the token and accept the pending personal invites", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		emailVerificationToken := build_domain.NewEmailVerificationTokenBuilder().WithUser(user).WithTokenHash(domain.HashSecretToken("secret-token")).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedEmailVerificationRepository := mock_domain.NewMockEmailVerificationRepository(mockCtrl)
+		mockedEmailVerificationRepository.EXPECT().GetByTokenHash(gomock.Any(), domain.HashSecretToken("secret-token")).Return(&emailVerificationToken, nil)
+		redeem := mockedEmailVerificationRepository.EXPECT().Redeem(gomock.Any(), emailVerificationToken.ID, gomock.Any()).DoAndReturn(func(ctx context.Context, tokenID string, verifiedUser domain.User) error {
+			assert.True(t, verifiedUser.IsEmailVerified())
+			return nil
+		})
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().AcceptPendingPersonal(gomock.Any(), user.ID).Return(nil).After(redeem)
+
+		emailVerificationService := application.NewEmailVerificationService(mockedEmailVerificationRepository, mockedUserRepository, mockedGroupInviteService, nil, nil, nil, nil, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.Confirm(context.Background(), "secret-token")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should confirm the email even when the pending personal invites cannot be accepted", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		emailVerificationToken := build_domain.NewEmailVerificationTokenBuilder().WithUser(user).WithTokenHash(domain.HashSecretToken("secret-token")).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedEmailVerificationRepository := mock_domain.NewMockEmailVerificationRepository(mockCtrl)
+		mockedEmailVerificationRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&emailVerificationToken, nil)
+		mockedEmailVerificationRepository.EXPECT().Redeem(gomock.Any(), emailVerificationToken.ID, gomock.Any()).Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().AcceptPendingPersonal(gomock.Any(), user.ID).Return(assert.AnError)
+
+		emailVerificationService := application.NewEmailVerificationService(mockedEmailVerificationRepository, mockedUserRepository, mockedGroupInviteService, nil, nil, nil, nil, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.Confirm(context.Background(), "secret-token")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error without accepting invites when the token cannot be redeemed", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		emailVerificationToken := build_domain.NewEmailVerificationTokenBuilder().WithUser(user).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedEmailVerificationRepository := mock_domain.NewMockEmailVerificationRepository(mockCtrl)
+		mockedEmailVerificationRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&emailVerificationToken, nil)
+		mockedEmailVerificationRepository.EXPECT().Redeem(gomock.Any(), emailVerificationToken.ID, gomock.Any()).Return(assert.AnError)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().AcceptPendingPersonal(gomock.Any(), gomock.Any()).Times(0)
+
+		emailVerificationService := application.NewEmailVerificationService(mockedEmailVerificationRepository, mockedUserRepository, mockedGroupInviteService, nil, nil, nil, nil, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.Confirm(context.Background(), "secret-token")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return validation error when the token does not exist", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedEmailVerificationRepository := mock_domain.NewMockEmailVerificationRepository(mockCtrl)
+		mockedEmailVerificationRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(nil, domain.NewResourceNotFoundError("email verification token not found"))
+
+		emailVerificationService := application.NewEmailVerificationService(mockedEmailVerificationRepository, nil, nil, nil, nil, nil, nil, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.Confirm(context.Background(), "unknown-token")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should return validation error when the user changed their email after the token was sent", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithEmail("new@example.com").Build()
+		emailVerificationToken := build_domain.NewEmailVerificationTokenBuilder().WithUser(user).WithEmail("old@example.com").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedEmailVerificationRepository := mock_domain.NewMockEmailVerificationRepository(mockCtrl)
+		mockedEmailVerificationRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&emailVerificationToken, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		emailVerificationService := application.NewEmailVerificationService(mockedEmailVerificationRepository, mockedUserRepository, nil, nil, nil, nil, nil, 48*time.Hour, verificationBaseURL)
+
+		// when
+		err := emailVerificationService.Confirm(context.Background(), "secret-token")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
@@ -4,42 +4,84 @@ package application
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
 )
 
 type GroupInviteService interface {
-	Create(ctx context.Context, groupID, requesterID string) (*domain.GroupInvite, error)
-	JoinGroup(ctx context.Context, inviteID, userID string) (*domain.Group, error)
+	Create(ctx context.Context, groupID, requesterID string, requiresApproval bool, maxUses int) (*domain.GroupInvite, error)
+	JoinGroup(ctx context.Context, inviteID, userID, ipAddress string) (*domain.Group, error)
+	JoinGroupByCode(ctx context.Context, code, userID, ipAddress string) (*domain.Group, error)
 	GetActive(ctx context.Context, groupID, requesterID string) (*domain.GroupInvite, error)
+	GetPreview(ctx context.Context, inviteID string) (*domain.GroupInvitePreview, error)
+	GetActiveQRCode(ctx context.Context, groupID, requesterID string, format domain.QRCodeFormat) ([]byte, error)
+	CreatePersonal(ctx context.Context, groupID, requesterID, email string) (*domain.GroupInvite, error)
+	ListPersonal(ctx context.Context, groupID, requesterID string) ([]domain.GroupInvite, error)
+	ResendPersonal(ctx context.Context, groupID, inviteID, requesterID string) (*domain.GroupInvite, error)
+	CancelPersonal(ctx context.Context, groupID, inviteID, requesterID string) error
+	List(ctx context.Context, groupID, requesterID string) ([]domain.GroupInvite, error)
+	Revoke(ctx context.Context, groupID, inviteID, requesterID string) (*domain.GroupInvite, error)
+	Rotate(ctx context.Context, groupID, requesterID string) (*domain.GroupInvite, error)
+	ListRedemptions(ctx context.Context, groupID, inviteID, requesterID string) ([]domain.GroupInviteRedemption, error)
+	AcceptPendingPersonal(ctx context.Context, userID string) error
+	// Redeem records a use of the invite by the user, failing with a conflict once the invite is out of uses.
+	Redeem(ctx context.Context, inviteID, userID string) error
 }
 
+const personalInviteEmailSubject = "You are invited to a Mystery Gifter group"
+
+// maxInviteCodeAttempts bounds how many codes are drawn before giving up on finding one that is not in use.
+const maxInviteCodeAttempts = 5
+
 type groupInviteService struct {
 	groupInviteRepository domain.GroupInviteRepository
 	groupRepository       domain.GroupRepository
-	userService           UserService
+	userRepository        domain.UserRepository
 	identityGenerator     domain.IdentityGenerator
+	inviteCodeGenerator   domain.InviteCodeGenerator
+	qrCodeGenerator       domain.QRCodeGenerator
+	mailer                domain.Mailer
 	linkExpiration        time.Duration
+	joinBaseURL           string
+	verificationPolicy    domain.EmailVerificationPolicy
+	attemptLimiter        AttemptLimiter
 }
 
 func NewGroupInviteService(
 	groupInviteRepository domain.GroupInviteRepository,
 	groupRepository domain.GroupRepository,
-	userService UserService,
+	userRepository domain.UserRepository,
 	identityGenerator domain.IdentityGenerator,
+	inviteCodeGenerator domain.InviteCodeGenerator,
+	qrCodeGenerator domain.QRCodeGenerator,
+	mailer domain.Mailer,
 	linkExpiration time.Duration,
+	joinBaseURL string,
+	verificationPolicy domain.EmailVerificationPolicy,
+	attemptLimiter AttemptLimiter,
 ) GroupInviteService {
 	return &groupInviteService{
 		groupInviteRepository: groupInviteRepository,
 		groupRepository:       groupRepository,
-		userService:           userService,
+		userRepository:        userRepository,
 		identityGenerator:     identityGenerator,
+		inviteCodeGenerator:   inviteCodeGenerator,
+		qrCodeGenerator:       qrCodeGenerator,
+		mailer:                mailer,
 		linkExpiration:        linkExpiration,
+		joinBaseURL:           joinBaseURL,
+		verificationPolicy:    verificationPolicy,
+		attemptLimiter:        attemptLimiter,
 	}
 }
 
-func (s *groupInviteService) Create(ctx context.Context, groupID, requesterID string) (*domain.GroupInvite, error) {
+func (s *groupInviteService) Create(ctx context.Context, groupID, requesterID string, requiresApproval bool, maxUses int) (*domain.GroupInvite, error) {
 	group, err := s.groupRepository.GetByID(ctx, groupID)
 	if err != nil {
 		return nil, err
@@ -49,12 +91,18 @@ func (s *groupInviteService) Create(ctx context.Context, groupID, requesterID st
 		return nil, err
 	}
 
-	groupInvite, err := domain.NewGroupInvite(s.identityGenerator, groupID, s.linkExpiration)
+	if err := s.authorizeUnverifiedUser(ctx, requesterID, domain.UnverifiedUserActionCreateInvite); err != nil {
+		return nil, err
+	}
+
+	groupInvite, err := domain.NewGroupInvite(s.identityGenerator, groupID, "", s.linkExpiration, requiresApproval, maxUses)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.groupInviteRepository.Create(ctx, *groupInvite); err != nil {
+	if err := s.storeWithUniqueCode(ctx, groupInvite, func(ctx context.Context, groupInvite domain.GroupInvite) error {
+		return s.groupInviteRepository.Create(ctx, groupInvite)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -74,14 +122,87 @@ func (s *groupInviteService) GetActive(ctx context.Context, groupID, requesterID
 	return s.groupInviteRepository.GetActiveByGroupID(ctx, groupID)
 }
 
-func (s *groupInviteService) JoinGroup(ctx context.Context, inviteID, userID string) (*domain.Group, error) {
+// GetPreview describes the group behind an invite to anyone who has it, so no membership check is made.
+func (s *groupInviteService) GetPreview(ctx context.Context, inviteID string) (*domain.GroupInvitePreview, error) {
 	groupInvite, err := s.groupInviteRepository.GetByID(ctx, inviteID)
 	if err != nil {
 		return nil, err
 	}
 
-	if groupInvite.IsExpired() {
-		return nil, domain.NewConflictError("invite has expired")
+	group, err := s.groupRepository.GetByID(ctx, groupInvite.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := domain.NewGroupInvitePreview(*groupInvite, *group)
+
+	return &preview, nil
+}
+
+// GetActiveQRCode renders the join URL of the group's active invite as a QR code image.
+func (s *groupInviteService) GetActiveQRCode(ctx context.Context, groupID, requesterID string, format domain.QRCodeFormat) ([]byte, error) {
+	groupInvite, err := s.GetActive(ctx, groupID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.qrCodeGenerator.Generate(groupInvite.JoinURL(s.joinBaseURL), format)
+}
+
+func (s *groupInviteService) JoinGroup(ctx context.Context, inviteID, userID, ipAddress string) (*domain.Group, error) {
+	reservation, err := s.reserveJoinAttempt(ctx, inviteID, userID, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	groupInvite, err := s.groupInviteRepository.GetByID(ctx, inviteID)
+	s.finishJoinAttempt(ctx, err, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.joinGroup(ctx, groupInvite, userID)
+}
+
+func (s *groupInviteService) JoinGroupByCode(ctx context.Context, code, userID, ipAddress string) (*domain.Group, error) {
+	code = domain.NormalizeInviteCode(code)
+	if !domain.IsValidInviteCode(code) {
+		return nil, domain.NewValidationError(validator.ValidationErrors{{Field: "Code", Error: "Code is not a valid invite code"}})
+	}
+
+	reservation, err := s.reserveJoinAttempt(ctx, code, userID, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	groupInvite, err := s.groupInviteRepository.GetActiveByCode(ctx, code)
+	s.finishJoinAttempt(ctx, err, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.joinGroup(ctx, groupInvite, userID)
+}
+
+// reserveJoinAttempt throttles lookups of invites that do not exist per client address, account and invite, so
+// invite codes cannot be guessed. The attempt is reserved before the lookup, so parallel guesses are throttled too.
+func (s *groupInviteService) reserveJoinAttempt(ctx context.Context, invite, userID, ipAddress string) (AttemptReservation, error) {
+	return s.attemptLimiter.Reserve(ctx, joinClientKey(ipAddress), joinAccountKey(userID), joinInviteKey(invite))
+}
+
+// finishJoinAttempt keeps the reservation as a failure when the invite does not exist and takes it back otherwise.
+// Successful joins only take back their own attempt: joining again is a no-op, so a known invite could otherwise be
+// used to clear the counters between guesses.
+func (s *groupInviteService) finishJoinAttempt(ctx context.Context, err error, reservation AttemptReservation) {
+	var notFoundErr *domain.ResourceNotFoundError
+	if !errors.As(err, &notFoundErr) {
+		s.attemptLimiter.Release(ctx, reservation)
+	}
+}
+
+func (s *groupInviteService) joinGroup(ctx context.Context, groupInvite *domain.GroupInvite, userID string) (*domain.Group, error) {
+	if err := groupInvite.CheckUsable(); err != nil {
+		return nil, err
 	}
 
 	group, err := s.groupRepository.GetByID(ctx, groupInvite.GroupID)
@@ -89,18 +210,370 @@ func (s *groupInviteService) JoinGroup(ctx context.Context, inviteID, userID str
 		return nil, err
 	}
 
-	targetUser, err := s.userService.GetByID(ctx, userID)
+	targetUser, err := s.userRepository.GetByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := group.AddUser(group.OwnerID, *targetUser); err != nil {
+	if err := s.verificationPolicy.Authorize(*targetUser, domain.UnverifiedUserActionJoinGroup); err != nil {
+		return nil, err
+	}
+
+	if err := groupInvite.CanBeUsedBy(*targetUser); err != nil {
 		return nil, err
 	}
 
+	// joining again is a no-op, so it must not consume a use of the invite
+	alreadyJoined := group.HasJoinedOrRequested(targetUser.ID)
+
+	if groupInvite.RequiresApproval {
+		err = group.RequestToJoin(*targetUser, groupInvite.ID)
+	} else {
+		err = group.AddUser(group.OwnerID, *targetUser)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if alreadyJoined {
+		return group, nil
+	}
+
 	if err := s.groupRepository.Update(ctx, *group); err != nil {
 		return nil, err
 	}
 
+	// requests waiting for approval only consume a use of the invite once the owner approves them, so rejected
+	// requests never exhaust it
+	if groupInvite.RequiresApproval {
+		return group, nil
+	}
+
+	// the use is only redeemed once the membership is saved, so a failed update never consumes it; when the invite
+	// ran out of uses in the meantime the membership is taken back
+	if err := s.groupInviteRepository.Redeem(ctx, groupInvite.ID, targetUser.ID, time.Now()); err != nil {
+		s.undoJoin(ctx, group.ID, targetUser.ID)
+		return nil, err
+	}
+
+	if groupInvite.IsPersonal() {
+		groupInvite.MarkAsUsed()
+		if err := s.groupInviteRepository.Update(ctx, *groupInvite); err != nil {
+			return nil, err
+		}
+	}
+
 	return group, nil
 }
+
+// undoJoin takes the user back out of a group they joined through an invite that could not be redeemed. It is best
+// effort, since the redemption error is what the user has to see.
+func (s *groupInviteService) undoJoin(ctx context.Context, groupID, userID string) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		log.Println("error undoing group join:", err)
+		return
+	}
+
+	if err := group.RemoveUser(group.OwnerID, userID); err != nil {
+		log.Println("error undoing group join:", err)
+		return
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		log.Println("error undoing group join:", err)
+	}
+}
+
+// CreatePersonal invites the email to the group and sends the invite to the address. Failures to deliver the email
+// are only logged, since the invite is already stored and can be resent.
+func (s *groupInviteService) CreatePersonal(ctx context.Context, groupID, requesterID, email string) (*domain.GroupInvite, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.CanInviteEmail(requesterID, email); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeUnverifiedUser(ctx, requesterID, domain.UnverifiedUserActionCreateInvite); err != nil {
+		return nil, err
+	}
+
+	pendingInvites, err := s.groupInviteRepository.ListPendingPersonalByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pendingInvite := range pendingInvites {
+		if strings.EqualFold(pendingInvite.Email, email) {
+			return nil, domain.NewConflictError("a pending invite already exists for this email")
+		}
+	}
+
+	groupInvite, err := domain.NewPersonalGroupInvite(s.identityGenerator, groupID, "", email, s.linkExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storeWithUniqueCode(ctx, groupInvite, func(ctx context.Context, groupInvite domain.GroupInvite) error {
+		return s.groupInviteRepository.Create(ctx, groupInvite)
+	}); err != nil {
+		return nil, err
+	}
+
+	s.sendPersonalInvite(ctx, *group, *groupInvite)
+
+	return groupInvite, nil
+}
+
+func (s *groupInviteService) ListPersonal(ctx context.Context, groupID, requesterID string) ([]domain.GroupInvite, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.CanManageInvites(requesterID); err != nil {
+		return nil, err
+	}
+
+	return s.groupInviteRepository.ListPendingPersonalByGroupID(ctx, groupID)
+}
+
+// ResendPersonal extends a pending personal invite and sends it to the address again.
+func (s *groupInviteService) ResendPersonal(ctx context.Context, groupID, inviteID, requesterID string) (*domain.GroupInvite, error) {
+	group, groupInvite, err := s.getManagedInvite(ctx, groupID, inviteID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := groupInvite.Resend(s.linkExpiration); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupInviteRepository.Update(ctx, *groupInvite); err != nil {
+		return nil, err
+	}
+
+	s.sendPersonalInvite(ctx, *group, *groupInvite)
+
+	return groupInvite, nil
+}
+
+func (s *groupInviteService) CancelPersonal(ctx context.Context, groupID, inviteID, requesterID string) error {
+	_, groupInvite, err := s.getManagedInvite(ctx, groupID, inviteID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if err := groupInvite.CanCancel(); err != nil {
+		return err
+	}
+
+	return s.groupInviteRepository.Delete(ctx, groupInvite.ID)
+}
+
+func (s *groupInviteService) List(ctx context.Context, groupID, requesterID string) ([]domain.GroupInvite, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.CanManageInvites(requesterID); err != nil {
+		return nil, err
+	}
+
+	return s.groupInviteRepository.ListByGroupID(ctx, groupID)
+}
+
+func (s *groupInviteService) Revoke(ctx context.Context, groupID, inviteID, requesterID string) (*domain.GroupInvite, error) {
+	_, groupInvite, err := s.getManagedInvite(ctx, groupID, inviteID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := groupInvite.Revoke(); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupInviteRepository.Update(ctx, *groupInvite); err != nil {
+		return nil, err
+	}
+
+	return groupInvite, nil
+}
+
+// Rotate replaces the active invite link with a fresh one, keeping its approval setting and usage limit.
+func (s *groupInviteService) Rotate(ctx context.Context, groupID, requesterID string) (*domain.GroupInvite, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.CanCreateInvite(requesterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeUnverifiedUser(ctx, requesterID, domain.UnverifiedUserActionCreateInvite); err != nil {
+		return nil, err
+	}
+
+	groupInvites, err := s.groupInviteRepository.ListByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the settings come from the newest link still in place, even when it already ran out of uses
+	requiresApproval, maxUses := false, 0
+	for _, groupInvite := range groupInvites {
+		if groupInvite.IsPersonal() || groupInvite.IsRevoked() || groupInvite.IsExpired() {
+			continue
+		}
+
+		requiresApproval, maxUses = groupInvite.RequiresApproval, groupInvite.MaxUses
+		break
+	}
+
+	groupInvite, err := domain.NewGroupInvite(s.identityGenerator, groupID, "", s.linkExpiration, requiresApproval, maxUses)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storeWithUniqueCode(ctx, groupInvite, func(ctx context.Context, groupInvite domain.GroupInvite) error {
+		return s.groupInviteRepository.Rotate(ctx, groupInvite)
+	}); err != nil {
+		return nil, err
+	}
+
+	return groupInvite, nil
+}
+
+// ListRedemptions returns who joined the group through the given invite, in the order they joined.
+func (s *groupInviteService) ListRedemptions(ctx context.Context, groupID, inviteID, requesterID string) ([]domain.GroupInviteRedemption, error) {
+	_, groupInvite, err := s.getManagedInvite(ctx, groupID, inviteID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.groupInviteRepository.ListRedemptions(ctx, groupInvite.ID)
+}
+
+// AcceptPendingPersonal turns the personal invites sent to the email of the user, before they had an account, into
+// memberships. Until the user verifies their email nothing happens, since only then is the address known to be
+// theirs, so it is called again once they do. An invite that fails is logged and skipped, so a group that closed in
+// the meantime does not keep the user out of the others.
+func (s *groupInviteService) AcceptPendingPersonal(ctx context.Context, userID string) error {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.IsEmailVerified() {
+		return nil
+	}
+
+	pendingInvites, err := s.groupInviteRepository.ListPendingPersonalByEmail(ctx, user.Email)
+	if err != nil {
+		return err
+	}
+
+	for _, pendingInvite := range pendingInvites {
+		if pendingInvite.CheckUsable() != nil {
+			continue
+		}
+
+		if _, err := s.joinGroup(ctx, &pendingInvite, user.ID); err != nil {
+			log.Println("error accepting pending group invite:", err)
+		}
+	}
+
+	return nil
+}
+
+// storeWithUniqueCode gives the invite a fresh code and stores it, drawing another code whenever the database
+// reports that an active invite already has it. Relying on the unique index rather than looking the code up first
+// keeps two requests from being handed the same code.
+func (s *groupInviteService) storeWithUniqueCode(ctx context.Context, groupInvite *domain.GroupInvite, store func(ctx context.Context, groupInvite domain.GroupInvite) error) error {
+	for range maxInviteCodeAttempts {
+		code, err := s.inviteCodeGenerator.Generate()
+		if err != nil {
+			return err
+		}
+		groupInvite.Code = code
+
+		err = store(ctx, *groupInvite)
+		if err == nil {
+			return nil
+		}
+
+		var conflictErr *domain.ConflictError
+		if !errors.As(err, &conflictErr) {
+			return err
+		}
+	}
+
+	return errors.New("could not generate a unique invite code")
+}
+
+// getManagedInvite loads an invite of the given group along with the group, making sure the requester is allowed to
+// manage it.
+func (s *groupInviteService) getManagedInvite(ctx context.Context, groupID, inviteID, requesterID string) (*domain.Group, *domain.GroupInvite, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := group.CanManageInvites(requesterID); err != nil {
+		return nil, nil, err
+	}
+
+	groupInvite, err := s.groupInviteRepository.GetByID(ctx, inviteID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if groupInvite.GroupID != group.ID {
+		return nil, nil, domain.NewResourceNotFoundError("group invite not found")
+	}
+
+	return group, groupInvite, nil
+}
+
+// sendPersonalInvite emails the link and the code of a personal invite to the invited address.
+func (s *groupInviteService) sendPersonalInvite(ctx context.Context, group domain.Group, groupInvite domain.GroupInvite) {
+	inviteEmail, err := domain.NewEmail(groupInvite.Email, personalInviteEmailSubject, s.buildPersonalInviteEmailBody(group, groupInvite))
+	if err != nil {
+		log.Println("error building group invite email:", err)
+		return
+	}
+
+	if err := s.mailer.Send(ctx, *inviteEmail); err != nil {
+		log.Println("error sending group invite email:", err)
+	}
+}
+
+func (s *groupInviteService) buildPersonalInviteEmailBody(group domain.Group, groupInvite domain.GroupInvite) string {
+	return fmt.Sprintf(
+		"Hi,\n\nYou were invited to join the Mystery Gifter group \"%s\". Open the link below or enter the code %s to join. It expires in %s.\n\n%s\n\nIf you do not have an account yet, sign up with this email address and you will be added to the group.\n",
+		group.Name, groupInvite.Code, s.linkExpiration, groupInvite.JoinURL(s.joinBaseURL),
+	)
+}
+
+// authorizeUnverifiedUser applies the email verification policy, only loading the user when the action is restricted.
+func (s *groupInviteService) authorizeUnverifiedUser(ctx context.Context, userID string, action domain.UnverifiedUserAction) error {
+	if !s.verificationPolicy.Restricts(action) {
+		return nil
+	}
+
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.verificationPolicy.Authorize(*user, action)
+}
+
+func (s *groupInviteService) Redeem(ctx context.Context, inviteID, userID string) error {
+	return s.groupInviteRepository.Redeem(ctx, inviteID, userID, time.Now())
+}
@@ -16,6 +16,31 @@ import (
 )
 
 func Test_groupInviteService_Create(t *testing.T) {
+	t.Run("should return forbidden error when the policy requires a verified email and the owner has not verified it", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		policy, _ := domain.NewEmailVerificationPolicy([]string{"CREATE_INVITE"})
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), owner.ID).Return(&owner, nil)
+
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", policy, nil)
+
+		// when
+		result, err := groupInviteService.Create(context.Background(), group.ID, owner.ID, false, 0)
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "verify your email address to create invites")
+	})
+
 	t.Run("should create group invite successfully", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
@@ -38,18 +63,90 @@ func Test_groupInviteService_Create(t *testing.T) {
 		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
 		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
 
-		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, expiration)
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		mockedInviteCodeGenerator.EXPECT().Generate().Return("ABCD2345", nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, nil)
 
 		// when
-		result, err := groupInviteService.Create(context.Background(), group.ID, owner.ID)
+		result, err := groupInviteService.Create(context.Background(), group.ID, owner.ID, false, 0)
 
 		// then
 		assert.NoError(t, err)
 		assert.Equal(t, generatedID, result.ID)
 		assert.Equal(t, group.ID, result.GroupID)
+		assert.Equal(t, "ABCD2345", result.Code)
 		assert.WithinDuration(t, time.Now().Add(expiration), result.ExpiresAt, time.Second)
 	})
 
+	t.Run("should draw another code when the generated one is already in use", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		gomock.InOrder(
+			mockedGroupInviteRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, groupInvite domain.GroupInvite) error {
+				assert.Equal(t, "TAKEN234", groupInvite.Code)
+				return domain.NewConflictError("invite code is already in use")
+			}),
+			mockedGroupInviteRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, groupInvite domain.GroupInvite) error {
+				assert.Equal(t, "FREE5678", groupInvite.Code)
+				return nil
+			}),
+		)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		gomock.InOrder(
+			mockedInviteCodeGenerator.EXPECT().Generate().Return("TAKEN234", nil),
+			mockedInviteCodeGenerator.EXPECT().Generate().Return("FREE5678", nil),
+		)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.Create(context.Background(), group.ID, owner.ID, false, 0)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "FREE5678", result.Code)
+	})
+
+	t.Run("should return error when no unused code can be found", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(domain.NewConflictError("invite code is already in use")).Times(5)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		mockedInviteCodeGenerator.EXPECT().Generate().Return("TAKEN234", nil).Times(5)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.Create(context.Background(), group.ID, owner.ID, false, 0)
+
+		// then
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "could not generate a unique invite code")
+	})
+
 	t.Run("should return not found error when group does not exist", func(t *testing.T) {
 		// given
 		groupID := uuid.New().String()
@@ -60,10 +157,10 @@ func Test_groupInviteService_Create(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, domain.NewResourceNotFoundError("group not found"))
 
-		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, nil)
 
 		// when
-		result, err := groupInviteService.Create(context.Background(), groupID, requesterID)
+		result, err := groupInviteService.Create(context.Background(), groupID, requesterID, false, 0)
 
 		// then
 		assert.Nil(t, result)
@@ -84,10 +181,10 @@ func Test_groupInviteService_Create(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
 
-		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, nil)
 
 		// when
-		result, err := groupInviteService.Create(context.Background(), group.ID, requester.ID)
+		result, err := groupInviteService.Create(context.Background(), group.ID, requester.ID, false, 0)
 
 		// then
 		assert.Nil(t, result)
@@ -107,10 +204,10 @@ func Test_groupInviteService_Create(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
 
-		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, nil)
 
 		// when
-		result, err := groupInviteService.Create(context.Background(), group.ID, owner.ID)
+		result, err := groupInviteService.Create(context.Background(), group.ID, owner.ID, false, 0)
 
 		// then
 		assert.Nil(t, result)
@@ -137,10 +234,13 @@ func Test_groupInviteService_Create(t *testing.T) {
 		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
 		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
 
-		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, expiration)
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		mockedInviteCodeGenerator.EXPECT().Generate().Return("ABCD2345", nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, nil)
 
 		// when
-		result, err := groupInviteService.Create(context.Background(), group.ID, owner.ID)
+		result, err := groupInviteService.Create(context.Background(), group.ID, owner.ID, false, 0)
 
 		// then
 		assert.Nil(t, result)
@@ -164,7 +264,7 @@ func Test_groupInviteService_GetActive(t *testing.T) {
 		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
 		mockedGroupInviteRepository.EXPECT().GetActiveByGroupID(gomock.Any(), group.ID).Return(&groupInvite, nil)
 
-		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, nil)
 
 		// when
 		result, err := groupInviteService.GetActive(context.Background(), group.ID, owner.ID)
@@ -185,7 +285,7 @@ func Test_groupInviteService_GetActive(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, domain.NewResourceNotFoundError("group not found"))
 
-		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, nil)
 
 		// when
 		result, err := groupInviteService.GetActive(context.Background(), groupID, requesterID)
@@ -209,7 +309,7 @@ func Test_groupInviteService_GetActive(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
 
-		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, nil)
 
 		// when
 		result, err := groupInviteService.GetActive(context.Background(), group.ID, requester.ID)
@@ -235,7 +335,7 @@ func Test_groupInviteService_GetActive(t *testing.T) {
 		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
 		mockedGroupInviteRepository.EXPECT().GetActiveByGroupID(gomock.Any(), group.ID).Return(nil, domain.NewResourceNotFoundError("no active invite found for this group"))
 
-		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, nil)
 
 		// when
 		result, err := groupInviteService.GetActive(context.Background(), group.ID, owner.ID)
@@ -249,7 +349,173 @@ func Test_groupInviteService_GetActive(t *testing.T) {
 	})
 }
 
+func Test_groupInviteService_GetPreview(t *testing.T) {
+	t.Run("should return the preview of the invite's group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.GetPreview(context.Background(), groupInvite.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, group.Name, result.GroupName)
+		assert.Equal(t, 1, result.MemberCount)
+	})
+
+	t.Run("should return not found error when the invite does not exist", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), inviteID).Return(nil, domain.NewResourceNotFoundError("group invite not found"))
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, nil, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.GetPreview(context.Background(), inviteID)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_groupInviteService_GetActiveQRCode(t *testing.T) {
+	t.Run("should render the join URL of the active invite", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+		activeInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).Build()
+		image := []byte("image")
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetActiveByGroupID(gomock.Any(), group.ID).Return(&activeInvite, nil)
+
+		mockedQRCodeGenerator := mock_domain.NewMockQRCodeGenerator(mockCtrl)
+		mockedQRCodeGenerator.EXPECT().Generate("https://mystery-gifter.app/invites/"+activeInvite.ID, domain.QRCodeFormatSVG).Return(image, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, mockedQRCodeGenerator, nil, time.Hour, "https://mystery-gifter.app/invites", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.GetActiveQRCode(context.Background(), group.ID, owner.ID, domain.QRCodeFormatSVG)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, image, result)
+	})
+
+	t.Run("should return not found error when there is no active invite", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetActiveByGroupID(gomock.Any(), group.ID).Return(nil, domain.NewResourceNotFoundError("no active invite found for this group"))
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "https://mystery-gifter.app/invites", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.GetActiveQRCode(context.Background(), group.ID, owner.ID, domain.QRCodeFormatPNG)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
 func Test_groupInviteService_JoinGroup(t *testing.T) {
+	t.Run("should return forbidden error when the policy requires a verified email and the user has not verified it", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		joiningUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).Build()
+		policy, _ := domain.NewEmailVerificationPolicy([]string{"JOIN_GROUP"})
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", policy, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "verify your email address to join groups")
+	})
+
+	t.Run("should let verified users join when the policy requires a verified email", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		owner := build_domain.NewUserBuilder().Build()
+		joiningUser := build_domain.NewUserBuilder().WithEmailVerifiedAt(&verifiedAt).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).Build()
+		policy, _ := domain.NewEmailVerificationPolicy([]string{"JOIN_GROUP"})
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Redeem(gomock.Any(), groupInvite.ID, joiningUser.ID, gomock.Any()).Return(nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", policy, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
+
+		// then
+		assert.NoError(t, err)
+		assert.Contains(t, result.Users, joiningUser)
+	})
+
 	t.Run("should join group successfully", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
@@ -261,6 +527,7 @@ func Test_groupInviteService_JoinGroup(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
 		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Redeem(gomock.Any(), groupInvite.ID, joiningUser.ID, gomock.Any()).Return(nil)
 
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
@@ -269,13 +536,17 @@ func Test_groupInviteService_JoinGroup(t *testing.T) {
 			return nil
 		})
 
-		mockedUserService := mock_application.NewMockUserService(mockCtrl)
-		mockedUserService.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
 
-		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserService, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
 
 		// when
-		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID)
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
 
 		// then
 		assert.NoError(t, err)
@@ -283,110 +554,1119 @@ func Test_groupInviteService_JoinGroup(t *testing.T) {
 		assert.Contains(t, result.Users, joiningUser)
 	})
 
-	t.Run("should return not found error when invite does not exist", func(t *testing.T) {
+	t.Run("should mark a personal invite as used after joining", func(t *testing.T) {
 		// given
-		inviteID := uuid.New().String()
-		userID := uuid.New().String()
-		expiration := 24 * time.Hour
+		verifiedAt := time.Now()
+		owner := build_domain.NewUserBuilder().Build()
+		joiningUser := build_domain.NewUserBuilder().WithEmail("friend@example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithEmail("friend@example.com").Build()
 
 		mockCtrl := gomock.NewController(t)
 		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
-		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), inviteID).Return(nil, domain.NewResourceNotFoundError("group invite not found"))
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Redeem(gomock.Any(), groupInvite.ID, joiningUser.ID, gomock.Any()).Return(nil)
+		mockedGroupInviteRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedInvite domain.GroupInvite) error {
+			assert.True(t, updatedInvite.IsUsed())
+			return nil
+		})
 
-		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, nil, nil, nil, expiration)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
+
+		// then
+		assert.NoError(t, err)
+		assert.Contains(t, result.Users, joiningUser)
+	})
+
+	t.Run("should return forbidden error when the personal invite targets another email", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		joiningUser := build_domain.NewUserBuilder().WithEmail("stranger@example.com").Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithEmail("friend@example.com").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
 
 		// when
-		result, err := groupInviteService.JoinGroup(context.Background(), inviteID, userID)
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
 
 		// then
 		assert.Nil(t, result)
-		assert.Error(t, err)
-		var notFoundErr *domain.ResourceNotFoundError
-		assert.ErrorAs(t, err, &notFoundErr)
-		assert.EqualError(t, notFoundErr, "group invite not found")
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
 	})
 
-	t.Run("should return conflict error when invite is expired", func(t *testing.T) {
+	t.Run("should return conflict error when the invite was revoked", func(t *testing.T) {
 		// given
-		joiningUser := build_domain.NewUserBuilder().Build()
-		groupInvite := build_domain.NewGroupInviteBuilder().WithExpiresAt(time.Now().Add(-1 * time.Hour)).Build()
-		expiration := 24 * time.Hour
+		revokedAt := time.Now()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithRevokedAt(&revokedAt).Build()
 
 		mockCtrl := gomock.NewController(t)
 		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
 		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
 
-		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, nil, nil, nil, expiration)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, nil, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
 
 		// when
-		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID)
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, uuid.New().String(), "203.0.113.10")
 
 		// then
 		assert.Nil(t, result)
-		assert.Error(t, err)
 		var conflictErr *domain.ConflictError
 		assert.ErrorAs(t, err, &conflictErr)
-		assert.EqualError(t, conflictErr, "invite has expired")
+		assert.EqualError(t, conflictErr, "invite has been revoked")
 	})
-
-	t.Run("should return conflict error when group is not open", func(t *testing.T) {
+	t.Run("should place user on the waitlist when the group is full", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
 		joiningUser := build_domain.NewUserBuilder().Build()
-		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusMatched).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithMaxMembers(1).Build()
 		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithExpiresAt(time.Now().Add(1 * time.Hour)).Build()
 		expiration := 24 * time.Hour
 
 		mockCtrl := gomock.NewController(t)
 		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
 		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Redeem(gomock.Any(), groupInvite.ID, joiningUser.ID, gomock.Any()).Return(nil)
 
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+			assert.NotContains(t, updatedGroup.Users, joiningUser)
+			assert.Contains(t, updatedGroup.Waitlist, joiningUser)
+			return nil
+		})
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
 
-		mockedUserService := mock_application.NewMockUserService(mockCtrl)
-		mockedUserService.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
 
-		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserService, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
 
 		// when
-		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID)
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
 
 		// then
-		assert.Nil(t, result)
-		assert.Error(t, err)
-		var conflictErr *domain.ConflictError
-		assert.ErrorAs(t, err, &conflictErr)
-		assert.EqualError(t, conflictErr, "group is not open for registration, contact the group owner to reopen the group")
+		assert.NoError(t, err)
+		assert.True(t, result.IsWaitlisted(joiningUser.ID))
 	})
 
-	t.Run("should return error when group update fails", func(t *testing.T) {
+	t.Run("should create a join request when the invite requires approval", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
 		joiningUser := build_domain.NewUserBuilder().Build()
-		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
-		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithExpiresAt(time.Now().Add(1 * time.Hour)).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithExpiresAt(time.Now().Add(1 * time.Hour)).WithRequiresApproval(true).Build()
 		expiration := 24 * time.Hour
 
 		mockCtrl := gomock.NewController(t)
 		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
 		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Redeem(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
-		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+			assert.NotContains(t, updatedGroup.Users, joiningUser)
+			joinRequest, ok := updatedGroup.PendingJoinRequest(joiningUser.ID)
+			assert.True(t, ok)
+			assert.Equal(t, groupInvite.ID, joinRequest.InviteID)
+			return nil
+		})
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
 
-		mockedUserService := mock_application.NewMockUserService(mockCtrl)
-		mockedUserService.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
 
-		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserService, nil, expiration)
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
 
 		// when
-		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID)
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, result.IsMember(joiningUser.ID))
+		assert.True(t, result.HasPendingJoinRequest(joiningUser.ID))
+	})
+
+	t.Run("should return not found error when invite does not exist", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+		userID := uuid.New().String()
+		expiration := 24 * time.Hour
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), inviteID).Return(nil, domain.NewResourceNotFoundError("group invite not found"))
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any()).Times(0)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, nil, nil, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), inviteID, userID, "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "group invite not found")
+	})
+
+	t.Run("should return conflict error when invite is expired", func(t *testing.T) {
+		// given
+		joiningUser := build_domain.NewUserBuilder().Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithExpiresAt(time.Now().Add(-1 * time.Hour)).Build()
+		expiration := 24 * time.Hour
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, nil, nil, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "invite has expired")
+	})
+
+	t.Run("should return conflict error when group is not open", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		joiningUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusMatched).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithExpiresAt(time.Now().Add(1 * time.Hour)).Build()
+		expiration := 24 * time.Hour
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "group is not open for registration, contact the group owner to reopen the group")
+	})
+
+	t.Run("should return error without redeeming the invite when group update fails", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		joiningUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithExpiresAt(time.Now().Add(1 * time.Hour)).Build()
+		expiration := 24 * time.Hour
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Redeem(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, expiration, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should not consume a use when the user already joined", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		member := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, member}).WithStatus(domain.GroupStatusOpen).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithMaxUses(1).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), member.ID).Return(&member, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, member.ID, "203.0.113.10")
+
+		// then
+		assert.NoError(t, err)
+		assert.Contains(t, result.Users, member)
+	})
+
+	t.Run("should return conflict error when the invite has no uses left", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().WithMaxUses(2).WithUses(2).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, nil, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, uuid.New().String(), "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "invite has reached its maximum number of uses")
+	})
+
+	t.Run("should take the user back out of the group when the redemption is rejected", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		joiningUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithMaxUses(1).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		joinedGroup := build_domain.NewGroupBuilder().WithID(group.ID).WithOwnerID(owner.ID).WithUsers([]domain.User{owner, joiningUser}).WithStatus(domain.GroupStatusOpen).Build()
+		gomock.InOrder(
+			mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil),
+			mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, group domain.Group) error {
+				assert.True(t, group.IsMember(joiningUser.ID))
+				return nil
+			}),
+			mockedGroupInviteRepository.EXPECT().Redeem(gomock.Any(), groupInvite.ID, joiningUser.ID, gomock.Any()).Return(domain.NewConflictError("invite has reached its maximum number of uses")),
+			mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&joinedGroup, nil),
+			mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, group domain.Group) error {
+				assert.False(t, group.IsMember(joiningUser.ID))
+				return nil
+			}),
+		)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroup(context.Background(), groupInvite.ID, joiningUser.ID, "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func Test_groupInviteService_JoinGroupByCode(t *testing.T) {
+	t.Run("should join the group using a normalized invite code", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		joiningUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithCode("ABCD2345").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetActiveByCode(gomock.Any(), "ABCD2345").Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Redeem(gomock.Any(), groupInvite.ID, joiningUser.ID, gomock.Any()).Return(nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), joiningUser.ID).Return(&joiningUser, nil)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroupByCode(context.Background(), "abcd-2345", joiningUser.ID, "203.0.113.10")
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result.Users, 2)
+	})
+
+	t.Run("should return not found error when no active invite has the code", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetActiveByCode(gomock.Any(), "ABCD2345").Return(nil, domain.NewResourceNotFoundError("no active invite found for this code"))
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any()).Times(0)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, nil, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroupByCode(context.Background(), "ABCD2345", uuid.New().String(), "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("should return validation error without looking up a code that could not have been generated", func(t *testing.T) {
+		// given
+		groupInviteService := application.NewGroupInviteService(nil, nil, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.JoinGroupByCode(context.Background(), "ABCD-0123", uuid.New().String(), "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should return too many requests error without looking up the code while the client has to wait", func(t *testing.T) {
+		// given
+		userID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "join:ip:203.0.113.10", "join:account:"+userID, "join:invite:ABCD2345").Return(application.AttemptReservation{}, domain.NewTooManyRequestsError("too many failed attempts, try again later", time.Minute))
+
+		groupInviteService := application.NewGroupInviteService(nil, nil, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, mockedAttemptLimiter)
+
+		// when
+		result, err := groupInviteService.JoinGroupByCode(context.Background(), "abcd-2345", userID, "203.0.113.10")
+
+		// then
+		assert.Nil(t, result)
+		var tooManyRequestsErr *domain.TooManyRequestsError
+		assert.ErrorAs(t, err, &tooManyRequestsErr)
+	})
+}
+
+func Test_groupInviteService_CreatePersonal(t *testing.T) {
+	t.Run("should create a personal invite successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListPendingPersonalByGroupID(gomock.Any(), group.ID).Return([]domain.GroupInvite{}, nil)
+		mockedGroupInviteRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, groupInvite domain.GroupInvite) error {
+			assert.Equal(t, "friend@example.com", groupInvite.Email)
+			return nil
+		})
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		mockedInviteCodeGenerator.EXPECT().Generate().Return("ABCD2345", nil)
+
+		mockedMailer := mock_domain.NewMockMailer(mockCtrl)
+		mockedMailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, email domain.Email) error {
+			assert.Equal(t, "friend@example.com", email.To)
+			assert.Contains(t, email.Body, group.Name)
+			assert.Contains(t, email.Body, "https://app.example.com/join/"+generatedID)
+			assert.Contains(t, email.Body, "ABCD2345")
+			return nil
+		})
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, mockedMailer, time.Hour, "https://app.example.com/join/", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.CreatePersonal(context.Background(), group.ID, owner.ID, "friend@example.com")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, generatedID, result.ID)
+		assert.True(t, result.IsPending())
+	})
+
+	t.Run("should keep the invite when the email cannot be delivered", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListPendingPersonalByGroupID(gomock.Any(), group.ID).Return([]domain.GroupInvite{}, nil)
+		mockedGroupInviteRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		mockedInviteCodeGenerator.EXPECT().Generate().Return("ABCD2345", nil)
+
+		mockedMailer := mock_domain.NewMockMailer(mockCtrl)
+		mockedMailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, mockedMailer, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.CreatePersonal(context.Background(), group.ID, owner.ID, "friend@example.com")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, generatedID, result.ID)
+	})
+
+	t.Run("should return conflict error when a pending invite exists for the email", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+		pendingInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithEmail("friend@example.com").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListPendingPersonalByGroupID(gomock.Any(), group.ID).Return([]domain.GroupInvite{pendingInvite}, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.CreatePersonal(context.Background(), group.ID, owner.ID, "Friend@example.com")
+
+		// then
+		assert.Nil(t, result)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "a pending invite already exists for this email")
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().WithStatus(domain.GroupStatusOpen).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.CreatePersonal(context.Background(), group.ID, uuid.New().String(), "friend@example.com")
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+}
+
+func Test_groupInviteService_ListPersonal(t *testing.T) {
+	t.Run("should list pending personal invites for the owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+		pendingInvites := []domain.GroupInvite{build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithEmail("friend@example.com").Build()}
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListPendingPersonalByGroupID(gomock.Any(), group.ID).Return(pendingInvites, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.ListPersonal(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pendingInvites, result)
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.ListPersonal(context.Background(), group.ID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the group owner can manage invites")
+	})
+}
+
+func Test_groupInviteService_ResendPersonal(t *testing.T) {
+	t.Run("should extend the expiration of a pending invite and send it again", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithEmail("friend@example.com").WithExpiresAt(time.Now().Add(-time.Hour)).Build()
+		expiration := 48 * time.Hour
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedInvite domain.GroupInvite) error {
+			assert.WithinDuration(t, time.Now().Add(expiration), updatedInvite.ExpiresAt, time.Second)
+			return nil
+		})
+
+		mockedMailer := mock_domain.NewMockMailer(mockCtrl)
+		mockedMailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, email domain.Email) error {
+			assert.Equal(t, "friend@example.com", email.To)
+			assert.Contains(t, email.Body, groupInvite.Code)
+			return nil
+		})
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, mockedMailer, expiration, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.ResendPersonal(context.Background(), group.ID, groupInvite.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, result.IsExpired())
+	})
+
+	t.Run("should return not found error when the invite belongs to another group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.ResendPersonal(context.Background(), group.ID, groupInvite.ID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_groupInviteService_CancelPersonal(t *testing.T) {
+	t.Run("should delete a pending personal invite", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithEmail("friend@example.com").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Delete(gomock.Any(), groupInvite.ID).Return(nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		err := groupInviteService.CancelPersonal(context.Background(), group.ID, groupInvite.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when cancelling a link invite", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		err := groupInviteService.CancelPersonal(context.Background(), group.ID, groupInvite.ID, owner.ID)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func Test_groupInviteService_List(t *testing.T) {
+	t.Run("should list all invites of the group for the owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+		groupInvites := []domain.GroupInvite{
+			build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).Build(),
+			build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithEmail("friend@example.com").Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListByGroupID(gomock.Any(), group.ID).Return(groupInvites, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.List(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, groupInvites, result)
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.List(context.Background(), group.ID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+}
+
+func Test_groupInviteService_Revoke(t *testing.T) {
+	t.Run("should revoke the invite", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedInvite domain.GroupInvite) error {
+			assert.True(t, updatedInvite.IsRevoked())
+			return nil
+		})
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.Revoke(context.Background(), group.ID, groupInvite.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupInviteStateRevoked, result.State())
+	})
+
+	t.Run("should return conflict error when the invite is already revoked", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+		revokedAt := time.Now()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithRevokedAt(&revokedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.Revoke(context.Background(), group.ID, groupInvite.ID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func Test_groupInviteService_Rotate(t *testing.T) {
+	t.Run("should replace the active invite keeping its approval setting", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		activeInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithRequiresApproval(true).Build()
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListByGroupID(gomock.Any(), group.ID).Return([]domain.GroupInvite{activeInvite}, nil)
+		mockedGroupInviteRepository.EXPECT().Rotate(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, groupInvite domain.GroupInvite) error {
+			assert.Equal(t, generatedID, groupInvite.ID)
+			assert.True(t, groupInvite.RequiresApproval)
+			return nil
+		})
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		mockedInviteCodeGenerator.EXPECT().Generate().Return("ABCD2345", nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.Rotate(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, generatedID, result.ID)
+	})
+
+	t.Run("should keep the usage limit of a link that ran out of uses, ignoring personal and revoked invites", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		revokedAt := time.Now().Add(-time.Hour)
+		personalInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithEmail("friend@mail.com").WithMaxUses(1).Build()
+		exhaustedInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithMaxUses(10).WithUses(10).Build()
+		revokedInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithMaxUses(3).WithRevokedAt(&revokedAt).Build()
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListByGroupID(gomock.Any(), group.ID).Return([]domain.GroupInvite{personalInvite, exhaustedInvite, revokedInvite}, nil)
+		mockedGroupInviteRepository.EXPECT().Rotate(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		mockedInviteCodeGenerator.EXPECT().Generate().Return("ABCD2345", nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.Rotate(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 10, result.MaxUses)
+		assert.Equal(t, 0, result.Uses)
+	})
+
+	t.Run("should issue a new invite when there is no active invite", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListByGroupID(gomock.Any(), group.ID).Return([]domain.GroupInvite{}, nil)
+		mockedGroupInviteRepository.EXPECT().Rotate(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		mockedInviteCodeGenerator.EXPECT().Generate().Return("ABCD2345", nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.Rotate(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, result.RequiresApproval)
+	})
+
+	t.Run("should return error when loading the invites fails", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListByGroupID(gomock.Any(), group.ID).Return(nil, assert.AnError)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.Rotate(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupInviteService_ListRedemptions(t *testing.T) {
+	t.Run("should list who joined through the invite", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).Build()
+		redemptions := []domain.GroupInviteRedemption{
+			{InviteID: groupInvite.ID, User: build_domain.NewUserBuilder().Build(), RedeemedAt: time.Now()},
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().GetByID(gomock.Any(), groupInvite.ID).Return(&groupInvite, nil)
+		mockedGroupInviteRepository.EXPECT().ListRedemptions(gomock.Any(), groupInvite.ID).Return(redemptions, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.ListRedemptions(context.Background(), group.ID, groupInvite.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, redemptions, result)
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupInviteService := application.NewGroupInviteService(nil, mockedGroupRepository, nil, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		result, err := groupInviteService.ListRedemptions(context.Background(), group.ID, uuid.New().String(), uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+}
+
+func Test_groupInviteService_AcceptPendingPersonal(t *testing.T) {
+	t.Run("should add the user to the groups of their usable personal invites", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithEmailVerifiedAt(&verifiedAt).Build()
+		owner := build_domain.NewUserBuilder().Build()
+		openGroup := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+		closedGroup := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusMatched).Build()
+		openGroupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(openGroup.ID).WithEmail(user.Email).WithExpiresAt(time.Now().Add(time.Hour)).Build()
+		closedGroupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(closedGroup.ID).WithEmail(user.Email).WithExpiresAt(time.Now().Add(time.Hour)).Build()
+		expiredInvite := build_domain.NewGroupInviteBuilder().WithEmail(user.Email).WithExpiresAt(time.Now().Add(-time.Hour)).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListPendingPersonalByEmail(gomock.Any(), user.Email).Return([]domain.GroupInvite{closedGroupInvite, expiredInvite, openGroupInvite}, nil)
+		mockedGroupInviteRepository.EXPECT().Redeem(gomock.Any(), openGroupInvite.ID, user.ID, gomock.Any()).Return(nil)
+		mockedGroupInviteRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, groupInvite domain.GroupInvite) error {
+			assert.Equal(t, openGroupInvite.ID, groupInvite.ID)
+			assert.True(t, groupInvite.IsUsed())
+			return nil
+		})
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), closedGroup.ID).Return(&closedGroup, nil)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), openGroup.ID).Return(&openGroup, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, group domain.Group) error {
+			assert.Equal(t, openGroup.ID, group.ID)
+			assert.True(t, group.IsMember(user.ID))
+			return nil
+		})
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil).Times(3)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		err := groupInviteService.AcceptPendingPersonal(context.Background(), user.ID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should keep the invites pending until the user verifies their email", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		groupInviteService := application.NewGroupInviteService(nil, nil, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		err := groupInviteService.AcceptPendingPersonal(context.Background(), user.ID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when the invites cannot be listed", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithEmailVerifiedAt(&verifiedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListPendingPersonalByEmail(gomock.Any(), user.Email).Return(nil, assert.AnError)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, nil, mockedUserRepository, nil, nil, nil, nil, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+
+		// when
+		err := groupInviteService.AcceptPendingPersonal(context.Background(), user.ID)
 
 		// then
-		assert.Nil(t, result)
-		assert.Error(t, err)
 		assert.ErrorIs(t, err, assert.AnError)
 	})
 }
@@ -4,47 +4,92 @@ package application
 
 import (
 	"context"
+	"errors"
+	"log"
+	"time"
 
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 )
 
 type GroupService interface {
-	Create(ctx context.Context, name, description, ownerID string) (*domain.Group, error)
+	Create(ctx context.Context, name string, description *string, settings domain.GroupSettings, draft bool, templateID, ownerID string) (*domain.Group, error)
 	GetByID(ctx context.Context, groupID, requesterID string) (*domain.Group, error)
 	Search(ctx context.Context, filters domain.GroupFilters) (*domain.SearchResult[domain.GroupSummary], error)
 	AddUser(ctx context.Context, groupID, requesterID, targetUserID string) (*domain.Group, error)
+	AddUserByEmail(ctx context.Context, groupID, requesterID, email string) error
 	RemoveUser(ctx context.Context, groupID, requesterID, targetUserID string) (*domain.Group, error)
 	GenerateMatches(ctx context.Context, groupID, requesterID string) (*domain.Group, error)
 	Reopen(ctx context.Context, groupID, requesterID string) (*domain.Group, error)
 	Archive(ctx context.Context, groupID, requesterID string) (*domain.Group, error)
-	GetUserMatch(ctx context.Context, groupID, requesterID string) (*domain.User, error)
+	Publish(ctx context.Context, groupID, requesterID string) (*domain.Group, error)
+	CloseRegistration(ctx context.Context, groupID, requesterID string) (*domain.Group, error)
+	Complete(ctx context.Context, groupID, requesterID string) (*domain.Group, error)
+	SetMaxMembers(ctx context.Context, groupID, requesterID string, maxMembers int) (*domain.Group, error)
+	ApproveJoinRequest(ctx context.Context, groupID, requesterID, userID string) (*domain.Group, error)
+	RejectJoinRequest(ctx context.Context, groupID, requesterID, userID string) (*domain.Group, error)
+	GetUserMatch(ctx context.Context, groupID, requesterID string) (*domain.Participant, error)
+	RevealMatches(ctx context.Context, groupID, requesterID string) ([]domain.RevealedMatch, error)
+	AddGuest(ctx context.Context, groupID, requesterID, name, email string) (*domain.Group, error)
+	UpdateGuest(ctx context.Context, groupID, requesterID, guestID, name, email string) (*domain.Group, error)
+	RemoveGuest(ctx context.Context, groupID, requesterID, guestID string) (*domain.Group, error)
+	GetGuestMatch(ctx context.Context, groupID, requesterID, guestID string) (*domain.Participant, error)
+	ClaimGuest(ctx context.Context, groupID, requesterID, guestID string) (*domain.Group, error)
+	MergeGuest(ctx context.Context, groupID, requesterID, guestID, userID string) (*domain.Group, error)
+	ListClaimableGuests(ctx context.Context, requesterID string) ([]domain.ClaimableGuest, error)
 }
 
 type groupService struct {
-	groupRepository   domain.GroupRepository
-	userService       UserService
-	identityGenerator domain.IdentityGenerator
+	groupRepository      domain.GroupRepository
+	userService          UserService
+	groupTemplateService GroupTemplateService
+	groupInviteService   GroupInviteService
+	identityGenerator    domain.IdentityGenerator
+	verificationPolicy   domain.EmailVerificationPolicy
 }
 
 func NewGroupService(
 	groupRepository domain.GroupRepository,
 	userService UserService,
+	groupTemplateService GroupTemplateService,
+	groupInviteService GroupInviteService,
 	identityGenerator domain.IdentityGenerator,
+	verificationPolicy domain.EmailVerificationPolicy,
 ) GroupService {
 	return &groupService{
-		groupRepository:   groupRepository,
-		userService:       userService,
-		identityGenerator: identityGenerator,
+		groupRepository:      groupRepository,
+		userService:          userService,
+		groupTemplateService: groupTemplateService,
+		groupInviteService:   groupInviteService,
+		identityGenerator:    identityGenerator,
+		verificationPolicy:   verificationPolicy,
 	}
 }
 
-func (s *groupService) Create(ctx context.Context, name, description, ownerID string) (*domain.Group, error) {
+// Create creates a group owned by the given user. When a template ID is given, the template pre-fills
+// the description and every setting that was not provided (nil); provided values are kept even when zero or empty.
+func (s *groupService) Create(ctx context.Context, name string, description *string, settings domain.GroupSettings, draft bool, templateID, ownerID string) (*domain.Group, error) {
 	owner, err := s.userService.GetByID(ctx, ownerID)
 	if err != nil {
 		return nil, err
 	}
 
-	group, err := domain.NewGroup(s.identityGenerator, name, description, *owner)
+	if err := s.verificationPolicy.Authorize(*owner, domain.UnverifiedUserActionCreateGroup); err != nil {
+		return nil, err
+	}
+
+	var groupDescription string
+	if templateID != "" {
+		groupTemplate, err := s.groupTemplateService.GetByID(ctx, templateID, ownerID)
+		if err != nil {
+			return nil, err
+		}
+
+		groupDescription, settings = groupTemplate.Apply(description, settings, time.Now())
+	} else if description != nil {
+		groupDescription = *description
+	}
+
+	group, err := domain.NewGroup(s.identityGenerator, name, groupDescription, settings, draft, *owner)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +146,33 @@ func (s *groupService) AddUser(ctx context.Context, groupID, requesterID, target
 	return group, nil
 }
 
+// AddUserByEmail adds the user registered with the email to the group. When nobody has signed up with the address
+// yet, a personal invite is created and emailed to the address instead, which turns into a membership once they
+// register. Both outcomes look the same to the caller, so the owner cannot use it to find out who has an account.
+func (s *groupService) AddUserByEmail(ctx context.Context, groupID, requesterID, email string) error {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	targetUser, err := s.userService.GetByEmail(ctx, email)
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if !errors.As(err, &notFoundErr) {
+			return err
+		}
+
+		_, err := s.groupInviteService.CreatePersonal(ctx, groupID, requesterID, email)
+		return err
+	}
+
+	if err := group.AddUser(requesterID, *targetUser); err != nil {
+		return err
+	}
+
+	return s.groupRepository.Update(ctx, *group)
+}
+
 func (s *groupService) RemoveUser(ctx context.Context, groupID, requesterID, targetUserID string) (*domain.Group, error) {
 	group, err := s.groupRepository.GetByID(ctx, groupID)
 	if err != nil {
@@ -171,7 +243,144 @@ func (s *groupService) Archive(ctx context.Context, groupID, requesterID string)
 	return group, nil
 }
 
-func (s *groupService) GetUserMatch(ctx context.Context, groupID, requesterID string) (*domain.User, error) {
+func (s *groupService) Publish(ctx context.Context, groupID, requesterID string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.Publish(requesterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *groupService) CloseRegistration(ctx context.Context, groupID, requesterID string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.CloseRegistration(requesterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *groupService) Complete(ctx context.Context, groupID, requesterID string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.Complete(requesterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *groupService) SetMaxMembers(ctx context.Context, groupID, requesterID string, maxMembers int) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.SetMaxMembers(requesterID, maxMembers); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// ApproveJoinRequest admits the user and only then redeems a use of the invite the request was made through. When
+// the invite ran out of uses in the meantime, the request is put back as pending.
+func (s *groupService) ApproveJoinRequest(ctx context.Context, groupID, requesterID, userID string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	joinRequest, _ := group.PendingJoinRequest(userID)
+
+	if err := group.ApproveJoinRequest(requesterID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	if joinRequest.InviteID != "" {
+		if err := s.groupInviteService.Redeem(ctx, joinRequest.InviteID, userID); err != nil {
+			s.undoApproval(ctx, groupID, joinRequest)
+			return nil, err
+		}
+	}
+
+	return group, nil
+}
+
+// undoApproval takes the user back out of the group and restores their join request. It is best effort, since the
+// redemption error is what the owner has to see.
+func (s *groupService) undoApproval(ctx context.Context, groupID string, joinRequest domain.JoinRequest) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		log.Println("error undoing join request approval:", err)
+		return
+	}
+
+	if err := group.RemoveUser(group.OwnerID, joinRequest.User.ID); err != nil {
+		log.Println("error undoing join request approval:", err)
+		return
+	}
+
+	if err := group.RequestToJoin(joinRequest.User, joinRequest.InviteID); err != nil {
+		log.Println("error undoing join request approval:", err)
+		return
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		log.Println("error undoing join request approval:", err)
+	}
+}
+
+func (s *groupService) RejectJoinRequest(ctx context.Context, groupID, requesterID, userID string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.RejectJoinRequest(requesterID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *groupService) GetUserMatch(ctx context.Context, groupID, requesterID string) (*domain.Participant, error) {
 	group, err := s.groupRepository.GetByID(ctx, groupID)
 	if err != nil {
 		return nil, err
@@ -179,3 +388,134 @@ func (s *groupService) GetUserMatch(ctx context.Context, groupID, requesterID st
 
 	return group.GetUserMatch(requesterID)
 }
+
+func (s *groupService) RevealMatches(ctx context.Context, groupID, requesterID string) ([]domain.RevealedMatch, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return group.RevealMatches(requesterID)
+}
+
+func (s *groupService) AddGuest(ctx context.Context, groupID, requesterID, name, email string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	guest, err := domain.NewGuest(s.identityGenerator, name, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.AddGuest(requesterID, *guest); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *groupService) UpdateGuest(ctx context.Context, groupID, requesterID, guestID, name, email string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.UpdateGuest(requesterID, guestID, name, email); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *groupService) RemoveGuest(ctx context.Context, groupID, requesterID, guestID string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.RemoveGuest(requesterID, guestID); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *groupService) GetGuestMatch(ctx context.Context, groupID, requesterID, guestID string) (*domain.Participant, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return group.GetGuestMatch(requesterID, guestID)
+}
+
+func (s *groupService) ClaimGuest(ctx context.Context, groupID, requesterID, guestID string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	requester, err := s.userService.GetByID(ctx, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.ClaimGuest(guestID, *requester); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *groupService) MergeGuest(ctx context.Context, groupID, requesterID, guestID, userID string) (*domain.Group, error) {
+	group, err := s.groupRepository.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := group.MergeGuest(requesterID, guestID, *user); err != nil {
+		return nil, err
+	}
+
+	if err := s.groupRepository.Update(ctx, *group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+func (s *groupService) ListClaimableGuests(ctx context.Context, requesterID string) ([]domain.ClaimableGuest, error) {
+	requester, err := s.userService.GetByID(ctx, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !requester.IsEmailVerified() {
+		return nil, domain.NewForbiddenError("verify your email address to claim a guest")
+	}
+
+	return s.groupRepository.ListClaimableGuests(ctx, requester.Email)
+}
@@ -17,6 +17,28 @@ import (
 )
 
 func Test_groupService_Create(t *testing.T) {
+	t.Run("should return forbidden error when the policy requires a verified email and the owner has not verified it", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		policy, _ := domain.NewEmailVerificationPolicy([]string{"CREATE_GROUP"})
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), owner.ID).Return(&owner, nil)
+
+		groupService := application.NewGroupService(nil, mockedUserService, nil, nil, nil, policy)
+
+		// when
+		result, err := groupService.Create(context.Background(), "Test Group", nil, domain.GroupSettings{}, false, "", owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "verify your email address to create groups")
+	})
+
 	t.Run("should create group successfully", func(t *testing.T) {
 		// given
 		name := "Test Group"
@@ -47,10 +69,10 @@ func Test_groupService_Create(t *testing.T) {
 			return nil
 		})
 
-		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, mockedIdentityGenerator)
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, mockedIdentityGenerator, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.Create(context.Background(), name, description, ownerID)
+		result, err := groupService.Create(context.Background(), name, &description, domain.GroupSettings{}, false, "", ownerID)
 
 		// then
 		assert.NoError(t, err)
@@ -77,10 +99,10 @@ func Test_groupService_Create(t *testing.T) {
 		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
 		mockedIdentityGenerator.EXPECT().Generate().Return(expectedGroup.ID, nil)
 
-		groupService := application.NewGroupService(nil, mockedUserService, mockedIdentityGenerator)
+		groupService := application.NewGroupService(nil, mockedUserService, nil, nil, mockedIdentityGenerator, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.Create(context.Background(), name, description, ownerID)
+		result, err := groupService.Create(context.Background(), name, &description, domain.GroupSettings{}, false, "", ownerID)
 
 		// then
 		assert.Nil(t, result)
@@ -103,10 +125,10 @@ func Test_groupService_Create(t *testing.T) {
 		mockedUserService := mock_application.NewMockUserService(mockCtrl)
 		mockedUserService.EXPECT().GetByID(gomock.Any(), ownerID).Return(nil, assert.AnError)
 
-		groupService := application.NewGroupService(nil, mockedUserService, nil)
+		groupService := application.NewGroupService(nil, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.Create(context.Background(), name, description, ownerID)
+		result, err := groupService.Create(context.Background(), name, &description, domain.GroupSettings{}, false, "", ownerID)
 
 		// then
 		assert.Nil(t, result)
@@ -129,10 +151,10 @@ func Test_groupService_Create(t *testing.T) {
 		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
 		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
 
-		groupService := application.NewGroupService(nil, mockedUserService, mockedIdentityGenerator)
+		groupService := application.NewGroupService(nil, mockedUserService, nil, nil, mockedIdentityGenerator, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.Create(context.Background(), name, description, ownerID)
+		result, err := groupService.Create(context.Background(), name, &description, domain.GroupSettings{}, false, "", ownerID)
 
 		// then
 		assert.Nil(t, result)
@@ -159,16 +181,104 @@ func Test_groupService_Create(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, mockedIdentityGenerator)
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, mockedIdentityGenerator, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.Create(context.Background(), name, description, ownerID)
+		result, err := groupService.Create(context.Background(), name, &description, domain.GroupSettings{}, false, "", ownerID)
 
 		// then
 		assert.Nil(t, result)
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, assert.AnError)
 	})
+
+	t.Run("should create a draft group when requested", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), owner.ID).Return(&owner, nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, group domain.Group) error {
+			assert.Equal(t, domain.GroupStatusDraft, group.Status)
+			return nil
+		})
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, mockedIdentityGenerator, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Create(context.Background(), "Test Group", nil, domain.GroupSettings{}, true, "", owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusDraft, result.Status)
+	})
+
+	t.Run("should pre-fill the group settings from the template", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		groupTemplate := build_domain.NewGroupTemplateBuilder().WithOwnerID(owner.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), owner.ID).Return(&owner, nil)
+
+		mockedGroupTemplateService := mock_application.NewMockGroupTemplateService(mockCtrl)
+		mockedGroupTemplateService.EXPECT().GetByID(gomock.Any(), groupTemplate.ID, owner.ID).Return(&groupTemplate, nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, mockedGroupTemplateService, nil, mockedIdentityGenerator, domain.EmailVerificationPolicy{})
+
+		budget := 1000
+
+		// when
+		result, err := groupService.Create(context.Background(), "Test Group", nil, domain.GroupSettings{Budget: &budget}, false, groupTemplate.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, groupTemplate.Description, result.Description)
+		assert.Equal(t, groupTemplate.MaxMembers, result.MaxMembers)
+		assert.Equal(t, 1000, result.Budget)
+		assert.Equal(t, groupTemplate.Rules, result.Rules)
+		assert.Equal(t, groupTemplate.MatchStrategy, result.MatchStrategy)
+		assert.NotNil(t, result.ExchangeDate)
+	})
+
+	t.Run("should return error when the template cannot be used", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		templateID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), owner.ID).Return(&owner, nil)
+
+		mockedGroupTemplateService := mock_application.NewMockGroupTemplateService(mockCtrl)
+		mockedGroupTemplateService.EXPECT().GetByID(gomock.Any(), templateID, owner.ID).Return(nil, domain.NewResourceNotFoundError("group template not found"))
+
+		groupService := application.NewGroupService(nil, mockedUserService, mockedGroupTemplateService, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Create(context.Background(), "Test Group", nil, domain.GroupSettings{}, false, templateID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
 }
 
 func Test_groupService_GetByID(t *testing.T) {
@@ -183,7 +293,7 @@ func Test_groupService_GetByID(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), expectedGroup.ID).Return(&expectedGroup, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.GetByID(context.Background(), expectedGroup.ID, requesterID)
@@ -204,7 +314,7 @@ func Test_groupService_GetByID(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.GetByID(context.Background(), group.ID, nonMemberID)
@@ -227,7 +337,7 @@ func Test_groupService_GetByID(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.GetByID(context.Background(), groupID, requesterID)
@@ -269,7 +379,7 @@ func Test_groupService_AddUser(t *testing.T) {
 		mockedUserService := mock_application.NewMockUserService(mockCtrl)
 		mockedUserService.EXPECT().GetByID(gomock.Any(), targetUser.ID).Return(&targetUser, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.AddUser(context.Background(), group.ID, requesterID, targetUser.ID)
@@ -293,7 +403,7 @@ func Test_groupService_AddUser(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.AddUser(context.Background(), groupID, requesterID, targetUserID)
@@ -318,7 +428,7 @@ func Test_groupService_AddUser(t *testing.T) {
 		mockedUserService := mock_application.NewMockUserService(mockCtrl)
 		mockedUserService.EXPECT().GetByID(gomock.Any(), targetUserID).Return(nil, assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.AddUser(context.Background(), group.ID, requesterID, targetUserID)
@@ -344,7 +454,7 @@ func Test_groupService_AddUser(t *testing.T) {
 		mockedUserService := mock_application.NewMockUserService(mockCtrl)
 		mockedUserService.EXPECT().GetByID(gomock.Any(), targetUser.ID).Return(&targetUser, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.AddUser(context.Background(), group.ID, requesterID, targetUser.ID)
@@ -369,7 +479,7 @@ func Test_groupService_AddUser(t *testing.T) {
 		mockedUserService := mock_application.NewMockUserService(mockCtrl)
 		mockedUserService.EXPECT().GetByID(gomock.Any(), targetUser.ID).Return(&targetUser, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.AddUser(context.Background(), group.ID, requesterID, targetUser.ID)
@@ -383,6 +493,168 @@ func Test_groupService_AddUser(t *testing.T) {
 	})
 }
 
+func Test_groupService_AddUserByEmail(t *testing.T) {
+	t.Run("should add the user registered with the email", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+		targetUser := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updatedGroup domain.Group) error {
+			assert.True(t, updatedGroup.IsMember(targetUser.ID))
+			return nil
+		})
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByEmail(gomock.Any(), targetUser.Email).Return(&targetUser, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		err := groupService.AddUserByEmail(context.Background(), group.ID, group.OwnerID, targetUser.Email)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should send a personal invite when nobody is registered with the email", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+		email := "friend@example.com"
+		expectedInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithEmail(email).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByEmail(gomock.Any(), email).Return(nil, domain.NewResourceNotFoundError("user not found"))
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().CreatePersonal(gomock.Any(), group.ID, group.OwnerID, email).Return(&expectedInvite, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, mockedGroupInviteService, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		err := groupService.AddUserByEmail(context.Background(), group.ID, group.OwnerID, email)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should email the personal invite to the address", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+		email := "friend@example.com"
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil).Times(2)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByEmail(gomock.Any(), email).Return(nil, domain.NewResourceNotFoundError("user not found"))
+
+		mockedGroupInviteRepository := mock_domain.NewMockGroupInviteRepository(mockCtrl)
+		mockedGroupInviteRepository.EXPECT().ListPendingPersonalByGroupID(gomock.Any(), group.ID).Return([]domain.GroupInvite{}, nil)
+		mockedGroupInviteRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, groupInvite domain.GroupInvite) error {
+			assert.Equal(t, generatedID, groupInvite.ID)
+			return nil
+		})
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		mockedInviteCodeGenerator := mock_domain.NewMockInviteCodeGenerator(mockCtrl)
+		mockedInviteCodeGenerator.EXPECT().Generate().Return("ABCD2345", nil)
+
+		mockedMailer := mock_domain.NewMockMailer(mockCtrl)
+		mockedMailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, sentEmail domain.Email) error {
+			assert.Equal(t, email, sentEmail.To)
+			assert.Contains(t, sentEmail.Body, "ABCD2345")
+			return nil
+		})
+
+		groupInviteService := application.NewGroupInviteService(mockedGroupInviteRepository, mockedGroupRepository, nil, mockedIdentityGenerator, mockedInviteCodeGenerator, nil, mockedMailer, time.Hour, "", domain.EmailVerificationPolicy{}, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, groupInviteService, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		err := groupService.AddUserByEmail(context.Background(), group.ID, owner.ID, email)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when the personal invite cannot be created", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+		email := "friend@example.com"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByEmail(gomock.Any(), email).Return(nil, domain.NewResourceNotFoundError("user not found"))
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().CreatePersonal(gomock.Any(), group.ID, "requester-id", email).Return(nil, domain.NewForbiddenError("only the group owner can manage invites"))
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, mockedGroupInviteService, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		err := groupService.AddUserByEmail(context.Background(), group.ID, "requester-id", email)
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("should return error when fails to get the user", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByEmail(gomock.Any(), "friend@example.com").Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		err := groupService.AddUserByEmail(context.Background(), group.ID, group.OwnerID, "friend@example.com")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return error when fails to get group", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), "group-id").Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		err := groupService.AddUserByEmail(context.Background(), "group-id", "requester-id", "friend@example.com")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
 func Test_groupService_RemoveUser(t *testing.T) {
 	t.Run("should remove user successfully", func(t *testing.T) {
 		// given
@@ -411,7 +683,7 @@ func Test_groupService_RemoveUser(t *testing.T) {
 			return nil
 		})
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.RemoveUser(context.Background(), initialGroup.ID, requesterID, targetUser.ID)
@@ -435,7 +707,7 @@ func Test_groupService_RemoveUser(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.RemoveUser(context.Background(), groupID, requesterID, targetUserID)
@@ -459,7 +731,7 @@ func Test_groupService_RemoveUser(t *testing.T) {
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
 		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.RemoveUser(context.Background(), group.ID, requesterID, targetUser.ID)
@@ -482,7 +754,7 @@ func Test_groupService_RemoveUser(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.RemoveUser(context.Background(), group.ID, requesterID, targetUser.ID)
@@ -537,7 +809,7 @@ func Test_groupService_GenerateMatches(t *testing.T) {
 			return nil
 		})
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.GenerateMatches(context.Background(), initialGroup.ID, requesterID)
@@ -603,7 +875,7 @@ func Test_groupService_GenerateMatches(t *testing.T) {
 			return nil
 		})
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.GenerateMatches(context.Background(), initialGroup.ID, requesterID)
@@ -643,7 +915,7 @@ func Test_groupService_GenerateMatches(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.GenerateMatches(context.Background(), groupID, requesterID)
@@ -669,7 +941,7 @@ func Test_groupService_GenerateMatches(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), initialGroup.ID).Return(&initialGroup, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.GenerateMatches(context.Background(), initialGroup.ID, requesterID)
@@ -679,7 +951,7 @@ func Test_groupService_GenerateMatches(t *testing.T) {
 		assert.Error(t, err)
 		var expectedError *domain.ConflictError
 		assert.ErrorAs(t, err, &expectedError)
-		assert.EqualError(t, expectedError, "group must have at least 3 users to generate matches")
+		assert.EqualError(t, expectedError, "group must have at least 3 participants to generate matches")
 	})
 
 	t.Run("should return forbidden error when requester is not the group owner", func(t *testing.T) {
@@ -698,7 +970,7 @@ func Test_groupService_GenerateMatches(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), initialGroup.ID).Return(&initialGroup, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.GenerateMatches(context.Background(), initialGroup.ID, requesterID)
@@ -728,7 +1000,7 @@ func Test_groupService_GenerateMatches(t *testing.T) {
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), initialGroup.ID).Return(&initialGroup, nil)
 		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.GenerateMatches(context.Background(), initialGroup.ID, requesterID)
@@ -740,121 +1012,414 @@ func Test_groupService_GenerateMatches(t *testing.T) {
 	})
 }
 
-func Test_groupService_GetUserMatch(t *testing.T) {
-	t.Run("should return user match successfully", func(t *testing.T) {
+func Test_groupService_SetMaxMembers(t *testing.T) {
+	t.Run("should set the member limit and promote waitlisted users", func(t *testing.T) {
 		// given
-		requester := build_domain.NewUserBuilder().Build()
-		matchedUser := build_domain.NewUserBuilder().Build()
+		owner := build_domain.NewUserBuilder().Build()
+		waitlisted := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
-			WithUsers([]domain.User{requester, matchedUser}).
-			WithMatches([]domain.Match{{GiverID: requester.ID, ReceiverID: matchedUser.ID}}).
-			WithStatus(domain.GroupStatusMatched).
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithWaitlist([]domain.User{waitlisted}).
+			WithMaxMembers(1).
 			Build()
 
 		mockCtrl := gomock.NewController(t)
 
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+			assert.Equal(t, 2, updatedGroup.MaxMembers)
+			assert.Equal(t, []domain.User{owner, waitlisted}, updatedGroup.Users)
+			assert.Empty(t, updatedGroup.Waitlist)
+			return nil
+		})
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.GetUserMatch(context.Background(), group.ID, requester.ID)
+		result, err := groupService.SetMaxMembers(context.Background(), group.ID, owner.ID, 2)
 
 		// then
 		assert.NoError(t, err)
-		assert.Equal(t, &matchedUser, result)
+		assert.Equal(t, 2, result.MaxMembers)
 	})
 
-	t.Run("should return error when fails to get group", func(t *testing.T) {
+	t.Run("should return error when group is not found", func(t *testing.T) {
 		// given
-		groupID := "some-group-id"
-		requesterID := "some-requester-id"
+		groupID := uuid.New().String()
 
 		mockCtrl := gomock.NewController(t)
 
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
-		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, domain.NewResourceNotFoundError("group not found"))
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.GetUserMatch(context.Background(), groupID, requesterID)
+		result, err := groupService.SetMaxMembers(context.Background(), groupID, uuid.New().String(), 2)
 
 		// then
 		assert.Nil(t, result)
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, assert.AnError)
+		var expectedError *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &expectedError)
 	})
 
-	t.Run("should return error when domain group fails to get user match", func(t *testing.T) {
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
 		// given
-		requester := build_domain.NewUserBuilder().Build()
-		group := build_domain.NewGroupBuilder().
-			WithUsers([]domain.User{requester}). // No match for requester
-			WithStatus(domain.GroupStatusMatched).
-			Build()
+		group := build_domain.NewGroupBuilder().Build()
 
 		mockCtrl := gomock.NewController(t)
 
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.GetUserMatch(context.Background(), group.ID, requester.ID)
+		result, err := groupService.SetMaxMembers(context.Background(), group.ID, uuid.New().String(), 2)
 
 		// then
 		assert.Nil(t, result)
-		assert.Error(t, err)
-		var expectedError *domain.ConflictError
+		var expectedError *domain.ForbiddenError
 		assert.ErrorAs(t, err, &expectedError)
-		assert.EqualError(t, expectedError, "match not found for the given user")
 	})
-}
 
-func Test_groupService_Reopen(t *testing.T) {
-	t.Run("should reopen group successfully", func(t *testing.T) {
+	t.Run("should return error when repository update fails", func(t *testing.T) {
 		// given
-		groupID := uuid.New().String()
-		requesterID := uuid.New().String()
+		group := build_domain.NewGroupBuilder().Build()
 
-		owner := build_domain.NewUserBuilder().WithID(requesterID).Build()
-		now := time.Now().UTC()
+		mockCtrl := gomock.NewController(t)
 
-		initialGroup := build_domain.NewGroupBuilder().
-			WithID(groupID).
-			WithOwnerID(requesterID).
-			WithUsers([]domain.User{owner}).
-			WithStatus(domain.GroupStatusMatched).
-			WithMatches([]domain.Match{{GiverID: "giver-id", ReceiverID: "receiver-id"}}).
-			WithCreatedAt(now).
-			WithUpdatedAt(now).
-			Build()
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-		expectedGroup := build_domain.NewGroupBuilder().
-			WithID(initialGroup.ID).
-			WithName(initialGroup.Name).
-			WithOwnerID(initialGroup.OwnerID).
-			WithUsers([]domain.User{owner}).
-			WithStatus(domain.GroupStatusOpen).
-			WithMatches([]domain.Match{}).
-			WithCreatedAt(now).
-			WithUpdatedAt(now).
-			Build()
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
-		mockCtrl := gomock.NewController(t)
+		// when
+		result, err := groupService.SetMaxMembers(context.Background(), group.ID, group.OwnerID, 2)
 
-		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
-		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(&initialGroup, nil)
-		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
-			updatedGroup.UpdatedAt = expectedGroup.UpdatedAt
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_ApproveJoinRequest(t *testing.T) {
+	t.Run("should approve join request successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+			assert.True(t, updatedGroup.IsMember(user.ID))
+			assert.Empty(t, updatedGroup.JoinRequests)
+			return nil
+		})
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ApproveJoinRequest(context.Background(), group.ID, owner.ID, user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, result.IsMember(user.ID))
+	})
+
+	t.Run("should redeem a use of the invite the request was made through once it is approved", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		inviteID := uuid.New().String()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithJoinRequests([]domain.JoinRequest{{User: user, InviteID: inviteID, CreatedAt: time.Now()}}).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		updateCall := mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().Redeem(gomock.Any(), inviteID, user.ID).Return(nil).After(updateCall)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, mockedGroupInviteService, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ApproveJoinRequest(context.Background(), group.ID, owner.ID, user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, result.IsMember(user.ID))
+	})
+
+	t.Run("should put the request back when the invite ran out of uses", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		inviteID := uuid.New().String()
+		joinRequest := domain.JoinRequest{User: user, InviteID: inviteID, CreatedAt: time.Now()}
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithJoinRequests([]domain.JoinRequest{joinRequest}).
+			Build()
+		approvedGroup := group
+		approvedGroup.Users = []domain.User{owner, user}
+		approvedGroup.JoinRequests = nil
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		gomock.InOrder(
+			mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil),
+			mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil),
+			mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&approvedGroup, nil),
+			mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+				assert.False(t, updatedGroup.IsMember(user.ID))
+				restoredRequest, ok := updatedGroup.PendingJoinRequest(user.ID)
+				assert.True(t, ok)
+				assert.Equal(t, inviteID, restoredRequest.InviteID)
+				return nil
+			}),
+		)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().Redeem(gomock.Any(), inviteID, user.ID).Return(domain.NewConflictError("invite has reached its maximum number of uses"))
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, mockedGroupInviteService, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ApproveJoinRequest(context.Background(), group.ID, owner.ID, user.ID)
+
+		// then
+		assert.Nil(t, result)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+
+	t.Run("should return not found error when join request does not exist", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ApproveJoinRequest(context.Background(), group.ID, group.OwnerID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		var expectedError *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &expectedError)
+	})
+
+	t.Run("should return error when repository update fails", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ApproveJoinRequest(context.Background(), group.ID, group.OwnerID, user.ID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_RejectJoinRequest(t *testing.T) {
+	t.Run("should reject join request successfully", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.RejectJoinRequest(context.Background(), group.ID, group.OwnerID, user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, result.JoinRequests)
+		assert.False(t, result.IsMember(user.ID))
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.RejectJoinRequest(context.Background(), group.ID, user.ID, user.ID)
+
+		// then
+		assert.Nil(t, result)
+		var expectedError *domain.ForbiddenError
+		assert.ErrorAs(t, err, &expectedError)
+	})
+}
+
+func Test_groupService_GetUserMatch(t *testing.T) {
+	t.Run("should return user match successfully", func(t *testing.T) {
+		// given
+		requester := build_domain.NewUserBuilder().Build()
+		matchedUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithUsers([]domain.User{requester, matchedUser}).
+			WithMatches([]domain.Match{{GiverID: requester.ID, ReceiverID: matchedUser.ID}}).
+			WithStatus(domain.GroupStatusMatched).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.GetUserMatch(context.Background(), group.ID, requester.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.NewParticipantFromUser(matchedUser), *result)
+	})
+
+	t.Run("should return error when fails to get group", func(t *testing.T) {
+		// given
+		groupID := "some-group-id"
+		requesterID := "some-requester-id"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.GetUserMatch(context.Background(), groupID, requesterID)
+
+		// then
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return error when domain group fails to get user match", func(t *testing.T) {
+		// given
+		requester := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithUsers([]domain.User{requester}). // No match for requester
+			WithStatus(domain.GroupStatusMatched).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.GetUserMatch(context.Background(), group.ID, requester.ID)
+
+		// then
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		var expectedError *domain.ConflictError
+		assert.ErrorAs(t, err, &expectedError)
+		assert.EqualError(t, expectedError, "match not found for the given user")
+	})
+}
+
+func Test_groupService_Reopen(t *testing.T) {
+	t.Run("should reopen group successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		requesterID := uuid.New().String()
+
+		owner := build_domain.NewUserBuilder().WithID(requesterID).Build()
+		now := time.Now().UTC()
+
+		initialGroup := build_domain.NewGroupBuilder().
+			WithID(groupID).
+			WithOwnerID(requesterID).
+			WithUsers([]domain.User{owner}).
+			WithStatus(domain.GroupStatusMatched).
+			WithMatches([]domain.Match{{GiverID: "giver-id", ReceiverID: "receiver-id"}}).
+			WithCreatedAt(now).
+			WithUpdatedAt(now).
+			Build()
+
+		expectedGroup := build_domain.NewGroupBuilder().
+			WithID(initialGroup.ID).
+			WithName(initialGroup.Name).
+			WithOwnerID(initialGroup.OwnerID).
+			WithUsers([]domain.User{owner}).
+			WithStatus(domain.GroupStatusOpen).
+			WithMatches([]domain.Match{}).
+			WithCreatedAt(now).
+			WithUpdatedAt(now).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(&initialGroup, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+			updatedGroup.UpdatedAt = expectedGroup.UpdatedAt
 			assert.Equal(t, expectedGroup, updatedGroup)
 			return nil
 		})
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.Reopen(context.Background(), groupID, requesterID)
@@ -877,7 +1442,7 @@ func Test_groupService_Reopen(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.Reopen(context.Background(), groupID, requesterID)
@@ -905,7 +1470,7 @@ func Test_groupService_Reopen(t *testing.T) {
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(&initialGroup, nil)
 		// No Update expected because domain logic should prevent it
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.Reopen(context.Background(), groupID, requesterID)
@@ -935,7 +1500,7 @@ func Test_groupService_Reopen(t *testing.T) {
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(&initialGroup, nil)
 		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.Reopen(context.Background(), groupID, requesterID)
@@ -985,7 +1550,7 @@ func Test_groupService_Archive(t *testing.T) {
 			return nil
 		})
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.Archive(context.Background(), groupID, requesterID)
@@ -1007,7 +1572,7 @@ func Test_groupService_Archive(t *testing.T) {
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.Archive(context.Background(), groupID, requesterID)
@@ -1037,7 +1602,7 @@ func Test_groupService_Archive(t *testing.T) {
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(&initialGroup, nil)
 		// No Update expected because domain logic should prevent it
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.Archive(context.Background(), groupID, requesterID)
@@ -1069,7 +1634,7 @@ func Test_groupService_Archive(t *testing.T) {
 		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(&initialGroup, nil)
 		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
 		result, err := groupService.Archive(context.Background(), groupID, requesterID)
@@ -1081,111 +1646,859 @@ func Test_groupService_Archive(t *testing.T) {
 	})
 }
 
-func Test_groupService_Search(t *testing.T) {
-	t.Run("should search groups successfully", func(t *testing.T) {
+func Test_groupService_Publish(t *testing.T) {
+	t.Run("should publish group successfully", func(t *testing.T) {
 		// given
-		name := "Birthday Party"
-		status := domain.GroupStatusOpen
-		ownerID := "550e8400-e29b-41d4-a716-446655440000"
-		userID := "550e8400-e29b-41d4-a716-446655440001"
-		limit := 10
-		offset := 0
-		sortBy := "name"
-		sortDirection := domain.SortDirectionTypeAsc
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusDraft).Build()
 
-		filters := build_domain.NewGroupFiltersBuilder().
-			WithName(name).
-			WithStatuses(status).
-			WithOwnerID(ownerID).
-			WithUserID(userID).
-			WithLimit(limit).
-			WithOffset(offset).
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+			assert.Equal(t, domain.GroupStatusOpen, updatedGroup.Status)
+			return nil
+		})
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Publish(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusOpen, result.Status)
+	})
+
+	t.Run("should return error when fails to get group", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Publish(context.Background(), groupID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return conflict error when the transition is not allowed", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Publish(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "action PUBLISH is not allowed for a group in OPEN status")
+	})
+
+	t.Run("should return error when fails to update group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusDraft).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Publish(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_CloseRegistration(t *testing.T) {
+	t.Run("should close group registration successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+			assert.Equal(t, domain.GroupStatusRegistrationClosed, updatedGroup.Status)
+			return nil
+		})
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.CloseRegistration(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusRegistrationClosed, result.Status)
+	})
+
+	t.Run("should return error when fails to get group", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.CloseRegistration(context.Background(), groupID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return conflict error when the transition is not allowed", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusMatched).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.CloseRegistration(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "action CLOSE_REGISTRATION is not allowed for a group in MATCHED status")
+	})
+
+	t.Run("should return error when fails to update group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.CloseRegistration(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_Complete(t *testing.T) {
+	t.Run("should complete group successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusMatched).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+			assert.Equal(t, domain.GroupStatusCompleted, updatedGroup.Status)
+			return nil
+		})
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Complete(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusCompleted, result.Status)
+	})
+
+	t.Run("should return error when fails to get group", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Complete(context.Background(), groupID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return conflict error when the transition is not allowed", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Complete(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "action COMPLETE is not allowed for a group in OPEN status")
+	})
+
+	t.Run("should return error when fails to update group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusMatched).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Complete(context.Background(), group.ID, owner.ID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_RevealMatches(t *testing.T) {
+	t.Run("should reveal matches of a completed group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user}).
+			WithMatches([]domain.Match{
+				{GiverID: owner.ID, ReceiverID: user.ID},
+				{GiverID: user.ID, ReceiverID: owner.ID},
+			}).
+			WithStatus(domain.GroupStatusCompleted).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.RevealMatches(context.Background(), group.ID, user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, owner.ID, result[0].Giver.ID)
+		assert.Equal(t, user.ID, result[0].Receiver.ID)
+	})
+
+	t.Run("should return error when fails to get group", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.RevealMatches(context.Background(), groupID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_Search(t *testing.T) {
+	t.Run("should search groups successfully", func(t *testing.T) {
+		// given
+		name := "Birthday Party"
+		status := domain.GroupStatusOpen
+		ownerID := "550e8400-e29b-41d4-a716-446655440000"
+		userID := "550e8400-e29b-41d4-a716-446655440001"
+		limit := 10
+		offset := 0
+		sortBy := "name"
+		sortDirection := domain.SortDirectionTypeAsc
+
+		filters := build_domain.NewGroupFiltersBuilder().
+			WithName(name).
+			WithStatuses(status).
+			WithOwnerID(ownerID).
+			WithUserID(userID).
+			WithLimit(limit).
+			WithOffset(offset).
 			WithSortBy(sortBy).
 			WithSortDirection(sortDirection).
 			Build()
 
-		groupID := "550e8400-e29b-41d4-a716-446655440002"
-		userCount := 5
-		now := time.Now().UTC()
+		groupID := "550e8400-e29b-41d4-a716-446655440002"
+		userCount := 5
+		now := time.Now().UTC()
+
+		groupSummaries := []domain.GroupSummary{
+			build_domain.NewGroupSummaryBuilder().
+				WithID(groupID).
+				WithName(name).
+				WithStatus(status).
+				WithOwnerID(ownerID).
+				WithUserCount(userCount).
+				WithCreatedAt(now).
+				WithUpdatedAt(now).
+				Build(),
+		}
+
+		expectedSearchResult := build_domain.NewSearchResultBuilder[domain.GroupSummary]().
+			WithResult(groupSummaries).
+			WithLimit(limit).
+			WithOffset(offset).
+			WithTotal(1).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().Search(gomock.Any(), filters).Return(&expectedSearchResult, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Search(context.Background(), filters)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, &expectedSearchResult, result)
+	})
+
+	t.Run("should return validation error when filters are invalid", func(t *testing.T) {
+		// given
+		invalidFilters := domain.GroupFilters{
+			Name:          "",
+			OwnerID:       "",
+			UserID:        "",
+			Limit:         0, // Invalid limit
+			Offset:        0,
+			SortDirection: "",
+			SortBy:        "",
+		}
+
+		groupService := application.NewGroupService(nil, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Search(context.Background(), invalidFilters)
+
+		// then
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		var expectedError *domain.ValidationError
+		assert.ErrorAs(t, err, &expectedError)
+	})
+
+	t.Run("should return error when repository fails", func(t *testing.T) {
+		// given
+		filters := build_domain.NewGroupFiltersBuilder().
+			WithLimit(10).
+			WithOffset(0).
+			WithSortBy("name").
+			WithSortDirection(domain.SortDirectionTypeAsc).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().Search(gomock.Any(), filters).Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.Search(context.Background(), filters)
+
+		// then
+		assert.Nil(t, result)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_AddGuest(t *testing.T) {
+	t.Run("should add guest successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedGroup domain.Group) error {
+			assert.Len(t, updatedGroup.Guests, 1)
+			assert.Equal(t, generatedID, updatedGroup.Guests[0].ID)
+			return nil
+		})
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, mockedIdentityGenerator, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.AddGuest(context.Background(), group.ID, owner.ID, "Grandma", "grandma@example.com")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "Grandma", result.Guests[0].Name)
+		assert.Equal(t, "grandma@example.com", result.Guests[0].Email)
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, mockedIdentityGenerator, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.AddGuest(context.Background(), group.ID, uuid.New().String(), "Grandma", "")
+
+		// then
+		assert.Nil(t, result)
+		var expectedError *domain.ForbiddenError
+		assert.ErrorAs(t, err, &expectedError)
+	})
+
+	t.Run("should return error when update fails", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, mockedIdentityGenerator, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.AddGuest(context.Background(), group.ID, owner.ID, "Grandma", "")
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_UpdateGuest(t *testing.T) {
+	t.Run("should update guest successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
 
-		groupSummaries := []domain.GroupSummary{
-			build_domain.NewGroupSummaryBuilder().
-				WithID(groupID).
-				WithName(name).
-				WithStatus(status).
-				WithOwnerID(ownerID).
-				WithUserCount(userCount).
-				WithCreatedAt(now).
-				WithUpdatedAt(now).
-				Build(),
-		}
+		mockCtrl := gomock.NewController(t)
 
-		expectedSearchResult := build_domain.NewSearchResultBuilder[domain.GroupSummary]().
-			WithResult(groupSummaries).
-			WithLimit(limit).
-			WithOffset(offset).
-			WithTotal(1).
-			Build()
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.UpdateGuest(context.Background(), group.ID, owner.ID, guest.ID, "Grandpa", "grandpa@example.com")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "Grandpa", result.Guests[0].Name)
+		assert.Equal(t, "grandpa@example.com", result.Guests[0].Email)
+	})
+
+	t.Run("should return error when fails to get group", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
 
 		mockCtrl := gomock.NewController(t)
+
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
-		mockedGroupRepository.EXPECT().Search(gomock.Any(), filters).Return(&expectedSearchResult, nil)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.Search(context.Background(), filters)
+		result, err := groupService.UpdateGuest(context.Background(), groupID, uuid.New().String(), uuid.New().String(), "Grandpa", "")
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_RemoveGuest(t *testing.T) {
+	t.Run("should remove guest successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.RemoveGuest(context.Background(), group.ID, owner.ID, guest.ID)
 
 		// then
 		assert.NoError(t, err)
-		assert.Equal(t, &expectedSearchResult, result)
+		assert.Empty(t, result.Guests)
 	})
 
-	t.Run("should return validation error when filters are invalid", func(t *testing.T) {
+	t.Run("should return not found error when guest does not exist", func(t *testing.T) {
 		// given
-		invalidFilters := domain.GroupFilters{
-			Name:          "",
-			OwnerID:       "",
-			UserID:        "",
-			Limit:         0, // Invalid limit
-			Offset:        0,
-			SortDirection: "",
-			SortBy:        "",
-		}
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+
+		mockCtrl := gomock.NewController(t)
 
-		groupService := application.NewGroupService(nil, nil, nil)
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.Search(context.Background(), invalidFilters)
+		result, err := groupService.RemoveGuest(context.Background(), group.ID, owner.ID, uuid.New().String())
 
 		// then
 		assert.Nil(t, result)
-		assert.Error(t, err)
-		var expectedError *domain.ValidationError
+		var expectedError *domain.ResourceNotFoundError
 		assert.ErrorAs(t, err, &expectedError)
+		assert.EqualError(t, expectedError, "guest not found")
 	})
+}
 
-	t.Run("should return error when repository fails", func(t *testing.T) {
+func Test_groupService_GetGuestMatch(t *testing.T) {
+	t.Run("should return guest match successfully", func(t *testing.T) {
 		// given
-		filters := build_domain.NewGroupFiltersBuilder().
-			WithLimit(10).
-			WithOffset(0).
-			WithSortBy("name").
-			WithSortDirection(domain.SortDirectionTypeAsc).
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithGuests([]domain.Guest{guest}).
+			WithMatches([]domain.Match{{GiverID: guest.ID, ReceiverID: owner.ID}, {GiverID: owner.ID, ReceiverID: guest.ID}}).
+			WithStatus(domain.GroupStatusMatched).
 			Build()
 
 		mockCtrl := gomock.NewController(t)
+
 		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
-		mockedGroupRepository.EXPECT().Search(gomock.Any(), filters).Return(nil, assert.AnError)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
 
-		groupService := application.NewGroupService(mockedGroupRepository, nil, nil)
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
 
 		// when
-		result, err := groupService.Search(context.Background(), filters)
+		result, err := groupService.GetGuestMatch(context.Background(), group.ID, owner.ID, guest.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.NewParticipantFromUser(owner), *result)
+	})
+
+	t.Run("should return error when fails to get group", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), groupID).Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, nil, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.GetGuestMatch(context.Background(), groupID, uuid.New().String(), uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_ClaimGuest(t *testing.T) {
+	t.Run("should claim guest successfully", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		requester := build_domain.NewUserBuilder().WithEmail(guest.Email).WithEmailVerifiedAt(&verifiedAt).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), requester.ID).Return(&requester, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ClaimGuest(context.Background(), group.ID, requester.ID, guest.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, result.Guests)
+		assert.Contains(t, result.Users, requester)
+	})
+
+	t.Run("should return forbidden error without saving when the requester has not verified their email", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		requester := build_domain.NewUserBuilder().WithEmail(guest.Email).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), requester.ID).Return(&requester, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ClaimGuest(context.Background(), group.ID, requester.ID, guest.ID)
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "verify your email address to claim a guest")
+	})
+
+	t.Run("should return error when fails to get requester", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+		requesterID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), requesterID).Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ClaimGuest(context.Background(), group.ID, requesterID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupService_MergeGuest(t *testing.T) {
+	t.Run("should merge guest into the user successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().WithEmail("").Build()
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.MergeGuest(context.Background(), group.ID, owner.ID, guest.ID, user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, result.Guests)
+		assert.Contains(t, result.Users, user)
+	})
+
+	t.Run("should return forbidden error without saving when the requester is not the owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+		mockedGroupRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.MergeGuest(context.Background(), group.ID, user.ID, guest.ID, user.ID)
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("should return error when fails to get the user", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+		userID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().GetByID(gomock.Any(), group.ID).Return(&group, nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), userID).Return(nil, assert.AnError)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.MergeGuest(context.Background(), group.ID, group.OwnerID, uuid.New().String(), userID)
 
 		// then
 		assert.Nil(t, result)
-		assert.Error(t, err)
 		assert.ErrorIs(t, err, assert.AnError)
 	})
 }
+
+func Test_groupService_ListClaimableGuests(t *testing.T) {
+	t.Run("should list the guests matching the email of the requester", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		requester := build_domain.NewUserBuilder().WithEmailVerifiedAt(&verifiedAt).Build()
+		claimableGuests := []domain.ClaimableGuest{
+			{
+				GroupID:   uuid.New().String(),
+				GroupName: "Secret Santa",
+				Guest:     build_domain.NewGuestBuilder().WithEmail(requester.Email).Build(),
+			},
+		}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().ListClaimableGuests(gomock.Any(), requester.Email).Return(claimableGuests, nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), requester.ID).Return(&requester, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ListClaimableGuests(context.Background(), requester.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, claimableGuests, result)
+	})
+
+	t.Run("should return forbidden error when the requester has not verified their email", func(t *testing.T) {
+		// given
+		requester := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupRepository := mock_domain.NewMockGroupRepository(mockCtrl)
+		mockedGroupRepository.EXPECT().ListClaimableGuests(gomock.Any(), gomock.Any()).Times(0)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().GetByID(gomock.Any(), requester.ID).Return(&requester, nil)
+
+		groupService := application.NewGroupService(mockedGroupRepository, mockedUserService, nil, nil, nil, domain.EmailVerificationPolicy{})
+
+		// when
+		result, err := groupService.ListClaimableGuests(context.Background(), requester.ID)
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "verify your email address to claim a guest")
+	})
+}
@@ -0,0 +1,85 @@
+package application
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_application/group_template_service.go . GroupTemplateService
+
+import (
+	"context"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type GroupTemplateService interface {
+	List(ctx context.Context, requesterID string) ([]domain.GroupTemplate, error)
+	GetByID(ctx context.Context, templateID, requesterID string) (*domain.GroupTemplate, error)
+	Create(ctx context.Context, ownerID, name, description string, maxMembers, budget int, rules string, exchangeInDays int, matchStrategy domain.MatchStrategy) (*domain.GroupTemplate, error)
+	Delete(ctx context.Context, templateID, requesterID string) error
+}
+
+type groupTemplateService struct {
+	groupTemplateRepository domain.GroupTemplateRepository
+	identityGenerator       domain.IdentityGenerator
+}
+
+func NewGroupTemplateService(groupTemplateRepository domain.GroupTemplateRepository, identityGenerator domain.IdentityGenerator) GroupTemplateService {
+	return &groupTemplateService{
+		groupTemplateRepository: groupTemplateRepository,
+		identityGenerator:       identityGenerator,
+	}
+}
+
+// List returns the built-in templates followed by the templates created by the requester.
+func (s *groupTemplateService) List(ctx context.Context, requesterID string) ([]domain.GroupTemplate, error) {
+	userTemplates, err := s.groupTemplateRepository.ListByOwnerID(ctx, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupTemplates := make([]domain.GroupTemplate, 0, len(domain.BuiltInGroupTemplates)+len(userTemplates))
+	groupTemplates = append(groupTemplates, domain.BuiltInGroupTemplates...)
+	groupTemplates = append(groupTemplates, userTemplates...)
+
+	return groupTemplates, nil
+}
+
+func (s *groupTemplateService) GetByID(ctx context.Context, templateID, requesterID string) (*domain.GroupTemplate, error) {
+	if groupTemplate, found := domain.FindBuiltInGroupTemplate(templateID); found {
+		return groupTemplate, nil
+	}
+
+	groupTemplate, err := s.groupTemplateRepository.GetByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := groupTemplate.CanUse(requesterID); err != nil {
+		return nil, err
+	}
+
+	return groupTemplate, nil
+}
+
+func (s *groupTemplateService) Create(ctx context.Context, ownerID, name, description string, maxMembers, budget int, rules string, exchangeInDays int, matchStrategy domain.MatchStrategy) (*domain.GroupTemplate, error) {
+	groupTemplate, err := domain.NewGroupTemplate(s.identityGenerator, ownerID, name, description, maxMembers, budget, rules, exchangeInDays, matchStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.groupTemplateRepository.Create(ctx, *groupTemplate); err != nil {
+		return nil, err
+	}
+
+	return groupTemplate, nil
+}
+
+func (s *groupTemplateService) Delete(ctx context.Context, templateID, requesterID string) error {
+	groupTemplate, err := s.GetByID(ctx, templateID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	if err := groupTemplate.CanDelete(requesterID); err != nil {
+		return err
+	}
+
+	return s.groupTemplateRepository.Delete(ctx, templateID)
+}
@@ -0,0 +1,214 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_groupTemplateService_List(t *testing.T) {
+	t.Run("should return the built-in templates followed by the user templates", func(t *testing.T) {
+		// given
+		ownerID := uuid.New().String()
+		userTemplate := build_domain.NewGroupTemplateBuilder().WithOwnerID(ownerID).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupTemplateRepository := mock_domain.NewMockGroupTemplateRepository(mockCtrl)
+		mockedGroupTemplateRepository.EXPECT().ListByOwnerID(gomock.Any(), ownerID).Return([]domain.GroupTemplate{userTemplate}, nil)
+
+		groupTemplateService := application.NewGroupTemplateService(mockedGroupTemplateRepository, nil)
+
+		// when
+		result, err := groupTemplateService.List(context.Background(), ownerID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, len(domain.BuiltInGroupTemplates)+1)
+		assert.Equal(t, domain.BuiltInGroupTemplates[0], result[0])
+		assert.Equal(t, userTemplate, result[len(result)-1])
+	})
+
+	t.Run("should return error when fails to list user templates", func(t *testing.T) {
+		// given
+		ownerID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupTemplateRepository := mock_domain.NewMockGroupTemplateRepository(mockCtrl)
+		mockedGroupTemplateRepository.EXPECT().ListByOwnerID(gomock.Any(), ownerID).Return(nil, assert.AnError)
+
+		groupTemplateService := application.NewGroupTemplateService(mockedGroupTemplateRepository, nil)
+
+		// when
+		result, err := groupTemplateService.List(context.Background(), ownerID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupTemplateService_GetByID(t *testing.T) {
+	t.Run("should return a built-in template without querying the repository", func(t *testing.T) {
+		// given
+		groupTemplateService := application.NewGroupTemplateService(nil, nil)
+
+		// when
+		result, err := groupTemplateService.GetByID(context.Background(), "office-secret-santa", uuid.New().String())
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "Office Secret Santa", result.Name)
+	})
+
+	t.Run("should return a template owned by the requester", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupTemplateRepository := mock_domain.NewMockGroupTemplateRepository(mockCtrl)
+		mockedGroupTemplateRepository.EXPECT().GetByID(gomock.Any(), groupTemplate.ID).Return(&groupTemplate, nil)
+
+		groupTemplateService := application.NewGroupTemplateService(mockedGroupTemplateRepository, nil)
+
+		// when
+		result, err := groupTemplateService.GetByID(context.Background(), groupTemplate.ID, groupTemplate.OwnerID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, &groupTemplate, result)
+	})
+
+	t.Run("should return forbidden error when the template belongs to another user", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupTemplateRepository := mock_domain.NewMockGroupTemplateRepository(mockCtrl)
+		mockedGroupTemplateRepository.EXPECT().GetByID(gomock.Any(), groupTemplate.ID).Return(&groupTemplate, nil)
+
+		groupTemplateService := application.NewGroupTemplateService(mockedGroupTemplateRepository, nil)
+
+		// when
+		result, err := groupTemplateService.GetByID(context.Background(), groupTemplate.ID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("should return error when fails to get template", func(t *testing.T) {
+		// given
+		templateID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupTemplateRepository := mock_domain.NewMockGroupTemplateRepository(mockCtrl)
+		mockedGroupTemplateRepository.EXPECT().GetByID(gomock.Any(), templateID).Return(nil, assert.AnError)
+
+		groupTemplateService := application.NewGroupTemplateService(mockedGroupTemplateRepository, nil)
+
+		// when
+		result, err := groupTemplateService.GetByID(context.Background(), templateID, uuid.New().String())
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupTemplateService_Create(t *testing.T) {
+	t.Run("should create a user template", func(t *testing.T) {
+		// given
+		ownerID := uuid.New().String()
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		mockedGroupTemplateRepository := mock_domain.NewMockGroupTemplateRepository(mockCtrl)
+		mockedGroupTemplateRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, groupTemplate domain.GroupTemplate) error {
+			assert.Equal(t, generatedID, groupTemplate.ID)
+			assert.Equal(t, ownerID, groupTemplate.OwnerID)
+			return nil
+		})
+
+		groupTemplateService := application.NewGroupTemplateService(mockedGroupTemplateRepository, mockedIdentityGenerator)
+
+		// when
+		result, err := groupTemplateService.Create(context.Background(), ownerID, "Book club", "", 10, 3000, "Books only", 7, domain.MatchStrategyRandom)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "Book club", result.Name)
+		assert.Equal(t, 3000, result.Budget)
+		assert.Equal(t, domain.MatchStrategyRandom, result.MatchStrategy)
+	})
+
+	t.Run("should return error when fails to save template", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		mockedGroupTemplateRepository := mock_domain.NewMockGroupTemplateRepository(mockCtrl)
+		mockedGroupTemplateRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		groupTemplateService := application.NewGroupTemplateService(mockedGroupTemplateRepository, mockedIdentityGenerator)
+
+		// when
+		result, err := groupTemplateService.Create(context.Background(), uuid.New().String(), "Book club", "", 0, 0, "", 0, "")
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_groupTemplateService_Delete(t *testing.T) {
+	t.Run("should delete a template owned by the requester", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedGroupTemplateRepository := mock_domain.NewMockGroupTemplateRepository(mockCtrl)
+		mockedGroupTemplateRepository.EXPECT().GetByID(gomock.Any(), groupTemplate.ID).Return(&groupTemplate, nil)
+		mockedGroupTemplateRepository.EXPECT().Delete(gomock.Any(), groupTemplate.ID).Return(nil)
+
+		groupTemplateService := application.NewGroupTemplateService(mockedGroupTemplateRepository, nil)
+
+		// when
+		err := groupTemplateService.Delete(context.Background(), groupTemplate.ID, groupTemplate.OwnerID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return forbidden error when deleting a built-in template", func(t *testing.T) {
+		// given
+		groupTemplateService := application.NewGroupTemplateService(nil, nil)
+
+		// when
+		err := groupTemplateService.Delete(context.Background(), "white-elephant", uuid.New().String())
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "built-in templates cannot be deleted")
+	})
+}
@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/application (interfaces: AttemptLimiter)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_application/attempt_limiter.go . AttemptLimiter
+//
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	context "context"
+	reflect "reflect"
+
+	application "github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttemptLimiter is a mock of AttemptLimiter interface.
+type MockAttemptLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttemptLimiterMockRecorder
+	isgomock struct{}
+}
+
+// MockAttemptLimiterMockRecorder is the mock recorder for MockAttemptLimiter.
+type MockAttemptLimiterMockRecorder struct {
+	mock *MockAttemptLimiter
+}
+
+// NewMockAttemptLimiter creates a new mock instance.
+func NewMockAttemptLimiter(ctrl *gomock.Controller) *MockAttemptLimiter {
+	mock := &MockAttemptLimiter{ctrl: ctrl}
+	mock.recorder = &MockAttemptLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttemptLimiter) EXPECT() *MockAttemptLimiterMockRecorder {
+	return m.recorder
+}
+
+// Release mocks base method.
+func (m *MockAttemptLimiter) Release(ctx context.Context, reservation application.AttemptReservation) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Release", ctx, reservation)
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockAttemptLimiterMockRecorder) Release(ctx, reservation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockAttemptLimiter)(nil).Release), ctx, reservation)
+}
+
+// Reserve mocks base method.
+func (m *MockAttemptLimiter) Reserve(ctx context.Context, keys ...string) (application.AttemptReservation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Reserve", varargs...)
+	ret0, _ := ret[0].(application.AttemptReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockAttemptLimiterMockRecorder) Reserve(ctx any, keys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockAttemptLimiter)(nil).Reserve), varargs...)
+}
+
+// Reset mocks base method.
+func (m *MockAttemptLimiter) Reset(ctx context.Context, keys ...string) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Reset", varargs...)
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockAttemptLimiterMockRecorder) Reset(ctx any, keys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockAttemptLimiter)(nil).Reset), varargs...)
+}
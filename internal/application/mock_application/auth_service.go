@@ -12,6 +12,7 @@ package mock_application
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -41,17 +42,105 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 	return m.recorder
 }
 
-// Login mocks base method.
-func (m *MockAuthService) Login(ctx context.Context, credentials domain.Credentials) (*domain.AuthSession, error) {
+// ChangePassword mocks base method.
+func (m *MockAuthService) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string, device domain.Device) (*domain.AuthSession, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, credentials)
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, currentPassword, newPassword, device)
 	ret0, _ := ret[0].(*domain.AuthSession)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceMockRecorder) ChangePassword(ctx, userID, currentPassword, newPassword, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthService)(nil).ChangePassword), ctx, userID, currentPassword, newPassword, device)
+}
+
+// CompleteLogin mocks base method.
+func (m *MockAuthService) CompleteLogin(ctx context.Context, user domain.User, device domain.Device) (*domain.LoginResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteLogin", ctx, user, device)
+	ret0, _ := ret[0].(*domain.LoginResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteLogin indicates an expected call of CompleteLogin.
+func (mr *MockAuthServiceMockRecorder) CompleteLogin(ctx, user, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteLogin", reflect.TypeOf((*MockAuthService)(nil).CompleteLogin), ctx, user, device)
+}
+
+// Login mocks base method.
+func (m *MockAuthService) Login(ctx context.Context, credentials domain.Credentials, device domain.Device) (*domain.LoginResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, credentials, device)
+	ret0, _ := ret[0].(*domain.LoginResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Login indicates an expected call of Login.
-func (mr *MockAuthServiceMockRecorder) Login(ctx, credentials any) *gomock.Call {
+func (mr *MockAuthServiceMockRecorder) Login(ctx, credentials, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), ctx, credentials, device)
+}
+
+// Logout mocks base method.
+func (m *MockAuthService) Logout(ctx context.Context, refreshToken, userID, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, refreshToken, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceMockRecorder) Logout(ctx, refreshToken, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthService)(nil).Logout), ctx, refreshToken, userID, sessionID)
+}
+
+// Refresh mocks base method.
+func (m *MockAuthService) Refresh(ctx context.Context, refreshToken string) (*domain.AuthSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*domain.AuthSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthServiceMockRecorder) Refresh(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuthService)(nil).Refresh), ctx, refreshToken)
+}
+
+// ValidateSession mocks base method.
+func (m *MockAuthService) ValidateSession(ctx context.Context, userID, sessionID string, issuedAt time.Time, device domain.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSession", ctx, userID, sessionID, issuedAt, device)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSession indicates an expected call of ValidateSession.
+func (mr *MockAuthServiceMockRecorder) ValidateSession(ctx, userID, sessionID, issuedAt, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSession", reflect.TypeOf((*MockAuthService)(nil).ValidateSession), ctx, userID, sessionID, issuedAt, device)
+}
+
+// VerifyTwoFactor mocks base method.
+func (m *MockAuthService) VerifyTwoFactor(ctx context.Context, challengeToken, code string, device domain.Device) (*domain.AuthSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTwoFactor", ctx, challengeToken, code, device)
+	ret0, _ := ret[0].(*domain.AuthSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTwoFactor indicates an expected call of VerifyTwoFactor.
+func (mr *MockAuthServiceMockRecorder) VerifyTwoFactor(ctx, challengeToken, code, device any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), ctx, credentials)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTwoFactor", reflect.TypeOf((*MockAuthService)(nil).VerifyTwoFactor), ctx, challengeToken, code, device)
 }
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/application (interfaces: AvatarService)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_application/avatar_service.go . AvatarService
+//
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAvatarService is a mock of AvatarService interface.
+type MockAvatarService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvatarServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAvatarServiceMockRecorder is the mock recorder for MockAvatarService.
+type MockAvatarServiceMockRecorder struct {
+	mock *MockAvatarService
+}
+
+// NewMockAvatarService creates a new mock instance.
+func NewMockAvatarService(ctrl *gomock.Controller) *MockAvatarService {
+	mock := &MockAvatarService{ctrl: ctrl}
+	mock.recorder = &MockAvatarServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvatarService) EXPECT() *MockAvatarServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAvatarService) Get(ctx context.Context, userID string, size domain.AvatarSize) (*domain.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, size)
+	ret0, _ := ret[0].(*domain.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAvatarServiceMockRecorder) Get(ctx, userID, size any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAvatarService)(nil).Get), ctx, userID, size)
+}
+
+// Remove mocks base method.
+func (m *MockAvatarService) Remove(ctx context.Context, userID string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockAvatarServiceMockRecorder) Remove(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockAvatarService)(nil).Remove), ctx, userID)
+}
+
+// Upload mocks base method.
+func (m *MockAvatarService) Upload(ctx context.Context, userID string, content []byte) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, userID, content)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAvatarServiceMockRecorder) Upload(ctx, userID, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAvatarService)(nil).Upload), ctx, userID, content)
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/application (interfaces: EmailVerificationService)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_application/email_verification_service.go . EmailVerificationService
+//
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailVerificationService is a mock of EmailVerificationService interface.
+type MockEmailVerificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailVerificationServiceMockRecorder is the mock recorder for MockEmailVerificationService.
+type MockEmailVerificationServiceMockRecorder struct {
+	mock *MockEmailVerificationService
+}
+
+// NewMockEmailVerificationService creates a new mock instance.
+func NewMockEmailVerificationService(ctrl *gomock.Controller) *MockEmailVerificationService {
+	mock := &MockEmailVerificationService{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationService) EXPECT() *MockEmailVerificationServiceMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockEmailVerificationService) Confirm(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockEmailVerificationServiceMockRecorder) Confirm(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockEmailVerificationService)(nil).Confirm), ctx, token)
+}
+
+// SendVerification mocks base method.
+func (m *MockEmailVerificationService) SendVerification(ctx context.Context, userID, ipAddress string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerification", ctx, userID, ipAddress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendVerification indicates an expected call of SendVerification.
+func (mr *MockEmailVerificationServiceMockRecorder) SendVerification(ctx, userID, ipAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerification", reflect.TypeOf((*MockEmailVerificationService)(nil).SendVerification), ctx, userID, ipAddress)
+}
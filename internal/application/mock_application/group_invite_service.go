@@ -41,19 +41,62 @@ func (m *MockGroupInviteService) EXPECT() *MockGroupInviteServiceMockRecorder {
 	return m.recorder
 }
 
+// AcceptPendingPersonal mocks base method.
+func (m *MockGroupInviteService) AcceptPendingPersonal(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptPendingPersonal", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptPendingPersonal indicates an expected call of AcceptPendingPersonal.
+func (mr *MockGroupInviteServiceMockRecorder) AcceptPendingPersonal(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptPendingPersonal", reflect.TypeOf((*MockGroupInviteService)(nil).AcceptPendingPersonal), ctx, userID)
+}
+
+// CancelPersonal mocks base method.
+func (m *MockGroupInviteService) CancelPersonal(ctx context.Context, groupID, inviteID, requesterID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPersonal", ctx, groupID, inviteID, requesterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPersonal indicates an expected call of CancelPersonal.
+func (mr *MockGroupInviteServiceMockRecorder) CancelPersonal(ctx, groupID, inviteID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPersonal", reflect.TypeOf((*MockGroupInviteService)(nil).CancelPersonal), ctx, groupID, inviteID, requesterID)
+}
+
 // Create mocks base method.
-func (m *MockGroupInviteService) Create(ctx context.Context, groupID, requesterID string) (*domain.GroupInvite, error) {
+func (m *MockGroupInviteService) Create(ctx context.Context, groupID, requesterID string, requiresApproval bool, maxUses int) (*domain.GroupInvite, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, groupID, requesterID)
+	ret := m.ctrl.Call(m, "Create", ctx, groupID, requesterID, requiresApproval, maxUses)
 	ret0, _ := ret[0].(*domain.GroupInvite)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockGroupInviteServiceMockRecorder) Create(ctx, groupID, requesterID any) *gomock.Call {
+func (mr *MockGroupInviteServiceMockRecorder) Create(ctx, groupID, requesterID, requiresApproval, maxUses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupInviteService)(nil).Create), ctx, groupID, requesterID, requiresApproval, maxUses)
+}
+
+// CreatePersonal mocks base method.
+func (m *MockGroupInviteService) CreatePersonal(ctx context.Context, groupID, requesterID, email string) (*domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonal", ctx, groupID, requesterID, email)
+	ret0, _ := ret[0].(*domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonal indicates an expected call of CreatePersonal.
+func (mr *MockGroupInviteServiceMockRecorder) CreatePersonal(ctx, groupID, requesterID, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupInviteService)(nil).Create), ctx, groupID, requesterID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonal", reflect.TypeOf((*MockGroupInviteService)(nil).CreatePersonal), ctx, groupID, requesterID, email)
 }
 
 // GetActive mocks base method.
@@ -71,17 +114,166 @@ func (mr *MockGroupInviteServiceMockRecorder) GetActive(ctx, groupID, requesterI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockGroupInviteService)(nil).GetActive), ctx, groupID, requesterID)
 }
 
+// GetActiveQRCode mocks base method.
+func (m *MockGroupInviteService) GetActiveQRCode(ctx context.Context, groupID, requesterID string, format domain.QRCodeFormat) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveQRCode", ctx, groupID, requesterID, format)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveQRCode indicates an expected call of GetActiveQRCode.
+func (mr *MockGroupInviteServiceMockRecorder) GetActiveQRCode(ctx, groupID, requesterID, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveQRCode", reflect.TypeOf((*MockGroupInviteService)(nil).GetActiveQRCode), ctx, groupID, requesterID, format)
+}
+
+// GetPreview mocks base method.
+func (m *MockGroupInviteService) GetPreview(ctx context.Context, inviteID string) (*domain.GroupInvitePreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreview", ctx, inviteID)
+	ret0, _ := ret[0].(*domain.GroupInvitePreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreview indicates an expected call of GetPreview.
+func (mr *MockGroupInviteServiceMockRecorder) GetPreview(ctx, inviteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreview", reflect.TypeOf((*MockGroupInviteService)(nil).GetPreview), ctx, inviteID)
+}
+
 // JoinGroup mocks base method.
-func (m *MockGroupInviteService) JoinGroup(ctx context.Context, inviteID, userID string) (*domain.Group, error) {
+func (m *MockGroupInviteService) JoinGroup(ctx context.Context, inviteID, userID, ipAddress string) (*domain.Group, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "JoinGroup", ctx, inviteID, userID)
+	ret := m.ctrl.Call(m, "JoinGroup", ctx, inviteID, userID, ipAddress)
 	ret0, _ := ret[0].(*domain.Group)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // JoinGroup indicates an expected call of JoinGroup.
-func (mr *MockGroupInviteServiceMockRecorder) JoinGroup(ctx, inviteID, userID any) *gomock.Call {
+func (mr *MockGroupInviteServiceMockRecorder) JoinGroup(ctx, inviteID, userID, ipAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinGroup", reflect.TypeOf((*MockGroupInviteService)(nil).JoinGroup), ctx, inviteID, userID, ipAddress)
+}
+
+// JoinGroupByCode mocks base method.
+func (m *MockGroupInviteService) JoinGroupByCode(ctx context.Context, code, userID, ipAddress string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinGroupByCode", ctx, code, userID, ipAddress)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinGroupByCode indicates an expected call of JoinGroupByCode.
+func (mr *MockGroupInviteServiceMockRecorder) JoinGroupByCode(ctx, code, userID, ipAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinGroupByCode", reflect.TypeOf((*MockGroupInviteService)(nil).JoinGroupByCode), ctx, code, userID, ipAddress)
+}
+
+// List mocks base method.
+func (m *MockGroupInviteService) List(ctx context.Context, groupID, requesterID string) ([]domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, groupID, requesterID)
+	ret0, _ := ret[0].([]domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGroupInviteServiceMockRecorder) List(ctx, groupID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGroupInviteService)(nil).List), ctx, groupID, requesterID)
+}
+
+// ListPersonal mocks base method.
+func (m *MockGroupInviteService) ListPersonal(ctx context.Context, groupID, requesterID string) ([]domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPersonal", ctx, groupID, requesterID)
+	ret0, _ := ret[0].([]domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonal indicates an expected call of ListPersonal.
+func (mr *MockGroupInviteServiceMockRecorder) ListPersonal(ctx, groupID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonal", reflect.TypeOf((*MockGroupInviteService)(nil).ListPersonal), ctx, groupID, requesterID)
+}
+
+// ListRedemptions mocks base method.
+func (m *MockGroupInviteService) ListRedemptions(ctx context.Context, groupID, inviteID, requesterID string) ([]domain.GroupInviteRedemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRedemptions", ctx, groupID, inviteID, requesterID)
+	ret0, _ := ret[0].([]domain.GroupInviteRedemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRedemptions indicates an expected call of ListRedemptions.
+func (mr *MockGroupInviteServiceMockRecorder) ListRedemptions(ctx, groupID, inviteID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRedemptions", reflect.TypeOf((*MockGroupInviteService)(nil).ListRedemptions), ctx, groupID, inviteID, requesterID)
+}
+
+// Redeem mocks base method.
+func (m *MockGroupInviteService) Redeem(ctx context.Context, inviteID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, inviteID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockGroupInviteServiceMockRecorder) Redeem(ctx, inviteID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockGroupInviteService)(nil).Redeem), ctx, inviteID, userID)
+}
+
+// ResendPersonal mocks base method.
+func (m *MockGroupInviteService) ResendPersonal(ctx context.Context, groupID, inviteID, requesterID string) (*domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendPersonal", ctx, groupID, inviteID, requesterID)
+	ret0, _ := ret[0].(*domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendPersonal indicates an expected call of ResendPersonal.
+func (mr *MockGroupInviteServiceMockRecorder) ResendPersonal(ctx, groupID, inviteID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendPersonal", reflect.TypeOf((*MockGroupInviteService)(nil).ResendPersonal), ctx, groupID, inviteID, requesterID)
+}
+
+// Revoke mocks base method.
+func (m *MockGroupInviteService) Revoke(ctx context.Context, groupID, inviteID, requesterID string) (*domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, groupID, inviteID, requesterID)
+	ret0, _ := ret[0].(*domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockGroupInviteServiceMockRecorder) Revoke(ctx, groupID, inviteID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockGroupInviteService)(nil).Revoke), ctx, groupID, inviteID, requesterID)
+}
+
+// Rotate mocks base method.
+func (m *MockGroupInviteService) Rotate(ctx context.Context, groupID, requesterID string) (*domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, groupID, requesterID)
+	ret0, _ := ret[0].(*domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockGroupInviteServiceMockRecorder) Rotate(ctx, groupID, requesterID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinGroup", reflect.TypeOf((*MockGroupInviteService)(nil).JoinGroup), ctx, inviteID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockGroupInviteService)(nil).Rotate), ctx, groupID, requesterID)
 }
@@ -41,6 +41,21 @@ func (m *MockGroupService) EXPECT() *MockGroupServiceMockRecorder {
 	return m.recorder
 }
 
+// AddGuest mocks base method.
+func (m *MockGroupService) AddGuest(ctx context.Context, groupID, requesterID, name, email string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuest", ctx, groupID, requesterID, name, email)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGuest indicates an expected call of AddGuest.
+func (mr *MockGroupServiceMockRecorder) AddGuest(ctx, groupID, requesterID, name, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuest", reflect.TypeOf((*MockGroupService)(nil).AddGuest), ctx, groupID, requesterID, name, email)
+}
+
 // AddUser mocks base method.
 func (m *MockGroupService) AddUser(ctx context.Context, groupID, requesterID, targetUserID string) (*domain.Group, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +71,35 @@ func (mr *MockGroupServiceMockRecorder) AddUser(ctx, groupID, requesterID, targe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockGroupService)(nil).AddUser), ctx, groupID, requesterID, targetUserID)
 }
 
+// AddUserByEmail mocks base method.
+func (m *MockGroupService) AddUserByEmail(ctx context.Context, groupID, requesterID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserByEmail", ctx, groupID, requesterID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserByEmail indicates an expected call of AddUserByEmail.
+func (mr *MockGroupServiceMockRecorder) AddUserByEmail(ctx, groupID, requesterID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserByEmail", reflect.TypeOf((*MockGroupService)(nil).AddUserByEmail), ctx, groupID, requesterID, email)
+}
+
+// ApproveJoinRequest mocks base method.
+func (m *MockGroupService) ApproveJoinRequest(ctx context.Context, groupID, requesterID, userID string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJoinRequest", ctx, groupID, requesterID, userID)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveJoinRequest indicates an expected call of ApproveJoinRequest.
+func (mr *MockGroupServiceMockRecorder) ApproveJoinRequest(ctx, groupID, requesterID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJoinRequest", reflect.TypeOf((*MockGroupService)(nil).ApproveJoinRequest), ctx, groupID, requesterID, userID)
+}
+
 // Archive mocks base method.
 func (m *MockGroupService) Archive(ctx context.Context, groupID, requesterID string) (*domain.Group, error) {
 	m.ctrl.T.Helper()
@@ -71,19 +115,64 @@ func (mr *MockGroupServiceMockRecorder) Archive(ctx, groupID, requesterID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockGroupService)(nil).Archive), ctx, groupID, requesterID)
 }
 
+// ClaimGuest mocks base method.
+func (m *MockGroupService) ClaimGuest(ctx context.Context, groupID, requesterID, guestID string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimGuest", ctx, groupID, requesterID, guestID)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimGuest indicates an expected call of ClaimGuest.
+func (mr *MockGroupServiceMockRecorder) ClaimGuest(ctx, groupID, requesterID, guestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimGuest", reflect.TypeOf((*MockGroupService)(nil).ClaimGuest), ctx, groupID, requesterID, guestID)
+}
+
+// CloseRegistration mocks base method.
+func (m *MockGroupService) CloseRegistration(ctx context.Context, groupID, requesterID string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseRegistration", ctx, groupID, requesterID)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseRegistration indicates an expected call of CloseRegistration.
+func (mr *MockGroupServiceMockRecorder) CloseRegistration(ctx, groupID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseRegistration", reflect.TypeOf((*MockGroupService)(nil).CloseRegistration), ctx, groupID, requesterID)
+}
+
+// Complete mocks base method.
+func (m *MockGroupService) Complete(ctx context.Context, groupID, requesterID string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, groupID, requesterID)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockGroupServiceMockRecorder) Complete(ctx, groupID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockGroupService)(nil).Complete), ctx, groupID, requesterID)
+}
+
 // Create mocks base method.
-func (m *MockGroupService) Create(ctx context.Context, name, description, ownerID string) (*domain.Group, error) {
+func (m *MockGroupService) Create(ctx context.Context, name string, description *string, settings domain.GroupSettings, draft bool, templateID, ownerID string) (*domain.Group, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, name, description, ownerID)
+	ret := m.ctrl.Call(m, "Create", ctx, name, description, settings, draft, templateID, ownerID)
 	ret0, _ := ret[0].(*domain.Group)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockGroupServiceMockRecorder) Create(ctx, name, description, ownerID any) *gomock.Call {
+func (mr *MockGroupServiceMockRecorder) Create(ctx, name, description, settings, draft, templateID, ownerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupService)(nil).Create), ctx, name, description, ownerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupService)(nil).Create), ctx, name, description, settings, draft, templateID, ownerID)
 }
 
 // GenerateMatches mocks base method.
@@ -116,11 +205,26 @@ func (mr *MockGroupServiceMockRecorder) GetByID(ctx, groupID, requesterID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGroupService)(nil).GetByID), ctx, groupID, requesterID)
 }
 
+// GetGuestMatch mocks base method.
+func (m *MockGroupService) GetGuestMatch(ctx context.Context, groupID, requesterID, guestID string) (*domain.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestMatch", ctx, groupID, requesterID, guestID)
+	ret0, _ := ret[0].(*domain.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestMatch indicates an expected call of GetGuestMatch.
+func (mr *MockGroupServiceMockRecorder) GetGuestMatch(ctx, groupID, requesterID, guestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestMatch", reflect.TypeOf((*MockGroupService)(nil).GetGuestMatch), ctx, groupID, requesterID, guestID)
+}
+
 // GetUserMatch mocks base method.
-func (m *MockGroupService) GetUserMatch(ctx context.Context, groupID, requesterID string) (*domain.User, error) {
+func (m *MockGroupService) GetUserMatch(ctx context.Context, groupID, requesterID string) (*domain.Participant, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUserMatch", ctx, groupID, requesterID)
-	ret0, _ := ret[0].(*domain.User)
+	ret0, _ := ret[0].(*domain.Participant)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -131,6 +235,81 @@ func (mr *MockGroupServiceMockRecorder) GetUserMatch(ctx, groupID, requesterID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserMatch", reflect.TypeOf((*MockGroupService)(nil).GetUserMatch), ctx, groupID, requesterID)
 }
 
+// ListClaimableGuests mocks base method.
+func (m *MockGroupService) ListClaimableGuests(ctx context.Context, requesterID string) ([]domain.ClaimableGuest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClaimableGuests", ctx, requesterID)
+	ret0, _ := ret[0].([]domain.ClaimableGuest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClaimableGuests indicates an expected call of ListClaimableGuests.
+func (mr *MockGroupServiceMockRecorder) ListClaimableGuests(ctx, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClaimableGuests", reflect.TypeOf((*MockGroupService)(nil).ListClaimableGuests), ctx, requesterID)
+}
+
+// MergeGuest mocks base method.
+func (m *MockGroupService) MergeGuest(ctx context.Context, groupID, requesterID, guestID, userID string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeGuest", ctx, groupID, requesterID, guestID, userID)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeGuest indicates an expected call of MergeGuest.
+func (mr *MockGroupServiceMockRecorder) MergeGuest(ctx, groupID, requesterID, guestID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeGuest", reflect.TypeOf((*MockGroupService)(nil).MergeGuest), ctx, groupID, requesterID, guestID, userID)
+}
+
+// Publish mocks base method.
+func (m *MockGroupService) Publish(ctx context.Context, groupID, requesterID string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, groupID, requesterID)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockGroupServiceMockRecorder) Publish(ctx, groupID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockGroupService)(nil).Publish), ctx, groupID, requesterID)
+}
+
+// RejectJoinRequest mocks base method.
+func (m *MockGroupService) RejectJoinRequest(ctx context.Context, groupID, requesterID, userID string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectJoinRequest", ctx, groupID, requesterID, userID)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectJoinRequest indicates an expected call of RejectJoinRequest.
+func (mr *MockGroupServiceMockRecorder) RejectJoinRequest(ctx, groupID, requesterID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectJoinRequest", reflect.TypeOf((*MockGroupService)(nil).RejectJoinRequest), ctx, groupID, requesterID, userID)
+}
+
+// RemoveGuest mocks base method.
+func (m *MockGroupService) RemoveGuest(ctx context.Context, groupID, requesterID, guestID string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGuest", ctx, groupID, requesterID, guestID)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveGuest indicates an expected call of RemoveGuest.
+func (mr *MockGroupServiceMockRecorder) RemoveGuest(ctx, groupID, requesterID, guestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuest", reflect.TypeOf((*MockGroupService)(nil).RemoveGuest), ctx, groupID, requesterID, guestID)
+}
+
 // RemoveUser mocks base method.
 func (m *MockGroupService) RemoveUser(ctx context.Context, groupID, requesterID, targetUserID string) (*domain.Group, error) {
 	m.ctrl.T.Helper()
@@ -161,6 +340,21 @@ func (mr *MockGroupServiceMockRecorder) Reopen(ctx, groupID, requesterID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockGroupService)(nil).Reopen), ctx, groupID, requesterID)
 }
 
+// RevealMatches mocks base method.
+func (m *MockGroupService) RevealMatches(ctx context.Context, groupID, requesterID string) ([]domain.RevealedMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevealMatches", ctx, groupID, requesterID)
+	ret0, _ := ret[0].([]domain.RevealedMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevealMatches indicates an expected call of RevealMatches.
+func (mr *MockGroupServiceMockRecorder) RevealMatches(ctx, groupID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevealMatches", reflect.TypeOf((*MockGroupService)(nil).RevealMatches), ctx, groupID, requesterID)
+}
+
 // Search mocks base method.
 func (m *MockGroupService) Search(ctx context.Context, filters domain.GroupFilters) (*domain.SearchResult[domain.GroupSummary], error) {
 	m.ctrl.T.Helper()
@@ -175,3 +369,33 @@ func (mr *MockGroupServiceMockRecorder) Search(ctx, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockGroupService)(nil).Search), ctx, filters)
 }
+
+// SetMaxMembers mocks base method.
+func (m *MockGroupService) SetMaxMembers(ctx context.Context, groupID, requesterID string, maxMembers int) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxMembers", ctx, groupID, requesterID, maxMembers)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMaxMembers indicates an expected call of SetMaxMembers.
+func (mr *MockGroupServiceMockRecorder) SetMaxMembers(ctx, groupID, requesterID, maxMembers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxMembers", reflect.TypeOf((*MockGroupService)(nil).SetMaxMembers), ctx, groupID, requesterID, maxMembers)
+}
+
+// UpdateGuest mocks base method.
+func (m *MockGroupService) UpdateGuest(ctx context.Context, groupID, requesterID, guestID, name, email string) (*domain.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuest", ctx, groupID, requesterID, guestID, name, email)
+	ret0, _ := ret[0].(*domain.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGuest indicates an expected call of UpdateGuest.
+func (mr *MockGroupServiceMockRecorder) UpdateGuest(ctx, groupID, requesterID, guestID, name, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuest", reflect.TypeOf((*MockGroupService)(nil).UpdateGuest), ctx, groupID, requesterID, guestID, name, email)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/application (interfaces: GroupTemplateService)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_application/group_template_service.go . GroupTemplateService
+//
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGroupTemplateService is a mock of GroupTemplateService interface.
+type MockGroupTemplateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupTemplateServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupTemplateServiceMockRecorder is the mock recorder for MockGroupTemplateService.
+type MockGroupTemplateServiceMockRecorder struct {
+	mock *MockGroupTemplateService
+}
+
+// NewMockGroupTemplateService creates a new mock instance.
+func NewMockGroupTemplateService(ctrl *gomock.Controller) *MockGroupTemplateService {
+	mock := &MockGroupTemplateService{ctrl: ctrl}
+	mock.recorder = &MockGroupTemplateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupTemplateService) EXPECT() *MockGroupTemplateServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockGroupTemplateService) Create(ctx context.Context, ownerID, name, description string, maxMembers, budget int, rules string, exchangeInDays int, matchStrategy domain.MatchStrategy) (*domain.GroupTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, ownerID, name, description, maxMembers, budget, rules, exchangeInDays, matchStrategy)
+	ret0, _ := ret[0].(*domain.GroupTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGroupTemplateServiceMockRecorder) Create(ctx, ownerID, name, description, maxMembers, budget, rules, exchangeInDays, matchStrategy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupTemplateService)(nil).Create), ctx, ownerID, name, description, maxMembers, budget, rules, exchangeInDays, matchStrategy)
+}
+
+// Delete mocks base method.
+func (m *MockGroupTemplateService) Delete(ctx context.Context, templateID, requesterID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, templateID, requesterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGroupTemplateServiceMockRecorder) Delete(ctx, templateID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGroupTemplateService)(nil).Delete), ctx, templateID, requesterID)
+}
+
+// GetByID mocks base method.
+func (m *MockGroupTemplateService) GetByID(ctx context.Context, templateID, requesterID string) (*domain.GroupTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, templateID, requesterID)
+	ret0, _ := ret[0].(*domain.GroupTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGroupTemplateServiceMockRecorder) GetByID(ctx, templateID, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGroupTemplateService)(nil).GetByID), ctx, templateID, requesterID)
+}
+
+// List mocks base method.
+func (m *MockGroupTemplateService) List(ctx context.Context, requesterID string) ([]domain.GroupTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, requesterID)
+	ret0, _ := ret[0].([]domain.GroupTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGroupTemplateServiceMockRecorder) List(ctx, requesterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGroupTemplateService)(nil).List), ctx, requesterID)
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/application (interfaces: PasswordResetService)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_application/password_reset_service.go . PasswordResetService
+//
+
+// Package mock_application is a generated GoMock package.
+package mock_application
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPasswordResetService is a mock of PasswordResetService interface.
+type MockPasswordResetService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPasswordResetServiceMockRecorder is the mock recorder for MockPasswordResetService.
+type MockPasswordResetServiceMockRecorder struct {
+	mock *MockPasswordResetService
+}
+
+// NewMockPasswordResetService creates a new mock instance.
+func NewMockPasswordResetService(ctrl *gomock.Controller) *MockPasswordResetService {
+	mock := &MockPasswordResetService{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetService) EXPECT() *MockPasswordResetServiceMockRecorder {
+	return m.recorder
+}
+
+// ConfirmReset mocks base method.
+func (m *MockPasswordResetService) ConfirmReset(ctx context.Context, token, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmReset", ctx, token, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmReset indicates an expected call of ConfirmReset.
+func (mr *MockPasswordResetServiceMockRecorder) ConfirmReset(ctx, token, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmReset", reflect.TypeOf((*MockPasswordResetService)(nil).ConfirmReset), ctx, token, newPassword)
+}
+
+// RequestReset mocks base method.
+func (m *MockPasswordResetService) RequestReset(ctx context.Context, email, ipAddress string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReset", ctx, email, ipAddress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestReset indicates an expected call of RequestReset.
+func (mr *MockPasswordResetServiceMockRecorder) RequestReset(ctx, email, ipAddress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReset", reflect.TypeOf((*MockPasswordResetService)(nil).RequestReset), ctx, email, ipAddress)
+}
@@ -0,0 +1,174 @@
+package application
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_application/oidc_service.go . OIDCService
+
+import (
+	"context"
+	"errors"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// OIDCService logs users in through the configured OpenID Connect identity provider. The first sign-in links the
+// external identity to the user with the same email, which both the provider and the user must have verified; later
+// sign-ins only rely on the link. Accounts are never created here, users have to sign up first.
+type OIDCService interface {
+	Start(ctx context.Context) (*domain.OIDCAuthorization, error)
+	Finish(ctx context.Context, state, code string, device domain.Device) (*domain.LoginResult, error)
+}
+
+type oidcService struct {
+	oidcProvider           domain.OIDCProvider
+	loginRequestRepository domain.OIDCLoginRequestRepository
+	userIdentityRepository domain.UserIdentityRepository
+	userRepository         domain.UserRepository
+	identityGenerator      domain.IdentityGenerator
+	secretTokenGenerator   domain.SecretTokenGenerator
+	authService            AuthService
+}
+
+func NewOIDCService(
+	oidcProvider domain.OIDCProvider,
+	loginRequestRepository domain.OIDCLoginRequestRepository,
+	userIdentityRepository domain.UserIdentityRepository,
+	userRepository domain.UserRepository,
+	identityGenerator domain.IdentityGenerator,
+	secretTokenGenerator domain.SecretTokenGenerator,
+	authService AuthService,
+) OIDCService {
+	return &oidcService{
+		oidcProvider:           oidcProvider,
+		loginRequestRepository: loginRequestRepository,
+		userIdentityRepository: userIdentityRepository,
+		userRepository:         userRepository,
+		identityGenerator:      identityGenerator,
+		secretTokenGenerator:   secretTokenGenerator,
+		authService:            authService,
+	}
+}
+
+// Start remembers the nonce and the PKCE code verifier of a new sign-in and returns where to send the user.
+func (s *oidcService) Start(ctx context.Context) (*domain.OIDCAuthorization, error) {
+	state, err := s.secretTokenGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := s.secretTokenGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	codeVerifier, err := s.secretTokenGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	loginRequest, err := domain.NewOIDCLoginRequest(s.identityGenerator, state, nonce, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationURL, err := s.oidcProvider.AuthorizationURL(ctx, state, nonce, domain.PKCEChallenge(codeVerifier))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.loginRequestRepository.Create(ctx, *loginRequest); err != nil {
+		return nil, err
+	}
+
+	return &domain.OIDCAuthorization{
+		URL:   authorizationURL,
+		State: state,
+	}, nil
+}
+
+// Finish redeems the authorization code the provider sent back with the state. The login request is used up first,
+// so a state can never be replayed, even when the code turns out to be wrong.
+func (s *oidcService) Finish(ctx context.Context, state, code string, device domain.Device) (*domain.LoginResult, error) {
+	loginRequest, err := s.loginRequestRepository.GetByStateHash(ctx, domain.HashSecretToken(state))
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, domain.NewUnauthorizedError("invalid or expired login request")
+		}
+		return nil, err
+	}
+
+	if !loginRequest.IsUsable() {
+		return nil, domain.NewUnauthorizedError("invalid or expired login request")
+	}
+
+	if err := s.loginRequestRepository.Redeem(ctx, loginRequest.ID); err != nil {
+		return nil, err
+	}
+
+	externalIdentity, err := s.oidcProvider.Exchange(ctx, code, loginRequest.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if externalIdentity.Nonce != loginRequest.Nonce {
+		return nil, domain.NewUnauthorizedError("invalid ID token")
+	}
+
+	user, err := s.getLinkedUser(ctx, *externalIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsDeleted() {
+		return nil, domain.NewUnauthorizedError("user account has been deleted")
+	}
+
+	return s.authService.CompleteLogin(ctx, *user, device)
+}
+
+func (s *oidcService) getLinkedUser(ctx context.Context, externalIdentity domain.ExternalIdentity) (*domain.User, error) {
+	userIdentity, err := s.userIdentityRepository.GetByProviderSubject(ctx, externalIdentity.Provider, externalIdentity.Subject)
+	if err == nil {
+		return s.userRepository.GetByID(ctx, userIdentity.UserID)
+	}
+
+	var notFoundErr *domain.ResourceNotFoundError
+	if !errors.As(err, &notFoundErr) {
+		return nil, err
+	}
+
+	return s.linkUser(ctx, externalIdentity)
+}
+
+// linkUser trusts the email only when both the provider and the account verified it. Otherwise anyone could claim an
+// account by signing up at the provider with its address, or register an account with someone else's address and
+// wait for the owner to link it.
+func (s *oidcService) linkUser(ctx context.Context, externalIdentity domain.ExternalIdentity) (*domain.User, error) {
+	if externalIdentity.Email == "" || !externalIdentity.EmailVerified {
+		return nil, domain.NewForbiddenError("the identity provider has not verified the email address")
+	}
+
+	user, err := s.userRepository.GetByEmail(ctx, externalIdentity.Email)
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, domain.NewResourceNotFoundError("no account is registered with this email address")
+		}
+		return nil, err
+	}
+
+	// an unverified address may have been registered by someone else, who would keep their password after the link
+	if !user.IsEmailVerified() {
+		return nil, domain.NewForbiddenError("verify the email address of the account before signing in with single sign-on")
+	}
+
+	userIdentity, err := domain.NewUserIdentity(s.identityGenerator, user.ID, externalIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userIdentityRepository.Create(ctx, *userIdentity); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,353 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+const codeVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+
+func Test_oidcService_Start(t *testing.T) {
+	t.Run("should store the login request and return the authorization URL", func(t *testing.T) {
+		// given
+		loginRequestID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+
+		mockCtrl := gomock.NewController(t)
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		gomock.InOrder(
+			mockedSecretTokenGenerator.EXPECT().Generate().Return("some-state", nil),
+			mockedSecretTokenGenerator.EXPECT().Generate().Return("some-nonce", nil),
+			mockedSecretTokenGenerator.EXPECT().Generate().Return(codeVerifier, nil),
+		)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(loginRequestID, nil)
+
+		mockedOIDCProvider := mock_domain.NewMockOIDCProvider(mockCtrl)
+		mockedOIDCProvider.EXPECT().AuthorizationURL(gomock.Any(), "some-state", "some-nonce", domain.PKCEChallenge(codeVerifier)).Return("https://idp.example.com/authorize?state=some-state", nil)
+
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, loginRequest domain.OIDCLoginRequest) error {
+			assert.Equal(t, loginRequestID, loginRequest.ID)
+			assert.Equal(t, domain.HashSecretToken("some-state"), loginRequest.StateHash)
+			assert.Equal(t, "some-nonce", loginRequest.Nonce)
+			assert.Equal(t, codeVerifier, loginRequest.CodeVerifier)
+			return nil
+		})
+
+		oidcService := application.NewOIDCService(mockedOIDCProvider, mockedLoginRequestRepository, nil, nil, mockedIdentityGenerator, mockedSecretTokenGenerator, nil)
+
+		// when
+		result, err := oidcService.Start(context.Background())
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "https://idp.example.com/authorize?state=some-state", result.URL)
+		assert.Equal(t, "some-state", result.State)
+	})
+
+	t.Run("should return the error when single sign-on is not configured", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return(codeVerifier, nil).Times(3)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		mockedOIDCProvider := mock_domain.NewMockOIDCProvider(mockCtrl)
+		mockedOIDCProvider.EXPECT().AuthorizationURL(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", domain.NewResourceNotFoundError("single sign-on is not configured"))
+
+		oidcService := application.NewOIDCService(mockedOIDCProvider, nil, nil, nil, mockedIdentityGenerator, mockedSecretTokenGenerator, nil)
+
+		// when
+		result, err := oidcService.Start(context.Background())
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_oidcService_Finish(t *testing.T) {
+	externalIdentity := domain.ExternalIdentity{
+		Provider:      "https://idp.example.com",
+		Subject:       "248289761001",
+		Email:         "user@example.com",
+		EmailVerified: true,
+		Nonce:         "some-nonce",
+	}
+
+	t.Run("should log in the user already linked to the external identity", func(t *testing.T) {
+		// given
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		userIdentity := build_domain.NewUserIdentityBuilder().WithUserID(user.ID).Build()
+		loginResult := domain.LoginResult{Session: &domain.AuthSession{User: user, AccessToken: "some_token"}}
+
+		mockCtrl := gomock.NewController(t)
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().GetByStateHash(gomock.Any(), domain.HashSecretToken("some-state")).Return(&loginRequest, nil)
+		mockedLoginRequestRepository.EXPECT().Redeem(gomock.Any(), loginRequest.ID).Return(nil)
+
+		mockedOIDCProvider := mock_domain.NewMockOIDCProvider(mockCtrl)
+		mockedOIDCProvider.EXPECT().Exchange(gomock.Any(), "some-code", loginRequest.CodeVerifier).Return(&externalIdentity, nil)
+
+		mockedUserIdentityRepository := mock_domain.NewMockUserIdentityRepository(mockCtrl)
+		mockedUserIdentityRepository.EXPECT().GetByProviderSubject(gomock.Any(), externalIdentity.Provider, externalIdentity.Subject).Return(&userIdentity, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().CompleteLogin(gomock.Any(), user, device).Return(&loginResult, nil)
+
+		oidcService := application.NewOIDCService(mockedOIDCProvider, mockedLoginRequestRepository, mockedUserIdentityRepository, mockedUserRepository, nil, nil, mockedAuthService)
+
+		// when
+		result, err := oidcService.Finish(context.Background(), "some-state", "some-code", device)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, &loginResult, result)
+	})
+
+	t.Run("should link the user with the verified email on the first sign-in", func(t *testing.T) {
+		// given
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().Build()
+		emailVerifiedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithEmail(externalIdentity.Email).WithEmailVerifiedAt(&emailVerifiedAt).Build()
+		userIdentityID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		loginResult := domain.LoginResult{ChallengeToken: "some_challenge_token"}
+
+		mockCtrl := gomock.NewController(t)
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().GetByStateHash(gomock.Any(), gomock.Any()).Return(&loginRequest, nil)
+		mockedLoginRequestRepository.EXPECT().Redeem(gomock.Any(), loginRequest.ID).Return(nil)
+
+		mockedOIDCProvider := mock_domain.NewMockOIDCProvider(mockCtrl)
+		mockedOIDCProvider.EXPECT().Exchange(gomock.Any(), "some-code", loginRequest.CodeVerifier).Return(&externalIdentity, nil)
+
+		mockedUserIdentityRepository := mock_domain.NewMockUserIdentityRepository(mockCtrl)
+		mockedUserIdentityRepository.EXPECT().GetByProviderSubject(gomock.Any(), externalIdentity.Provider, externalIdentity.Subject).Return(nil, domain.NewResourceNotFoundError("user identity not found"))
+		mockedUserIdentityRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, userIdentity domain.UserIdentity) error {
+			assert.Equal(t, userIdentityID, userIdentity.ID)
+			assert.Equal(t, user.ID, userIdentity.UserID)
+			assert.Equal(t, externalIdentity.Provider, userIdentity.Provider)
+			assert.Equal(t, externalIdentity.Subject, userIdentity.Subject)
+			return nil
+		})
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(userIdentityID, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), externalIdentity.Email).Return(&user, nil)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().CompleteLogin(gomock.Any(), user, device).Return(&loginResult, nil)
+
+		oidcService := application.NewOIDCService(mockedOIDCProvider, mockedLoginRequestRepository, mockedUserIdentityRepository, mockedUserRepository, mockedIdentityGenerator, nil, mockedAuthService)
+
+		// when
+		result, err := oidcService.Finish(context.Background(), "some-state", "some-code", device)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, result.RequiresTwoFactor())
+	})
+
+	t.Run("should return forbidden when the provider has not verified the email", func(t *testing.T) {
+		// given
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().Build()
+		unverifiedIdentity := externalIdentity
+		unverifiedIdentity.EmailVerified = false
+
+		mockCtrl := gomock.NewController(t)
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().GetByStateHash(gomock.Any(), gomock.Any()).Return(&loginRequest, nil)
+		mockedLoginRequestRepository.EXPECT().Redeem(gomock.Any(), loginRequest.ID).Return(nil)
+
+		mockedOIDCProvider := mock_domain.NewMockOIDCProvider(mockCtrl)
+		mockedOIDCProvider.EXPECT().Exchange(gomock.Any(), gomock.Any(), gomock.Any()).Return(&unverifiedIdentity, nil)
+
+		mockedUserIdentityRepository := mock_domain.NewMockUserIdentityRepository(mockCtrl)
+		mockedUserIdentityRepository.EXPECT().GetByProviderSubject(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.NewResourceNotFoundError("user identity not found"))
+
+		oidcService := application.NewOIDCService(mockedOIDCProvider, mockedLoginRequestRepository, mockedUserIdentityRepository, nil, nil, nil, nil)
+
+		// when
+		result, err := oidcService.Finish(context.Background(), "some-state", "some-code", device)
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+
+	t.Run("should return forbidden without linking when the account has not verified the email", func(t *testing.T) {
+		// given
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().Build()
+		user := build_domain.NewUserBuilder().WithEmail(externalIdentity.Email).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().GetByStateHash(gomock.Any(), gomock.Any()).Return(&loginRequest, nil)
+		mockedLoginRequestRepository.EXPECT().Redeem(gomock.Any(), loginRequest.ID).Return(nil)
+
+		mockedOIDCProvider := mock_domain.NewMockOIDCProvider(mockCtrl)
+		mockedOIDCProvider.EXPECT().Exchange(gomock.Any(), gomock.Any(), gomock.Any()).Return(&externalIdentity, nil)
+
+		mockedUserIdentityRepository := mock_domain.NewMockUserIdentityRepository(mockCtrl)
+		mockedUserIdentityRepository.EXPECT().GetByProviderSubject(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.NewResourceNotFoundError("user identity not found"))
+		mockedUserIdentityRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), externalIdentity.Email).Return(&user, nil)
+
+		oidcService := application.NewOIDCService(mockedOIDCProvider, mockedLoginRequestRepository, mockedUserIdentityRepository, mockedUserRepository, nil, nil, nil)
+
+		// when
+		result, err := oidcService.Finish(context.Background(), "some-state", "some-code", device)
+
+		// then
+		assert.Nil(t, result)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "verify the email address of the account before signing in with single sign-on")
+	})
+
+	t.Run("should return not found when no account has the email", func(t *testing.T) {
+		// given
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().GetByStateHash(gomock.Any(), gomock.Any()).Return(&loginRequest, nil)
+		mockedLoginRequestRepository.EXPECT().Redeem(gomock.Any(), loginRequest.ID).Return(nil)
+
+		mockedOIDCProvider := mock_domain.NewMockOIDCProvider(mockCtrl)
+		mockedOIDCProvider.EXPECT().Exchange(gomock.Any(), gomock.Any(), gomock.Any()).Return(&externalIdentity, nil)
+
+		mockedUserIdentityRepository := mock_domain.NewMockUserIdentityRepository(mockCtrl)
+		mockedUserIdentityRepository.EXPECT().GetByProviderSubject(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.NewResourceNotFoundError("user identity not found"))
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), externalIdentity.Email).Return(nil, domain.NewResourceNotFoundError("user not found"))
+
+		oidcService := application.NewOIDCService(mockedOIDCProvider, mockedLoginRequestRepository, mockedUserIdentityRepository, mockedUserRepository, nil, nil, nil)
+
+		// when
+		result, err := oidcService.Finish(context.Background(), "some-state", "some-code", device)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "no account is registered with this email address")
+	})
+
+	t.Run("should return unauthorized when the state is unknown", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().GetByStateHash(gomock.Any(), gomock.Any()).Return(nil, domain.NewResourceNotFoundError("login request not found"))
+
+		oidcService := application.NewOIDCService(nil, mockedLoginRequestRepository, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := oidcService.Finish(context.Background(), "some-state", "some-code", device)
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid or expired login request")
+	})
+
+	t.Run("should return unauthorized when the login request has expired", func(t *testing.T) {
+		// given
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().WithExpiresAt(time.Now().Add(-time.Second)).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().GetByStateHash(gomock.Any(), gomock.Any()).Return(&loginRequest, nil)
+
+		oidcService := application.NewOIDCService(nil, mockedLoginRequestRepository, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := oidcService.Finish(context.Background(), "some-state", "some-code", device)
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+
+	t.Run("should return unauthorized when the nonce does not match", func(t *testing.T) {
+		// given
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().WithNonce("another-nonce").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().GetByStateHash(gomock.Any(), gomock.Any()).Return(&loginRequest, nil)
+		mockedLoginRequestRepository.EXPECT().Redeem(gomock.Any(), loginRequest.ID).Return(nil)
+
+		mockedOIDCProvider := mock_domain.NewMockOIDCProvider(mockCtrl)
+		mockedOIDCProvider.EXPECT().Exchange(gomock.Any(), gomock.Any(), gomock.Any()).Return(&externalIdentity, nil)
+
+		oidcService := application.NewOIDCService(mockedOIDCProvider, mockedLoginRequestRepository, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := oidcService.Finish(context.Background(), "some-state", "some-code", device)
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid ID token")
+	})
+
+	t.Run("should return unauthorized when the linked account has been deleted", func(t *testing.T) {
+		// given
+		deletedAt := time.Now()
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().Build()
+		user := build_domain.NewUserBuilder().WithDeletedAt(&deletedAt).Build()
+		userIdentity := build_domain.NewUserIdentityBuilder().WithUserID(user.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedLoginRequestRepository := mock_domain.NewMockOIDCLoginRequestRepository(mockCtrl)
+		mockedLoginRequestRepository.EXPECT().GetByStateHash(gomock.Any(), gomock.Any()).Return(&loginRequest, nil)
+		mockedLoginRequestRepository.EXPECT().Redeem(gomock.Any(), loginRequest.ID).Return(nil)
+
+		mockedOIDCProvider := mock_domain.NewMockOIDCProvider(mockCtrl)
+		mockedOIDCProvider.EXPECT().Exchange(gomock.Any(), gomock.Any(), gomock.Any()).Return(&externalIdentity, nil)
+
+		mockedUserIdentityRepository := mock_domain.NewMockUserIdentityRepository(mockCtrl)
+		mockedUserIdentityRepository.EXPECT().GetByProviderSubject(gomock.Any(), gomock.Any(), gomock.Any()).Return(&userIdentity, nil)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		oidcService := application.NewOIDCService(mockedOIDCProvider, mockedLoginRequestRepository, mockedUserIdentityRepository, mockedUserRepository, nil, nil, nil)
+
+		// when
+		result, err := oidcService.Finish(context.Background(), "some-state", "some-code", device)
+
+		// then
+		assert.Nil(t, result)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "user account has been deleted")
+	})
+}
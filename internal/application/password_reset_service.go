@@ -0,0 +1,150 @@
+package application
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_application/password_reset_service.go . PasswordResetService
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// PasswordResetService lets users who forgot their password choose a new one through a link sent by email.
+// Neither method tells the caller whether an account exists for the given email.
+type PasswordResetService interface {
+	RequestReset(ctx context.Context, email, ipAddress string) error
+	ConfirmReset(ctx context.Context, token, newPassword string) error
+}
+
+const passwordResetEmailSubject = "Reset your Mystery Gifter password"
+
+type passwordResetService struct {
+	passwordResetRepository domain.PasswordResetRepository
+	userRepository          domain.UserRepository
+	identityGenerator       domain.IdentityGenerator
+	secretTokenGenerator    domain.SecretTokenGenerator
+	passwordManager         domain.PasswordManager
+	mailer                  domain.Mailer
+	attemptLimiter          AttemptLimiter
+	tokenExpiration         time.Duration
+	resetBaseURL            string
+}
+
+func NewPasswordResetService(
+	passwordResetRepository domain.PasswordResetRepository,
+	userRepository domain.UserRepository,
+	identityGenerator domain.IdentityGenerator,
+	secretTokenGenerator domain.SecretTokenGenerator,
+	passwordManager domain.PasswordManager,
+	mailer domain.Mailer,
+	attemptLimiter AttemptLimiter,
+	tokenExpiration time.Duration,
+	resetBaseURL string,
+) PasswordResetService {
+	return &passwordResetService{
+		passwordResetRepository: passwordResetRepository,
+		userRepository:          userRepository,
+		identityGenerator:       identityGenerator,
+		secretTokenGenerator:    secretTokenGenerator,
+		passwordManager:         passwordManager,
+		mailer:                  mailer,
+		attemptLimiter:          attemptLimiter,
+		tokenExpiration:         tokenExpiration,
+		resetBaseURL:            resetBaseURL,
+	}
+}
+
+// RequestReset emails a reset link when the email belongs to a user and silently does nothing otherwise.
+// Failures to deliver the email are only logged, since reporting them would reveal that the account exists.
+// Every request counts against the email and the client address, whether or not the account exists, and a throttled
+// request is dropped just as silently, so mailboxes cannot be flooded and throttling reveals nothing either.
+func (s *passwordResetService) RequestReset(ctx context.Context, email, ipAddress string) error {
+	if _, err := s.attemptLimiter.Reserve(ctx, passwordResetClientKey(ipAddress), passwordResetEmailKey(email)); err != nil {
+		var tooManyRequestsErr *domain.TooManyRequestsError
+		if errors.As(err, &tooManyRequestsErr) {
+			log.Println("password reset request throttled:", err)
+			return nil
+		}
+		return err
+	}
+
+	user, err := s.userRepository.GetByEmail(ctx, email)
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return err
+	}
+
+	token, err := s.secretTokenGenerator.Generate()
+	if err != nil {
+		return err
+	}
+
+	passwordResetToken, err := domain.NewPasswordResetToken(s.identityGenerator, user.ID, token, s.tokenExpiration)
+	if err != nil {
+		return err
+	}
+
+	if err := s.passwordResetRepository.Create(ctx, *passwordResetToken); err != nil {
+		return err
+	}
+
+	resetEmail, err := domain.NewEmail(user.Email, passwordResetEmailSubject, s.buildResetEmailBody(*user, token))
+	if err != nil {
+		return err
+	}
+
+	if err := s.mailer.Send(ctx, *resetEmail); err != nil {
+		log.Println("error sending password reset email:", err)
+	}
+
+	return nil
+}
+
+// ConfirmReset sets the new password of the token owner and ends every session of the user.
+func (s *passwordResetService) ConfirmReset(ctx context.Context, token, newPassword string) error {
+	passwordResetToken, err := s.passwordResetRepository.GetByTokenHash(ctx, domain.HashSecretToken(token))
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return invalidPasswordResetTokenError()
+		}
+		return err
+	}
+
+	if !passwordResetToken.IsUsable() {
+		return invalidPasswordResetTokenError()
+	}
+
+	user, err := s.userRepository.GetByID(ctx, passwordResetToken.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := user.ResetPassword(s.passwordManager, newPassword); err != nil {
+		return err
+	}
+
+	return s.passwordResetRepository.Redeem(ctx, passwordResetToken.ID, *user)
+}
+
+func (s *passwordResetService) buildResetEmailBody(user domain.User, token string) string {
+	resetURL := strings.TrimSuffix(s.resetBaseURL, "/") + "?token=" + url.QueryEscape(token)
+
+	return fmt.Sprintf(
+		"Hi %s,\n\nWe received a request to reset your password. Use the link below to choose a new one. It expires in %s and can only be used once.\n\n%s\n\nIf you did not ask for this, you can ignore this email and your password will stay the same.\n",
+		user.Name, s.tokenExpiration, resetURL,
+	)
+}
+
+func invalidPasswordResetTokenError() error {
+	return domain.NewValidationError(validator.ValidationErrors{{Field: "Token", Error: "Token is invalid or has expired"}})
+}
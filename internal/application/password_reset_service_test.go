@@ -0,0 +1,274 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+const resetBaseURL = "http://localhost:3000/reset-password"
+
+func Test_passwordResetService_RequestReset(t *testing.T) {
+	ipAddress := "203.0.113.7"
+
+	t.Run("should store the hashed token and email the reset link", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		tokenID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(&user, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("secret-token", nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(tokenID, nil)
+
+		mockedPasswordResetRepository := mock_domain.NewMockPasswordResetRepository(mockCtrl)
+		mockedPasswordResetRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, passwordResetToken domain.PasswordResetToken) error {
+			assert.Equal(t, tokenID, passwordResetToken.ID)
+			assert.Equal(t, user.ID, passwordResetToken.UserID)
+			assert.Equal(t, domain.HashSecretToken("secret-token"), passwordResetToken.TokenHash)
+			return nil
+		})
+
+		mockedMailer := mock_domain.NewMockMailer(mockCtrl)
+		mockedMailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, email domain.Email) error {
+			assert.Equal(t, user.Email, email.To)
+			assert.Contains(t, email.Body, resetBaseURL+"?token=secret-token")
+			return nil
+		})
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "password-reset:ip:"+ipAddress, "password-reset:email:"+user.Email).Return(application.AttemptReservation{}, nil)
+
+		passwordResetService := application.NewPasswordResetService(mockedPasswordResetRepository, mockedUserRepository, mockedIdentityGenerator, mockedSecretTokenGenerator, nil, mockedMailer, mockedAttemptLimiter, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.RequestReset(context.Background(), user.Email, ipAddress)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should do nothing when no user has the email", func(t *testing.T) {
+		// given
+		email := "unknown@example.com"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), email).Return(nil, domain.NewResourceNotFoundError("user not found"))
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "password-reset:ip:"+ipAddress, "password-reset:email:"+email).Return(application.AttemptReservation{}, nil)
+
+		passwordResetService := application.NewPasswordResetService(nil, mockedUserRepository, nil, nil, nil, nil, mockedAttemptLimiter, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.RequestReset(context.Background(), email, ipAddress)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not report mail delivery failures", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(&user, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("secret-token", nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		mockedPasswordResetRepository := mock_domain.NewMockPasswordResetRepository(mockCtrl)
+		mockedPasswordResetRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedMailer := mock_domain.NewMockMailer(mockCtrl)
+		mockedMailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "password-reset:ip:"+ipAddress, "password-reset:email:"+user.Email).Return(application.AttemptReservation{}, nil)
+
+		passwordResetService := application.NewPasswordResetService(mockedPasswordResetRepository, mockedUserRepository, mockedIdentityGenerator, mockedSecretTokenGenerator, nil, mockedMailer, mockedAttemptLimiter, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.RequestReset(context.Background(), user.Email, ipAddress)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when storing the token fails", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(&user, nil)
+
+		mockedSecretTokenGenerator := mock_domain.NewMockSecretTokenGenerator(mockCtrl)
+		mockedSecretTokenGenerator.EXPECT().Generate().Return("secret-token", nil)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		mockedPasswordResetRepository := mock_domain.NewMockPasswordResetRepository(mockCtrl)
+		mockedPasswordResetRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "password-reset:ip:"+ipAddress, "password-reset:email:"+user.Email).Return(application.AttemptReservation{}, nil)
+
+		passwordResetService := application.NewPasswordResetService(mockedPasswordResetRepository, mockedUserRepository, mockedIdentityGenerator, mockedSecretTokenGenerator, nil, nil, mockedAttemptLimiter, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.RequestReset(context.Background(), user.Email, ipAddress)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+	t.Run("should silently send nothing when the requests are throttled", func(t *testing.T) {
+		// given
+		email := "someone@example.com"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "password-reset:ip:"+ipAddress, "password-reset:email:"+email).Return(application.AttemptReservation{}, domain.NewTooManyRequestsError("too many failed attempts, try again later", time.Minute))
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), gomock.Any()).Times(0)
+
+		passwordResetService := application.NewPasswordResetService(nil, mockedUserRepository, nil, nil, nil, nil, mockedAttemptLimiter, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.RequestReset(context.Background(), email, ipAddress)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when the attempt cannot be counted", func(t *testing.T) {
+		// given
+		email := "someone@example.com"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, assert.AnError)
+
+		passwordResetService := application.NewPasswordResetService(nil, nil, nil, nil, nil, nil, mockedAttemptLimiter, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.RequestReset(context.Background(), email, ipAddress)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_passwordResetService_ConfirmReset(t *testing.T) {
+	t.Run("should set the new password and redeem the token", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("old_hash").Build()
+		passwordResetToken := build_domain.NewPasswordResetTokenBuilder().WithUserID(user.ID).WithTokenHash(domain.HashSecretToken("secret-token")).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedPasswordResetRepository := mock_domain.NewMockPasswordResetRepository(mockCtrl)
+		mockedPasswordResetRepository.EXPECT().GetByTokenHash(gomock.Any(), domain.HashSecretToken("secret-token")).Return(&passwordResetToken, nil)
+		mockedPasswordResetRepository.EXPECT().Redeem(gomock.Any(), passwordResetToken.ID, gomock.Any()).DoAndReturn(func(ctx context.Context, tokenID string, updatedUser domain.User) error {
+			assert.Equal(t, "new_hash", updatedUser.Password)
+			assert.NotNil(t, updatedUser.PasswordChangedAt)
+			return nil
+		})
+
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Hash("new-password").Return("new_hash", nil)
+
+		passwordResetService := application.NewPasswordResetService(mockedPasswordResetRepository, mockedUserRepository, nil, nil, mockedPasswordManager, nil, nil, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.ConfirmReset(context.Background(), "secret-token", "new-password")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return validation error when the token does not exist", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedPasswordResetRepository := mock_domain.NewMockPasswordResetRepository(mockCtrl)
+		mockedPasswordResetRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(nil, domain.NewResourceNotFoundError("password reset token not found"))
+
+		passwordResetService := application.NewPasswordResetService(mockedPasswordResetRepository, nil, nil, nil, nil, nil, nil, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.ConfirmReset(context.Background(), "unknown-token", "new-password")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should return validation error when the token has already been used", func(t *testing.T) {
+		// given
+		usedAt := time.Now().Add(-time.Minute)
+		passwordResetToken := build_domain.NewPasswordResetTokenBuilder().WithUsedAt(&usedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedPasswordResetRepository := mock_domain.NewMockPasswordResetRepository(mockCtrl)
+		mockedPasswordResetRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&passwordResetToken, nil)
+
+		passwordResetService := application.NewPasswordResetService(mockedPasswordResetRepository, nil, nil, nil, nil, nil, nil, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.ConfirmReset(context.Background(), "secret-token", "new-password")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should return validation error when the token has expired", func(t *testing.T) {
+		// given
+		passwordResetToken := build_domain.NewPasswordResetTokenBuilder().WithExpiresAt(time.Now().Add(-time.Minute)).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedPasswordResetRepository := mock_domain.NewMockPasswordResetRepository(mockCtrl)
+		mockedPasswordResetRepository.EXPECT().GetByTokenHash(gomock.Any(), gomock.Any()).Return(&passwordResetToken, nil)
+
+		passwordResetService := application.NewPasswordResetService(mockedPasswordResetRepository, nil, nil, nil, nil, nil, nil, time.Hour, resetBaseURL)
+
+		// when
+		err := passwordResetService.ConfirmReset(context.Background(), "secret-token", "new-password")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
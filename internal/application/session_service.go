@@ -0,0 +1,51 @@
+package application
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_application/session_service.go . SessionService
+
+import (
+	"context"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// SessionService lets users see where they are logged in and end their sessions on other devices, such as a lost
+// phone, without changing the password.
+type SessionService interface {
+	List(ctx context.Context, userID string) ([]domain.Session, error)
+	Revoke(ctx context.Context, userID, sessionID string) error
+	RevokeAll(ctx context.Context, userID string) error
+}
+
+type sessionService struct {
+	sessionRepository domain.SessionRepository
+}
+
+func NewSessionService(sessionRepository domain.SessionRepository) SessionService {
+	return &sessionService{
+		sessionRepository: sessionRepository,
+	}
+}
+
+// List returns the sessions of the user that can still be used, most recently seen first.
+func (s *sessionService) List(ctx context.Context, userID string) ([]domain.Session, error) {
+	return s.sessionRepository.ListActiveByUserID(ctx, userID)
+}
+
+// Revoke ends one session of the user. Sessions of other users are reported as not found, so their IDs cannot be probed.
+func (s *sessionService) Revoke(ctx context.Context, userID, sessionID string) error {
+	session, err := s.sessionRepository.GetByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if !session.BelongsTo(userID) {
+		return domain.NewResourceNotFoundError("session not found")
+	}
+
+	return s.sessionRepository.Revoke(ctx, session.ID)
+}
+
+// RevokeAll ends every session of the user, including the one making the request.
+func (s *sessionService) RevokeAll(ctx context.Context, userID string) error {
+	return s.sessionRepository.RevokeAllByUserID(ctx, userID)
+}
@@ -0,0 +1,109 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_sessionService_List(t *testing.T) {
+	t.Run("should return the active sessions of the user", func(t *testing.T) {
+		// given
+		userID := "some-user-id"
+		sessions := []domain.Session{
+			build_domain.NewSessionBuilder().WithUserID(userID).Build(),
+			build_domain.NewSessionBuilder().WithUserID(userID).Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().ListActiveByUserID(gomock.Any(), userID).Return(sessions, nil)
+
+		sessionService := application.NewSessionService(mockedSessionRepository)
+
+		// when
+		result, err := sessionService.List(context.Background(), userID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, sessions, result)
+	})
+}
+
+func Test_sessionService_Revoke(t *testing.T) {
+	t.Run("should revoke a session of the user", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), session.ID).Return(&session, nil)
+		mockedSessionRepository.EXPECT().Revoke(gomock.Any(), session.ID).Return(nil)
+
+		sessionService := application.NewSessionService(mockedSessionRepository)
+
+		// when
+		err := sessionService.Revoke(context.Background(), session.UserID, session.ID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return not found error without revoking when the session belongs to another user", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), session.ID).Return(&session, nil)
+
+		sessionService := application.NewSessionService(mockedSessionRepository)
+
+		// when
+		err := sessionService.Revoke(context.Background(), "some-other-user-id", session.ID)
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "session not found")
+	})
+
+	t.Run("should return error when the session does not exist", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().GetByID(gomock.Any(), "some-session-id").Return(nil, domain.NewResourceNotFoundError("session not found"))
+
+		sessionService := application.NewSessionService(mockedSessionRepository)
+
+		// when
+		err := sessionService.Revoke(context.Background(), "some-user-id", "some-session-id")
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_sessionService_RevokeAll(t *testing.T) {
+	t.Run("should revoke every session of the user", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedSessionRepository := mock_domain.NewMockSessionRepository(mockCtrl)
+		mockedSessionRepository.EXPECT().RevokeAllByUserID(gomock.Any(), "some-user-id").Return(nil)
+
+		sessionService := application.NewSessionService(mockedSessionRepository)
+
+		// when
+		err := sessionService.RevokeAll(context.Background(), "some-user-id")
+
+		// then
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,196 @@
+package application
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_application/two_factor_service.go . TwoFactorService
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// TwoFactorService manages the optional TOTP second factor. Users enroll by adding the secret to an authenticator
+// app and enable it by entering a code, which hands out the recovery codes; from then on logins ask for a code.
+type TwoFactorService interface {
+	Enroll(ctx context.Context, userID string) (*domain.TwoFactorEnrollment, error)
+	Enable(ctx context.Context, userID, code string) ([]string, error)
+	Disable(ctx context.Context, userID, code string) error
+	IsEnabled(ctx context.Context, userID string) (bool, error)
+	Verify(ctx context.Context, userID, code string) error
+}
+
+type twoFactorService struct {
+	twoFactorRepository   domain.TwoFactorRepository
+	userRepository        domain.UserRepository
+	totpManager           domain.TOTPManager
+	identityGenerator     domain.IdentityGenerator
+	recoveryCodeGenerator domain.RecoveryCodeGenerator
+	attemptLimiter        AttemptLimiter
+}
+
+func NewTwoFactorService(
+	twoFactorRepository domain.TwoFactorRepository,
+	userRepository domain.UserRepository,
+	totpManager domain.TOTPManager,
+	identityGenerator domain.IdentityGenerator,
+	recoveryCodeGenerator domain.RecoveryCodeGenerator,
+	attemptLimiter AttemptLimiter,
+) TwoFactorService {
+	return &twoFactorService{
+		twoFactorRepository:   twoFactorRepository,
+		userRepository:        userRepository,
+		totpManager:           totpManager,
+		identityGenerator:     identityGenerator,
+		recoveryCodeGenerator: recoveryCodeGenerator,
+		attemptLimiter:        attemptLimiter,
+	}
+}
+
+// Enroll starts over with a new secret on every call until the enrollment is enabled, so a user who lost the QR code
+// can simply ask again.
+func (s *twoFactorService) Enroll(ctx context.Context, userID string) (*domain.TwoFactorEnrollment, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := s.IsEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if enabled {
+		return nil, domain.NewConflictError("two-factor authentication is already enabled")
+	}
+
+	secret, err := s.totpManager.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := domain.NewTwoFactor(user.ID, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactorRepository.Save(ctx, *twoFactor); err != nil {
+		return nil, err
+	}
+
+	return &domain.TwoFactorEnrollment{
+		Secret: secret,
+		URI:    s.totpManager.URI(secret, user.Email),
+	}, nil
+}
+
+// Enable confirms the enrollment with a code from the authenticator app and returns the recovery codes, which are
+// only shown this once.
+func (s *twoFactorService) Enable(ctx context.Context, userID, code string) ([]string, error) {
+	twoFactor, err := s.twoFactorRepository.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	step, valid := s.totpManager.Validate(twoFactor.Secret, code, time.Now())
+	if !valid {
+		return nil, newIncorrectCodeError()
+	}
+
+	if err := twoFactor.Enable(step); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, plainCodes, err := domain.NewRecoveryCodes(s.identityGenerator, s.recoveryCodeGenerator, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.twoFactorRepository.Enable(ctx, *twoFactor, recoveryCodes); err != nil {
+		return nil, err
+	}
+
+	return plainCodes, nil
+}
+
+// Disable asks for a code as well, so a stolen session alone cannot turn the second factor off.
+func (s *twoFactorService) Disable(ctx context.Context, userID, code string) error {
+	if err := s.Verify(ctx, userID, code); err != nil {
+		return err
+	}
+
+	return s.twoFactorRepository.Delete(ctx, userID)
+}
+
+func (s *twoFactorService) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	twoFactor, err := s.twoFactorRepository.GetByUserID(ctx, userID)
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return twoFactor.IsEnabled(), nil
+}
+
+// Verify accepts a code from the authenticator app, which cannot be used twice, or an unused recovery code. Wrong
+// codes are throttled per account, since six digits are quickly guessed otherwise, and the attempt is reserved before
+// the code is checked so parallel guesses are throttled too.
+func (s *twoFactorService) Verify(ctx context.Context, userID, code string) error {
+	attemptKey := twoFactorAccountKey(userID)
+
+	reservation, err := s.attemptLimiter.Reserve(ctx, attemptKey)
+	if err != nil {
+		return err
+	}
+
+	err = s.verify(ctx, userID, code)
+
+	// a wrong code keeps the reservation as a failure
+	var unauthorizedErr *domain.UnauthorizedError
+	if errors.As(err, &unauthorizedErr) {
+		return newIncorrectCodeError()
+	}
+
+	s.attemptLimiter.Release(ctx, reservation)
+
+	return err
+}
+
+// verify uses the code, failing with an UnauthorizedError only when the code is wrong.
+func (s *twoFactorService) verify(ctx context.Context, userID, code string) error {
+	twoFactor, err := s.twoFactorRepository.GetByUserID(ctx, userID)
+	if err != nil {
+		var notFoundErr *domain.ResourceNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return domain.NewResourceNotFoundError("two-factor authentication is not enabled")
+		}
+		return err
+	}
+
+	if !twoFactor.IsEnabled() {
+		return domain.NewResourceNotFoundError("two-factor authentication is not enabled")
+	}
+
+	return s.useCode(ctx, *twoFactor, code)
+}
+
+func (s *twoFactorService) useCode(ctx context.Context, twoFactor domain.TwoFactor, code string) error {
+	if !domain.IsTOTPCode(code) {
+		return s.twoFactorRepository.UseRecoveryCode(ctx, twoFactor.UserID, domain.HashRecoveryCode(code))
+	}
+
+	step, valid := s.totpManager.Validate(twoFactor.Secret, code, time.Now())
+	if !valid {
+		return domain.NewUnauthorizedError("invalid code")
+	}
+
+	return s.twoFactorRepository.UseStep(ctx, twoFactor.UserID, step)
+}
+
+func newIncorrectCodeError() error {
+	return domain.NewValidationError(validator.ValidationErrors{{Field: "Code", Error: "Code is incorrect"}})
+}
@@ -0,0 +1,330 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_twoFactorService_Enroll(t *testing.T) {
+	t.Run("should store a pending enrollment and return the secret with its otpauth URI", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), user.ID).Return(nil, domain.NewResourceNotFoundError("two-factor authentication not found"))
+		mockedTwoFactorRepository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, twoFactor domain.TwoFactor) error {
+			assert.Equal(t, user.ID, twoFactor.UserID)
+			assert.Equal(t, secret, twoFactor.Secret)
+			assert.False(t, twoFactor.IsEnabled())
+			return nil
+		})
+
+		mockedTOTPManager := mock_domain.NewMockTOTPManager(mockCtrl)
+		mockedTOTPManager.EXPECT().GenerateSecret().Return(secret, nil)
+		mockedTOTPManager.EXPECT().URI(secret, user.Email).Return("otpauth://totp/some-uri")
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, mockedUserRepository, mockedTOTPManager, nil, nil, nil)
+
+		// when
+		enrollment, err := twoFactorService.Enroll(context.Background(), user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, secret, enrollment.Secret)
+		assert.Equal(t, "otpauth://totp/some-uri", enrollment.URI)
+	})
+
+	t.Run("should return conflict error when two-factor authentication is already enabled", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		enabledAt := time.Now()
+		twoFactor := build_domain.NewTwoFactorBuilder().WithUserID(user.ID).WithEnabledAt(&enabledAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), user.ID).Return(&twoFactor, nil)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, mockedUserRepository, nil, nil, nil, nil)
+
+		// when
+		enrollment, err := twoFactorService.Enroll(context.Background(), user.ID)
+
+		// then
+		assert.Nil(t, enrollment)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func Test_twoFactorService_Enable(t *testing.T) {
+	t.Run("should enable the enrollment and return the recovery codes", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), twoFactor.UserID).Return(&twoFactor, nil)
+		mockedTwoFactorRepository.EXPECT().Enable(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, enabled domain.TwoFactor, recoveryCodes []domain.RecoveryCode) error {
+			assert.True(t, enabled.IsEnabled())
+			assert.Equal(t, int64(42), enabled.LastUsedStep)
+			assert.Len(t, recoveryCodes, domain.RecoveryCodeCount)
+			return nil
+		})
+
+		mockedTOTPManager := mock_domain.NewMockTOTPManager(mockCtrl)
+		mockedTOTPManager.EXPECT().Validate(twoFactor.Secret, "123456", gomock.Any()).Return(int64(42), true)
+
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil).Times(domain.RecoveryCodeCount)
+
+		mockedRecoveryCodeGenerator := mock_domain.NewMockRecoveryCodeGenerator(mockCtrl)
+		mockedRecoveryCodeGenerator.EXPECT().Generate().Return("7KQ2-MX9D-4HRT-ZP3W", nil).Times(domain.RecoveryCodeCount)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, mockedTOTPManager, mockedIdentityGenerator, mockedRecoveryCodeGenerator, nil)
+
+		// when
+		recoveryCodes, err := twoFactorService.Enable(context.Background(), twoFactor.UserID, "123456")
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, recoveryCodes, domain.RecoveryCodeCount)
+		assert.Equal(t, "7KQ2-MX9D-4HRT-ZP3W", recoveryCodes[0])
+	})
+
+	t.Run("should return validation error when the code is wrong", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), twoFactor.UserID).Return(&twoFactor, nil)
+
+		mockedTOTPManager := mock_domain.NewMockTOTPManager(mockCtrl)
+		mockedTOTPManager.EXPECT().Validate(twoFactor.Secret, "000000", gomock.Any()).Return(int64(0), false)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, mockedTOTPManager, nil, nil, nil)
+
+		// when
+		recoveryCodes, err := twoFactorService.Enable(context.Background(), twoFactor.UserID, "000000")
+
+		// then
+		assert.Nil(t, recoveryCodes)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_twoFactorService_Disable(t *testing.T) {
+	t.Run("should remove two-factor authentication after a valid code", func(t *testing.T) {
+		// given
+		enabledAt := time.Now()
+		twoFactor := build_domain.NewTwoFactorBuilder().WithEnabledAt(&enabledAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "two-factor:account:"+twoFactor.UserID).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), twoFactor.UserID).Return(&twoFactor, nil)
+		mockedTwoFactorRepository.EXPECT().UseStep(gomock.Any(), twoFactor.UserID, int64(42)).Return(nil)
+		mockedTwoFactorRepository.EXPECT().Delete(gomock.Any(), twoFactor.UserID).Return(nil)
+
+		mockedTOTPManager := mock_domain.NewMockTOTPManager(mockCtrl)
+		mockedTOTPManager.EXPECT().Validate(twoFactor.Secret, "123456", gomock.Any()).Return(int64(42), true)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, mockedTOTPManager, nil, nil, mockedAttemptLimiter)
+
+		// when
+		err := twoFactorService.Disable(context.Background(), twoFactor.UserID, "123456")
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func Test_twoFactorService_IsEnabled(t *testing.T) {
+	t.Run("should report users who never enrolled as not enabled", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), "some-user-id").Return(nil, domain.NewResourceNotFoundError("two-factor authentication not found"))
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, nil, nil, nil, nil)
+
+		// when
+		enabled, err := twoFactorService.IsEnabled(context.Background(), "some-user-id")
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, enabled)
+	})
+
+	t.Run("should report pending enrollments as not enabled", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), twoFactor.UserID).Return(&twoFactor, nil)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, nil, nil, nil, nil)
+
+		// when
+		enabled, err := twoFactorService.IsEnabled(context.Background(), twoFactor.UserID)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, enabled)
+	})
+
+	t.Run("should return error when the repository fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), "some-user-id").Return(nil, assert.AnError)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := twoFactorService.IsEnabled(context.Background(), "some-user-id")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_twoFactorService_Verify(t *testing.T) {
+	enabledAt := time.Now()
+
+	t.Run("should accept an unused recovery code", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().WithEnabledAt(&enabledAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), twoFactor.UserID).Return(&twoFactor, nil)
+		mockedTwoFactorRepository.EXPECT().UseRecoveryCode(gomock.Any(), twoFactor.UserID, domain.HashRecoveryCode("7KQ2-MX9D-4HRT-ZP3W")).Return(nil)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, nil, nil, nil, mockedAttemptLimiter)
+
+		// when
+		err := twoFactorService.Verify(context.Background(), twoFactor.UserID, "7kq2-mx9d-4hrt-zp3w")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should record a failed attempt when a code is replayed", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().WithEnabledAt(&enabledAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any()).Times(0)
+
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), twoFactor.UserID).Return(&twoFactor, nil)
+		mockedTwoFactorRepository.EXPECT().UseStep(gomock.Any(), twoFactor.UserID, int64(42)).Return(domain.NewUnauthorizedError("code has already been used"))
+
+		mockedTOTPManager := mock_domain.NewMockTOTPManager(mockCtrl)
+		mockedTOTPManager.EXPECT().Validate(twoFactor.Secret, "123456", gomock.Any()).Return(int64(42), true)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, mockedTOTPManager, nil, nil, mockedAttemptLimiter)
+
+		// when
+		err := twoFactorService.Verify(context.Background(), twoFactor.UserID, "123456")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should record a failed attempt when the code is wrong", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().WithEnabledAt(&enabledAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any()).Times(0)
+
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), twoFactor.UserID).Return(&twoFactor, nil)
+
+		mockedTOTPManager := mock_domain.NewMockTOTPManager(mockCtrl)
+		mockedTOTPManager.EXPECT().Validate(twoFactor.Secret, "000000", gomock.Any()).Return(int64(0), false)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, mockedTOTPManager, nil, nil, mockedAttemptLimiter)
+
+		// when
+		err := twoFactorService.Verify(context.Background(), twoFactor.UserID, "000000")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "Code is incorrect", validationErr.Details().(validator.ValidationErrors)[0].Error)
+	})
+
+	t.Run("should return not found error when two-factor authentication is only pending", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(application.AttemptReservation{}, nil)
+		mockedAttemptLimiter.EXPECT().Release(gomock.Any(), gomock.Any())
+
+		mockedTwoFactorRepository := mock_domain.NewMockTwoFactorRepository(mockCtrl)
+		mockedTwoFactorRepository.EXPECT().GetByUserID(gomock.Any(), twoFactor.UserID).Return(&twoFactor, nil)
+
+		twoFactorService := application.NewTwoFactorService(mockedTwoFactorRepository, nil, nil, nil, nil, mockedAttemptLimiter)
+
+		// when
+		err := twoFactorService.Verify(context.Background(), twoFactor.UserID, "123456")
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "two-factor authentication is not enabled")
+	})
+
+	t.Run("should return too many requests error without checking the code while the account has to wait", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedAttemptLimiter := mock_application.NewMockAttemptLimiter(mockCtrl)
+		mockedAttemptLimiter.EXPECT().Reserve(gomock.Any(), "two-factor:account:some-user-id").Return(application.AttemptReservation{}, domain.NewTooManyRequestsError("too many failed attempts, try again later", time.Minute))
+
+		twoFactorService := application.NewTwoFactorService(nil, nil, nil, nil, nil, mockedAttemptLimiter)
+
+		// when
+		err := twoFactorService.Verify(context.Background(), "some-user-id", "123456")
+
+		// then
+		var tooManyRequestsErr *domain.TooManyRequestsError
+		assert.ErrorAs(t, err, &tooManyRequestsErr)
+	})
+}
@@ -4,6 +4,7 @@ package application
 
 import (
 	"context"
+	"log"
 
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 )
@@ -11,27 +12,71 @@ import (
 type UserService interface {
 	Create(ctx context.Context, user domain.User) error
 	GetByID(ctx context.Context, userID string) (*domain.User, error)
+	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	UpdateProfile(ctx context.Context, userID string, name, surname, email *string) (*domain.User, error)
 }
 
 type userService struct {
-	userRepository domain.UserRepository
+	userRepository           domain.UserRepository
+	emailVerificationService EmailVerificationService
 }
 
-func NewUserService(userRepository domain.UserRepository) UserService {
+func NewUserService(userRepository domain.UserRepository, emailVerificationService EmailVerificationService) UserService {
 	return &userService{
-		userRepository: userRepository,
+		userRepository:           userRepository,
+		emailVerificationService: emailVerificationService,
 	}
 }
 
+// Create stores the user and emails them a link to verify their address. Personal invites sent to the address before
+// the user signed up are accepted once it is verified.
 func (s *userService) Create(ctx context.Context, user domain.User) error {
 	if err := user.Validate(); err != nil {
 		return err
 	}
 
-	return s.userRepository.Create(ctx, user)
+	if err := s.userRepository.Create(ctx, user); err != nil {
+		return err
+	}
+
+	s.sendEmailVerification(ctx, user.ID)
+
+	return nil
 }
 
 func (s *userService) GetByID(ctx context.Context, userID string) (*domain.User, error) {
 	return s.userRepository.GetByID(ctx, userID)
 }
 
+func (s *userService) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	return s.userRepository.GetByEmail(ctx, email)
+}
+
+// UpdateProfile changes the given fields of the user, emailing a verification link when the email changed.
+func (s *userService) UpdateProfile(ctx context.Context, userID string, name, surname, email *string) (*domain.User, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.UpdateProfile(name, surname, email); err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepository.Update(ctx, *user); err != nil {
+		return nil, err
+	}
+
+	if email != nil && !user.IsEmailVerified() {
+		s.sendEmailVerification(ctx, user.ID)
+	}
+
+	return user, nil
+}
+
+// sendEmailVerification is best effort: the account change already happened and the user can ask for a new link later.
+func (s *userService) sendEmailVerification(ctx context.Context, userID string) {
+	if err := s.emailVerificationService.SendVerification(ctx, userID, ""); err != nil {
+		log.Println("error sending email verification:", err)
+	}
+}
@@ -3,9 +3,11 @@ package application_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
@@ -22,7 +24,30 @@ func Test_userService_Create(t *testing.T) {
 		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
 		mockedUserRepository.EXPECT().Create(gomock.Any(), user).Return(nil)
 
-		userService := application.NewUserService(mockedUserRepository)
+		mockedEmailVerificationService := mock_application.NewMockEmailVerificationService(mockCtrl)
+		mockedEmailVerificationService.EXPECT().SendVerification(gomock.Any(), user.ID, "").Return(nil)
+
+		userService := application.NewUserService(mockedUserRepository, mockedEmailVerificationService)
+
+		// when
+		err := userService.Create(context.Background(), user)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should create user even when the verification email cannot be sent", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().Create(gomock.Any(), user).Return(nil)
+
+		mockedEmailVerificationService := mock_application.NewMockEmailVerificationService(mockCtrl)
+		mockedEmailVerificationService.EXPECT().SendVerification(gomock.Any(), user.ID, "").Return(assert.AnError)
+
+		userService := application.NewUserService(mockedUserRepository, mockedEmailVerificationService)
 
 		// when
 		err := userService.Create(context.Background(), user)
@@ -39,7 +64,10 @@ func Test_userService_Create(t *testing.T) {
 		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
 		mockedUserRepository.EXPECT().Create(gomock.Any(), user).Return(assert.AnError)
 
-		userService := application.NewUserService(mockedUserRepository)
+		mockedEmailVerificationService := mock_application.NewMockEmailVerificationService(mockCtrl)
+		mockedEmailVerificationService.EXPECT().SendVerification(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		userService := application.NewUserService(mockedUserRepository, mockedEmailVerificationService)
 
 		// when
 		err := userService.Create(context.Background(), user)
@@ -53,7 +81,7 @@ func Test_userService_Create(t *testing.T) {
 		// given
 		invalidUser := build_domain.NewUserBuilder().WithName("").Build()
 
-		userService := application.NewUserService(nil)
+		userService := application.NewUserService(nil, nil)
 
 		// when
 		err := userService.Create(context.Background(), invalidUser)
@@ -77,7 +105,7 @@ func Test_userService_GetByID(t *testing.T) {
 		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
 		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
 
-		userService := application.NewUserService(mockedUserRepository)
+		userService := application.NewUserService(mockedUserRepository, nil)
 
 		// when
 		result, err := userService.GetByID(context.Background(), user.ID)
@@ -95,7 +123,7 @@ func Test_userService_GetByID(t *testing.T) {
 		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
 		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(nil, assert.AnError)
 
-		userService := application.NewUserService(mockedUserRepository)
+		userService := application.NewUserService(mockedUserRepository, nil)
 
 		// when
 		result, err := userService.GetByID(context.Background(), user.ID)
@@ -107,3 +135,152 @@ func Test_userService_GetByID(t *testing.T) {
 	})
 }
 
+func Test_userService_GetByEmail(t *testing.T) {
+	t.Run("should get user by email successfully", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), user.Email).Return(&user, nil)
+
+		userService := application.NewUserService(mockedUserRepository, nil)
+
+		// when
+		result, err := userService.GetByEmail(context.Background(), user.Email)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, user, *result)
+	})
+
+	t.Run("should return an error when repository fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByEmail(gomock.Any(), "john@example.com").Return(nil, assert.AnError)
+
+		userService := application.NewUserService(mockedUserRepository, nil)
+
+		// when
+		result, err := userService.GetByEmail(context.Background(), "john@example.com")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Nil(t, result)
+	})
+}
+
+func Test_userService_UpdateProfile(t *testing.T) {
+	t.Run("should update the user's profile successfully", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		surname := "Souza"
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedUser domain.User) error {
+			assert.Equal(t, "Souza", updatedUser.Surname)
+			return nil
+		})
+
+		userService := application.NewUserService(mockedUserRepository, nil)
+
+		// when
+		result, err := userService.UpdateProfile(context.Background(), user.ID, nil, &surname, nil)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "Souza", result.Surname)
+	})
+
+	t.Run("should email a verification link when the email changes", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithEmail("old@example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+		email := "new@example.com"
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedEmailVerificationService := mock_application.NewMockEmailVerificationService(mockCtrl)
+		mockedEmailVerificationService.EXPECT().SendVerification(gomock.Any(), user.ID, "").Return(nil)
+
+		userService := application.NewUserService(mockedUserRepository, mockedEmailVerificationService)
+
+		// when
+		result, err := userService.UpdateProfile(context.Background(), user.ID, nil, nil, &email)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, result.IsEmailVerified())
+	})
+
+	t.Run("should not email a verification link when the email is sent unchanged", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithEmail("same@example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+		email := "Same@example.com"
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		mockedEmailVerificationService := mock_application.NewMockEmailVerificationService(mockCtrl)
+		mockedEmailVerificationService.EXPECT().SendVerification(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		userService := application.NewUserService(mockedUserRepository, mockedEmailVerificationService)
+
+		// when
+		result, err := userService.UpdateProfile(context.Background(), user.ID, nil, nil, &email)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, result.IsEmailVerified())
+	})
+
+	t.Run("should return a validation error without saving when the profile is invalid", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		name := ""
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+
+		userService := application.NewUserService(mockedUserRepository, nil)
+
+		// when
+		result, err := userService.UpdateProfile(context.Background(), user.ID, &name, nil, nil)
+
+		// then
+		assert.Nil(t, result)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should return an error when repository fails", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		name := "John"
+
+		mockCtrl := gomock.NewController(t)
+		mockedUserRepository := mock_domain.NewMockUserRepository(mockCtrl)
+		mockedUserRepository.EXPECT().GetByID(gomock.Any(), user.ID).Return(&user, nil)
+		mockedUserRepository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(domain.NewConflictError("the email is already registered"))
+
+		userService := application.NewUserService(mockedUserRepository, nil)
+
+		// when
+		result, err := userService.UpdateProfile(context.Background(), user.ID, &name, nil, nil)
+
+		// then
+		assert.Nil(t, result)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
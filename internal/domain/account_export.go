@@ -0,0 +1,86 @@
+package domain
+
+import "time"
+
+// AccountExport is a copy of everything stored about a user, built for the personal data export.
+// Other members of the user's groups are left out, except for the receivers the user was matched with.
+type AccountExport struct {
+	User        User
+	Memberships []GroupMembership
+	OwnedGroups []Group
+	// Invites are the invites of the groups the user owns, since only the owner can create them.
+	Invites []GroupInvite
+	Matches []OutgoingMatch
+	// Sessions are every login of the user, with the device and address they were used from.
+	Sessions    []Session
+	GeneratedAt time.Time
+}
+
+// GroupMembership is a group the user takes part in and the role they have in it.
+type GroupMembership struct {
+	GroupID   string
+	GroupName string
+	Status    GroupStatus
+	IsOwner   bool
+}
+
+// OutgoingMatch is the participant the user was drawn to give a gift to in a group.
+type OutgoingMatch struct {
+	GroupID   string
+	GroupName string
+	Receiver  Participant
+}
+
+// NewAccountExport gathers the data of the user from the groups they are a member of, the invites of the
+// groups they own and their sessions.
+func NewAccountExport(user User, groups []Group, invites []GroupInvite, sessions []Session) (*AccountExport, error) {
+	export := AccountExport{
+		User:        user,
+		Memberships: make([]GroupMembership, 0, len(groups)),
+		OwnedGroups: []Group{},
+		Invites:     invites,
+		Matches:     []OutgoingMatch{},
+		Sessions:    sessions,
+		GeneratedAt: time.Now(),
+	}
+
+	if export.Invites == nil {
+		export.Invites = []GroupInvite{}
+	}
+
+	if export.Sessions == nil {
+		export.Sessions = []Session{}
+	}
+
+	for _, group := range groups {
+		isOwner := group.OwnerID == user.ID
+
+		export.Memberships = append(export.Memberships, GroupMembership{
+			GroupID:   group.ID,
+			GroupName: group.Name,
+			Status:    group.Status,
+			IsOwner:   isOwner,
+		})
+
+		if isOwner {
+			export.OwnedGroups = append(export.OwnedGroups, group)
+		}
+
+		if !group.hasMatchAsGiver(user.ID) {
+			continue
+		}
+
+		receiver, err := group.getMatchReceiver(user.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		export.Matches = append(export.Matches, OutgoingMatch{
+			GroupID:   group.ID,
+			GroupName: group.Name,
+			Receiver:  *receiver,
+		})
+	}
+
+	return &export, nil
+}
@@ -0,0 +1,89 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+)
+
+func Test_NewAccountExport(t *testing.T) {
+	t.Run("should list the memberships, the owned groups and the outgoing matches of the user", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		other := build_domain.NewUserBuilder().WithName("Mary").Build()
+		third := build_domain.NewUserBuilder().Build()
+
+		ownedGroup := build_domain.NewGroupBuilder().
+			WithOwnerID(user.ID).
+			WithUsers([]domain.User{user, other}).
+			WithStatus(domain.GroupStatusOpen).
+			Build()
+		matchedGroup := build_domain.NewGroupBuilder().
+			WithOwnerID(other.ID).
+			WithUsers([]domain.User{other, user, third}).
+			WithMatches([]domain.Match{
+				{GiverID: other.ID, ReceiverID: user.ID},
+				{GiverID: user.ID, ReceiverID: third.ID},
+				{GiverID: third.ID, ReceiverID: other.ID},
+			}).
+			WithStatus(domain.GroupStatusMatched).
+			Build()
+		invite := build_domain.NewGroupInviteBuilder().WithGroupID(ownedGroup.ID).Build()
+		session := build_domain.NewSessionBuilder().WithUserID(user.ID).Build()
+
+		// when
+		export, err := domain.NewAccountExport(user, []domain.Group{ownedGroup, matchedGroup}, []domain.GroupInvite{invite}, []domain.Session{session})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, user, export.User)
+		assert.Equal(t, []domain.GroupMembership{
+			{GroupID: ownedGroup.ID, GroupName: ownedGroup.Name, Status: domain.GroupStatusOpen, IsOwner: true},
+			{GroupID: matchedGroup.ID, GroupName: matchedGroup.Name, Status: domain.GroupStatusMatched, IsOwner: false},
+		}, export.Memberships)
+		assert.Equal(t, []domain.Group{ownedGroup}, export.OwnedGroups)
+		assert.Equal(t, []domain.GroupInvite{invite}, export.Invites)
+		assert.Equal(t, []domain.OutgoingMatch{
+			{GroupID: matchedGroup.ID, GroupName: matchedGroup.Name, Receiver: domain.NewParticipantFromUser(third)},
+		}, export.Matches)
+		assert.Equal(t, []domain.Session{session}, export.Sessions)
+		assert.False(t, export.GeneratedAt.IsZero())
+	})
+
+	t.Run("should return empty lists when the user has no groups", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		// when
+		export, err := domain.NewAccountExport(user, nil, nil, nil)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, export.Memberships)
+		assert.NotNil(t, export.OwnedGroups)
+		assert.NotNil(t, export.Invites)
+		assert.NotNil(t, export.Matches)
+		assert.NotNil(t, export.Sessions)
+	})
+
+	t.Run("should return conflict error when the receiver of the user is not a participant", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(user.ID).
+			WithUsers([]domain.User{user}).
+			WithMatches([]domain.Match{{GiverID: user.ID, ReceiverID: build_domain.NewUserBuilder().Build().ID}}).
+			WithStatus(domain.GroupStatusMatched).
+			Build()
+
+		// when
+		export, err := domain.NewAccountExport(user, []domain.Group{group}, nil, nil)
+
+		// then
+		assert.Nil(t, export)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
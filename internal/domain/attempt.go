@@ -0,0 +1,82 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/attempt_store.go . AttemptStore
+
+import (
+	"context"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// FailedAttempts counts the failures recorded under a key, such as a client address or an account.
+type FailedAttempts struct {
+	Count         int
+	LastFailureAt time.Time
+}
+
+// AttemptStore keeps failed attempts between requests. An attempt is reserved before its outcome is known and
+// counts as a failure unless it is released, so concurrent attempts cannot all be verified before the first failure
+// is recorded. A record is dropped once it expires, which ends any wait on its key. Counters only live in the process
+// with the in-memory store; running several instances needs a shared backend, which must reserve atomically.
+type AttemptStore interface {
+	// Reserve counts an attempt made at the given time, keeps the record for ttl and returns the counter as it was
+	// before, so each of several concurrent attempts sees the ones reserved before it.
+	Reserve(ctx context.Context, key string, at time.Time, ttl time.Duration) (FailedAttempts, error)
+	// Release takes back an attempt reserved at the given time. The time of the last failure goes back to the one
+	// in previous, the counter returned by Reserve, unless another attempt was reserved since.
+	Release(ctx context.Context, key string, reservedAt time.Time, previous FailedAttempts) error
+	Reset(ctx context.Context, key string) error
+}
+
+// AttemptPolicy slows down guessing. The first FreeAttempts failures are free; after them each attempt must wait
+// BaseDelay, doubled for every further failure up to MaxDelay. Once LockoutThreshold failures are reached the key
+// is locked for LockoutDuration, and a key without failures for that long starts over.
+type AttemptPolicy struct {
+	FreeAttempts     int           `validate:"gte=0"`
+	BaseDelay        time.Duration `validate:"gt=0"`
+	MaxDelay         time.Duration `validate:"gtefield=BaseDelay"`
+	LockoutThreshold int           `validate:"gtfield=FreeAttempts"`
+	LockoutDuration  time.Duration `validate:"gtefield=MaxDelay"`
+}
+
+func NewAttemptPolicy(freeAttempts int, baseDelay, maxDelay time.Duration, lockoutThreshold int, lockoutDuration time.Duration) (AttemptPolicy, error) {
+	policy := AttemptPolicy{
+		FreeAttempts:     freeAttempts,
+		BaseDelay:        baseDelay,
+		MaxDelay:         maxDelay,
+		LockoutThreshold: lockoutThreshold,
+		LockoutDuration:  lockoutDuration,
+	}
+
+	if errs := validator.Validate(policy); len(errs) > 0 {
+		return AttemptPolicy{}, NewValidationError(errs)
+	}
+
+	return policy, nil
+}
+
+// RetryAfter returns how long the key of the attempts must wait before the next attempt, or zero when it may try now.
+func (p AttemptPolicy) RetryAfter(attempts FailedAttempts, now time.Time) time.Duration {
+	var wait time.Duration
+
+	switch {
+	case attempts.Count >= p.LockoutThreshold:
+		wait = p.LockoutDuration
+	case attempts.Count >= p.FreeAttempts:
+		wait = p.BaseDelay
+		for i := p.FreeAttempts; i < attempts.Count && wait < p.MaxDelay; i++ {
+			wait *= 2
+		}
+		wait = min(wait, p.MaxDelay)
+	default:
+		return 0
+	}
+
+	return max(attempts.LastFailureAt.Add(wait).Sub(now), 0)
+}
+
+// Retention is how long failures are remembered after the last one.
+func (p AttemptPolicy) Retention() time.Duration {
+	return p.LockoutDuration
+}
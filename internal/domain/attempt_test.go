@@ -0,0 +1,69 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+func Test_NewAttemptPolicy(t *testing.T) {
+	t.Run("should create a valid policy", func(t *testing.T) {
+		// when
+		policy, err := domain.NewAttemptPolicy(5, time.Second, 5*time.Minute, 10, 15*time.Minute)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 5, policy.FreeAttempts)
+		assert.Equal(t, 15*time.Minute, policy.Retention())
+	})
+
+	t.Run("should return validation error when the lockout is shorter than the longest delay", func(t *testing.T) {
+		// when
+		_, err := domain.NewAttemptPolicy(5, time.Second, time.Hour, 10, 15*time.Minute)
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should return validation error when the lockout comes before the delays", func(t *testing.T) {
+		// when
+		_, err := domain.NewAttemptPolicy(5, time.Second, 5*time.Minute, 5, 15*time.Minute)
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_AttemptPolicy_RetryAfter(t *testing.T) {
+	policy, _ := domain.NewAttemptPolicy(3, time.Second, 10*time.Second, 8, time.Minute)
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		attempts domain.FailedAttempts
+		expected time.Duration
+	}{
+		{"should not wait without failures", domain.FailedAttempts{}, 0},
+		{"should not wait within the free attempts", domain.FailedAttempts{Count: 2, LastFailureAt: now}, 0},
+		{"should wait the base delay after the free attempts", domain.FailedAttempts{Count: 3, LastFailureAt: now}, time.Second},
+		{"should double the delay with every further failure", domain.FailedAttempts{Count: 5, LastFailureAt: now}, 4 * time.Second},
+		{"should cap the delay", domain.FailedAttempts{Count: 7, LastFailureAt: now}, 10 * time.Second},
+		{"should lock the key once the threshold is reached", domain.FailedAttempts{Count: 8, LastFailureAt: now}, time.Minute},
+		{"should only wait what is left since the last failure", domain.FailedAttempts{Count: 8, LastFailureAt: now.Add(-40 * time.Second)}, 20 * time.Second},
+		{"should not wait once the delay is over", domain.FailedAttempts{Count: 5, LastFailureAt: now.Add(-time.Minute)}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			retryAfter := policy.RetryAfter(tt.attempts, now)
+
+			// then
+			assert.Equal(t, tt.expected, retryAfter)
+		})
+	}
+}
@@ -27,19 +27,24 @@ func (c *Credentials) Validate() error {
 	return nil
 }
 
+// AuthSession pairs a short-lived access token with the refresh token used to get the next one.
 type AuthSession struct {
-	User        User   `validate:"required"`
-	AccessToken string `validate:"required"`
-	TokenType   string `validate:"required"`
-	ExpiresIn   int64  `validate:"required"`
+	User                  User   `validate:"required"`
+	AccessToken           string `validate:"required"`
+	TokenType             string `validate:"required"`
+	ExpiresIn             int64  `validate:"required"`
+	RefreshToken          string `validate:"required"`
+	RefreshTokenExpiresIn int64  `validate:"required"`
 }
 
-func NewAuthSession(user User, accessToken, tokenType string, expiresIn int64) (*AuthSession, error) {
+func NewAuthSession(user User, accessToken, tokenType string, expiresIn int64, refreshToken string, refreshTokenExpiresIn int64) (*AuthSession, error) {
 	authSession := AuthSession{
-		User:        user,
-		AccessToken: accessToken,
-		TokenType:   tokenType,
-		ExpiresIn:   expiresIn,
+		User:                  user,
+		AccessToken:           accessToken,
+		TokenType:             tokenType,
+		ExpiresIn:             expiresIn,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresIn: refreshTokenExpiresIn,
 	}
 
 	if err := authSession.Validate(); err != nil {
@@ -55,3 +60,15 @@ func (a *AuthSession) Validate() error {
 	}
 	return nil
 }
+
+// LoginResult is the outcome of accepting credentials: a session, or a two-factor challenge to answer with a code
+// when the user enabled two-factor authentication.
+type LoginResult struct {
+	Session            *AuthSession
+	ChallengeToken     string
+	ChallengeExpiresIn int64
+}
+
+func (r *LoginResult) RequiresTwoFactor() bool {
+	return r.Session == nil
+}
@@ -87,9 +87,11 @@ func Test_NewAuthSession(t *testing.T) {
 		tokenType := "Bearer"
 		now := time.Now()
 		expiresIn := now.Add(time.Hour).Unix()
+		refreshToken := "some_refresh_token"
+		refreshTokenExpiresIn := now.Add(time.Hour * 24).Unix()
 
 		// when
-		authSession, err := domain.NewAuthSession(user, accessToken, tokenType, expiresIn)
+		authSession, err := domain.NewAuthSession(user, accessToken, tokenType, expiresIn, refreshToken, refreshTokenExpiresIn)
 
 		// then
 		assert.NoError(t, err)
@@ -97,6 +99,8 @@ func Test_NewAuthSession(t *testing.T) {
 		assert.Equal(t, accessToken, authSession.AccessToken)
 		assert.Equal(t, tokenType, authSession.TokenType)
 		assert.Equal(t, expiresIn, authSession.ExpiresIn)
+		assert.Equal(t, refreshToken, authSession.RefreshToken)
+		assert.Equal(t, refreshTokenExpiresIn, authSession.RefreshTokenExpiresIn)
 	})
 
 	t.Run("should return validation error when access token is empty", func(t *testing.T) {
@@ -105,9 +109,11 @@ func Test_NewAuthSession(t *testing.T) {
 		accessToken := ""
 		tokenType := "Bearer"
 		expiresIn := time.Now().Add(time.Hour).Unix()
+		refreshToken := "some_refresh_token"
+		refreshTokenExpiresIn := time.Now().Add(time.Hour * 24).Unix()
 
 		// when
-		authSession, err := domain.NewAuthSession(user, accessToken, tokenType, expiresIn)
+		authSession, err := domain.NewAuthSession(user, accessToken, tokenType, expiresIn, refreshToken, refreshTokenExpiresIn)
 
 		// then
 		assert.Error(t, err)
@@ -125,9 +131,11 @@ func Test_NewAuthSession(t *testing.T) {
 		accessToken := "some_token"
 		tokenType := ""
 		expiresIn := time.Now().Add(time.Hour).Unix()
+		refreshToken := "some_refresh_token"
+		refreshTokenExpiresIn := time.Now().Add(time.Hour * 24).Unix()
 
 		// when
-		authSession, err := domain.NewAuthSession(user, accessToken, tokenType, expiresIn)
+		authSession, err := domain.NewAuthSession(user, accessToken, tokenType, expiresIn, refreshToken, refreshTokenExpiresIn)
 
 		// then
 		assert.Error(t, err)
@@ -145,9 +153,11 @@ func Test_NewAuthSession(t *testing.T) {
 		accessToken := "some_token"
 		tokenType := "Bearer"
 		expiresIn := time.Now().Add(time.Hour).Unix()
+		refreshToken := "some_refresh_token"
+		refreshTokenExpiresIn := time.Now().Add(time.Hour * 24).Unix()
 
 		// when
-		authSession, err := domain.NewAuthSession(user, accessToken, tokenType, expiresIn)
+		authSession, err := domain.NewAuthSession(user, accessToken, tokenType, expiresIn, refreshToken, refreshTokenExpiresIn)
 
 		// then
 		assert.Nil(t, authSession)
@@ -158,4 +168,26 @@ func Test_NewAuthSession(t *testing.T) {
 		assert.Len(t, errors, 1)
 		assert.Contains(t, errors, validator.FieldError{Field: "Name", Error: "Name is a required field"})
 	})
+
+	t.Run("should return validation error when refresh token is empty", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		accessToken := "some_token"
+		tokenType := "Bearer"
+		expiresIn := time.Now().Add(time.Hour).Unix()
+		refreshToken := ""
+		refreshTokenExpiresIn := time.Now().Add(time.Hour * 24).Unix()
+
+		// when
+		authSession, err := domain.NewAuthSession(user, accessToken, tokenType, expiresIn, refreshToken, refreshTokenExpiresIn)
+
+		// then
+		assert.Error(t, err)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Nil(t, authSession)
+		errors := validationErr.Details()
+		assert.Len(t, errors, 1)
+		assert.Contains(t, errors, validator.FieldError{Field: "RefreshToken", Error: "RefreshToken is a required field"})
+	})
 }
@@ -0,0 +1,99 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/blob_storage.go . BlobStorage
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/image_processor.go . ImageProcessor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// Blob is a file kept in a BlobStorage.
+type Blob struct {
+	Content     []byte
+	ContentType string
+}
+
+// BlobStorage keeps files under slash separated keys, such as "avatars/<user ID>/<avatar ID>/small.png".
+type BlobStorage interface {
+	Put(ctx context.Context, key string, blob Blob) error
+	// Get fails with a ResourceNotFoundError when nothing is stored under the key.
+	Get(ctx context.Context, key string) (*Blob, error)
+	// Delete removes the file stored under the key. Deleting a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// ImageProcessor turns uploaded pictures into thumbnails.
+type ImageProcessor interface {
+	// Thumbnails decodes the image once, crops its center square and scales it to size x size pixels for every
+	// size, encoded as PNG and returned in the order of the sizes. It fails with a ValidationError when the content
+	// is not an image it can decode.
+	Thumbnails(content []byte, sizes []int) ([][]byte, error)
+}
+
+type AvatarSize string
+
+const (
+	AvatarSizeSmall  AvatarSize = "small"
+	AvatarSizeMedium AvatarSize = "medium"
+	AvatarSizeLarge  AvatarSize = "large"
+)
+
+// AvatarContentType is the type of every stored thumbnail, whatever the type of the uploaded picture.
+const AvatarContentType = "image/png"
+
+// AvatarSizes lists the thumbnails rendered for every avatar, from the smallest to the largest.
+var AvatarSizes = []AvatarSize{AvatarSizeSmall, AvatarSizeMedium, AvatarSizeLarge}
+
+var avatarSizePixels = map[AvatarSize]int{
+	AvatarSizeSmall:  64,
+	AvatarSizeMedium: 128,
+	AvatarSizeLarge:  256,
+}
+
+// avatarUploadContentTypes are the picture types accepted on upload, as sniffed from the content.
+var avatarUploadContentTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+func NewAvatarSize(size string) (AvatarSize, error) {
+	avatarSize := AvatarSize(size)
+	if _, ok := avatarSizePixels[avatarSize]; !ok {
+		return "", NewValidationError(validator.ValidationErrors{{
+			Field: "Size",
+			Error: fmt.Sprintf("Size must be one of [%s %s %s]", AvatarSizeSmall, AvatarSizeMedium, AvatarSizeLarge),
+		}})
+	}
+	return avatarSize, nil
+}
+
+// Pixels is the width and height of the thumbnail.
+func (s AvatarSize) Pixels() int {
+	return avatarSizePixels[s]
+}
+
+// ValidateAvatarUpload checks an uploaded picture before it is decoded. The type is sniffed from the content
+// instead of trusting the one declared by the client.
+func ValidateAvatarUpload(content []byte, maxSize int) error {
+	if len(content) == 0 {
+		return NewValidationError(validator.ValidationErrors{{Field: "Avatar", Error: "Avatar is a required field"}})
+	}
+
+	if len(content) > maxSize {
+		return NewValidationError(validator.ValidationErrors{{Field: "Avatar", Error: fmt.Sprintf("Avatar must be at most %d bytes", maxSize)}})
+	}
+
+	if !slices.Contains(avatarUploadContentTypes, http.DetectContentType(content)) {
+		return NewValidationError(validator.ValidationErrors{{Field: "Avatar", Error: "Avatar must be one of [image/jpeg image/png image/gif]"}})
+	}
+
+	return nil
+}
+
+// AvatarKey is where the thumbnail of the given size of an avatar is stored. Every upload gets a new avatar ID,
+// so a key always refers to the same picture and can be cached indefinitely.
+func AvatarKey(userID, avatarID string, size AvatarSize) string {
+	return fmt.Sprintf("avatars/%s/%s/%s.png", userID, avatarID, size)
+}
@@ -0,0 +1,77 @@
+package domain_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+func Test_NewAvatarSize(t *testing.T) {
+	t.Run("should parse the known sizes", func(t *testing.T) {
+		// when
+		size, err := domain.NewAvatarSize("medium")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AvatarSizeMedium, size)
+		assert.Equal(t, 128, size.Pixels())
+	})
+
+	t.Run("should return validation error for unknown sizes", func(t *testing.T) {
+		// when
+		_, err := domain.NewAvatarSize("huge")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Size", Error: "Size must be one of [small medium large]"})
+	})
+}
+
+func Test_ValidateAvatarUpload(t *testing.T) {
+	var picture bytes.Buffer
+	_ = png.Encode(&picture, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+
+	t.Run("should accept pictures within the size limit", func(t *testing.T) {
+		// when
+		err := domain.ValidateAvatarUpload(picture.Bytes(), 1024)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return validation error when the picture is empty", func(t *testing.T) {
+		// when
+		err := domain.ValidateAvatarUpload(nil, 1024)
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Avatar", Error: "Avatar is a required field"})
+	})
+
+	t.Run("should return validation error when the picture is too large", func(t *testing.T) {
+		// when
+		err := domain.ValidateAvatarUpload(picture.Bytes(), 10)
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Avatar", Error: "Avatar must be at most 10 bytes"})
+	})
+
+	t.Run("should return validation error when the content is not a picture", func(t *testing.T) {
+		// when
+		err := domain.ValidateAvatarUpload([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"), 1024)
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Avatar", Error: "Avatar must be one of [image/jpeg image/png image/gif]"})
+	})
+}
@@ -15,10 +15,12 @@ func NewAuthSessionBuilder() *AuthSessionBuilder {
 
 	return &AuthSessionBuilder{
 		authSession: domain.AuthSession{
-			User:        user,
-			AccessToken: "DefaultAccessToken",
-			TokenType:   "Bearer",
-			ExpiresIn:   time.Now().Add(time.Hour * 24).Unix(), // Expires in 24 hours by default
+			User:                  user,
+			AccessToken:           "DefaultAccessToken",
+			TokenType:             "Bearer",
+			ExpiresIn:             time.Now().Add(time.Hour * 24).Unix(), // Expires in 24 hours by default
+			RefreshToken:          "DefaultRefreshToken",
+			RefreshTokenExpiresIn: time.Now().Add(time.Hour * 24 * 30).Unix(),
 		},
 	}
 }
@@ -43,6 +45,16 @@ func (b *AuthSessionBuilder) WithExpiresIn(expiresIn int64) *AuthSessionBuilder
 	return b
 }
 
+func (b *AuthSessionBuilder) WithRefreshToken(refreshToken string) *AuthSessionBuilder {
+	b.authSession.RefreshToken = refreshToken
+	return b
+}
+
+func (b *AuthSessionBuilder) WithRefreshTokenExpiresIn(refreshTokenExpiresIn int64) *AuthSessionBuilder {
+	b.authSession.RefreshTokenExpiresIn = refreshTokenExpiresIn
+	return b
+}
+
 func (b *AuthSessionBuilder) Build() domain.AuthSession {
 	return b.authSession
 }
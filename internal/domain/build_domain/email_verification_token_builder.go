@@ -0,0 +1,62 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type EmailVerificationTokenBuilder struct {
+	emailVerificationToken domain.EmailVerificationToken
+}
+
+func NewEmailVerificationTokenBuilder() *EmailVerificationTokenBuilder {
+	now := time.Now().UTC()
+
+	return &EmailVerificationTokenBuilder{
+		emailVerificationToken: domain.EmailVerificationToken{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			Email:     "default@example.com",
+			TokenHash: domain.HashSecretToken("some-secret-token"),
+			ExpiresAt: now.Add(48 * time.Hour),
+			CreatedAt: now,
+		},
+	}
+}
+
+func (b *EmailVerificationTokenBuilder) WithID(id string) *EmailVerificationTokenBuilder {
+	b.emailVerificationToken.ID = id
+	return b
+}
+
+func (b *EmailVerificationTokenBuilder) WithUser(user domain.User) *EmailVerificationTokenBuilder {
+	b.emailVerificationToken.UserID = user.ID
+	b.emailVerificationToken.Email = user.Email
+	return b
+}
+
+func (b *EmailVerificationTokenBuilder) WithEmail(email string) *EmailVerificationTokenBuilder {
+	b.emailVerificationToken.Email = email
+	return b
+}
+
+func (b *EmailVerificationTokenBuilder) WithTokenHash(tokenHash string) *EmailVerificationTokenBuilder {
+	b.emailVerificationToken.TokenHash = tokenHash
+	return b
+}
+
+func (b *EmailVerificationTokenBuilder) WithUsedAt(usedAt *time.Time) *EmailVerificationTokenBuilder {
+	b.emailVerificationToken.UsedAt = usedAt
+	return b
+}
+
+func (b *EmailVerificationTokenBuilder) WithExpiresAt(expiresAt time.Time) *EmailVerificationTokenBuilder {
+	b.emailVerificationToken.ExpiresAt = expiresAt
+	return b
+}
+
+func (b *EmailVerificationTokenBuilder) Build() domain.EmailVerificationToken {
+	return b.emailVerificationToken
+}
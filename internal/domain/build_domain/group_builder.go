@@ -17,14 +17,15 @@ func NewGroupBuilder() *GroupBuilder {
 
 	return &GroupBuilder{
 		group: domain.Group{
-			ID:          uuid.New().String(),
-			Name:        "Test Group",
-			Description: "Test Group Description",
-			Users:       []domain.User{user},
-			Status:      domain.GroupStatusOpen,
-			OwnerID:     user.ID,
-			CreatedAt:   now,
-			UpdatedAt:   now,
+			ID:            uuid.New().String(),
+			Name:          "Test Group",
+			Description:   "Test Group Description",
+			Users:         []domain.User{user},
+			MatchStrategy: domain.MatchStrategyCycle,
+			Status:        domain.GroupStatusOpen,
+			OwnerID:       user.ID,
+			CreatedAt:     now,
+			UpdatedAt:     now,
 		},
 	}
 }
@@ -44,11 +45,46 @@ func (b *GroupBuilder) WithDescription(description string) *GroupBuilder {
 	return b
 }
 
+func (b *GroupBuilder) WithMatchStrategy(matchStrategy domain.MatchStrategy) *GroupBuilder {
+	b.group.MatchStrategy = matchStrategy
+	return b
+}
+
 func (b *GroupBuilder) WithUsers(users []domain.User) *GroupBuilder {
 	b.group.Users = users
 	return b
 }
 
+func (b *GroupBuilder) WithGuests(guests []domain.Guest) *GroupBuilder {
+	b.group.Guests = guests
+	return b
+}
+
+func (b *GroupBuilder) WithWaitlist(waitlist []domain.User) *GroupBuilder {
+	b.group.Waitlist = waitlist
+	return b
+}
+
+func (b *GroupBuilder) WithMaxMembers(maxMembers int) *GroupBuilder {
+	b.group.MaxMembers = maxMembers
+	return b
+}
+
+func (b *GroupBuilder) WithBudget(budget int) *GroupBuilder {
+	b.group.Budget = budget
+	return b
+}
+
+func (b *GroupBuilder) WithRules(rules string) *GroupBuilder {
+	b.group.Rules = rules
+	return b
+}
+
+func (b *GroupBuilder) WithExchangeDate(exchangeDate *time.Time) *GroupBuilder {
+	b.group.ExchangeDate = exchangeDate
+	return b
+}
+
 func (b *GroupBuilder) WithMatches(matches []domain.Match) *GroupBuilder {
 	b.group.Matches = matches
 	return b
@@ -74,6 +110,16 @@ func (b *GroupBuilder) WithUpdatedAt(updatedAt time.Time) *GroupBuilder {
 	return b
 }
 
+func (b *GroupBuilder) WithJoinRequests(joinRequests []domain.JoinRequest) *GroupBuilder {
+	b.group.JoinRequests = joinRequests
+	return b
+}
+
+func (b *GroupBuilder) WithVersion(version int) *GroupBuilder {
+	b.group.Version = version
+	return b
+}
+
 func (b *GroupBuilder) Build() domain.Group {
 	return b.group
 }
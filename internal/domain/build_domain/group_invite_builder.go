@@ -18,6 +18,7 @@ func NewGroupInviteBuilder() *GroupInviteBuilder {
 		groupInvite: domain.GroupInvite{
 			ID:        uuid.New().String(),
 			GroupID:   uuid.New().String(),
+			Code:      "ABCD2345",
 			ExpiresAt: now.Add(24 * time.Hour),
 			CreatedAt: now,
 		},
@@ -34,6 +35,11 @@ func (b *GroupInviteBuilder) WithGroupID(groupID string) *GroupInviteBuilder {
 	return b
 }
 
+func (b *GroupInviteBuilder) WithCode(code string) *GroupInviteBuilder {
+	b.groupInvite.Code = code
+	return b
+}
+
 func (b *GroupInviteBuilder) WithExpiresAt(expiresAt time.Time) *GroupInviteBuilder {
 	b.groupInvite.ExpiresAt = expiresAt
 	return b
@@ -44,6 +50,36 @@ func (b *GroupInviteBuilder) WithCreatedAt(createdAt time.Time) *GroupInviteBuil
 	return b
 }
 
+func (b *GroupInviteBuilder) WithRequiresApproval(requiresApproval bool) *GroupInviteBuilder {
+	b.groupInvite.RequiresApproval = requiresApproval
+	return b
+}
+
+func (b *GroupInviteBuilder) WithEmail(email string) *GroupInviteBuilder {
+	b.groupInvite.Email = email
+	return b
+}
+
+func (b *GroupInviteBuilder) WithUsedAt(usedAt *time.Time) *GroupInviteBuilder {
+	b.groupInvite.UsedAt = usedAt
+	return b
+}
+
+func (b *GroupInviteBuilder) WithRevokedAt(revokedAt *time.Time) *GroupInviteBuilder {
+	b.groupInvite.RevokedAt = revokedAt
+	return b
+}
+
+func (b *GroupInviteBuilder) WithMaxUses(maxUses int) *GroupInviteBuilder {
+	b.groupInvite.MaxUses = maxUses
+	return b
+}
+
+func (b *GroupInviteBuilder) WithUses(uses int) *GroupInviteBuilder {
+	b.groupInvite.Uses = uses
+	return b
+}
+
 func (b *GroupInviteBuilder) Build() domain.GroupInvite {
 	return b.groupInvite
 }
@@ -0,0 +1,91 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type GroupTemplateBuilder struct {
+	groupTemplate domain.GroupTemplate
+}
+
+func NewGroupTemplateBuilder() *GroupTemplateBuilder {
+	now := time.Now().UTC()
+
+	return &GroupTemplateBuilder{
+		groupTemplate: domain.GroupTemplate{
+			ID:             uuid.New().String(),
+			Name:           "Book club exchange",
+			Description:    "Everyone gives a book",
+			MaxMembers:     10,
+			Budget:         3000,
+			Rules:          "Gift a book you loved",
+			ExchangeInDays: 15,
+			MatchStrategy:  domain.MatchStrategyRandom,
+			OwnerID:        uuid.New().String(),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		},
+	}
+}
+
+func (b *GroupTemplateBuilder) WithID(id string) *GroupTemplateBuilder {
+	b.groupTemplate.ID = id
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithName(name string) *GroupTemplateBuilder {
+	b.groupTemplate.Name = name
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithDescription(description string) *GroupTemplateBuilder {
+	b.groupTemplate.Description = description
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithMaxMembers(maxMembers int) *GroupTemplateBuilder {
+	b.groupTemplate.MaxMembers = maxMembers
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithBudget(budget int) *GroupTemplateBuilder {
+	b.groupTemplate.Budget = budget
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithRules(rules string) *GroupTemplateBuilder {
+	b.groupTemplate.Rules = rules
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithExchangeInDays(exchangeInDays int) *GroupTemplateBuilder {
+	b.groupTemplate.ExchangeInDays = exchangeInDays
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithMatchStrategy(matchStrategy domain.MatchStrategy) *GroupTemplateBuilder {
+	b.groupTemplate.MatchStrategy = matchStrategy
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithOwnerID(ownerID string) *GroupTemplateBuilder {
+	b.groupTemplate.OwnerID = ownerID
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithCreatedAt(createdAt time.Time) *GroupTemplateBuilder {
+	b.groupTemplate.CreatedAt = createdAt
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithUpdatedAt(updatedAt time.Time) *GroupTemplateBuilder {
+	b.groupTemplate.UpdatedAt = updatedAt
+	return b
+}
+
+func (b *GroupTemplateBuilder) Build() domain.GroupTemplate {
+	return b.groupTemplate
+}
@@ -0,0 +1,55 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type GuestBuilder struct {
+	guest domain.Guest
+}
+
+func NewGuestBuilder() *GuestBuilder {
+	now := time.Now().UTC()
+
+	return &GuestBuilder{
+		guest: domain.Guest{
+			ID:        uuid.New().String(),
+			Name:      "Grandma",
+			Email:     "grandma@example.com",
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+}
+
+func (b *GuestBuilder) WithID(id string) *GuestBuilder {
+	b.guest.ID = id
+	return b
+}
+
+func (b *GuestBuilder) WithName(name string) *GuestBuilder {
+	b.guest.Name = name
+	return b
+}
+
+func (b *GuestBuilder) WithEmail(email string) *GuestBuilder {
+	b.guest.Email = email
+	return b
+}
+
+func (b *GuestBuilder) WithCreatedAt(createdAt time.Time) *GuestBuilder {
+	b.guest.CreatedAt = createdAt
+	return b
+}
+
+func (b *GuestBuilder) WithUpdatedAt(updatedAt time.Time) *GuestBuilder {
+	b.guest.UpdatedAt = updatedAt
+	return b
+}
+
+func (b *GuestBuilder) Build() domain.Guest {
+	return b.guest
+}
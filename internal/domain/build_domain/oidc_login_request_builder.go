@@ -0,0 +1,51 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type OIDCLoginRequestBuilder struct {
+	loginRequest domain.OIDCLoginRequest
+}
+
+func NewOIDCLoginRequestBuilder() *OIDCLoginRequestBuilder {
+	now := time.Now().UTC()
+
+	return &OIDCLoginRequestBuilder{
+		loginRequest: domain.OIDCLoginRequest{
+			ID:           uuid.New().String(),
+			StateHash:    domain.HashSecretToken("some-state"),
+			Nonce:        "some-nonce",
+			CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+			ExpiresAt:    now.Add(domain.OIDCLoginRequestDuration),
+			CreatedAt:    now,
+		},
+	}
+}
+
+func (b *OIDCLoginRequestBuilder) WithStateHash(stateHash string) *OIDCLoginRequestBuilder {
+	b.loginRequest.StateHash = stateHash
+	return b
+}
+
+func (b *OIDCLoginRequestBuilder) WithNonce(nonce string) *OIDCLoginRequestBuilder {
+	b.loginRequest.Nonce = nonce
+	return b
+}
+
+func (b *OIDCLoginRequestBuilder) WithUsedAt(usedAt *time.Time) *OIDCLoginRequestBuilder {
+	b.loginRequest.UsedAt = usedAt
+	return b
+}
+
+func (b *OIDCLoginRequestBuilder) WithExpiresAt(expiresAt time.Time) *OIDCLoginRequestBuilder {
+	b.loginRequest.ExpiresAt = expiresAt
+	return b
+}
+
+func (b *OIDCLoginRequestBuilder) Build() domain.OIDCLoginRequest {
+	return b.loginRequest
+}
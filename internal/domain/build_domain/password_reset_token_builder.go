@@ -0,0 +1,60 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type PasswordResetTokenBuilder struct {
+	passwordResetToken domain.PasswordResetToken
+}
+
+func NewPasswordResetTokenBuilder() *PasswordResetTokenBuilder {
+	now := time.Now().UTC()
+
+	return &PasswordResetTokenBuilder{
+		passwordResetToken: domain.PasswordResetToken{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			TokenHash: domain.HashSecretToken("some-secret-token"),
+			ExpiresAt: now.Add(time.Hour),
+			CreatedAt: now,
+		},
+	}
+}
+
+func (b *PasswordResetTokenBuilder) WithID(id string) *PasswordResetTokenBuilder {
+	b.passwordResetToken.ID = id
+	return b
+}
+
+func (b *PasswordResetTokenBuilder) WithUserID(userID string) *PasswordResetTokenBuilder {
+	b.passwordResetToken.UserID = userID
+	return b
+}
+
+func (b *PasswordResetTokenBuilder) WithTokenHash(tokenHash string) *PasswordResetTokenBuilder {
+	b.passwordResetToken.TokenHash = tokenHash
+	return b
+}
+
+func (b *PasswordResetTokenBuilder) WithUsedAt(usedAt *time.Time) *PasswordResetTokenBuilder {
+	b.passwordResetToken.UsedAt = usedAt
+	return b
+}
+
+func (b *PasswordResetTokenBuilder) WithExpiresAt(expiresAt time.Time) *PasswordResetTokenBuilder {
+	b.passwordResetToken.ExpiresAt = expiresAt
+	return b
+}
+
+func (b *PasswordResetTokenBuilder) WithCreatedAt(createdAt time.Time) *PasswordResetTokenBuilder {
+	b.passwordResetToken.CreatedAt = createdAt
+	return b
+}
+
+func (b *PasswordResetTokenBuilder) Build() domain.PasswordResetToken {
+	return b.passwordResetToken
+}
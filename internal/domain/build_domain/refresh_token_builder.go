@@ -0,0 +1,71 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type RefreshTokenBuilder struct {
+	refreshToken domain.RefreshToken
+}
+
+func NewRefreshTokenBuilder() *RefreshTokenBuilder {
+	now := time.Now().UTC()
+
+	return &RefreshTokenBuilder{
+		refreshToken: domain.RefreshToken{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			SessionID: uuid.New().String(),
+			TokenHash: domain.HashSecretToken("some-refresh-token"),
+			ExpiresAt: now.Add(time.Hour * 24 * 30),
+			CreatedAt: now,
+		},
+	}
+}
+
+func (b *RefreshTokenBuilder) WithID(id string) *RefreshTokenBuilder {
+	b.refreshToken.ID = id
+	return b
+}
+
+func (b *RefreshTokenBuilder) WithUserID(userID string) *RefreshTokenBuilder {
+	b.refreshToken.UserID = userID
+	return b
+}
+
+func (b *RefreshTokenBuilder) WithSessionID(sessionID string) *RefreshTokenBuilder {
+	b.refreshToken.SessionID = sessionID
+	return b
+}
+
+func (b *RefreshTokenBuilder) WithTokenHash(tokenHash string) *RefreshTokenBuilder {
+	b.refreshToken.TokenHash = tokenHash
+	return b
+}
+
+func (b *RefreshTokenBuilder) WithUsedAt(usedAt *time.Time) *RefreshTokenBuilder {
+	b.refreshToken.UsedAt = usedAt
+	return b
+}
+
+func (b *RefreshTokenBuilder) WithRevokedAt(revokedAt *time.Time) *RefreshTokenBuilder {
+	b.refreshToken.RevokedAt = revokedAt
+	return b
+}
+
+func (b *RefreshTokenBuilder) WithExpiresAt(expiresAt time.Time) *RefreshTokenBuilder {
+	b.refreshToken.ExpiresAt = expiresAt
+	return b
+}
+
+func (b *RefreshTokenBuilder) WithCreatedAt(createdAt time.Time) *RefreshTokenBuilder {
+	b.refreshToken.CreatedAt = createdAt
+	return b
+}
+
+func (b *RefreshTokenBuilder) Build() domain.RefreshToken {
+	return b.refreshToken
+}
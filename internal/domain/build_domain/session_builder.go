@@ -0,0 +1,72 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type SessionBuilder struct {
+	session domain.Session
+}
+
+func NewSessionBuilder() *SessionBuilder {
+	now := time.Now().UTC()
+
+	return &SessionBuilder{
+		session: domain.Session{
+			ID:         uuid.New().String(),
+			UserID:     uuid.New().String(),
+			UserAgent:  "Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0",
+			IPAddress:  "203.0.113.10",
+			LastSeenAt: now,
+			ExpiresAt:  now.Add(time.Hour * 24 * 30),
+			CreatedAt:  now,
+		},
+	}
+}
+
+func (b *SessionBuilder) WithID(id string) *SessionBuilder {
+	b.session.ID = id
+	return b
+}
+
+func (b *SessionBuilder) WithUserID(userID string) *SessionBuilder {
+	b.session.UserID = userID
+	return b
+}
+
+func (b *SessionBuilder) WithUserAgent(userAgent string) *SessionBuilder {
+	b.session.UserAgent = userAgent
+	return b
+}
+
+func (b *SessionBuilder) WithIPAddress(ipAddress string) *SessionBuilder {
+	b.session.IPAddress = ipAddress
+	return b
+}
+
+func (b *SessionBuilder) WithLastSeenAt(lastSeenAt time.Time) *SessionBuilder {
+	b.session.LastSeenAt = lastSeenAt
+	return b
+}
+
+func (b *SessionBuilder) WithRevokedAt(revokedAt *time.Time) *SessionBuilder {
+	b.session.RevokedAt = revokedAt
+	return b
+}
+
+func (b *SessionBuilder) WithExpiresAt(expiresAt time.Time) *SessionBuilder {
+	b.session.ExpiresAt = expiresAt
+	return b
+}
+
+func (b *SessionBuilder) WithCreatedAt(createdAt time.Time) *SessionBuilder {
+	b.session.CreatedAt = createdAt
+	return b
+}
+
+func (b *SessionBuilder) Build() domain.Session {
+	return b.session
+}
@@ -0,0 +1,46 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type TwoFactorBuilder struct {
+	twoFactor domain.TwoFactor
+}
+
+func NewTwoFactorBuilder() *TwoFactorBuilder {
+	return &TwoFactorBuilder{
+		twoFactor: domain.TwoFactor{
+			UserID:    uuid.New().String(),
+			Secret:    "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+			CreatedAt: time.Now().UTC(),
+		},
+	}
+}
+
+func (b *TwoFactorBuilder) WithUserID(userID string) *TwoFactorBuilder {
+	b.twoFactor.UserID = userID
+	return b
+}
+
+func (b *TwoFactorBuilder) WithSecret(secret string) *TwoFactorBuilder {
+	b.twoFactor.Secret = secret
+	return b
+}
+
+func (b *TwoFactorBuilder) WithEnabledAt(enabledAt *time.Time) *TwoFactorBuilder {
+	b.twoFactor.EnabledAt = enabledAt
+	return b
+}
+
+func (b *TwoFactorBuilder) WithLastUsedStep(lastUsedStep int64) *TwoFactorBuilder {
+	b.twoFactor.LastUsedStep = lastUsedStep
+	return b
+}
+
+func (b *TwoFactorBuilder) Build() domain.TwoFactor {
+	return b.twoFactor
+}
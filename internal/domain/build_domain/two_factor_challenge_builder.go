@@ -0,0 +1,50 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type TwoFactorChallengeBuilder struct {
+	twoFactorChallenge domain.TwoFactorChallenge
+}
+
+func NewTwoFactorChallengeBuilder() *TwoFactorChallengeBuilder {
+	now := time.Now().UTC()
+
+	return &TwoFactorChallengeBuilder{
+		twoFactorChallenge: domain.TwoFactorChallenge{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			TokenHash: domain.HashSecretToken("some-challenge-token"),
+			ExpiresAt: now.Add(domain.TwoFactorChallengeDuration),
+			CreatedAt: now,
+		},
+	}
+}
+
+func (b *TwoFactorChallengeBuilder) WithUserID(userID string) *TwoFactorChallengeBuilder {
+	b.twoFactorChallenge.UserID = userID
+	return b
+}
+
+func (b *TwoFactorChallengeBuilder) WithTokenHash(tokenHash string) *TwoFactorChallengeBuilder {
+	b.twoFactorChallenge.TokenHash = tokenHash
+	return b
+}
+
+func (b *TwoFactorChallengeBuilder) WithUsedAt(usedAt *time.Time) *TwoFactorChallengeBuilder {
+	b.twoFactorChallenge.UsedAt = usedAt
+	return b
+}
+
+func (b *TwoFactorChallengeBuilder) WithExpiresAt(expiresAt time.Time) *TwoFactorChallengeBuilder {
+	b.twoFactorChallenge.ExpiresAt = expiresAt
+	return b
+}
+
+func (b *TwoFactorChallengeBuilder) Build() domain.TwoFactorChallenge {
+	return b.twoFactorChallenge
+}
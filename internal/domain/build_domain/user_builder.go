@@ -52,6 +52,26 @@ func (b *UserBuilder) WithPassword(password string) *UserBuilder {
 	return b
 }
 
+func (b *UserBuilder) WithPasswordChangedAt(passwordChangedAt *time.Time) *UserBuilder {
+	b.user.PasswordChangedAt = passwordChangedAt
+	return b
+}
+
+func (b *UserBuilder) WithEmailVerifiedAt(emailVerifiedAt *time.Time) *UserBuilder {
+	b.user.EmailVerifiedAt = emailVerifiedAt
+	return b
+}
+
+func (b *UserBuilder) WithDeletedAt(deletedAt *time.Time) *UserBuilder {
+	b.user.DeletedAt = deletedAt
+	return b
+}
+
+func (b *UserBuilder) WithAvatarID(avatarID string) *UserBuilder {
+	b.user.AvatarID = avatarID
+	return b
+}
+
 func (b *UserBuilder) WithCreatedAt(createdAt time.Time) *UserBuilder {
 	b.user.CreatedAt = createdAt
 	return b
@@ -0,0 +1,43 @@
+package build_domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type UserIdentityBuilder struct {
+	userIdentity domain.UserIdentity
+}
+
+func NewUserIdentityBuilder() *UserIdentityBuilder {
+	return &UserIdentityBuilder{
+		userIdentity: domain.UserIdentity{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			Provider:  "https://idp.example.com",
+			Subject:   "248289761001",
+			CreatedAt: time.Now().UTC(),
+		},
+	}
+}
+
+func (b *UserIdentityBuilder) WithUserID(userID string) *UserIdentityBuilder {
+	b.userIdentity.UserID = userID
+	return b
+}
+
+func (b *UserIdentityBuilder) WithProvider(provider string) *UserIdentityBuilder {
+	b.userIdentity.Provider = provider
+	return b
+}
+
+func (b *UserIdentityBuilder) WithSubject(subject string) *UserIdentityBuilder {
+	b.userIdentity.Subject = subject
+	return b
+}
+
+func (b *UserIdentityBuilder) Build() domain.UserIdentity {
+	return b.userIdentity
+}
@@ -0,0 +1,125 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/email_verification_repository.go . EmailVerificationRepository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, emailVerificationToken EmailVerificationToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*EmailVerificationToken, error)
+	// Redeem marks the token as used and stores the verification of the user in a single transaction,
+	// failing with a conflict when the token has already been used or has expired.
+	Redeem(ctx context.Context, tokenID string, user User) error
+}
+
+// EmailVerificationToken proves that a user can read the inbox of Email. The address is kept with the token
+// so a link sent before an email change cannot verify the new address.
+type EmailVerificationToken struct {
+	ID        string `validate:"required,uuid"`
+	UserID    string `validate:"required,uuid"`
+	Email     string `validate:"required,email"`
+	TokenHash string `validate:"required,len=64"`
+	UsedAt    *time.Time
+	ExpiresAt time.Time `validate:"required"`
+	CreatedAt time.Time `validate:"required"`
+}
+
+func NewEmailVerificationToken(identityGenerator IdentityGenerator, user User, token string, expiration time.Duration) (*EmailVerificationToken, error) {
+	id, err := identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	emailVerificationToken := EmailVerificationToken{
+		ID:        id,
+		UserID:    user.ID,
+		Email:     user.Email,
+		TokenHash: HashSecretToken(token),
+		ExpiresAt: now.Add(expiration),
+		CreatedAt: now,
+	}
+
+	if err := emailVerificationToken.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &emailVerificationToken, nil
+}
+
+func (t *EmailVerificationToken) Validate() error {
+	if errs := validator.Validate(t); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+// IsUsableFor reports whether the token can still verify the current email of the user.
+func (t *EmailVerificationToken) IsUsableFor(user User) bool {
+	return t.UsedAt == nil && time.Now().Before(t.ExpiresAt) && t.UserID == user.ID && strings.EqualFold(t.Email, user.Email)
+}
+
+// UnverifiedUserAction is something the EmailVerificationPolicy may forbid until the user verifies their email.
+type UnverifiedUserAction string
+
+const (
+	UnverifiedUserActionCreateGroup  UnverifiedUserAction = "CREATE_GROUP"
+	UnverifiedUserActionJoinGroup    UnverifiedUserAction = "JOIN_GROUP"
+	UnverifiedUserActionCreateInvite UnverifiedUserAction = "CREATE_INVITE"
+)
+
+var unverifiedUserActionDescriptions = map[UnverifiedUserAction]string{
+	UnverifiedUserActionCreateGroup:  "create groups",
+	UnverifiedUserActionJoinGroup:    "join groups",
+	UnverifiedUserActionCreateInvite: "create invites",
+}
+
+// EmailVerificationPolicy lists the actions that require a verified email. The zero value restricts nothing.
+type EmailVerificationPolicy struct {
+	restrictedActions []UnverifiedUserAction
+}
+
+func NewEmailVerificationPolicy(restrictedActions []string) (EmailVerificationPolicy, error) {
+	var policy EmailVerificationPolicy
+
+	for _, restrictedAction := range restrictedActions {
+		action := UnverifiedUserAction(strings.ToUpper(strings.TrimSpace(restrictedAction)))
+		if action == "" {
+			continue
+		}
+
+		if _, ok := unverifiedUserActionDescriptions[action]; !ok {
+			return EmailVerificationPolicy{}, NewValidationError(validator.ValidationErrors{{
+				Field: "RestrictedActions",
+				Error: fmt.Sprintf("RestrictedActions must be one of [%s %s %s]", UnverifiedUserActionCreateGroup, UnverifiedUserActionJoinGroup, UnverifiedUserActionCreateInvite),
+			}})
+		}
+
+		if !slices.Contains(policy.restrictedActions, action) {
+			policy.restrictedActions = append(policy.restrictedActions, action)
+		}
+	}
+
+	return policy, nil
+}
+
+// Restricts reports whether the action requires a verified email, letting callers skip loading the user otherwise.
+func (p EmailVerificationPolicy) Restricts(action UnverifiedUserAction) bool {
+	return slices.Contains(p.restrictedActions, action)
+}
+
+func (p EmailVerificationPolicy) Authorize(user User, action UnverifiedUserAction) error {
+	if p.Restricts(action) && !user.IsEmailVerified() {
+		return NewForbiddenError("verify your email address to " + unverifiedUserActionDescriptions[action])
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_NewEmailVerificationToken(t *testing.T) {
+	t.Run("should bind the token to the current email of the user", func(t *testing.T) {
+		// given
+		id := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(id, nil)
+
+		// when
+		emailVerificationToken, err := domain.NewEmailVerificationToken(mockedIdentityGenerator, user, "secret-token", 48*time.Hour)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, id, emailVerificationToken.ID)
+		assert.Equal(t, user.ID, emailVerificationToken.UserID)
+		assert.Equal(t, user.Email, emailVerificationToken.Email)
+		assert.Equal(t, domain.HashSecretToken("secret-token"), emailVerificationToken.TokenHash)
+		assert.WithinDuration(t, time.Now().Add(48*time.Hour), emailVerificationToken.ExpiresAt, time.Second)
+	})
+
+	t.Run("should return error when identity generation fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
+
+		// when
+		emailVerificationToken, err := domain.NewEmailVerificationToken(mockedIdentityGenerator, build_domain.NewUserBuilder().Build(), "secret-token", time.Hour)
+
+		// then
+		assert.Nil(t, emailVerificationToken)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_EmailVerificationToken_IsUsableFor(t *testing.T) {
+	t.Run("should be usable for the user and email it was sent to", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		emailVerificationToken := build_domain.NewEmailVerificationTokenBuilder().WithUser(user).Build()
+
+		// when
+		result := emailVerificationToken.IsUsableFor(user)
+
+		// then
+		assert.True(t, result)
+	})
+
+	t.Run("should not be usable after the user changed their email", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithEmail("new@example.com").Build()
+		emailVerificationToken := build_domain.NewEmailVerificationTokenBuilder().WithUser(user).WithEmail("old@example.com").Build()
+
+		// when
+		result := emailVerificationToken.IsUsableFor(user)
+
+		// then
+		assert.False(t, result)
+	})
+
+	t.Run("should not be usable once used", func(t *testing.T) {
+		// given
+		usedAt := time.Now()
+		user := build_domain.NewUserBuilder().Build()
+		emailVerificationToken := build_domain.NewEmailVerificationTokenBuilder().WithUser(user).WithUsedAt(&usedAt).Build()
+
+		// when
+		result := emailVerificationToken.IsUsableFor(user)
+
+		// then
+		assert.False(t, result)
+	})
+
+	t.Run("should not be usable once expired", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		emailVerificationToken := build_domain.NewEmailVerificationTokenBuilder().WithUser(user).WithExpiresAt(time.Now().Add(-time.Minute)).Build()
+
+		// when
+		result := emailVerificationToken.IsUsableFor(user)
+
+		// then
+		assert.False(t, result)
+	})
+}
+
+func Test_NewEmailVerificationPolicy(t *testing.T) {
+	t.Run("should parse the restricted actions ignoring case and blanks", func(t *testing.T) {
+		// when
+		policy, err := domain.NewEmailVerificationPolicy([]string{"join_group", " CREATE_INVITE ", ""})
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, policy.Restricts(domain.UnverifiedUserActionJoinGroup))
+		assert.True(t, policy.Restricts(domain.UnverifiedUserActionCreateInvite))
+		assert.False(t, policy.Restricts(domain.UnverifiedUserActionCreateGroup))
+	})
+
+	t.Run("should return validation error for unknown actions", func(t *testing.T) {
+		// when
+		_, err := domain.NewEmailVerificationPolicy([]string{"DELETE_EVERYTHING"})
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_EmailVerificationPolicy_Authorize(t *testing.T) {
+	policy, _ := domain.NewEmailVerificationPolicy([]string{"JOIN_GROUP"})
+
+	t.Run("should forbid restricted actions to unverified users", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		// when
+		err := policy.Authorize(user, domain.UnverifiedUserActionJoinGroup)
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "verify your email address to join groups")
+	})
+
+	t.Run("should allow restricted actions to verified users", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithEmailVerifiedAt(&verifiedAt).Build()
+
+		// when
+		err := policy.Authorize(user, domain.UnverifiedUserActionJoinGroup)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should allow actions that are not restricted", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		// when
+		err := policy.Authorize(user, domain.UnverifiedUserActionCreateGroup)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should restrict nothing with the zero value", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		// when
+		err := domain.EmailVerificationPolicy{}.Authorize(user, domain.UnverifiedUserActionJoinGroup)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
@@ -1,7 +1,9 @@
 package domain
 
 import (
+	"math"
 	"net/http"
+	"time"
 
 	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
 )
@@ -95,3 +97,24 @@ func NewForbiddenError(message string) error {
 		},
 	}
 }
+
+// TooManyRequestsError tells the client to wait RetryAfter before trying again.
+type TooManyRequestsError struct {
+	customError
+	RetryAfter time.Duration
+}
+
+func NewTooManyRequestsError(message string, retryAfter time.Duration) error {
+	return &TooManyRequestsError{
+		customError: customError{
+			message:    message,
+			statusCode: http.StatusTooManyRequests,
+		},
+		RetryAfter: retryAfter,
+	}
+}
+
+// RetryAfterSeconds rounds the wait up to whole seconds, as the Retry-After header expects, so clients never retry early.
+func (e *TooManyRequestsError) RetryAfterSeconds() int {
+	return max(int(math.Ceil(e.RetryAfter.Seconds())), 1)
+}
@@ -4,7 +4,9 @@ package domain
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	"slices"
@@ -15,28 +17,107 @@ import (
 type GroupStatus string
 
 const (
-	GroupStatusOpen     GroupStatus = "OPEN"
-	GroupStatusMatched  GroupStatus = "MATCHED"
-	GroupStatusArchived GroupStatus = "ARCHIVED"
+	GroupStatusDraft              GroupStatus = "DRAFT"
+	GroupStatusOpen               GroupStatus = "OPEN"
+	GroupStatusRegistrationClosed GroupStatus = "REGISTRATION_CLOSED"
+	GroupStatusMatched            GroupStatus = "MATCHED"
+	GroupStatusCompleted          GroupStatus = "COMPLETED"
+	GroupStatusArchived           GroupStatus = "ARCHIVED"
 )
 
+// MatchStrategy is how the draw pairs givers with receivers.
+type MatchStrategy string
+
+const (
+	// MatchStrategyCycle chains every participant into a single loop, so each gift leads to the next participant and
+	// nobody ends up trading gifts with a small circle of their own.
+	MatchStrategyCycle MatchStrategy = "CYCLE"
+	// MatchStrategyRandom picks any draw in which nobody gives to themselves, so participants may end up in several
+	// smaller loops, including pairs giving to each other.
+	MatchStrategyRandom MatchStrategy = "RANDOM"
+)
+
+// GroupAction is a lifecycle action that moves a group from one status to another.
+type GroupAction string
+
+const (
+	GroupActionPublish           GroupAction = "PUBLISH"
+	GroupActionCloseRegistration GroupAction = "CLOSE_REGISTRATION"
+	GroupActionReopen            GroupAction = "REOPEN"
+	GroupActionGenerateMatches   GroupAction = "GENERATE_MATCHES"
+	GroupActionComplete          GroupAction = "COMPLETE"
+	GroupActionArchive           GroupAction = "ARCHIVE"
+)
+
+// groupActions lists every lifecycle action in the order AllowedActions reports them.
+var groupActions = []GroupAction{
+	GroupActionPublish,
+	GroupActionCloseRegistration,
+	GroupActionReopen,
+	GroupActionGenerateMatches,
+	GroupActionComplete,
+	GroupActionArchive,
+}
+
+// groupTransitions is the group lifecycle state machine. For each status it maps the allowed
+// actions to the status they lead to; any action not listed is rejected.
+var groupTransitions = map[GroupStatus]map[GroupAction]GroupStatus{
+	GroupStatusDraft: {
+		GroupActionPublish: GroupStatusOpen,
+		GroupActionArchive: GroupStatusArchived,
+	},
+	GroupStatusOpen: {
+		GroupActionCloseRegistration: GroupStatusRegistrationClosed,
+		GroupActionGenerateMatches:   GroupStatusMatched,
+		GroupActionArchive:           GroupStatusArchived,
+	},
+	GroupStatusRegistrationClosed: {
+		GroupActionReopen:          GroupStatusOpen,
+		GroupActionGenerateMatches: GroupStatusMatched,
+		GroupActionArchive:         GroupStatusArchived,
+	},
+	GroupStatusMatched: {
+		GroupActionReopen:   GroupStatusOpen,
+		GroupActionComplete: GroupStatusCompleted,
+		GroupActionArchive:  GroupStatusArchived,
+	},
+	GroupStatusCompleted: {
+		GroupActionArchive: GroupStatusArchived,
+	},
+	GroupStatusArchived: {},
+}
+
 type GroupRepository interface {
 	Search(ctx context.Context, filters GroupFilters) (*SearchResult[GroupSummary], error)
 	Create(ctx context.Context, group Group) error
+	// Update saves the group as long as nobody saved it since it was read, which is told apart by its version; a
+	// group changed in the meantime results in a ConflictError, so concurrent joins cannot overfill it.
 	Update(ctx context.Context, group Group) error
 	GetByID(ctx context.Context, groupID string) (*Group, error)
+	// ListClaimableGuests lists the guests of groups that are not archived whose email matches the given one,
+	// ignoring case.
+	ListClaimableGuests(ctx context.Context, email string) ([]ClaimableGuest, error)
 }
 
 type Group struct {
-	ID          string      `validate:"required,uuid"`
-	Name        string      `validate:"required"`
-	Description string      `validate:"omitempty,max=255"`
-	Users       []User      `validate:"required,min=1"`
-	OwnerID     string      `validate:"required,uuid"`
-	Matches     []Match     `validate:"dive,omitempty"`
-	Status      GroupStatus `validate:"required,oneof=OPEN MATCHED ARCHIVED"`
-	CreatedAt   time.Time   `validate:"required"`
-	UpdatedAt   time.Time   `validate:"required"`
+	ID            string        `validate:"required,uuid"`
+	Name          string        `validate:"required"`
+	Description   string        `validate:"omitempty,max=255"`
+	Users         []User        `validate:"required,min=1"`
+	Guests        []Guest       `validate:"dive"`
+	Waitlist      []User        `validate:"dive"`
+	JoinRequests  []JoinRequest `validate:"dive"`
+	MaxMembers    int           `validate:"min=0"`
+	Budget        int           `validate:"min=0"`
+	Rules         string        `validate:"omitempty,max=1000"`
+	ExchangeDate  *time.Time
+	MatchStrategy MatchStrategy `validate:"required,oneof=CYCLE RANDOM"`
+	OwnerID       string        `validate:"required,uuid"`
+	Matches       []Match       `validate:"dive,omitempty"`
+	Status        GroupStatus   `validate:"required,oneof=DRAFT OPEN REGISTRATION_CLOSED MATCHED COMPLETED ARCHIVED"`
+	CreatedAt     time.Time     `validate:"required"`
+	UpdatedAt     time.Time     `validate:"required"`
+	Version       int           `validate:"min=0"`
 }
 
 type Match struct {
@@ -52,23 +133,74 @@ func (m *Match) Validate() error {
 	return nil
 }
 
-func NewGroup(identityGenerator IdentityGenerator, name, description string, owner User) (*Group, error) {
+// JoinRequest is a pending request to join a group through an invite that requires owner approval.
+type JoinRequest struct {
+	User User `validate:"required"`
+	// InviteID is the invite the request was made through; its use is only redeemed once the request is approved.
+	InviteID  string    `validate:"omitempty,uuid"`
+	CreatedAt time.Time `validate:"required"`
+}
+
+// RevealedMatch is a giver and receiver pair, disclosed to the group once the gifts have been exchanged.
+type RevealedMatch struct {
+	Giver    Participant
+	Receiver Participant
+}
+
+// GroupSettings are the configurable rules of a gift exchange. A nil field was not given, so a template may fill it;
+// a group created without it gets no member limit, no budget, no extra rules, no exchange date and the cycle strategy.
+// A given zero value is kept as is, so it also overrides the template.
+type GroupSettings struct {
+	MaxMembers *int
+	// Budget is the suggested gift value in the smallest currency unit (e.g. cents).
+	Budget        *int
+	Rules         *string
+	ExchangeDate  *time.Time
+	MatchStrategy *MatchStrategy
+}
+
+// NewGroup creates a group owned by the given user with the given settings.
+// Draft groups start in DRAFT status so the owner can set them up before publishing; otherwise they start OPEN.
+func NewGroup(identityGenerator IdentityGenerator, name, description string, settings GroupSettings, draft bool, owner User) (*Group, error) {
 	id, err := identityGenerator.Generate()
 	if err != nil {
 		return nil, err
 	}
 
+	status := GroupStatusOpen
+	if draft {
+		status = GroupStatusDraft
+	}
+
 	now := time.Now()
 
 	group := &Group{
-		ID:          id,
-		Name:        name,
-		Description: description,
-		OwnerID:     owner.ID,
-		Users:       []User{owner},
-		Status:      GroupStatusOpen,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		ID:            id,
+		Name:          name,
+		Description:   description,
+		OwnerID:       owner.ID,
+		Users:         []User{owner},
+		ExchangeDate:  settings.ExchangeDate,
+		MatchStrategy: MatchStrategyCycle,
+		Status:        status,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if settings.MaxMembers != nil {
+		group.MaxMembers = *settings.MaxMembers
+	}
+
+	if settings.Budget != nil {
+		group.Budget = *settings.Budget
+	}
+
+	if settings.Rules != nil {
+		group.Rules = *settings.Rules
+	}
+
+	if settings.MatchStrategy != nil {
+		group.MatchStrategy = *settings.MatchStrategy
 	}
 
 	if err := group.Validate(); err != nil {
@@ -86,6 +218,10 @@ func (g *Group) Validate() error {
 	return nil
 }
 
+func (g *Group) IsDraft() bool {
+	return g.Status == GroupStatusDraft
+}
+
 func (g *Group) IsOpen() bool {
 	return g.Status == GroupStatusOpen
 }
@@ -94,10 +230,65 @@ func (g *Group) IsMatched() bool {
 	return g.Status == GroupStatusMatched
 }
 
+func (g *Group) IsCompleted() bool {
+	return g.Status == GroupStatusCompleted
+}
+
 func (g *Group) IsArchived() bool {
 	return g.Status == GroupStatusArchived
 }
 
+// isAcceptingMembers reports whether participants can still be added, either by the owner while the group
+// is being set up or by anyone while registration is open.
+func (g *Group) isAcceptingMembers() bool {
+	return g.IsDraft() || g.IsOpen()
+}
+
+// isBeforeMatching reports whether the matches have not been drawn yet, so participants can still leave.
+func (g *Group) isBeforeMatching() bool {
+	return g.isAcceptingMembers() || g.Status == GroupStatusRegistrationClosed
+}
+
+// hasDrawnMatches reports whether the matches have been drawn and can be looked up.
+func (g *Group) hasDrawnMatches() bool {
+	return g.IsMatched() || g.IsCompleted()
+}
+
+// CanTransition reports whether the action is allowed from the current status.
+func (g *Group) CanTransition(action GroupAction) bool {
+	_, ok := groupTransitions[g.Status][action]
+	return ok
+}
+
+// AllowedActions returns the lifecycle actions that can be taken from the current status.
+func (g *Group) AllowedActions() []GroupAction {
+	actions := make([]GroupAction, 0, len(groupActions))
+	for _, action := range groupActions {
+		if g.CanTransition(action) {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// transition moves the group to the status the action leads to, rejecting actions the state machine does not allow.
+// Spots freed while the group was not accepting members go to the waitlist as soon as it accepts them again.
+func (g *Group) transition(action GroupAction) error {
+	next, ok := groupTransitions[g.Status][action]
+	if !ok {
+		return NewConflictError(fmt.Sprintf("action %s is not allowed for a group in %s status", action, g.Status))
+	}
+
+	g.Status = next
+	g.UpdatedAt = time.Now()
+
+	if g.isAcceptingMembers() {
+		g.promoteFromWaitlist()
+	}
+
+	return nil
+}
+
 func (g *Group) IsMember(userID string) bool {
 	for _, user := range g.Users {
 		if user.ID == userID {
@@ -107,6 +298,37 @@ func (g *Group) IsMember(userID string) bool {
 	return false
 }
 
+func (g *Group) IsWaitlisted(userID string) bool {
+	return g.waitlistIndex(userID) >= 0
+}
+
+func (g *Group) waitlistIndex(userID string) int {
+	for i, user := range g.Waitlist {
+		if user.ID == userID {
+			return i
+		}
+	}
+	return -1
+}
+
+// HasJoinedOrRequested reports whether the user is a member, is waitlisted or is waiting for approval.
+func (g *Group) HasJoinedOrRequested(userID string) bool {
+	return g.IsMember(userID) || g.IsWaitlisted(userID) || g.HasPendingJoinRequest(userID)
+}
+
+// IsFull reports whether the group has reached its member limit. Guests count towards the limit.
+func (g *Group) IsFull() bool {
+	return g.MaxMembers > 0 && len(g.Users)+len(g.Guests) >= g.MaxMembers
+}
+
+// promoteFromWaitlist moves waitlisted users into the group, in the order they joined, while there is room.
+func (g *Group) promoteFromWaitlist() {
+	for len(g.Waitlist) > 0 && !g.IsFull() {
+		g.Users = append(g.Users, g.Waitlist[0])
+		g.Waitlist = slices.Delete(g.Waitlist, 0, 1)
+	}
+}
+
 func (g *Group) CanView(requesterID string) error {
 	if !g.IsMember(requesterID) {
 		return NewForbiddenError("user is not a member of this group")
@@ -126,8 +348,27 @@ func (g *Group) CanCreateInvite(requesterID string) error {
 	return nil
 }
 
+func (g *Group) CanInviteEmail(requesterID, email string) error {
+	if err := g.CanCreateInvite(requesterID); err != nil {
+		return err
+	}
+
+	if g.hasParticipantWithEmail(email, "") {
+		return NewConflictError("a participant with this email is already in the group")
+	}
+
+	return nil
+}
+
+func (g *Group) CanManageInvites(requesterID string) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can manage invites")
+	}
+	return nil
+}
+
 func (g *Group) AddUser(requesterID string, targetUser User) error {
-	if !g.IsOpen() {
+	if !g.isAcceptingMembers() {
 		return NewConflictError("group is not open for registration, contact the group owner to reopen the group")
 	}
 
@@ -135,20 +376,117 @@ func (g *Group) AddUser(requesterID string, targetUser User) error {
 		return NewForbiddenError("only the group owner can add other users")
 	}
 
-	for _, existingUser := range g.Users {
-		if existingUser.ID == targetUser.ID {
-			return nil
+	if targetUser.IsDeleted() {
+		return NewResourceNotFoundError("user not found")
+	}
+
+	if g.IsMember(targetUser.ID) || g.IsWaitlisted(targetUser.ID) {
+		return nil
+	}
+
+	if index := g.joinRequestIndex(targetUser.ID); index >= 0 {
+		g.JoinRequests = slices.Delete(g.JoinRequests, index, index+1)
+	}
+
+	g.admit(targetUser)
+	g.UpdatedAt = time.Now()
+
+	return g.Validate()
+}
+
+// admit adds the user to the group, or to the waitlist when the group is full.
+func (g *Group) admit(user User) {
+	if g.IsFull() {
+		g.Waitlist = append(g.Waitlist, user)
+	} else {
+		g.Users = append(g.Users, user)
+	}
+}
+
+func (g *Group) HasPendingJoinRequest(userID string) bool {
+	return g.joinRequestIndex(userID) >= 0
+}
+
+// PendingJoinRequest returns the pending join request of the user, if any.
+func (g *Group) PendingJoinRequest(userID string) (JoinRequest, bool) {
+	index := g.joinRequestIndex(userID)
+	if index < 0 {
+		return JoinRequest{}, false
+	}
+	return g.JoinRequests[index], true
+}
+
+func (g *Group) joinRequestIndex(userID string) int {
+	for i, joinRequest := range g.JoinRequests {
+		if joinRequest.User.ID == userID {
+			return i
 		}
 	}
+	return -1
+}
+
+// RequestToJoin adds a pending request of the user to join the group through the given invite.
+func (g *Group) RequestToJoin(user User, inviteID string) error {
+	if !g.IsOpen() {
+		return NewConflictError("group is not open for registration, contact the group owner to reopen the group")
+	}
+
+	if g.IsMember(user.ID) || g.IsWaitlisted(user.ID) || g.HasPendingJoinRequest(user.ID) {
+		return nil
+	}
+
+	now := time.Now()
+
+	g.JoinRequests = append(g.JoinRequests, JoinRequest{
+		User:      user,
+		InviteID:  inviteID,
+		CreatedAt: now,
+	})
+	g.UpdatedAt = now
+
+	return g.Validate()
+}
 
-	g.Users = append(g.Users, targetUser)
+func (g *Group) ApproveJoinRequest(requesterID, userID string) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can approve join requests")
+	}
+
+	if !g.IsOpen() {
+		return NewConflictError("group is not open for registration, contact the group owner to reopen the group")
+	}
+
+	index := g.joinRequestIndex(userID)
+	if index < 0 {
+		return NewResourceNotFoundError("join request not found")
+	}
+
+	user := g.JoinRequests[index].User
+	g.JoinRequests = slices.Delete(g.JoinRequests, index, index+1)
+	g.admit(user)
+	g.UpdatedAt = time.Now()
+
+	return g.Validate()
+}
+
+func (g *Group) RejectJoinRequest(requesterID, userID string) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can reject join requests")
+	}
+
+	index := g.joinRequestIndex(userID)
+	if index < 0 {
+		return NewResourceNotFoundError("join request not found")
+	}
+
+	g.JoinRequests = slices.Delete(g.JoinRequests, index, index+1)
 	g.UpdatedAt = time.Now()
 
 	return g.Validate()
 }
 
 func (g *Group) RemoveUser(requesterID, targetUserID string) error {
-	if !g.IsOpen() {
+	if !g.isBeforeMatching() {
 		return NewConflictError("group is not open for removal, contact the group owner to reopen the group")
 	}
 
@@ -160,9 +498,18 @@ func (g *Group) RemoveUser(requesterID, targetUserID string) error {
 		return NewForbiddenError("cannot remove group owner")
 	}
 
+	if index := g.waitlistIndex(targetUserID); index >= 0 {
+		g.Waitlist = slices.Delete(g.Waitlist, index, index+1)
+		g.UpdatedAt = time.Now()
+		return g.Validate()
+	}
+
 	for i, user := range g.Users {
 		if user.ID == targetUserID {
 			g.Users = slices.Delete(g.Users, i, i+1)
+			if g.isAcceptingMembers() {
+				g.promoteFromWaitlist()
+			}
 			g.UpdatedAt = time.Now()
 			break
 		}
@@ -171,49 +518,153 @@ func (g *Group) RemoveUser(requesterID, targetUserID string) error {
 	return g.Validate()
 }
 
+// RemoveDeletedUser takes a user whose account is being deleted out of the group. Before the matches are drawn
+// the user leaves like any other member; afterwards the user stays, anonymised, so the matches keep pointing at
+// a participant. A group owned by the user is handed over to the next member, or archived when nobody is left.
+func (g *Group) RemoveDeletedUser(userID string) error {
+	if index := g.waitlistIndex(userID); index >= 0 {
+		g.Waitlist = slices.Delete(g.Waitlist, index, index+1)
+	}
+
+	if index := g.joinRequestIndex(userID); index >= 0 {
+		g.JoinRequests = slices.Delete(g.JoinRequests, index, index+1)
+	}
+
+	if g.OwnerID == userID {
+		if nextOwner := g.nextOwner(userID); nextOwner != nil {
+			g.OwnerID = nextOwner.ID
+		} else if g.CanTransition(GroupActionArchive) {
+			if err := g.transition(GroupActionArchive); err != nil {
+				return err
+			}
+		}
+	}
+
+	if g.OwnerID != userID && g.isBeforeMatching() {
+		for i, user := range g.Users {
+			if user.ID == userID {
+				g.Users = slices.Delete(g.Users, i, i+1)
+				if g.isAcceptingMembers() {
+					g.promoteFromWaitlist()
+				}
+				break
+			}
+		}
+	}
+
+	g.UpdatedAt = time.Now()
+
+	return g.Validate()
+}
+
+// RenameApartFrom gives the group the first free name of the form "Name (2)", "Name (3)" and so on when its
+// name is one of the taken names. It is used when the group is handed over to an owner who may already have a
+// group with the same name, since an owner cannot have two groups with the same name.
+func (g *Group) RenameApartFrom(takenNames []string) {
+	if !slices.Contains(takenNames, g.Name) {
+		return
+	}
+
+	for suffix := 2; ; suffix++ {
+		name := fmt.Sprintf("%s (%d)", g.Name, suffix)
+		if !slices.Contains(takenNames, name) {
+			g.Name = name
+			g.UpdatedAt = time.Now()
+			return
+		}
+	}
+}
+
+// nextOwner returns the first member other than the current owner who can take over the group, if any.
+func (g *Group) nextOwner(ownerID string) *User {
+	for _, user := range g.Users {
+		if user.ID != ownerID && !user.IsDeleted() {
+			return &user
+		}
+	}
+	return nil
+}
+
 func (g *Group) GenerateMatches(requesterID string) error {
 	if requesterID != g.OwnerID {
 		return NewForbiddenError("only the group owner can generate matches")
 	}
 
-	if !g.IsOpen() {
+	if !g.CanTransition(GroupActionGenerateMatches) {
 		return NewConflictError("group is not open for matches")
 	}
 
-	if len(g.Users) < 3 {
-		return NewConflictError("group must have at least 3 users to generate matches")
+	participants := g.Participants()
+
+	if len(participants) < 3 {
+		return NewConflictError("group must have at least 3 participants to generate matches")
 	}
 
-	userIDs := make([]string, len(g.Users))
-	for i, user := range g.Users {
-		userIDs[i] = user.ID
+	participantIDs := make([]string, len(participants))
+	for i, participant := range participants {
+		participantIDs[i] = participant.ID
 	}
 
 	source := rand.NewSource(time.Now().UnixNano())
 	r := rand.New(source)
 
-	r.Shuffle(len(userIDs), func(i, j int) {
-		userIDs[i], userIDs[j] = userIDs[j], userIDs[i]
-	})
-
-	currentMatches := make([]Match, len(userIDs))
-	for i := range userIDs {
-		giverID := userIDs[i]
-		receiverID := userIDs[(i+1)%len(userIDs)]
+	var currentMatches []Match
+	if g.MatchStrategy == MatchStrategyRandom {
+		currentMatches = drawRandomMatches(r, participantIDs)
+	} else {
+		currentMatches = drawCycleMatches(r, participantIDs)
+	}
 
-		currentMatches[i] = Match{
-			GiverID:    giverID,
-			ReceiverID: receiverID,
-		}
+	if err := g.transition(GroupActionGenerateMatches); err != nil {
+		return err
 	}
 
 	g.Matches = currentMatches
-	g.Status = GroupStatusMatched
-	g.UpdatedAt = time.Now()
 
 	return g.Validate()
 }
 
+// drawCycleMatches shuffles the participants and has each of them give to the next one, closing a single loop.
+func drawCycleMatches(r *rand.Rand, participantIDs []string) []Match {
+	r.Shuffle(len(participantIDs), func(i, j int) {
+		participantIDs[i], participantIDs[j] = participantIDs[j], participantIDs[i]
+	})
+
+	matches := make([]Match, len(participantIDs))
+	for i := range participantIDs {
+		matches[i] = Match{
+			GiverID:    participantIDs[i],
+			ReceiverID: participantIDs[(i+1)%len(participantIDs)],
+		}
+	}
+
+	return matches
+}
+
+// drawRandomMatches reshuffles the receivers until nobody is their own receiver. Every such draw is equally likely,
+// and about one shuffle in three succeeds whatever the group size.
+func drawRandomMatches(r *rand.Rand, participantIDs []string) []Match {
+	receiverIDs := slices.Clone(participantIDs)
+
+	for {
+		r.Shuffle(len(receiverIDs), func(i, j int) {
+			receiverIDs[i], receiverIDs[j] = receiverIDs[j], receiverIDs[i]
+		})
+
+		matches := make([]Match, 0, len(participantIDs))
+		for i, giverID := range participantIDs {
+			if giverID == receiverIDs[i] {
+				break
+			}
+			matches = append(matches, Match{GiverID: giverID, ReceiverID: receiverIDs[i]})
+		}
+
+		if len(matches) == len(participantIDs) {
+			return matches
+		}
+	}
+}
+
 func (g *Group) Reopen(requesterID string) error {
 	if requesterID != g.OwnerID {
 		return NewForbiddenError("only the group owner can reopen the group")
@@ -223,12 +674,75 @@ func (g *Group) Reopen(requesterID string) error {
 		return NewConflictError("group is archived and cannot be reopened")
 	}
 
-	if g.Status == GroupStatusOpen {
+	if g.IsOpen() {
 		return NewConflictError("group is already open")
 	}
 
+	if err := g.transition(GroupActionReopen); err != nil {
+		return err
+	}
+
 	g.Matches = []Match{}
-	g.Status = GroupStatusOpen
+
+	return g.Validate()
+}
+
+// Publish opens a draft group for registration, allowing invites to be created.
+func (g *Group) Publish(requesterID string) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can publish the group")
+	}
+
+	if err := g.transition(GroupActionPublish); err != nil {
+		return err
+	}
+
+	return g.Validate()
+}
+
+// CloseRegistration stops new participants from joining while keeping the group ready for the draw.
+func (g *Group) CloseRegistration(requesterID string) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can close registration")
+	}
+
+	if err := g.transition(GroupActionCloseRegistration); err != nil {
+		return err
+	}
+
+	return g.Validate()
+}
+
+// Complete marks the gifts as exchanged, after which the matches can be revealed to the group.
+func (g *Group) Complete(requesterID string) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can complete the group")
+	}
+
+	if err := g.transition(GroupActionComplete); err != nil {
+		return err
+	}
+
+	return g.Validate()
+}
+
+func (g *Group) SetMaxMembers(requesterID string, maxMembers int) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can change the member limit")
+	}
+
+	if g.IsArchived() {
+		return NewConflictError("group is archived and cannot be changed")
+	}
+
+	if maxMembers > 0 && maxMembers < len(g.Users)+len(g.Guests) {
+		return NewConflictError("member limit cannot be lower than the current number of participants")
+	}
+
+	g.MaxMembers = maxMembers
+	if g.isAcceptingMembers() {
+		g.promoteFromWaitlist()
+	}
 	g.UpdatedAt = time.Now()
 
 	return g.Validate()
@@ -239,24 +753,71 @@ func (g *Group) Archive(requesterID string) error {
 		return NewForbiddenError("only the group owner can archive the group")
 	}
 
-	if g.Status == GroupStatusArchived {
+	if g.IsArchived() {
 		return NewConflictError("group is already archived")
 	}
 
-	g.Status = GroupStatusArchived
-	g.UpdatedAt = time.Now()
+	if err := g.transition(GroupActionArchive); err != nil {
+		return err
+	}
 
 	return g.Validate()
 }
 
-func (g *Group) GetUserMatch(requesterID string) (*User, error) {
-	if !g.IsMatched() {
+func (g *Group) GetUserMatch(requesterID string) (*Participant, error) {
+	if !g.hasDrawnMatches() {
 		return nil, NewConflictError("group is not matched")
 	}
 
+	return g.getMatchReceiver(requesterID)
+}
+
+// RevealMatches discloses every giver and receiver pair to the group members once the group is completed.
+func (g *Group) RevealMatches(requesterID string) ([]RevealedMatch, error) {
+	if err := g.CanView(requesterID); err != nil {
+		return nil, err
+	}
+
+	if !g.IsCompleted() {
+		return nil, NewConflictError("matches can only be revealed once the group is completed")
+	}
+
+	participants := make(map[string]Participant, len(g.Users)+len(g.Guests))
+	for _, participant := range g.Participants() {
+		participants[participant.ID] = participant
+	}
+
+	revealedMatches := make([]RevealedMatch, 0, len(g.Matches))
+	for _, match := range g.Matches {
+		giver, giverFound := participants[match.GiverID]
+		receiver, receiverFound := participants[match.ReceiverID]
+		if !giverFound || !receiverFound {
+			return nil, NewConflictError("participant not found for the identified match")
+		}
+
+		revealedMatches = append(revealedMatches, RevealedMatch{
+			Giver:    giver,
+			Receiver: receiver,
+		})
+	}
+
+	return revealedMatches, nil
+}
+
+// hasMatchAsGiver reports whether a receiver was drawn for the participant, whatever the current status.
+func (g *Group) hasMatchAsGiver(giverID string) bool {
+	for _, match := range g.Matches {
+		if match.GiverID == giverID {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Group) getMatchReceiver(giverID string) (*Participant, error) {
 	var userMatch *Match
 	for _, match := range g.Matches {
-		if match.GiverID == requesterID {
+		if match.GiverID == giverID {
 			userMatch = &match
 			break
 		}
@@ -266,15 +827,220 @@ func (g *Group) GetUserMatch(requesterID string) (*User, error) {
 		return nil, NewConflictError("match not found for the given user")
 	}
 
-	for _, user := range g.Users {
-		if user.ID == userMatch.ReceiverID {
-			return &user, nil
+	for _, participant := range g.Participants() {
+		if participant.ID == userMatch.ReceiverID {
+			return &participant, nil
 		}
 	}
 
 	return nil, NewConflictError("receiver user not found for the identified match")
 }
 
+func (g *Group) Participants() []Participant {
+	participants := make([]Participant, 0, len(g.Users)+len(g.Guests))
+
+	for _, user := range g.Users {
+		participants = append(participants, NewParticipantFromUser(user))
+	}
+
+	for _, guest := range g.Guests {
+		participants = append(participants, NewParticipantFromGuest(guest))
+	}
+
+	return participants
+}
+
+func (g *Group) findGuest(guestID string) (int, error) {
+	for i, guest := range g.Guests {
+		if guest.ID == guestID {
+			return i, nil
+		}
+	}
+
+	return -1, NewResourceNotFoundError("guest not found")
+}
+
+func (g *Group) hasParticipantWithEmail(email, ignoredID string) bool {
+	if email == "" {
+		return false
+	}
+
+	for _, participant := range g.Participants() {
+		if participant.ID != ignoredID && strings.EqualFold(participant.Email, email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *Group) AddGuest(requesterID string, guest Guest) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can add guests")
+	}
+
+	if !g.isAcceptingMembers() {
+		return NewConflictError("group is not open for registration, contact the group owner to reopen the group")
+	}
+
+	if g.hasParticipantWithEmail(guest.Email, guest.ID) {
+		return NewConflictError("a participant with this email is already in the group")
+	}
+
+	if g.IsFull() {
+		return NewConflictError("group has reached its member limit")
+	}
+
+	g.Guests = append(g.Guests, guest)
+	g.UpdatedAt = time.Now()
+
+	return g.Validate()
+}
+
+func (g *Group) UpdateGuest(requesterID, guestID, name, email string) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can update guests")
+	}
+
+	if g.IsArchived() {
+		return NewConflictError("group is archived and cannot be changed")
+	}
+
+	index, err := g.findGuest(guestID)
+	if err != nil {
+		return err
+	}
+
+	if g.hasParticipantWithEmail(email, guestID) {
+		return NewConflictError("a participant with this email is already in the group")
+	}
+
+	now := time.Now()
+
+	g.Guests[index].Name = name
+	g.Guests[index].Email = email
+	g.Guests[index].UpdatedAt = now
+	g.UpdatedAt = now
+
+	return g.Validate()
+}
+
+func (g *Group) RemoveGuest(requesterID, guestID string) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can remove guests")
+	}
+
+	if !g.isBeforeMatching() {
+		return NewConflictError("group is not open for removal, contact the group owner to reopen the group")
+	}
+
+	index, err := g.findGuest(guestID)
+	if err != nil {
+		return err
+	}
+
+	g.Guests = slices.Delete(g.Guests, index, index+1)
+	if g.isAcceptingMembers() {
+		g.promoteFromWaitlist()
+	}
+	g.UpdatedAt = time.Now()
+
+	return g.Validate()
+}
+
+func (g *Group) GetGuestMatch(requesterID, guestID string) (*Participant, error) {
+	if requesterID != g.OwnerID {
+		return nil, NewForbiddenError("only the group owner can view guest matches")
+	}
+
+	if !g.hasDrawnMatches() {
+		return nil, NewConflictError("group is not matched")
+	}
+
+	if _, err := g.findGuest(guestID); err != nil {
+		return nil, err
+	}
+
+	return g.getMatchReceiver(guestID)
+}
+
+// ClaimGuest merges a guest into a registered user, carrying over any match the guest already had.
+func (g *Group) ClaimGuest(guestID string, user User) error {
+	if g.IsArchived() {
+		return NewConflictError("group is archived and cannot be changed")
+	}
+
+	index, err := g.findGuest(guestID)
+	if err != nil {
+		return err
+	}
+
+	if !g.Guests[index].CanBeClaimedBy(user) {
+		if !user.IsEmailVerified() {
+			return NewForbiddenError("verify your email address to claim a guest")
+		}
+		return NewForbiddenError("guest can only be claimed by a user with the same email")
+	}
+
+	return g.replaceGuest(index, user)
+}
+
+// MergeGuest lets the owner replace a guest with a registered user, carrying over any match the guest already had.
+// Unlike ClaimGuest the guest does not need the email of the user, so guests added without an email can be merged.
+func (g *Group) MergeGuest(requesterID, guestID string, user User) error {
+	if requesterID != g.OwnerID {
+		return NewForbiddenError("only the group owner can merge guests")
+	}
+
+	if g.IsArchived() {
+		return NewConflictError("group is archived and cannot be changed")
+	}
+
+	if user.IsDeleted() {
+		return NewResourceNotFoundError("user not found")
+	}
+
+	index, err := g.findGuest(guestID)
+	if err != nil {
+		return err
+	}
+
+	return g.replaceGuest(index, user)
+}
+
+// replaceGuest puts the user in the place of the guest at the index, taking the user off the waitlist and the join
+// requests since they now hold the spot of the guest.
+func (g *Group) replaceGuest(index int, user User) error {
+	if g.IsMember(user.ID) {
+		return NewConflictError("user is already a member of this group")
+	}
+
+	guestID := g.Guests[index].ID
+
+	for i := range g.Matches {
+		if g.Matches[i].GiverID == guestID {
+			g.Matches[i].GiverID = user.ID
+		}
+		if g.Matches[i].ReceiverID == guestID {
+			g.Matches[i].ReceiverID = user.ID
+		}
+	}
+
+	if waitlistIndex := g.waitlistIndex(user.ID); waitlistIndex >= 0 {
+		g.Waitlist = slices.Delete(g.Waitlist, waitlistIndex, waitlistIndex+1)
+	}
+
+	if joinRequestIndex := g.joinRequestIndex(user.ID); joinRequestIndex >= 0 {
+		g.JoinRequests = slices.Delete(g.JoinRequests, joinRequestIndex, joinRequestIndex+1)
+	}
+
+	g.Guests = slices.Delete(g.Guests, index, index+1)
+	g.Users = append(g.Users, user)
+	g.UpdatedAt = time.Now()
+
+	return g.Validate()
+}
+
 const (
 	DefaultGroupLimit         = 15
 	DefaultGroupOffset        = 0
@@ -286,9 +1052,11 @@ type GroupSummary struct {
 	ID          string      `validate:"required,uuid"`
 	Name        string      `validate:"required"`
 	Description string      `validate:"omitempty,max=255"`
-	Status      GroupStatus `validate:"required,oneof=OPEN MATCHED ARCHIVED"`
+	Status      GroupStatus `validate:"required,oneof=DRAFT OPEN REGISTRATION_CLOSED MATCHED COMPLETED ARCHIVED"`
 	OwnerID     string      `validate:"required,uuid"`
 	UserCount   int
+	GuestCount  int
+	MaxMembers  int       `validate:"min=0"`
 	CreatedAt   time.Time `validate:"required"`
 	UpdatedAt   time.Time `validate:"required"`
 }
@@ -302,7 +1070,7 @@ func (g *GroupSummary) Validate() error {
 
 type GroupFilters struct {
 	Name          string
-	Statuses      []GroupStatus     `validate:"omitempty,dive,oneof=OPEN MATCHED ARCHIVED"`
+	Statuses      []GroupStatus     `validate:"omitempty,dive,oneof=DRAFT OPEN REGISTRATION_CLOSED MATCHED COMPLETED ARCHIVED"`
 	OwnerID       string            `validate:"omitempty,uuid"`
 	UserID        string            `validate:"omitempty,uuid"`
 	Limit         int               `validate:"required,min=1"`
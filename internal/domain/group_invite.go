@@ -4,25 +4,112 @@ package domain
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
 )
 
 type GroupInviteRepository interface {
+	// Create stores the invite, failing with a conflict when an invite that was not revoked already has its code.
 	Create(ctx context.Context, groupInvite GroupInvite) error
 	GetByID(ctx context.Context, id string) (*GroupInvite, error)
+	// GetActiveByGroupID returns the newest link invite of the group that is neither revoked, expired nor out of uses.
 	GetActiveByGroupID(ctx context.Context, groupID string) (*GroupInvite, error)
+	GetActiveByCode(ctx context.Context, code string) (*GroupInvite, error)
+	ListByGroupID(ctx context.Context, groupID string) ([]GroupInvite, error)
+	ListPendingPersonalByGroupID(ctx context.Context, groupID string) ([]GroupInvite, error)
+	// ListPendingPersonalByEmail lists the personal invites sent to the email, in any group, that were neither
+	// used nor revoked. The email is matched ignoring case.
+	ListPendingPersonalByEmail(ctx context.Context, email string) ([]GroupInvite, error)
+	Update(ctx context.Context, groupInvite GroupInvite) error
+	Delete(ctx context.Context, id string) error
+	// Rotate revokes the active link invites of the group and stores the given invite in a single transaction,
+	// failing with a conflict like Create.
+	Rotate(ctx context.Context, groupInvite GroupInvite) error
+	// Redeem records that the user joined through the invite, failing with a conflict once the invite
+	// has reached its maximum number of uses.
+	Redeem(ctx context.Context, inviteID, userID string, redeemedAt time.Time) error
+	ListRedemptions(ctx context.Context, inviteID string) ([]GroupInviteRedemption, error)
 }
 
+const (
+	// InviteCodeAlphabet leaves out characters that are easily mistaken for one another (0/O and 1/I).
+	InviteCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	InviteCodeLength   = 8
+)
+
+type GroupInviteState string
+
+const (
+	GroupInviteStateActive    GroupInviteState = "ACTIVE"
+	GroupInviteStateExpired   GroupInviteState = "EXPIRED"
+	GroupInviteStateRevoked   GroupInviteState = "REVOKED"
+	GroupInviteStateUsed      GroupInviteState = "USED"
+	GroupInviteStateExhausted GroupInviteState = "EXHAUSTED"
+)
+
+// GroupInvite grants access to a group. Link invites have no email and can be shared with anyone,
+// while personal invites target a single email address and can only be used once.
 type GroupInvite struct {
-	ID        string    `validate:"required,uuid"`
-	GroupID   string    `validate:"required,uuid"`
-	ExpiresAt time.Time `validate:"required"`
-	CreatedAt time.Time `validate:"required"`
+	ID               string `validate:"required,uuid"`
+	GroupID          string `validate:"required,uuid"`
+	Code             string `validate:"omitempty,len=8"`
+	Email            string `validate:"omitempty,email"`
+	RequiresApproval bool
+	MaxUses          int `validate:"min=0"`
+	Uses             int `validate:"min=0"`
+	UsedAt           *time.Time
+	RevokedAt        *time.Time
+	ExpiresAt        time.Time `validate:"required"`
+	CreatedAt        time.Time `validate:"required"`
+}
+
+// GroupInviteRedemption records a user who joined, or asked to join, a group through an invite.
+type GroupInviteRedemption struct {
+	InviteID   string    `validate:"required,uuid"`
+	User       User      `validate:"required"`
+	RedeemedAt time.Time `validate:"required"`
+}
+
+// GroupInvitePreview is what anyone holding an invite can see about the group before joining it.
+// It deliberately leaves out member emails and IDs.
+type GroupInvitePreview struct {
+	GroupName        string
+	GroupDescription string
+	GroupStatus      GroupStatus
+	OwnerName        string
+	MemberCount      int
+	MaxMembers       int
+	InviteState      GroupInviteState
+	RequiresApproval bool
+	ExpiresAt        time.Time
+}
+
+func NewGroupInvitePreview(groupInvite GroupInvite, group Group) GroupInvitePreview {
+	preview := GroupInvitePreview{
+		GroupName:        group.Name,
+		GroupDescription: group.Description,
+		GroupStatus:      group.Status,
+		MemberCount:      len(group.Users) + len(group.Guests),
+		MaxMembers:       group.MaxMembers,
+		InviteState:      groupInvite.State(),
+		RequiresApproval: groupInvite.RequiresApproval,
+		ExpiresAt:        groupInvite.ExpiresAt,
+	}
+
+	for _, user := range group.Users {
+		if user.ID == group.OwnerID {
+			preview.OwnerName = strings.TrimSpace(user.Name + " " + user.Surname)
+			break
+		}
+	}
+
+	return preview
 }
 
-func NewGroupInvite(identityGenerator IdentityGenerator, groupID string, expiration time.Duration) (*GroupInvite, error) {
+// NewGroupInvite creates an invite link. A maxUses of 0 means the link can be used any number of times.
+func NewGroupInvite(identityGenerator IdentityGenerator, groupID, code string, expiration time.Duration, requiresApproval bool, maxUses int) (*GroupInvite, error) {
 	id, err := identityGenerator.Generate()
 	if err != nil {
 		return nil, err
@@ -31,10 +118,13 @@ func NewGroupInvite(identityGenerator IdentityGenerator, groupID string, expirat
 	now := time.Now()
 
 	groupInvite := &GroupInvite{
-		ID:        id,
-		GroupID:   groupID,
-		ExpiresAt: now.Add(expiration),
-		CreatedAt: now,
+		ID:               id,
+		GroupID:          groupID,
+		Code:             code,
+		RequiresApproval: requiresApproval,
+		MaxUses:          maxUses,
+		ExpiresAt:        now.Add(expiration),
+		CreatedAt:        now,
 	}
 
 	if err := groupInvite.Validate(); err != nil {
@@ -44,6 +134,46 @@ func NewGroupInvite(identityGenerator IdentityGenerator, groupID string, expirat
 	return groupInvite, nil
 }
 
+func NewPersonalGroupInvite(identityGenerator IdentityGenerator, groupID, code, email string, expiration time.Duration) (*GroupInvite, error) {
+	if email == "" {
+		return nil, NewValidationError(validator.ValidationErrors{{Field: "Email", Error: "Email is a required field"}})
+	}
+
+	groupInvite, err := NewGroupInvite(identityGenerator, groupID, code, expiration, false, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	groupInvite.Email = strings.ToLower(email)
+
+	if err := groupInvite.Validate(); err != nil {
+		return nil, err
+	}
+
+	return groupInvite, nil
+}
+
+// NormalizeInviteCode makes codes typed by users comparable with stored ones, ignoring case, spaces and dashes.
+func NormalizeInviteCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+// IsValidInviteCode tells whether a normalized code could have been generated, so codes that cannot exist are
+// turned down without a lookup.
+func IsValidInviteCode(code string) bool {
+	if len(code) != InviteCodeLength {
+		return false
+	}
+
+	for _, char := range code {
+		if !strings.ContainsRune(InviteCodeAlphabet, char) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (i *GroupInvite) Validate() error {
 	if errs := validator.Validate(i); len(errs) > 0 {
 		return NewValidationError(errs)
@@ -55,3 +185,123 @@ func (i *GroupInvite) Validate() error {
 func (i *GroupInvite) IsExpired() bool {
 	return time.Now().After(i.ExpiresAt)
 }
+
+// JoinURL is the frontend address where the invite is accepted, made of the configured base URL and the invite ID.
+func (i *GroupInvite) JoinURL(baseURL string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + i.ID
+}
+
+func (i *GroupInvite) IsPersonal() bool {
+	return i.Email != ""
+}
+
+func (i *GroupInvite) IsUsed() bool {
+	return i.UsedAt != nil
+}
+
+func (i *GroupInvite) IsRevoked() bool {
+	return i.RevokedAt != nil
+}
+
+// IsExhausted reports whether the invite has reached its maximum number of uses.
+func (i *GroupInvite) IsExhausted() bool {
+	return i.MaxUses > 0 && i.Uses >= i.MaxUses
+}
+
+// IsPending reports whether a personal invite is still waiting to be used.
+func (i *GroupInvite) IsPending() bool {
+	return i.IsPersonal() && !i.IsUsed() && !i.IsRevoked()
+}
+
+// State summarises whether the invite can still be used. Revocation and usage take precedence over expiration.
+func (i *GroupInvite) State() GroupInviteState {
+	switch {
+	case i.IsRevoked():
+		return GroupInviteStateRevoked
+	case i.IsUsed():
+		return GroupInviteStateUsed
+	case i.IsExhausted():
+		return GroupInviteStateExhausted
+	case i.IsExpired():
+		return GroupInviteStateExpired
+	default:
+		return GroupInviteStateActive
+	}
+}
+
+// CheckUsable returns an error when the invite was revoked, has expired or has no uses left.
+func (i *GroupInvite) CheckUsable() error {
+	if i.IsRevoked() {
+		return NewConflictError("invite has been revoked")
+	}
+
+	if i.IsExpired() {
+		return NewConflictError("invite has expired")
+	}
+
+	if i.IsExhausted() {
+		return NewConflictError("invite has reached its maximum number of uses")
+	}
+
+	return nil
+}
+
+func (i *GroupInvite) Revoke() error {
+	if i.IsRevoked() {
+		return NewConflictError("invite has already been revoked")
+	}
+
+	now := time.Now()
+	i.RevokedAt = &now
+
+	return nil
+}
+
+// CanBeUsedBy checks that a personal invite has not been used yet and was sent to the user's email, which the user
+// must have verified. Link invites can be used by anyone.
+func (i *GroupInvite) CanBeUsedBy(user User) error {
+	if !i.IsPersonal() {
+		return nil
+	}
+
+	if i.IsUsed() {
+		return NewConflictError("invite has already been used")
+	}
+
+	if !strings.EqualFold(i.Email, user.Email) {
+		return NewForbiddenError("invite was sent to a different email address")
+	}
+
+	if !user.IsEmailVerified() {
+		return NewForbiddenError("verify your email address to use this invite")
+	}
+
+	return nil
+}
+
+func (i *GroupInvite) MarkAsUsed() {
+	if !i.IsPersonal() {
+		return
+	}
+
+	now := time.Now()
+	i.UsedAt = &now
+}
+
+// Resend extends the expiration of a pending personal invite so it can be delivered again.
+func (i *GroupInvite) Resend(expiration time.Duration) error {
+	if !i.IsPending() {
+		return NewConflictError("only pending personal invites can be resent")
+	}
+
+	i.ExpiresAt = time.Now().Add(expiration)
+
+	return nil
+}
+
+func (i *GroupInvite) CanCancel() error {
+	if !i.IsPending() {
+		return NewConflictError("only pending personal invites can be cancelled")
+	}
+	return nil
+}
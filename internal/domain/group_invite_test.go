@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
 	"go.uber.org/mock/gomock"
 )
@@ -24,16 +25,78 @@ func Test_NewGroupInvite(t *testing.T) {
 		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
 
 		// when
-		groupInvite, err := domain.NewGroupInvite(mockedIdentityGenerator, groupID, expiration)
+		groupInvite, err := domain.NewGroupInvite(mockedIdentityGenerator, groupID, "ABCD2345", expiration, false, 0)
 
 		// then
 		assert.NoError(t, err)
 		assert.Equal(t, generatedID, groupInvite.ID)
 		assert.Equal(t, groupID, groupInvite.GroupID)
+		assert.Equal(t, "ABCD2345", groupInvite.Code)
 		assert.WithinDuration(t, now.Add(expiration), groupInvite.ExpiresAt, time.Second)
 		assert.WithinDuration(t, now, groupInvite.CreatedAt, time.Second)
 	})
 
+	t.Run("should create a group invite that requires approval", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		groupInvite, err := domain.NewGroupInvite(mockedIdentityGenerator, groupID, "ABCD2345", time.Hour, true, 0)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, groupInvite.RequiresApproval)
+	})
+
+	t.Run("should create a group invite limited to a number of uses", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		groupInvite, err := domain.NewGroupInvite(mockedIdentityGenerator, uuid.New().String(), "ABCD2345", time.Hour, false, 5)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 5, groupInvite.MaxUses)
+		assert.Equal(t, 0, groupInvite.Uses)
+	})
+
+	t.Run("should return validation error when max uses is negative", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		groupInvite, err := domain.NewGroupInvite(mockedIdentityGenerator, uuid.New().String(), "ABCD2345", time.Hour, false, -1)
+
+		// then
+		assert.Nil(t, groupInvite)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should return validation error when the code has the wrong length", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		groupInvite, err := domain.NewGroupInvite(mockedIdentityGenerator, uuid.New().String(), "ABC", time.Hour, false, 0)
+
+		// then
+		assert.Nil(t, groupInvite)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
 	t.Run("should return error when identity generator fails", func(t *testing.T) {
 		// given
 		groupID := uuid.New().String()
@@ -44,7 +107,7 @@ func Test_NewGroupInvite(t *testing.T) {
 		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
 
 		// when
-		groupInvite, err := domain.NewGroupInvite(mockedIdentityGenerator, groupID, expiration)
+		groupInvite, err := domain.NewGroupInvite(mockedIdentityGenerator, groupID, "ABCD2345", expiration, false, 0)
 
 		// then
 		assert.Error(t, err)
@@ -86,3 +149,390 @@ func Test_GroupInvite_IsExpired(t *testing.T) {
 		assert.True(t, result)
 	})
 }
+
+func Test_NewPersonalGroupInvite(t *testing.T) {
+	t.Run("should create a personal invite with a normalized email", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		// when
+		groupInvite, err := domain.NewPersonalGroupInvite(mockedIdentityGenerator, groupID, "ABCD2345", "Friend@Example.com", time.Hour)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, generatedID, groupInvite.ID)
+		assert.Equal(t, "friend@example.com", groupInvite.Email)
+		assert.True(t, groupInvite.IsPersonal())
+		assert.True(t, groupInvite.IsPending())
+	})
+
+	t.Run("should return validation error when email is empty", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+
+		// when
+		groupInvite, err := domain.NewPersonalGroupInvite(mockedIdentityGenerator, uuid.New().String(), "ABCD2345", "", time.Hour)
+
+		// then
+		assert.Nil(t, groupInvite)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("should return validation error when email is invalid", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		groupInvite, err := domain.NewPersonalGroupInvite(mockedIdentityGenerator, uuid.New().String(), "ABCD2345", "not-an-email", time.Hour)
+
+		// then
+		assert.Nil(t, groupInvite)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_GroupInvite_CanBeUsedBy(t *testing.T) {
+	t.Run("should allow anyone to use a link invite", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+
+		// when
+		err := groupInvite.CanBeUsedBy(user)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should allow the invited user to use a personal invite", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").Build()
+		user := build_domain.NewUserBuilder().WithEmail("Friend@example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+
+		// when
+		err := groupInvite.CanBeUsedBy(user)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return forbidden error when the invited user has not verified their email", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").Build()
+		user := build_domain.NewUserBuilder().WithEmail("friend@example.com").Build()
+
+		// when
+		err := groupInvite.CanBeUsedBy(user)
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "verify your email address to use this invite")
+	})
+
+	t.Run("should return forbidden error when the email does not match", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").Build()
+		user := build_domain.NewUserBuilder().WithEmail("stranger@example.com").Build()
+
+		// when
+		err := groupInvite.CanBeUsedBy(user)
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "invite was sent to a different email address")
+	})
+
+	t.Run("should return conflict error when the personal invite was already used", func(t *testing.T) {
+		// given
+		usedAt := time.Now()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").WithUsedAt(&usedAt).Build()
+		user := build_domain.NewUserBuilder().WithEmail("friend@example.com").Build()
+
+		// when
+		err := groupInvite.CanBeUsedBy(user)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "invite has already been used")
+	})
+}
+
+func Test_GroupInvite_MarkAsUsed(t *testing.T) {
+	t.Run("should mark a personal invite as used", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").Build()
+
+		// when
+		groupInvite.MarkAsUsed()
+
+		// then
+		assert.True(t, groupInvite.IsUsed())
+		assert.False(t, groupInvite.IsPending())
+	})
+
+	t.Run("should keep link invites reusable", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		// when
+		groupInvite.MarkAsUsed()
+
+		// then
+		assert.False(t, groupInvite.IsUsed())
+	})
+}
+
+func Test_GroupInvite_Resend(t *testing.T) {
+	t.Run("should extend the expiration of a pending personal invite", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").WithExpiresAt(time.Now().Add(-time.Hour)).Build()
+
+		// when
+		err := groupInvite.Resend(24 * time.Hour)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, groupInvite.IsExpired())
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), groupInvite.ExpiresAt, time.Second)
+	})
+
+	t.Run("should return conflict error for a link invite", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		// when
+		err := groupInvite.Resend(time.Hour)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "only pending personal invites can be resent")
+	})
+}
+
+func Test_GroupInvite_CanCancel(t *testing.T) {
+	t.Run("should allow cancelling a pending personal invite", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").Build()
+
+		// when
+		err := groupInvite.CanCancel()
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when the invite was already used", func(t *testing.T) {
+		// given
+		usedAt := time.Now()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").WithUsedAt(&usedAt).Build()
+
+		// when
+		err := groupInvite.CanCancel()
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "only pending personal invites can be cancelled")
+	})
+}
+
+func Test_GroupInvite_State(t *testing.T) {
+	usedAt := time.Now()
+	revokedAt := time.Now()
+
+	testCases := []struct {
+		name        string
+		groupInvite domain.GroupInvite
+		expected    domain.GroupInviteState
+	}{
+		{"active link invite", build_domain.NewGroupInviteBuilder().Build(), domain.GroupInviteStateActive},
+		{"expired invite", build_domain.NewGroupInviteBuilder().WithExpiresAt(time.Now().Add(-time.Hour)).Build(), domain.GroupInviteStateExpired},
+		{"used personal invite", build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").WithUsedAt(&usedAt).Build(), domain.GroupInviteStateUsed},
+		{"exhausted invite", build_domain.NewGroupInviteBuilder().WithMaxUses(2).WithUses(2).Build(), domain.GroupInviteStateExhausted},
+		{"invite with uses left", build_domain.NewGroupInviteBuilder().WithMaxUses(2).WithUses(1).Build(), domain.GroupInviteStateActive},
+		{"revoked expired invite", build_domain.NewGroupInviteBuilder().WithExpiresAt(time.Now().Add(-time.Hour)).WithRevokedAt(&revokedAt).Build(), domain.GroupInviteStateRevoked},
+	}
+
+	for _, testCase := range testCases {
+		t.Run("should return the state of a "+testCase.name, func(t *testing.T) {
+			// when
+			result := testCase.groupInvite.State()
+
+			// then
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func Test_GroupInvite_CheckUsable(t *testing.T) {
+	t.Run("should return nil for an active invite", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		// when
+		err := groupInvite.CheckUsable()
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error for a revoked invite", func(t *testing.T) {
+		// given
+		revokedAt := time.Now()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithRevokedAt(&revokedAt).Build()
+
+		// when
+		err := groupInvite.CheckUsable()
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "invite has been revoked")
+	})
+
+	t.Run("should return conflict error when the invite has no uses left", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().WithMaxUses(3).WithUses(3).Build()
+
+		// when
+		err := groupInvite.CheckUsable()
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "invite has reached its maximum number of uses")
+	})
+
+	t.Run("should return conflict error for an expired invite", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().WithExpiresAt(time.Now().Add(-time.Hour)).Build()
+
+		// when
+		err := groupInvite.CheckUsable()
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "invite has expired")
+	})
+}
+
+func Test_GroupInvite_Revoke(t *testing.T) {
+	t.Run("should revoke the invite", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").Build()
+
+		// when
+		err := groupInvite.Revoke()
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, groupInvite.IsRevoked())
+		assert.False(t, groupInvite.IsPending())
+	})
+
+	t.Run("should return conflict error when the invite is already revoked", func(t *testing.T) {
+		// given
+		revokedAt := time.Now()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithRevokedAt(&revokedAt).Build()
+
+		// when
+		err := groupInvite.Revoke()
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "invite has already been revoked")
+	})
+}
+
+func Test_NormalizeInviteCode(t *testing.T) {
+	t.Run("should ignore case, spaces and dashes", func(t *testing.T) {
+		// when
+		result := domain.NormalizeInviteCode(" abcd-2345 ")
+
+		// then
+		assert.Equal(t, "ABCD2345", result)
+	})
+}
+
+func Test_IsValidInviteCode(t *testing.T) {
+	t.Run("should accept a code made of the alphabet with the expected length", func(t *testing.T) {
+		// when
+		result := domain.IsValidInviteCode("ABCD2345")
+
+		// then
+		assert.True(t, result)
+	})
+
+	t.Run("should reject codes that could not have been generated", func(t *testing.T) {
+		for _, code := range []string{"", "ABCD234", "ABCD23456", "ABCD0123", "ABCDI234", "ABCD%345"} {
+			// when
+			result := domain.IsValidInviteCode(code)
+
+			// then
+			assert.False(t, result, code)
+		}
+	})
+}
+
+func Test_GroupInvite_JoinURL(t *testing.T) {
+	t.Run("should append the invite ID to the base URL", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		// when
+		result := groupInvite.JoinURL("https://mystery-gifter.app/invites/")
+
+		// then
+		assert.Equal(t, "https://mystery-gifter.app/invites/"+groupInvite.ID, result)
+	})
+}
+
+func Test_NewGroupInvitePreview(t *testing.T) {
+	t.Run("should summarise the group without exposing its members", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().WithName("Maria").WithSurname("Silva").Build()
+		member := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithName("Amigo Secreto").
+			WithDescription("Troca de fim de ano").
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, member}).
+			WithGuests([]domain.Guest{guest}).
+			WithMaxMembers(10).
+			WithStatus(domain.GroupStatusOpen).
+			Build()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).WithRequiresApproval(true).Build()
+
+		// when
+		preview := domain.NewGroupInvitePreview(groupInvite, group)
+
+		// then
+		assert.Equal(t, "Amigo Secreto", preview.GroupName)
+		assert.Equal(t, "Troca de fim de ano", preview.GroupDescription)
+		assert.Equal(t, domain.GroupStatusOpen, preview.GroupStatus)
+		assert.Equal(t, "Maria Silva", preview.OwnerName)
+		assert.Equal(t, 3, preview.MemberCount)
+		assert.Equal(t, 10, preview.MaxMembers)
+		assert.Equal(t, domain.GroupInviteStateActive, preview.InviteState)
+		assert.True(t, preview.RequiresApproval)
+		assert.Equal(t, groupInvite.ExpiresAt, preview.ExpiresAt)
+	})
+}
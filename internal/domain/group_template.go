@@ -0,0 +1,173 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/group_template_repository.go . GroupTemplateRepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+type GroupTemplateRepository interface {
+	Create(ctx context.Context, groupTemplate GroupTemplate) error
+	GetByID(ctx context.Context, id string) (*GroupTemplate, error)
+	ListByOwnerID(ctx context.Context, ownerID string) ([]GroupTemplate, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// GroupTemplate holds preset settings for a common exchange format. Built-in templates have no owner
+// and are available to everyone; user-defined templates are only visible to the user who created them.
+// Templates without a match strategy leave the default one in place.
+type GroupTemplate struct {
+	ID             string        `validate:"required"`
+	Name           string        `validate:"required,max=255"`
+	Description    string        `validate:"omitempty,max=255"`
+	MaxMembers     int           `validate:"min=0"`
+	Budget         int           `validate:"min=0"`
+	Rules          string        `validate:"omitempty,max=1000"`
+	ExchangeInDays int           `validate:"min=0"`
+	MatchStrategy  MatchStrategy `validate:"omitempty,oneof=CYCLE RANDOM"`
+	OwnerID        string        `validate:"omitempty,uuid"`
+	CreatedAt      time.Time     `validate:"required"`
+	UpdatedAt      time.Time     `validate:"required"`
+}
+
+var builtInTemplatesCreatedAt = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// BuiltInGroupTemplates are the templates shipped with the application, identified by stable slugs.
+var BuiltInGroupTemplates = []GroupTemplate{
+	{
+		ID:             "office-secret-santa",
+		Name:           "Office Secret Santa",
+		Description:    "A light-hearted gift exchange among coworkers",
+		Budget:         2500,
+		Rules:          "Keep gifts work-appropriate and within the budget. Gifts are exchanged at the office party.",
+		ExchangeInDays: 21,
+		MatchStrategy:  MatchStrategyRandom,
+		CreatedAt:      builtInTemplatesCreatedAt,
+		UpdatedAt:      builtInTemplatesCreatedAt,
+	},
+	{
+		ID:             "family-exchange",
+		Name:           "Family exchange",
+		Description:    "A family gift exchange where everyone gives one thoughtful gift",
+		Budget:         5000,
+		Rules:          "Each participant gives one gift to their match. Share a wishlist to help your giver.",
+		ExchangeInDays: 30,
+		MatchStrategy:  MatchStrategyCycle,
+		CreatedAt:      builtInTemplatesCreatedAt,
+		UpdatedAt:      builtInTemplatesCreatedAt,
+	},
+	{
+		ID:             "white-elephant",
+		Name:           "White elephant",
+		Description:    "A playful exchange of funny or unexpected gifts",
+		Budget:         2000,
+		Rules:          "Bring a wrapped gift within the budget. The sillier, the better.",
+		ExchangeInDays: 14,
+		MatchStrategy:  MatchStrategyRandom,
+		CreatedAt:      builtInTemplatesCreatedAt,
+		UpdatedAt:      builtInTemplatesCreatedAt,
+	},
+}
+
+// FindBuiltInGroupTemplate returns the built-in template with the given ID, if there is one.
+func FindBuiltInGroupTemplate(id string) (*GroupTemplate, bool) {
+	for _, groupTemplate := range BuiltInGroupTemplates {
+		if groupTemplate.ID == id {
+			return &groupTemplate, true
+		}
+	}
+	return nil, false
+}
+
+func NewGroupTemplate(identityGenerator IdentityGenerator, ownerID, name, description string, maxMembers, budget int, rules string, exchangeInDays int, matchStrategy MatchStrategy) (*GroupTemplate, error) {
+	id, err := identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	groupTemplate := GroupTemplate{
+		ID:             id,
+		Name:           name,
+		Description:    description,
+		MaxMembers:     maxMembers,
+		Budget:         budget,
+		Rules:          rules,
+		ExchangeInDays: exchangeInDays,
+		MatchStrategy:  matchStrategy,
+		OwnerID:        ownerID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	if err := groupTemplate.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &groupTemplate, nil
+}
+
+func (t *GroupTemplate) Validate() error {
+	if errs := validator.Validate(t); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+func (t *GroupTemplate) IsBuiltIn() bool {
+	return t.OwnerID == ""
+}
+
+func (t *GroupTemplate) CanUse(requesterID string) error {
+	if !t.IsBuiltIn() && t.OwnerID != requesterID {
+		return NewForbiddenError("template belongs to another user")
+	}
+	return nil
+}
+
+func (t *GroupTemplate) CanDelete(requesterID string) error {
+	if t.IsBuiltIn() {
+		return NewForbiddenError("built-in templates cannot be deleted")
+	}
+
+	if t.OwnerID != requesterID {
+		return NewForbiddenError("only the template owner can delete the template")
+	}
+
+	return nil
+}
+
+// Apply pre-fills the description and the settings that were not given (nil) with the template values. Values given
+// by the caller always take precedence over the template, even zero or empty ones.
+func (t *GroupTemplate) Apply(description *string, settings GroupSettings, now time.Time) (string, GroupSettings) {
+	if description == nil {
+		description = &t.Description
+	}
+
+	if settings.MaxMembers == nil {
+		settings.MaxMembers = &t.MaxMembers
+	}
+
+	if settings.Budget == nil {
+		settings.Budget = &t.Budget
+	}
+
+	if settings.Rules == nil {
+		settings.Rules = &t.Rules
+	}
+
+	if settings.ExchangeDate == nil && t.ExchangeInDays > 0 {
+		exchangeDate := now.AddDate(0, 0, t.ExchangeInDays)
+		settings.ExchangeDate = &exchangeDate
+	}
+
+	if settings.MatchStrategy == nil && t.MatchStrategy != "" {
+		settings.MatchStrategy = &t.MatchStrategy
+	}
+
+	return *description, settings
+}
@@ -0,0 +1,231 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_NewGroupTemplate(t *testing.T) {
+	t.Run("should create a new group template successfully", func(t *testing.T) {
+		// given
+		generatedID := uuid.New().String()
+		ownerID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		// when
+		groupTemplate, err := domain.NewGroupTemplate(mockedIdentityGenerator, ownerID, "Book club", "Books only", 12, 3000, "Gift a book", 10, domain.MatchStrategyRandom)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, generatedID, groupTemplate.ID)
+		assert.Equal(t, ownerID, groupTemplate.OwnerID)
+		assert.Equal(t, "Book club", groupTemplate.Name)
+		assert.Equal(t, 12, groupTemplate.MaxMembers)
+		assert.Equal(t, 3000, groupTemplate.Budget)
+		assert.Equal(t, "Gift a book", groupTemplate.Rules)
+		assert.Equal(t, 10, groupTemplate.ExchangeInDays)
+		assert.Equal(t, domain.MatchStrategyRandom, groupTemplate.MatchStrategy)
+		assert.False(t, groupTemplate.IsBuiltIn())
+	})
+
+	t.Run("should return error when identity generator fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
+
+		// when
+		groupTemplate, err := domain.NewGroupTemplate(mockedIdentityGenerator, uuid.New().String(), "Book club", "", 0, 0, "", 0, "")
+
+		// then
+		assert.Nil(t, groupTemplate)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return validation error when budget is negative", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		groupTemplate, err := domain.NewGroupTemplate(mockedIdentityGenerator, uuid.New().String(), "Book club", "", 0, -1, "", 0, "")
+
+		// then
+		assert.Nil(t, groupTemplate)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Budget", Error: "Budget must be 0 or greater"})
+	})
+}
+
+func Test_FindBuiltInGroupTemplate(t *testing.T) {
+	t.Run("should find a built-in template by its slug", func(t *testing.T) {
+		// when
+		groupTemplate, found := domain.FindBuiltInGroupTemplate("white-elephant")
+
+		// then
+		assert.True(t, found)
+		assert.Equal(t, "White elephant", groupTemplate.Name)
+		assert.True(t, groupTemplate.IsBuiltIn())
+	})
+
+	t.Run("should not find unknown templates", func(t *testing.T) {
+		// when
+		groupTemplate, found := domain.FindBuiltInGroupTemplate(uuid.New().String())
+
+		// then
+		assert.False(t, found)
+		assert.Nil(t, groupTemplate)
+	})
+}
+
+func Test_GroupTemplate_CanUse(t *testing.T) {
+	t.Run("should allow anyone to use a built-in template", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().WithOwnerID("").Build()
+
+		// when
+		err := groupTemplate.CanUse(uuid.New().String())
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return forbidden error when template belongs to another user", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		// when
+		err := groupTemplate.CanUse(uuid.New().String())
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "template belongs to another user")
+	})
+}
+
+func Test_GroupTemplate_CanDelete(t *testing.T) {
+	t.Run("should allow the owner to delete the template", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		// when
+		err := groupTemplate.CanDelete(groupTemplate.OwnerID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return forbidden error for built-in templates", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().WithOwnerID("").Build()
+
+		// when
+		err := groupTemplate.CanDelete(uuid.New().String())
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "built-in templates cannot be deleted")
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		// when
+		err := groupTemplate.CanDelete(uuid.New().String())
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the template owner can delete the template")
+	})
+}
+
+func Test_GroupTemplate_Apply(t *testing.T) {
+	t.Run("should pre-fill every setting that was not given", func(t *testing.T) {
+		// given
+		now := time.Now()
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		// when
+		description, settings := groupTemplate.Apply(nil, domain.GroupSettings{}, now)
+
+		// then
+		assert.Equal(t, groupTemplate.Description, description)
+		assert.Equal(t, groupTemplate.MaxMembers, *settings.MaxMembers)
+		assert.Equal(t, groupTemplate.Budget, *settings.Budget)
+		assert.Equal(t, groupTemplate.Rules, *settings.Rules)
+		assert.Equal(t, now.AddDate(0, 0, groupTemplate.ExchangeInDays), *settings.ExchangeDate)
+		assert.Equal(t, groupTemplate.MatchStrategy, *settings.MatchStrategy)
+	})
+
+	t.Run("should keep values given explicitly", func(t *testing.T) {
+		// given
+		exchangeDate := time.Now().Add(48 * time.Hour)
+		ownDescription := "Our own description"
+		maxMembers := 4
+		budget := 1000
+		rules := "Handmade gifts only"
+		matchStrategy := domain.MatchStrategyCycle
+		groupTemplate := build_domain.NewGroupTemplateBuilder().WithMatchStrategy(domain.MatchStrategyRandom).Build()
+		explicitSettings := domain.GroupSettings{
+			MaxMembers:    &maxMembers,
+			Budget:        &budget,
+			Rules:         &rules,
+			ExchangeDate:  &exchangeDate,
+			MatchStrategy: &matchStrategy,
+		}
+
+		// when
+		description, settings := groupTemplate.Apply(&ownDescription, explicitSettings, time.Now())
+
+		// then
+		assert.Equal(t, "Our own description", description)
+		assert.Equal(t, explicitSettings, settings)
+	})
+
+	t.Run("should keep zero and empty values given explicitly", func(t *testing.T) {
+		// given
+		emptyDescription := ""
+		noLimit := 0
+		noBudget := 0
+		noRules := ""
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		// when
+		description, settings := groupTemplate.Apply(&emptyDescription, domain.GroupSettings{MaxMembers: &noLimit, Budget: &noBudget, Rules: &noRules}, time.Now())
+
+		// then
+		assert.Empty(t, description)
+		assert.Equal(t, 0, *settings.MaxMembers)
+		assert.Equal(t, 0, *settings.Budget)
+		assert.Empty(t, *settings.Rules)
+	})
+
+	t.Run("should leave the exchange date and the strategy empty when the template has none", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().WithExchangeInDays(0).WithMatchStrategy("").Build()
+
+		// when
+		_, settings := groupTemplate.Apply(nil, domain.GroupSettings{}, time.Now())
+
+		// then
+		assert.Nil(t, settings.ExchangeDate)
+		assert.Nil(t, settings.MatchStrategy)
+	})
+}
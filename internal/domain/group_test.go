@@ -1,6 +1,8 @@
 package domain_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -27,7 +29,7 @@ func Test_NewGroup(t *testing.T) {
 		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
 
 		// when
-		group, err := domain.NewGroup(mockedIdentityGenerator, name, description, owner)
+		group, err := domain.NewGroup(mockedIdentityGenerator, name, description, domain.GroupSettings{}, false, owner)
 
 		// then
 		assert.NoError(t, err)
@@ -52,7 +54,7 @@ func Test_NewGroup(t *testing.T) {
 		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
 
 		// when
-		group, err := domain.NewGroup(mockedIdentityGenerator, name, description, owner)
+		group, err := domain.NewGroup(mockedIdentityGenerator, name, description, domain.GroupSettings{}, false, owner)
 
 		// then
 		assert.Error(t, err)
@@ -71,7 +73,7 @@ func Test_NewGroup(t *testing.T) {
 		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
 
 		// when
-		group, err := domain.NewGroup(mockedIdentityGenerator, name, description, owner)
+		group, err := domain.NewGroup(mockedIdentityGenerator, name, description, domain.GroupSettings{}, false, owner)
 
 		// then
 		assert.Nil(t, group)
@@ -96,7 +98,7 @@ func Test_NewGroup(t *testing.T) {
 		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
 
 		// when
-		group, err := domain.NewGroup(mockedIdentityGenerator, name, description, owner)
+		group, err := domain.NewGroup(mockedIdentityGenerator, name, description, domain.GroupSettings{}, false, owner)
 
 		// then
 		assert.NoError(t, err)
@@ -107,6 +109,70 @@ func Test_NewGroup(t *testing.T) {
 		assert.Equal(t, domain.GroupStatusOpen, group.Status)
 		assert.WithinDuration(t, now, group.CreatedAt, time.Second)
 	})
+
+	t.Run("should create a group with the given settings", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		exchangeDate := time.Now().AddDate(0, 0, 7)
+		maxMembers := 8
+		budget := 2500
+		rules := "No gift cards"
+		matchStrategy := domain.MatchStrategyRandom
+		settings := domain.GroupSettings{
+			MaxMembers:    &maxMembers,
+			Budget:        &budget,
+			Rules:         &rules,
+			ExchangeDate:  &exchangeDate,
+			MatchStrategy: &matchStrategy,
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		group, err := domain.NewGroup(mockedIdentityGenerator, "Test Group", "", settings, false, owner)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 8, group.MaxMembers)
+		assert.Equal(t, 2500, group.Budget)
+		assert.Equal(t, "No gift cards", group.Rules)
+		assert.Equal(t, &exchangeDate, group.ExchangeDate)
+		assert.Equal(t, domain.MatchStrategyRandom, group.MatchStrategy)
+	})
+
+	t.Run("should use the cycle strategy when none is given", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		group, err := domain.NewGroup(mockedIdentityGenerator, "Test Group", "", domain.GroupSettings{}, false, owner)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.MatchStrategyCycle, group.MatchStrategy)
+	})
+
+	t.Run("should create a draft group when requested", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		group, err := domain.NewGroup(mockedIdentityGenerator, "Test Group", "", domain.GroupSettings{}, true, owner)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusDraft, group.Status)
+	})
 }
 
 func Test_Group_IsMember(t *testing.T) {
@@ -211,6 +277,48 @@ func Test_Group_CanCreateInvite(t *testing.T) {
 	})
 }
 
+func Test_Group_CanInviteEmail(t *testing.T) {
+	t.Run("should return nil when the email does not belong to a participant", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+
+		// when
+		err := group.CanInviteEmail(owner.ID, "someone.else@example.com")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when a participant already uses the email", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		member := build_domain.NewUserBuilder().WithEmail("member@example.com").Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, member}).WithStatus(domain.GroupStatusOpen).Build()
+
+		// when
+		err := group.CanInviteEmail(owner.ID, "MEMBER@example.com")
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "a participant with this email is already in the group")
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithStatus(domain.GroupStatusOpen).Build()
+
+		// when
+		err := group.CanInviteEmail(uuid.New().String(), "someone@example.com")
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+	})
+}
+
 func Test_Group_AddUser(t *testing.T) {
 	t.Run("should add user successfully when requester is owner", func(t *testing.T) {
 		// given
@@ -228,6 +336,22 @@ func Test_Group_AddUser(t *testing.T) {
 		assert.NotEqual(t, originalUpdateTime, group.UpdatedAt)
 	})
 
+	t.Run("should return not found error when the target user deleted their account", func(t *testing.T) {
+		// given
+		deletedAt := time.Now()
+		owner := build_domain.NewUserBuilder().Build()
+		targetUser := build_domain.NewUserBuilder().WithDeletedAt(&deletedAt).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).Build()
+
+		// when
+		err := group.AddUser(owner.ID, targetUser)
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.NotContains(t, group.Users, targetUser)
+	})
+
 	t.Run("should return forbidden error when requester is the target user but not the owner", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
@@ -298,6 +422,85 @@ func Test_Group_AddUser(t *testing.T) {
 		assert.EqualError(t, conflictErr, "group is not open for registration, contact the group owner to reopen the group")
 		assert.NotContains(t, group.Users, targetUser)
 	})
+
+	t.Run("should place user on the waitlist when the group is full", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		targetUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithMaxMembers(1).
+			Build()
+
+		// when
+		err := group.AddUser(owner.ID, targetUser)
+
+		// then
+		assert.NoError(t, err)
+		assert.NotContains(t, group.Users, targetUser)
+		assert.Equal(t, []domain.User{targetUser}, group.Waitlist)
+		assert.True(t, group.IsWaitlisted(targetUser.ID))
+	})
+
+	t.Run("should count guests towards the member limit", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		targetUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithGuests([]domain.Guest{build_domain.NewGuestBuilder().Build()}).
+			WithMaxMembers(2).
+			Build()
+
+		// when
+		err := group.AddUser(owner.ID, targetUser)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, group.IsWaitlisted(targetUser.ID))
+		assert.False(t, group.IsMember(targetUser.ID))
+	})
+
+	t.Run("should not add a user who is already on the waitlist", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		targetUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithWaitlist([]domain.User{targetUser}).
+			WithMaxMembers(1).
+			Build()
+
+		// when
+		err := group.AddUser(owner.ID, targetUser)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, group.Waitlist, 1)
+		assert.Len(t, group.Users, 1)
+	})
+
+	t.Run("should clear a pending join request when the owner adds the user directly", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		targetUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithJoinRequests([]domain.JoinRequest{{User: targetUser, CreatedAt: time.Now()}}).
+			Build()
+
+		// when
+		err := group.AddUser(owner.ID, targetUser)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, group.IsMember(targetUser.ID))
+		assert.Empty(t, group.JoinRequests)
+	})
 }
 
 func Test_Group_RemoveUser(t *testing.T) {
@@ -416,387 +619,1733 @@ func Test_Group_RemoveUser(t *testing.T) {
 		assert.EqualError(t, conflictErr, "group is not open for removal, contact the group owner to reopen the group")
 		assert.Contains(t, group.Users, targetUser)
 	})
-}
 
-func Test_Group_Reopen(t *testing.T) {
-	t.Run("should reopen a matched group successfully", func(t *testing.T) {
+	t.Run("should promote the first waitlisted user when a member leaves", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
+		member := build_domain.NewUserBuilder().Build()
+		firstWaitlisted := build_domain.NewUserBuilder().Build()
+		secondWaitlisted := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1}).
-			WithStatus(domain.GroupStatusMatched).
-			WithMatches([]domain.Match{{GiverID: owner.ID, ReceiverID: user1.ID}}).Build()
-		originalUpdatedAt := group.UpdatedAt
+			WithUsers([]domain.User{owner, member}).
+			WithWaitlist([]domain.User{firstWaitlisted, secondWaitlisted}).
+			WithMaxMembers(2).
+			Build()
 
 		// when
-		err := group.Reopen(owner.ID)
+		err := group.RemoveUser(member.ID, member.ID)
 
 		// then
 		assert.NoError(t, err)
-		assert.Equal(t, domain.GroupStatusOpen, group.Status)
-		assert.Empty(t, group.Matches)
-		assert.NotEqual(t, originalUpdatedAt, group.UpdatedAt)
+		assert.Equal(t, []domain.User{owner, firstWaitlisted}, group.Users)
+		assert.Equal(t, []domain.User{secondWaitlisted}, group.Waitlist)
 	})
 
-	t.Run("should return forbidden error when requester is not owner", func(t *testing.T) {
+	t.Run("should keep the waitlist while registration is closed and promote it once the group reopens", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
+		member := build_domain.NewUserBuilder().Build()
+		waitlisted := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1}).
-			WithStatus(domain.GroupStatusMatched).
-			WithMatches([]domain.Match{{GiverID: owner.ID, ReceiverID: user1.ID}}).Build()
-		requesterID := uuid.New().String()
-		originalUpdatedAt := group.UpdatedAt
+			WithUsers([]domain.User{owner, member}).
+			WithWaitlist([]domain.User{waitlisted}).
+			WithMaxMembers(2).
+			WithStatus(domain.GroupStatusRegistrationClosed).
+			Build()
 
 		// when
-		err := group.Reopen(requesterID)
+		removeErr := group.RemoveUser(member.ID, member.ID)
+		usersWhileClosed := slices.Clone(group.Users)
+		reopenErr := group.Reopen(owner.ID)
 
 		// then
-		assert.Error(t, err)
-		var forbiddenErr *domain.ForbiddenError
-		assert.ErrorAs(t, err, &forbiddenErr)
-		assert.EqualError(t, forbiddenErr, "only the group owner can reopen the group")
-		assert.Equal(t, domain.GroupStatusMatched, group.Status)
-		assert.NotEmpty(t, group.Matches)
-		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
+		assert.NoError(t, removeErr)
+		assert.NoError(t, reopenErr)
+		assert.Equal(t, []domain.User{owner}, usersWhileClosed)
+		assert.Equal(t, []domain.User{owner, waitlisted}, group.Users)
+		assert.Empty(t, group.Waitlist)
 	})
 
-	t.Run("should return conflict error when group is already open", func(t *testing.T) {
+	t.Run("should remove a user from the waitlist without promoting anyone", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
+		firstWaitlisted := build_domain.NewUserBuilder().Build()
+		secondWaitlisted := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1}).
-			WithStatus(domain.GroupStatusOpen).Build()
-		originalUpdatedAt := group.UpdatedAt
+			WithUsers([]domain.User{owner}).
+			WithWaitlist([]domain.User{firstWaitlisted, secondWaitlisted}).
+			WithMaxMembers(1).
+			Build()
 
 		// when
-		err := group.Reopen(owner.ID)
+		err := group.RemoveUser(owner.ID, firstWaitlisted.ID)
 
 		// then
-		assert.Error(t, err)
-		var conflictErr *domain.ConflictError
-		assert.ErrorAs(t, err, &conflictErr)
-		assert.EqualError(t, conflictErr, "group is already open")
-		assert.Equal(t, domain.GroupStatusOpen, group.Status)
-		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.User{owner}, group.Users)
+		assert.Equal(t, []domain.User{secondWaitlisted}, group.Waitlist)
 	})
+}
 
-	t.Run("should return conflict error when group is archived", func(t *testing.T) {
+func Test_Group_RemoveDeletedUser(t *testing.T) {
+	t.Run("should remove a member from an open group and promote the waitlist", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
+		member := build_domain.NewUserBuilder().Build()
+		waitlisted := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1}).
-			WithStatus(domain.GroupStatusArchived).Build()
-		originalUpdatedAt := group.UpdatedAt
+			WithUsers([]domain.User{owner, member}).
+			WithWaitlist([]domain.User{waitlisted}).
+			WithMaxMembers(2).
+			WithStatus(domain.GroupStatusOpen).Build()
 
 		// when
-		err := group.Reopen(owner.ID)
+		err := group.RemoveDeletedUser(member.ID)
 
 		// then
-		assert.Error(t, err)
-		var conflictErr *domain.ConflictError
-		assert.ErrorAs(t, err, &conflictErr)
-		assert.EqualError(t, conflictErr, "group is archived and cannot be reopened")
-		assert.Equal(t, domain.GroupStatusArchived, group.Status)
-		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
+		assert.NoError(t, err)
+		assert.False(t, group.IsMember(member.ID))
+		assert.True(t, group.IsMember(waitlisted.ID))
+		assert.Empty(t, group.Waitlist)
 	})
-}
 
-func Test_Group_Archive(t *testing.T) {
-	t.Run("should archive an open group successfully", func(t *testing.T) {
+	t.Run("should keep a member of a matched group so the matches stay consistent", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
+		member := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1}).
-			WithStatus(domain.GroupStatusOpen).Build()
-		originalUpdatedAt := group.UpdatedAt
+			WithUsers([]domain.User{owner, member}).
+			WithMatches([]domain.Match{{GiverID: owner.ID, ReceiverID: member.ID}, {GiverID: member.ID, ReceiverID: owner.ID}}).
+			WithStatus(domain.GroupStatusMatched).Build()
 
 		// when
-		err := group.Archive(owner.ID)
+		err := group.RemoveDeletedUser(member.ID)
 
 		// then
 		assert.NoError(t, err)
-		assert.Equal(t, domain.GroupStatusArchived, group.Status)
-		assert.NotEqual(t, originalUpdatedAt, group.UpdatedAt)
+		assert.True(t, group.IsMember(member.ID))
+		assert.Len(t, group.Matches, 2)
+		assert.Equal(t, domain.GroupStatusMatched, group.Status)
 	})
 
-	t.Run("should archive a matched group successfully", func(t *testing.T) {
+	t.Run("should remove a waitlisted user and a pending join request", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1}).
-			WithStatus(domain.GroupStatusMatched).Build()
-		originalUpdatedAt := group.UpdatedAt
+			WithUsers([]domain.User{owner}).
+			WithWaitlist([]domain.User{user}).
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			WithStatus(domain.GroupStatusOpen).Build()
 
 		// when
-		err := group.Archive(owner.ID)
+		err := group.RemoveDeletedUser(user.ID)
 
 		// then
 		assert.NoError(t, err)
-		assert.Equal(t, domain.GroupStatusArchived, group.Status)
-		assert.NotEqual(t, originalUpdatedAt, group.UpdatedAt)
+		assert.Empty(t, group.Waitlist)
+		assert.Empty(t, group.JoinRequests)
 	})
 
-	t.Run("should return forbidden error when requester is not owner", func(t *testing.T) {
+	t.Run("should hand an open group over to the next member when the owner is deleted", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
+		member := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1}).
+			WithUsers([]domain.User{owner, member}).
 			WithStatus(domain.GroupStatusOpen).Build()
-		requesterID := uuid.New().String()
-		originalUpdatedAt := group.UpdatedAt
 
 		// when
-		err := group.Archive(requesterID)
+		err := group.RemoveDeletedUser(owner.ID)
 
 		// then
-		assert.Error(t, err)
-		var forbiddenErr *domain.ForbiddenError
-		assert.ErrorAs(t, err, &forbiddenErr)
-		assert.EqualError(t, forbiddenErr, "only the group owner can archive the group")
+		assert.NoError(t, err)
+		assert.Equal(t, member.ID, group.OwnerID)
+		assert.False(t, group.IsMember(owner.ID))
 		assert.Equal(t, domain.GroupStatusOpen, group.Status)
-		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
 	})
 
-	t.Run("should return conflict error when group is already archived", func(t *testing.T) {
+	t.Run("should hand a matched group over and keep the former owner as a participant", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
+		member := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1}).
-			WithStatus(domain.GroupStatusArchived).Build()
-		originalUpdatedAt := group.UpdatedAt
+			WithUsers([]domain.User{owner, member}).
+			WithStatus(domain.GroupStatusMatched).Build()
 
 		// when
-		err := group.Archive(owner.ID)
+		err := group.RemoveDeletedUser(owner.ID)
 
 		// then
-		assert.Error(t, err)
-		var conflictErr *domain.ConflictError
-		assert.ErrorAs(t, err, &conflictErr)
-		assert.EqualError(t, conflictErr, "group is already archived")
+		assert.NoError(t, err)
+		assert.Equal(t, member.ID, group.OwnerID)
+		assert.True(t, group.IsMember(owner.ID))
+	})
+
+	t.Run("should not hand the group over to a member whose account was deleted", func(t *testing.T) {
+		// given
+		deletedAt := time.Now()
+		owner := build_domain.NewUserBuilder().Build()
+		deletedMember := build_domain.NewUserBuilder().WithDeletedAt(&deletedAt).Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, deletedMember}).
+			WithStatus(domain.GroupStatusCompleted).Build()
+
+		// when
+		err := group.RemoveDeletedUser(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, owner.ID, group.OwnerID)
 		assert.Equal(t, domain.GroupStatusArchived, group.Status)
-		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
 	})
-}
 
-func Test_Group_GenerateMatches(t *testing.T) {
-	t.Run("should generate matches successfully", func(t *testing.T) {
+	t.Run("should archive the group when the owner is the only member", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
-		user2 := build_domain.NewUserBuilder().Build()
-		user3 := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithStatus(domain.GroupStatusOpen).Build()
 
-		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1, user2, user3}).Build()
+		// when
+		err := group.RemoveDeletedUser(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, owner.ID, group.OwnerID)
+		assert.True(t, group.IsMember(owner.ID))
+		assert.Equal(t, domain.GroupStatusArchived, group.Status)
+	})
+
+	t.Run("should keep an archived group as it is when the owner is the only member", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithStatus(domain.GroupStatusArchived).Build()
 
 		// when
-		err := group.GenerateMatches(owner.ID)
+		err := group.RemoveDeletedUser(owner.ID)
 
 		// then
 		assert.NoError(t, err)
-		assert.Len(t, group.Matches, 4)
+		assert.Equal(t, domain.GroupStatusArchived, group.Status)
+	})
+}
 
-		for _, match := range group.Matches {
-			assert.NotEqual(t, match.GiverID, match.ReceiverID)
-			assert.Contains(t, []string{owner.ID, user1.ID, user2.ID, user3.ID}, match.GiverID)
-			assert.Contains(t, []string{owner.ID, user1.ID, user2.ID, user3.ID}, match.ReceiverID)
-		}
+func Test_Group_RenameApartFrom(t *testing.T) {
+	t.Run("should keep the name when it is not taken", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().WithName("Secret Santa").Build()
 
-		// Ensure all users are givers and receivers exactly once
-		givers := make(map[string]int)
-		receivers := make(map[string]int)
-		for _, match := range group.Matches {
-			givers[match.GiverID]++
-			receivers[match.ReceiverID]++
-		}
+		// when
+		group.RenameApartFrom([]string{"Secret Santa 2024", "secret santa"})
 
-		assert.Len(t, givers, 4)
-		assert.Len(t, receivers, 4)
-		for _, count := range givers {
-			assert.Equal(t, 1, count)
-		}
-		for _, count := range receivers {
-			assert.Equal(t, 1, count)
-		}
+		// then
+		assert.Equal(t, "Secret Santa", group.Name)
+	})
 
-		// Ensure the updated time is set
-		assert.WithinDuration(t, time.Now(), group.UpdatedAt, time.Second)
+	t.Run("should take the first free numbered name when the name is taken", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().WithName("Secret Santa").Build()
+
+		// when
+		group.RenameApartFrom([]string{"Secret Santa", "Secret Santa (2)", "Secret Santa (4)"})
+
+		// then
+		assert.Equal(t, "Secret Santa (3)", group.Name)
 	})
+}
 
-	t.Run("should return an error when requester is not group owner", func(t *testing.T) {
+func Test_Group_Reopen(t *testing.T) {
+	t.Run("should reopen a matched group successfully", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
 		user1 := build_domain.NewUserBuilder().Build()
-		user2 := build_domain.NewUserBuilder().Build()
-		user3 := build_domain.NewUserBuilder().Build()
-
-		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1, user2, user3}).Build()
-		requesterID := "some-other-user-id"
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}).
+			WithStatus(domain.GroupStatusMatched).
+			WithMatches([]domain.Match{{GiverID: owner.ID, ReceiverID: user1.ID}}).Build()
+		originalUpdatedAt := group.UpdatedAt
 
 		// when
-		err := group.GenerateMatches(requesterID)
+		err := group.Reopen(owner.ID)
 
 		// then
-		assert.Error(t, err)
-		var forbiddenError *domain.ForbiddenError
-		assert.ErrorAs(t, err, &forbiddenError)
-		assert.EqualError(t, forbiddenError, "only the group owner can generate matches")
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusOpen, group.Status)
 		assert.Empty(t, group.Matches)
+		assert.NotEqual(t, originalUpdatedAt, group.UpdatedAt)
 	})
 
-	t.Run("should return an error when group has less than 3 users", func(t *testing.T) {
+	t.Run("should promote waitlisted users into the spots freed while the group was closed", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
-		user1 := build_domain.NewUserBuilder().Build()
-
-		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1}).Build()
+		firstWaitlisted := build_domain.NewUserBuilder().Build()
+		secondWaitlisted := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithWaitlist([]domain.User{firstWaitlisted, secondWaitlisted}).
+			WithMaxMembers(2).
+			WithStatus(domain.GroupStatusRegistrationClosed).
+			Build()
 
 		// when
-		err := group.GenerateMatches(owner.ID)
+		err := group.Reopen(owner.ID)
 
 		// then
-		assert.Error(t, err)
-		var conflictError *domain.ConflictError
-		assert.ErrorAs(t, err, &conflictError)
-		assert.EqualError(t, conflictError, "group must have at least 3 users to generate matches")
-		assert.Empty(t, group.Matches)
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.User{owner, firstWaitlisted}, group.Users)
+		assert.Equal(t, []domain.User{secondWaitlisted}, group.Waitlist)
 	})
 
-	t.Run("should return an error when group is not open for matches", func(t *testing.T) {
+	t.Run("should return forbidden error when requester is not owner", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
 		user1 := build_domain.NewUserBuilder().Build()
-
-		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1}).WithStatus(domain.GroupStatusMatched).Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}).
+			WithStatus(domain.GroupStatusMatched).
+			WithMatches([]domain.Match{{GiverID: owner.ID, ReceiverID: user1.ID}}).Build()
+		requesterID := uuid.New().String()
+		originalUpdatedAt := group.UpdatedAt
 
 		// when
-		err := group.GenerateMatches(owner.ID)
+		err := group.Reopen(requesterID)
 
 		// then
 		assert.Error(t, err)
-		var conflictError *domain.ConflictError
-		assert.ErrorAs(t, err, &conflictError)
-		assert.EqualError(t, conflictError, "group is not open for matches")
-		assert.Empty(t, group.Matches)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the group owner can reopen the group")
+		assert.Equal(t, domain.GroupStatusMatched, group.Status)
+		assert.NotEmpty(t, group.Matches)
+		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
 	})
-}
 
-func Test_Group_GetUserMatch(t *testing.T) {
-	t.Run("should return the receiver user successfully when match exists", func(t *testing.T) {
+	t.Run("should return conflict error when group is already open", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
 		user1 := build_domain.NewUserBuilder().Build()
-		user2 := build_domain.NewUserBuilder().Build()
-		user3 := build_domain.NewUserBuilder().Build()
-
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1, user2, user3}).
-			WithStatus(domain.GroupStatusMatched).
-			WithMatches([]domain.Match{
-				build_domain.NewMatchBuilder().WithGiverID(owner.ID).WithReceiverID(user1.ID).Build(),
-				build_domain.NewMatchBuilder().WithGiverID(user1.ID).WithReceiverID(user2.ID).Build(),
-				build_domain.NewMatchBuilder().WithGiverID(user2.ID).WithReceiverID(user3.ID).Build(),
-				build_domain.NewMatchBuilder().WithGiverID(user3.ID).WithReceiverID(owner.ID).Build(),
-			}).
-			Build()
+			WithUsers([]domain.User{owner, user1}).
+			WithStatus(domain.GroupStatusOpen).Build()
+		originalUpdatedAt := group.UpdatedAt
 
 		// when
-		receiver, err := group.GetUserMatch(owner.ID)
+		err := group.Reopen(owner.ID)
 
 		// then
-		assert.NoError(t, err)
-		assert.Equal(t, user1, *receiver)
+		assert.Error(t, err)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "group is already open")
+		assert.Equal(t, domain.GroupStatusOpen, group.Status)
+		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
 	})
 
-	t.Run("should return not found error when user has no match", func(t *testing.T) {
+	t.Run("should return conflict error when group is archived", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
 		user1 := build_domain.NewUserBuilder().Build()
-
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
 			WithUsers([]domain.User{owner, user1}).
-			WithStatus(domain.GroupStatusMatched).
-			WithMatches([]domain.Match{
-				build_domain.NewMatchBuilder().WithGiverID(owner.ID).WithReceiverID(user1.ID).Build(),
-				build_domain.NewMatchBuilder().WithGiverID(user1.ID).WithReceiverID(owner.ID).Build(),
-			}).
-			Build()
-
-		unknownUserID := uuid.New().String()
+			WithStatus(domain.GroupStatusArchived).Build()
+		originalUpdatedAt := group.UpdatedAt
 
 		// when
-		receiver, err := group.GetUserMatch(unknownUserID)
+		err := group.Reopen(owner.ID)
 
 		// then
 		assert.Error(t, err)
-		var notFoundErr *domain.ConflictError
-		assert.ErrorAs(t, err, &notFoundErr)
-		assert.EqualError(t, notFoundErr, "match not found for the given user")
-		assert.Nil(t, receiver)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "group is archived and cannot be reopened")
+		assert.Equal(t, domain.GroupStatusArchived, group.Status)
+		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
 	})
+}
 
-	t.Run("should return not found error when receiver user is not found in the group", func(t *testing.T) {
+func Test_Group_Archive(t *testing.T) {
+	t.Run("should archive an open group successfully", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
 		user1 := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}).
+			WithStatus(domain.GroupStatusOpen).Build()
+		originalUpdatedAt := group.UpdatedAt
 
-		// Create a match where the receiver is NOT in the group's Users slice
-		matchWithMissingReceiver := build_domain.NewMatchBuilder().WithGiverID(owner.ID).WithReceiverID(uuid.New().String()).Build()
+		// when
+		err := group.Archive(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusArchived, group.Status)
+		assert.NotEqual(t, originalUpdatedAt, group.UpdatedAt)
+	})
 
+	t.Run("should archive a matched group successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().
 			WithOwnerID(owner.ID).
-			WithUsers([]domain.User{owner, user1}). // user1 is in the group, but matchWithMissingReceiver's receiver is not
-			WithStatus(domain.GroupStatusMatched).
-			WithMatches([]domain.Match{
-				matchWithMissingReceiver,
-				build_domain.NewMatchBuilder().WithGiverID(user1.ID).WithReceiverID(owner.ID).Build(),
-			}).
-			Build()
+			WithUsers([]domain.User{owner, user1}).
+			WithStatus(domain.GroupStatusMatched).Build()
+		originalUpdatedAt := group.UpdatedAt
 
 		// when
-		receiver, err := group.GetUserMatch(owner.ID)
+		err := group.Archive(owner.ID)
 
 		// then
-		assert.Error(t, err)
-		var notFoundErr *domain.ConflictError
-		assert.ErrorAs(t, err, &notFoundErr)
-		assert.EqualError(t, notFoundErr, "receiver user not found for the identified match")
-		assert.Nil(t, receiver)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusArchived, group.Status)
+		assert.NotEqual(t, originalUpdatedAt, group.UpdatedAt)
 	})
 
-	t.Run("should return conflict error when group is not matched", func(t *testing.T) {
+	t.Run("should return forbidden error when requester is not owner", func(t *testing.T) {
 		// given
 		owner := build_domain.NewUserBuilder().Build()
 		user1 := build_domain.NewUserBuilder().Build()
-
-		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1}).Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}).
+			WithStatus(domain.GroupStatusOpen).Build()
+		requesterID := uuid.New().String()
+		originalUpdatedAt := group.UpdatedAt
 
 		// when
-		receiver, err := group.GetUserMatch(owner.ID)
+		err := group.Archive(requesterID)
 
 		// then
 		assert.Error(t, err)
-		var conflictErr *domain.ConflictError
-		assert.ErrorAs(t, err, &conflictErr)
-		assert.EqualError(t, conflictErr, "group is not matched")
-		assert.Nil(t, receiver)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the group owner can archive the group")
+		assert.Equal(t, domain.GroupStatusOpen, group.Status)
+		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
+	})
+
+	t.Run("should return conflict error when group is already archived", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}).
+			WithStatus(domain.GroupStatusArchived).Build()
+		originalUpdatedAt := group.UpdatedAt
+
+		// when
+		err := group.Archive(owner.ID)
+
+		// then
+		assert.Error(t, err)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "group is already archived")
+		assert.Equal(t, domain.GroupStatusArchived, group.Status)
+		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
+	})
+}
+
+func Test_Group_SetMaxMembers(t *testing.T) {
+	t.Run("should set the member limit and promote waitlisted users when there is room", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		waitlisted := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithWaitlist([]domain.User{waitlisted}).
+			WithMaxMembers(1).
+			Build()
+		originalUpdateTime := group.UpdatedAt
+
+		// when
+		err := group.SetMaxMembers(owner.ID, 5)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 5, group.MaxMembers)
+		assert.Equal(t, []domain.User{owner, waitlisted}, group.Users)
+		assert.Empty(t, group.Waitlist)
+		assert.NotEqual(t, originalUpdateTime, group.UpdatedAt)
+	})
+
+	t.Run("should remove the member limit when set to zero", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		waitlisted := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithWaitlist([]domain.User{waitlisted}).
+			WithMaxMembers(1).
+			Build()
+
+		// when
+		err := group.SetMaxMembers(owner.ID, 0)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, group.IsFull())
+		assert.Contains(t, group.Users, waitlisted)
+	})
+
+	t.Run("should not promote waitlisted users when the group is not open", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		waitlisted := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithWaitlist([]domain.User{waitlisted}).
+			WithMaxMembers(1).
+			WithStatus(domain.GroupStatusMatched).
+			Build()
+
+		// when
+		err := group.SetMaxMembers(owner.ID, 5)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.User{waitlisted}, group.Waitlist)
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		// when
+		err := group.SetMaxMembers(uuid.New().String(), 5)
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the group owner can change the member limit")
+	})
+
+	t.Run("should return conflict error when group is archived", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().WithStatus(domain.GroupStatusArchived).Build()
+
+		// when
+		err := group.SetMaxMembers(group.OwnerID, 5)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "group is archived and cannot be changed")
+	})
+
+	t.Run("should return conflict error when limit is lower than the current number of participants", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, build_domain.NewUserBuilder().Build()}).
+			Build()
+
+		// when
+		err := group.SetMaxMembers(owner.ID, 1)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "member limit cannot be lower than the current number of participants")
+		assert.Equal(t, 0, group.MaxMembers)
+	})
+
+	t.Run("should return validation error when limit is negative", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		// when
+		err := group.SetMaxMembers(group.OwnerID, -1)
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_Group_GenerateMatches(t *testing.T) {
+	t.Run("should generate matches successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+		user2 := build_domain.NewUserBuilder().Build()
+		user3 := build_domain.NewUserBuilder().Build()
+
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1, user2, user3}).Build()
+
+		// when
+		err := group.GenerateMatches(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, group.Matches, 4)
+
+		for _, match := range group.Matches {
+			assert.NotEqual(t, match.GiverID, match.ReceiverID)
+			assert.Contains(t, []string{owner.ID, user1.ID, user2.ID, user3.ID}, match.GiverID)
+			assert.Contains(t, []string{owner.ID, user1.ID, user2.ID, user3.ID}, match.ReceiverID)
+		}
+
+		// Ensure all users are givers and receivers exactly once
+		givers := make(map[string]int)
+		receivers := make(map[string]int)
+		for _, match := range group.Matches {
+			givers[match.GiverID]++
+			receivers[match.ReceiverID]++
+		}
+
+		assert.Len(t, givers, 4)
+		assert.Len(t, receivers, 4)
+		for _, count := range givers {
+			assert.Equal(t, 1, count)
+		}
+		for _, count := range receivers {
+			assert.Equal(t, 1, count)
+		}
+
+		// Ensure the updated time is set
+		assert.WithinDuration(t, time.Now(), group.UpdatedAt, time.Second)
+	})
+
+	t.Run("should chain every participant into a single loop with the cycle strategy", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		users := []domain.User{owner}
+		for range 5 {
+			users = append(users, build_domain.NewUserBuilder().Build())
+		}
+
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers(users).WithMatchStrategy(domain.MatchStrategyCycle).Build()
+
+		// when
+		err := group.GenerateMatches(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+
+		receiverOf := make(map[string]string)
+		for _, match := range group.Matches {
+			receiverOf[match.GiverID] = match.ReceiverID
+		}
+
+		loopLength := 1
+		for current := receiverOf[owner.ID]; current != owner.ID; current = receiverOf[current] {
+			loopLength++
+		}
+		assert.Equal(t, len(users), loopLength)
+	})
+
+	t.Run("should draw valid matches that can split into smaller loops with the random strategy", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		users := []domain.User{owner, build_domain.NewUserBuilder().Build(), build_domain.NewUserBuilder().Build(), build_domain.NewUserBuilder().Build()}
+
+		sawPair := false
+
+		// when
+		for range 200 {
+			group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers(users).WithMatchStrategy(domain.MatchStrategyRandom).Build()
+			err := group.GenerateMatches(owner.ID)
+
+			// then
+			assert.NoError(t, err)
+			assert.Len(t, group.Matches, len(users))
+
+			receiverOf := make(map[string]string)
+			receivers := make(map[string]bool)
+			for _, match := range group.Matches {
+				assert.NotEqual(t, match.GiverID, match.ReceiverID)
+				receiverOf[match.GiverID] = match.ReceiverID
+				receivers[match.ReceiverID] = true
+			}
+			assert.Len(t, receivers, len(users))
+
+			if receiverOf[receiverOf[owner.ID]] == owner.ID {
+				sawPair = true
+			}
+		}
+
+		assert.True(t, sawPair, "expected the random strategy to pair some participants with each other")
+	})
+
+	t.Run("should return an error when requester is not group owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+		user2 := build_domain.NewUserBuilder().Build()
+		user3 := build_domain.NewUserBuilder().Build()
+
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1, user2, user3}).Build()
+		requesterID := "some-other-user-id"
+
+		// when
+		err := group.GenerateMatches(requesterID)
+
+		// then
+		assert.Error(t, err)
+		var forbiddenError *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenError)
+		assert.EqualError(t, forbiddenError, "only the group owner can generate matches")
+		assert.Empty(t, group.Matches)
+	})
+
+	t.Run("should return an error when group has less than 3 users", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1}).Build()
+
+		// when
+		err := group.GenerateMatches(owner.ID)
+
+		// then
+		assert.Error(t, err)
+		var conflictError *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictError)
+		assert.EqualError(t, conflictError, "group must have at least 3 participants to generate matches")
+		assert.Empty(t, group.Matches)
+	})
+
+	t.Run("should return an error when group is not open for matches", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1}).WithStatus(domain.GroupStatusMatched).Build()
+
+		// when
+		err := group.GenerateMatches(owner.ID)
+
+		// then
+		assert.Error(t, err)
+		var conflictError *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictError)
+		assert.EqualError(t, conflictError, "group is not open for matches")
+		assert.Empty(t, group.Matches)
+	})
+}
+
+func Test_Group_GetUserMatch(t *testing.T) {
+	t.Run("should return the receiver user successfully when match exists", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+		user2 := build_domain.NewUserBuilder().Build()
+		user3 := build_domain.NewUserBuilder().Build()
+
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1, user2, user3}).
+			WithStatus(domain.GroupStatusMatched).
+			WithMatches([]domain.Match{
+				build_domain.NewMatchBuilder().WithGiverID(owner.ID).WithReceiverID(user1.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(user1.ID).WithReceiverID(user2.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(user2.ID).WithReceiverID(user3.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(user3.ID).WithReceiverID(owner.ID).Build(),
+			}).
+			Build()
+
+		// when
+		receiver, err := group.GetUserMatch(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.NewParticipantFromUser(user1), *receiver)
+	})
+
+	t.Run("should return not found error when user has no match", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}).
+			WithStatus(domain.GroupStatusMatched).
+			WithMatches([]domain.Match{
+				build_domain.NewMatchBuilder().WithGiverID(owner.ID).WithReceiverID(user1.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(user1.ID).WithReceiverID(owner.ID).Build(),
+			}).
+			Build()
+
+		unknownUserID := uuid.New().String()
+
+		// when
+		receiver, err := group.GetUserMatch(unknownUserID)
+
+		// then
+		assert.Error(t, err)
+		var notFoundErr *domain.ConflictError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "match not found for the given user")
+		assert.Nil(t, receiver)
+	})
+
+	t.Run("should return not found error when receiver user is not found in the group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+
+		// Create a match where the receiver is NOT in the group's Users slice
+		matchWithMissingReceiver := build_domain.NewMatchBuilder().WithGiverID(owner.ID).WithReceiverID(uuid.New().String()).Build()
+
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}). // user1 is in the group, but matchWithMissingReceiver's receiver is not
+			WithStatus(domain.GroupStatusMatched).
+			WithMatches([]domain.Match{
+				matchWithMissingReceiver,
+				build_domain.NewMatchBuilder().WithGiverID(user1.ID).WithReceiverID(owner.ID).Build(),
+			}).
+			Build()
+
+		// when
+		receiver, err := group.GetUserMatch(owner.ID)
+
+		// then
+		assert.Error(t, err)
+		var notFoundErr *domain.ConflictError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "receiver user not found for the identified match")
+		assert.Nil(t, receiver)
+	})
+
+	t.Run("should return conflict error when group is not matched", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1}).Build()
+
+		// when
+		receiver, err := group.GetUserMatch(owner.ID)
+
+		// then
+		assert.Error(t, err)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "group is not matched")
+		assert.Nil(t, receiver)
+	})
+}
+
+func Test_Group_AddGuest(t *testing.T) {
+	t.Run("should add guest successfully when requester is owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+
+		// when
+		err := group.AddGuest(owner.ID, guest)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Guest{guest}, group.Guests)
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+
+		// when
+		err := group.AddGuest(uuid.New().String(), guest)
+
+		// then
+		var forbiddenError *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenError)
+		assert.EqualError(t, forbiddenError, "only the group owner can add guests")
+		assert.Empty(t, group.Guests)
+	})
+
+	t.Run("should return conflict error when group is not open", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusMatched).Build()
+
+		// when
+		err := group.AddGuest(owner.ID, guest)
+
+		// then
+		var conflictError *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictError)
+		assert.EqualError(t, conflictError, "group is not open for registration, contact the group owner to reopen the group")
+	})
+
+	t.Run("should return conflict error when a participant already has the guest email", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().WithEmail("owner@example.com").Build()
+		guest := build_domain.NewGuestBuilder().WithEmail("OWNER@example.com").Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+
+		// when
+		err := group.AddGuest(owner.ID, guest)
+
+		// then
+		var conflictError *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictError)
+		assert.EqualError(t, conflictError, "a participant with this email is already in the group")
+	})
+
+	t.Run("should return conflict error when the group is full", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithMaxMembers(1).
+			Build()
+		guest := build_domain.NewGuestBuilder().Build()
+
+		// when
+		err := group.AddGuest(owner.ID, guest)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "group has reached its member limit")
+		assert.Empty(t, group.Guests)
+	})
+}
+
+func Test_Group_UpdateGuest(t *testing.T) {
+	t.Run("should update guest successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.UpdateGuest(owner.ID, guest.ID, "Grandpa", "")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "Grandpa", group.Guests[0].Name)
+		assert.Empty(t, group.Guests[0].Email)
+		assert.WithinDuration(t, time.Now(), group.Guests[0].UpdatedAt, time.Second)
+	})
+
+	t.Run("should return not found error when guest does not exist", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+
+		// when
+		err := group.UpdateGuest(owner.ID, uuid.New().String(), "Grandpa", "")
+
+		// then
+		var notFoundError *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundError)
+		assert.EqualError(t, notFoundError, "guest not found")
+	})
+
+	t.Run("should return validation error when name is empty", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.UpdateGuest(owner.ID, guest.ID, "", "")
+
+		// then
+		var validationError *domain.ValidationError
+		assert.ErrorAs(t, err, &validationError)
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.UpdateGuest(uuid.New().String(), guest.ID, "Grandpa", "")
+
+		// then
+		var forbiddenError *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenError)
+		assert.EqualError(t, forbiddenError, "only the group owner can update guests")
+	})
+}
+
+func Test_Group_RemoveGuest(t *testing.T) {
+	t.Run("should remove guest successfully", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.RemoveGuest(owner.ID, guest.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, group.Guests)
+	})
+
+	t.Run("should return conflict error when group is not open", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).WithStatus(domain.GroupStatusMatched).Build()
+
+		// when
+		err := group.RemoveGuest(owner.ID, guest.ID)
+
+		// then
+		var conflictError *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictError)
+		assert.Len(t, group.Guests, 1)
+	})
+
+	t.Run("should return not found error when guest does not exist", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+
+		// when
+		err := group.RemoveGuest(owner.ID, uuid.New().String())
+
+		// then
+		var notFoundError *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundError)
+		assert.EqualError(t, notFoundError, "guest not found")
+	})
+}
+
+func Test_Group_GenerateMatches_WithGuests(t *testing.T) {
+	t.Run("should include guests in the draw", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.GenerateMatches(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, group.Matches, 3)
+
+		participantIDs := []string{owner.ID, user1.ID, guest.ID}
+		for _, match := range group.Matches {
+			assert.Contains(t, participantIDs, match.GiverID)
+			assert.Contains(t, participantIDs, match.ReceiverID)
+		}
+	})
+}
+
+func Test_Group_GetGuestMatch(t *testing.T) {
+	t.Run("should return the guest receiver when requester is owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}).
+			WithGuests([]domain.Guest{guest}).
+			WithStatus(domain.GroupStatusMatched).
+			WithMatches([]domain.Match{
+				build_domain.NewMatchBuilder().WithGiverID(owner.ID).WithReceiverID(guest.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(guest.ID).WithReceiverID(user1.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(user1.ID).WithReceiverID(owner.ID).Build(),
+			}).
+			Build()
+
+		// when
+		receiver, err := group.GetGuestMatch(owner.ID, guest.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.NewParticipantFromUser(user1), *receiver)
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).WithStatus(domain.GroupStatusMatched).Build()
+
+		// when
+		receiver, err := group.GetGuestMatch(uuid.New().String(), guest.ID)
+
+		// then
+		var forbiddenError *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenError)
+		assert.EqualError(t, forbiddenError, "only the group owner can view guest matches")
+		assert.Nil(t, receiver)
+	})
+}
+
+func Test_Group_ClaimGuest(t *testing.T) {
+	t.Run("should merge guest into user and carry over matches", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().WithEmail("grandma@example.com").Build()
+		verifiedAt := time.Now()
+		claimingUser := build_domain.NewUserBuilder().WithEmail("Grandma@Example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}).
+			WithGuests([]domain.Guest{guest}).
+			WithStatus(domain.GroupStatusMatched).
+			WithMatches([]domain.Match{
+				build_domain.NewMatchBuilder().WithGiverID(owner.ID).WithReceiverID(guest.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(guest.ID).WithReceiverID(user1.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(user1.ID).WithReceiverID(owner.ID).Build(),
+			}).
+			Build()
+
+		// when
+		err := group.ClaimGuest(guest.ID, claimingUser)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, group.Guests)
+		assert.Contains(t, group.Users, claimingUser)
+		assert.Equal(t, claimingUser.ID, group.Matches[0].ReceiverID)
+		assert.Equal(t, claimingUser.ID, group.Matches[1].GiverID)
+	})
+
+	t.Run("should return forbidden error when emails do not match", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().WithEmail("grandma@example.com").Build()
+		claimingUser := build_domain.NewUserBuilder().WithEmail("someone@example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.ClaimGuest(guest.ID, claimingUser)
+
+		// then
+		var forbiddenError *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenError)
+		assert.EqualError(t, forbiddenError, "guest can only be claimed by a user with the same email")
+		assert.Len(t, group.Guests, 1)
+	})
+
+	t.Run("should return forbidden error when the user has not verified their email", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().WithEmail("grandma@example.com").Build()
+		claimingUser := build_domain.NewUserBuilder().WithEmail("grandma@example.com").Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.ClaimGuest(guest.ID, claimingUser)
+
+		// then
+		var forbiddenError *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenError)
+		assert.EqualError(t, forbiddenError, "verify your email address to claim a guest")
+		assert.Len(t, group.Guests, 1)
+	})
+
+	t.Run("should return conflict error when user is already a member", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		owner := build_domain.NewUserBuilder().WithEmail("grandma@example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+		guest := build_domain.NewGuestBuilder().WithEmail("grandma@example.com").Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.ClaimGuest(guest.ID, owner)
+
+		// then
+		var conflictError *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictError)
+		assert.EqualError(t, conflictError, "user is already a member of this group")
+	})
+
+	t.Run("should return conflict error when group is archived", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		claimingUser := build_domain.NewUserBuilder().WithEmail(guest.Email).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).WithStatus(domain.GroupStatusArchived).Build()
+
+		// when
+		err := group.ClaimGuest(guest.ID, claimingUser)
+
+		// then
+		var conflictError *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictError)
+		assert.EqualError(t, conflictError, "group is archived and cannot be changed")
+	})
+}
+
+func Test_Group_MergeGuest(t *testing.T) {
+	t.Run("should merge a guest without email into the user and carry over matches", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().WithEmail("").Build()
+		mergedUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user1}).
+			WithGuests([]domain.Guest{guest}).
+			WithJoinRequests([]domain.JoinRequest{{User: mergedUser, CreatedAt: time.Now()}}).
+			WithStatus(domain.GroupStatusMatched).
+			WithMatches([]domain.Match{
+				build_domain.NewMatchBuilder().WithGiverID(owner.ID).WithReceiverID(guest.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(guest.ID).WithReceiverID(user1.ID).Build(),
+				build_domain.NewMatchBuilder().WithGiverID(user1.ID).WithReceiverID(owner.ID).Build(),
+			}).
+			Build()
+
+		// when
+		err := group.MergeGuest(owner.ID, guest.ID, mergedUser)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, group.Guests)
+		assert.Empty(t, group.JoinRequests)
+		assert.Contains(t, group.Users, mergedUser)
+		assert.Equal(t, mergedUser.ID, group.Matches[0].ReceiverID)
+		assert.Equal(t, mergedUser.ID, group.Matches[1].GiverID)
+	})
+
+	t.Run("should return forbidden error when the requester is not the owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		mergedUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.MergeGuest(mergedUser.ID, guest.ID, mergedUser)
+
+		// then
+		var forbiddenError *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenError)
+		assert.EqualError(t, forbiddenError, "only the group owner can merge guests")
+		assert.Len(t, group.Guests, 1)
+	})
+
+	t.Run("should return not found error when the user was deleted", func(t *testing.T) {
+		// given
+		deletedAt := time.Now()
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		mergedUser := build_domain.NewUserBuilder().WithDeletedAt(&deletedAt).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.MergeGuest(owner.ID, guest.ID, mergedUser)
+
+		// then
+		var notFoundError *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundError)
+		assert.Len(t, group.Guests, 1)
+	})
+
+	t.Run("should return conflict error when the user is already a member", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		// when
+		err := group.MergeGuest(owner.ID, guest.ID, owner)
+
+		// then
+		var conflictError *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictError)
+		assert.EqualError(t, conflictError, "user is already a member of this group")
+	})
+}
+
+func Test_Group_RequestToJoin(t *testing.T) {
+	t.Run("should create a pending join request", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().Build()
+
+		// when
+		err := group.RequestToJoin(user, "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d")
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, group.HasPendingJoinRequest(user.ID))
+		assert.False(t, group.IsMember(user.ID))
+		joinRequest, ok := group.PendingJoinRequest(user.ID)
+		assert.True(t, ok)
+		assert.Equal(t, user, joinRequest.User)
+		assert.Equal(t, "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", joinRequest.InviteID)
+	})
+
+	t.Run("should not create a duplicate join request", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		// when
+		err := group.RequestToJoin(user, "")
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, group.JoinRequests, 1)
+	})
+
+	t.Run("should do nothing when user is already a member", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		// when
+		err := group.RequestToJoin(group.Users[0], "")
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, group.JoinRequests)
+	})
+
+	t.Run("should return conflict error when group is not open", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithStatus(domain.GroupStatusMatched).Build()
+
+		// when
+		err := group.RequestToJoin(user, "")
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.Empty(t, group.JoinRequests)
+	})
+}
+
+func Test_Group_ApproveJoinRequest(t *testing.T) {
+	t.Run("should add the user to the group and remove the request", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		// when
+		err := group.ApproveJoinRequest(owner.ID, user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, group.IsMember(user.ID))
+		assert.Empty(t, group.JoinRequests)
+	})
+
+	t.Run("should place the user on the waitlist when the group is full", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithMaxMembers(1).
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		// when
+		err := group.ApproveJoinRequest(owner.ID, user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, group.IsWaitlisted(user.ID))
+		assert.Empty(t, group.JoinRequests)
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		// when
+		err := group.ApproveJoinRequest(user.ID, user.ID)
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the group owner can approve join requests")
+		assert.Len(t, group.JoinRequests, 1)
+	})
+
+	t.Run("should return not found error when there is no pending request", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		// when
+		err := group.ApproveJoinRequest(group.OwnerID, uuid.New().String())
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "join request not found")
+	})
+
+	t.Run("should return conflict error when group is not open", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithStatus(domain.GroupStatusMatched).
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		// when
+		err := group.ApproveJoinRequest(group.OwnerID, user.ID)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func Test_Group_RejectJoinRequest(t *testing.T) {
+	t.Run("should remove the request without adding the user", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		// when
+		err := group.RejectJoinRequest(group.OwnerID, user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, group.JoinRequests)
+		assert.False(t, group.IsMember(user.ID))
+	})
+
+	t.Run("should return forbidden error when requester is not the owner", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithJoinRequests([]domain.JoinRequest{{User: user, CreatedAt: time.Now()}}).
+			Build()
+
+		// when
+		err := group.RejectJoinRequest(user.ID, user.ID)
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the group owner can reject join requests")
+	})
+
+	t.Run("should return not found error when there is no pending request", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		// when
+		err := group.RejectJoinRequest(group.OwnerID, uuid.New().String())
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_Group_AllowedActions(t *testing.T) {
+	testCases := []struct {
+		status   domain.GroupStatus
+		expected []domain.GroupAction
+	}{
+		{domain.GroupStatusDraft, []domain.GroupAction{domain.GroupActionPublish, domain.GroupActionArchive}},
+		{domain.GroupStatusOpen, []domain.GroupAction{domain.GroupActionCloseRegistration, domain.GroupActionGenerateMatches, domain.GroupActionArchive}},
+		{domain.GroupStatusRegistrationClosed, []domain.GroupAction{domain.GroupActionReopen, domain.GroupActionGenerateMatches, domain.GroupActionArchive}},
+		{domain.GroupStatusMatched, []domain.GroupAction{domain.GroupActionReopen, domain.GroupActionComplete, domain.GroupActionArchive}},
+		{domain.GroupStatusCompleted, []domain.GroupAction{domain.GroupActionArchive}},
+		{domain.GroupStatusArchived, []domain.GroupAction{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("should return the allowed actions for a %s group", testCase.status), func(t *testing.T) {
+			// given
+			group := build_domain.NewGroupBuilder().WithStatus(testCase.status).Build()
+
+			// when
+			actions := group.AllowedActions()
+
+			// then
+			assert.Equal(t, testCase.expected, actions)
+		})
+	}
+}
+
+func Test_Group_Publish(t *testing.T) {
+	t.Run("should publish a draft group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusDraft).Build()
+
+		// when
+		err := group.Publish(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusOpen, group.Status)
+	})
+
+	t.Run("should return forbidden error when requester is not owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusDraft).Build()
+
+		// when
+		err := group.Publish(uuid.New().String())
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the group owner can publish the group")
+		assert.Equal(t, domain.GroupStatusDraft, group.Status)
+	})
+
+	t.Run("should return conflict error when group is not a draft", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusMatched).Build()
+		originalUpdatedAt := group.UpdatedAt
+
+		// when
+		err := group.Publish(owner.ID)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "action PUBLISH is not allowed for a group in MATCHED status")
+		assert.Equal(t, domain.GroupStatusMatched, group.Status)
+		assert.Equal(t, originalUpdatedAt, group.UpdatedAt)
+	})
+}
+
+func Test_Group_CloseRegistration(t *testing.T) {
+	t.Run("should close registration of an open group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+
+		// when
+		err := group.CloseRegistration(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusRegistrationClosed, group.Status)
+	})
+
+	t.Run("should return forbidden error when requester is not owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+
+		// when
+		err := group.CloseRegistration(uuid.New().String())
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the group owner can close registration")
+	})
+
+	t.Run("should return conflict error when group is a draft", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusDraft).Build()
+
+		// when
+		err := group.CloseRegistration(owner.ID)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "action CLOSE_REGISTRATION is not allowed for a group in DRAFT status")
+	})
+
+	t.Run("should reject new users once registration is closed", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusRegistrationClosed).Build()
+
+		// when
+		err := group.AddUser(owner.ID, user)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.False(t, group.IsMember(user.ID))
+	})
+
+	t.Run("should allow generating matches once registration is closed", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user1 := build_domain.NewUserBuilder().Build()
+		user2 := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user1, user2}).WithStatus(domain.GroupStatusRegistrationClosed).Build()
+
+		// when
+		err := group.GenerateMatches(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusMatched, group.Status)
+		assert.Len(t, group.Matches, 3)
+	})
+}
+
+func Test_Group_Complete(t *testing.T) {
+	t.Run("should complete a matched group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusMatched).Build()
+
+		// when
+		err := group.Complete(owner.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.GroupStatusCompleted, group.Status)
+	})
+
+	t.Run("should return forbidden error when requester is not owner", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusMatched).Build()
+
+		// when
+		err := group.Complete(uuid.New().String())
+
+		// then
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
+		assert.EqualError(t, forbiddenErr, "only the group owner can complete the group")
+	})
+
+	t.Run("should return conflict error when matches were not generated", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusOpen).Build()
+
+		// when
+		err := group.Complete(owner.ID)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "action COMPLETE is not allowed for a group in OPEN status")
+	})
+
+	t.Run("should not allow reopening a completed group", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusCompleted).Build()
+
+		// when
+		err := group.Reopen(owner.ID)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "action REOPEN is not allowed for a group in COMPLETED status")
+		assert.Equal(t, domain.GroupStatusCompleted, group.Status)
+	})
+}
+
+func Test_Group_RevealMatches(t *testing.T) {
+	t.Run("should reveal every match once the group is completed", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		user := build_domain.NewUserBuilder().Build()
+		guest := build_domain.NewGuestBuilder().Build()
+		matches := []domain.Match{
+			{GiverID: owner.ID, ReceiverID: user.ID},
+			{GiverID: user.ID, ReceiverID: guest.ID},
+			{GiverID: guest.ID, ReceiverID: owner.ID},
+		}
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner, user}).
+			WithGuests([]domain.Guest{guest}).
+			WithMatches(matches).
+			WithStatus(domain.GroupStatusCompleted).Build()
+
+		// when
+		revealedMatches, err := group.RevealMatches(user.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, revealedMatches, 3)
+		assert.Equal(t, owner.ID, revealedMatches[0].Giver.ID)
+		assert.Equal(t, user.ID, revealedMatches[0].Receiver.ID)
+		assert.Equal(t, guest.ID, revealedMatches[1].Receiver.ID)
+		assert.True(t, revealedMatches[1].Receiver.IsGuest)
+	})
+
+	t.Run("should return conflict error when group is not completed", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusMatched).Build()
+
+		// when
+		revealedMatches, err := group.RevealMatches(owner.ID)
+
+		// then
+		assert.Nil(t, revealedMatches)
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "matches can only be revealed once the group is completed")
+	})
+
+	t.Run("should return forbidden error when requester is not a member", func(t *testing.T) {
+		// given
+		owner := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithStatus(domain.GroupStatusCompleted).Build()
+
+		// when
+		revealedMatches, err := group.RevealMatches(uuid.New().String())
+
+		// then
+		assert.Nil(t, revealedMatches)
+		var forbiddenErr *domain.ForbiddenError
+		assert.ErrorAs(t, err, &forbiddenErr)
 	})
 }
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+type Guest struct {
+	ID        string    `validate:"required,uuid"`
+	Name      string    `validate:"required,max=255"`
+	Email     string    `validate:"omitempty,email,max=255"`
+	CreatedAt time.Time `validate:"required"`
+	UpdatedAt time.Time `validate:"required"`
+}
+
+func NewGuest(identityGenerator IdentityGenerator, name, email string) (*Guest, error) {
+	id, err := identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	guest := Guest{
+		ID:        id,
+		Name:      name,
+		Email:     email,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := guest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &guest, nil
+}
+
+func (g *Guest) Validate() error {
+	if errs := validator.Validate(g); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+// CanBeClaimedBy reports whether the user owns the email of the guest. The user must have verified the email, since
+// anyone can sign up with an address they do not own.
+func (g *Guest) CanBeClaimedBy(user User) bool {
+	return user.IsEmailVerified() && g.Email != "" && strings.EqualFold(g.Email, user.Email)
+}
+
+// ClaimableGuest is a guest spot that a user can claim, together with the group it belongs to.
+type ClaimableGuest struct {
+	GroupID   string
+	GroupName string
+	Guest     Guest
+}
+
+// Participant is anyone taking part in the draw, either a registered user or a guest.
+type Participant struct {
+	ID      string
+	Name    string
+	Surname string
+	Email   string
+	IsGuest bool
+}
+
+func NewParticipantFromUser(user User) Participant {
+	return Participant{
+		ID:      user.ID,
+		Name:    user.Name,
+		Surname: user.Surname,
+		Email:   user.Email,
+	}
+}
+
+func NewParticipantFromGuest(guest Guest) Participant {
+	return Participant{
+		ID:      guest.ID,
+		Name:    guest.Name,
+		Email:   guest.Email,
+		IsGuest: true,
+	}
+}
@@ -0,0 +1,118 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_NewGuest(t *testing.T) {
+	t.Run("should create a new guest successfully", func(t *testing.T) {
+		// given
+		generatedID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(generatedID, nil)
+
+		// when
+		guest, err := domain.NewGuest(mockedIdentityGenerator, "Grandma", "grandma@example.com")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, generatedID, guest.ID)
+		assert.Equal(t, "Grandma", guest.Name)
+		assert.Equal(t, "grandma@example.com", guest.Email)
+		assert.WithinDuration(t, time.Now(), guest.CreatedAt, time.Second)
+	})
+
+	t.Run("should create a new guest without email", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		guest, err := domain.NewGuest(mockedIdentityGenerator, "Grandpa", "")
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, guest.Email)
+	})
+
+	t.Run("should return validation error when email is invalid", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(uuid.New().String(), nil)
+
+		// when
+		guest, err := domain.NewGuest(mockedIdentityGenerator, "Grandpa", "not-an-email")
+
+		// then
+		assert.Nil(t, guest)
+		var validationError *domain.ValidationError
+		assert.ErrorAs(t, err, &validationError)
+		assert.Contains(t, validationError.Details(), validator.FieldError{Field: "Email", Error: "Email must be a valid email address"})
+	})
+
+	t.Run("should return error when identity generator fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
+
+		// when
+		guest, err := domain.NewGuest(mockedIdentityGenerator, "Grandpa", "")
+
+		// then
+		assert.Nil(t, guest)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_Guest_CanBeClaimedBy(t *testing.T) {
+	t.Run("should return true when emails match ignoring case", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		guest := build_domain.NewGuestBuilder().WithEmail("grandma@example.com").Build()
+		user := build_domain.NewUserBuilder().WithEmail("GRANDMA@example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+
+		// when
+		result := guest.CanBeClaimedBy(user)
+
+		// then
+		assert.True(t, result)
+	})
+
+	t.Run("should return false when the user has not verified their email", func(t *testing.T) {
+		// given
+		guest := build_domain.NewGuestBuilder().WithEmail("grandma@example.com").Build()
+		user := build_domain.NewUserBuilder().WithEmail("grandma@example.com").Build()
+
+		// when
+		result := guest.CanBeClaimedBy(user)
+
+		// then
+		assert.False(t, result)
+	})
+
+	t.Run("should return false when guest has no email", func(t *testing.T) {
+		// given
+		guest := build_domain.NewGuestBuilder().WithEmail("").Build()
+		user := build_domain.NewUserBuilder().Build()
+
+		// when
+		result := guest.CanBeClaimedBy(user)
+
+		// then
+		assert.False(t, result)
+	})
+}
@@ -1,7 +1,26 @@
 package domain
 
 //go:generate go run go.uber.org/mock/mockgen -destination mock_domain/identity_generator.go . IdentityGenerator
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/invite_code_generator.go . InviteCodeGenerator
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/secret_token_generator.go . SecretTokenGenerator
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/recovery_code_generator.go . RecoveryCodeGenerator
 
 type IdentityGenerator interface {
 	Generate() (string, error)
 }
+
+// InviteCodeGenerator generates short codes that are easy to read aloud and type, see InviteCodeAlphabet.
+type InviteCodeGenerator interface {
+	Generate() (string, error)
+}
+
+// SecretTokenGenerator generates long random tokens that are sent to users to prove ownership of something, like an email address.
+type SecretTokenGenerator interface {
+	Generate() (string, error)
+}
+
+// RecoveryCodeGenerator generates the two-factor recovery codes that users write down, grouped for readability,
+// such as "7KQ2-MX9D-4HRT-ZP3W".
+type RecoveryCodeGenerator interface {
+	Generate() (string, error)
+}
@@ -0,0 +1,41 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/mailer.go . Mailer
+
+import (
+	"context"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// Email is a plain text message sent to a single recipient.
+type Email struct {
+	To      string `validate:"required,email"`
+	Subject string `validate:"required"`
+	Body    string `validate:"required"`
+}
+
+func NewEmail(to, subject, body string) (*Email, error) {
+	email := Email{
+		To:      to,
+		Subject: subject,
+		Body:    body,
+	}
+
+	if err := email.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &email, nil
+}
+
+func (e *Email) Validate() error {
+	if errs := validator.Validate(e); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+type Mailer interface {
+	Send(ctx context.Context, email Email) error
+}
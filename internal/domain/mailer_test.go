@@ -0,0 +1,29 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+func Test_NewEmail(t *testing.T) {
+	t.Run("should create an email", func(t *testing.T) {
+		// when
+		email, err := domain.NewEmail("john@example.com", "Subject", "Body")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.Email{To: "john@example.com", Subject: "Subject", Body: "Body"}, *email)
+	})
+
+	t.Run("should return validation error when the recipient is invalid", func(t *testing.T) {
+		// when
+		email, err := domain.NewEmail("not-an-email", "Subject", "Body")
+
+		// then
+		assert.Nil(t, email)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
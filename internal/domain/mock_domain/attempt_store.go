@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: AttemptStore)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/attempt_store.go . AttemptStore
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttemptStore is a mock of AttemptStore interface.
+type MockAttemptStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttemptStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockAttemptStoreMockRecorder is the mock recorder for MockAttemptStore.
+type MockAttemptStoreMockRecorder struct {
+	mock *MockAttemptStore
+}
+
+// NewMockAttemptStore creates a new mock instance.
+func NewMockAttemptStore(ctrl *gomock.Controller) *MockAttemptStore {
+	mock := &MockAttemptStore{ctrl: ctrl}
+	mock.recorder = &MockAttemptStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttemptStore) EXPECT() *MockAttemptStoreMockRecorder {
+	return m.recorder
+}
+
+// Release mocks base method.
+func (m *MockAttemptStore) Release(ctx context.Context, key string, reservedAt time.Time, previous domain.FailedAttempts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, key, reservedAt, previous)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockAttemptStoreMockRecorder) Release(ctx, key, reservedAt, previous any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockAttemptStore)(nil).Release), ctx, key, reservedAt, previous)
+}
+
+// Reserve mocks base method.
+func (m *MockAttemptStore) Reserve(ctx context.Context, key string, at time.Time, ttl time.Duration) (domain.FailedAttempts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, key, at, ttl)
+	ret0, _ := ret[0].(domain.FailedAttempts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockAttemptStoreMockRecorder) Reserve(ctx, key, at, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockAttemptStore)(nil).Reserve), ctx, key, at, ttl)
+}
+
+// Reset mocks base method.
+func (m *MockAttemptStore) Reset(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockAttemptStoreMockRecorder) Reset(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockAttemptStore)(nil).Reset), ctx, key)
+}
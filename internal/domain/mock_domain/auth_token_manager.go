@@ -11,6 +11,7 @@ package mock_domain
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -40,18 +41,18 @@ func (m *MockAuthTokenManager) EXPECT() *MockAuthTokenManagerMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockAuthTokenManager) Create(userID string, expiresIn int64) (string, error) {
+func (m *MockAuthTokenManager) Create(userID, sessionID string, expiresIn int64) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", userID, expiresIn)
+	ret := m.ctrl.Call(m, "Create", userID, sessionID, expiresIn)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockAuthTokenManagerMockRecorder) Create(userID, expiresIn any) *gomock.Call {
+func (mr *MockAuthTokenManagerMockRecorder) Create(userID, sessionID, expiresIn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuthTokenManager)(nil).Create), userID, expiresIn)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuthTokenManager)(nil).Create), userID, sessionID, expiresIn)
 }
 
 // GetAuthUserID mocks base method.
@@ -69,6 +70,36 @@ func (mr *MockAuthTokenManagerMockRecorder) GetAuthUserID(token any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthUserID", reflect.TypeOf((*MockAuthTokenManager)(nil).GetAuthUserID), token)
 }
 
+// GetIssuedAt mocks base method.
+func (m *MockAuthTokenManager) GetIssuedAt(token any) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssuedAt", token)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssuedAt indicates an expected call of GetIssuedAt.
+func (mr *MockAuthTokenManagerMockRecorder) GetIssuedAt(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssuedAt", reflect.TypeOf((*MockAuthTokenManager)(nil).GetIssuedAt), token)
+}
+
+// GetSessionID mocks base method.
+func (m *MockAuthTokenManager) GetSessionID(token any) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionID", token)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionID indicates an expected call of GetSessionID.
+func (mr *MockAuthTokenManagerMockRecorder) GetSessionID(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionID", reflect.TypeOf((*MockAuthTokenManager)(nil).GetSessionID), token)
+}
+
 // GetTokenType mocks base method.
 func (m *MockAuthTokenManager) GetTokenType() string {
 	m.ctrl.T.Helper()
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: BlobStorage)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/blob_storage.go . BlobStorage
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBlobStorage is a mock of BlobStorage interface.
+type MockBlobStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockBlobStorageMockRecorder is the mock recorder for MockBlobStorage.
+type MockBlobStorageMockRecorder struct {
+	mock *MockBlobStorage
+}
+
+// NewMockBlobStorage creates a new mock instance.
+func NewMockBlobStorage(ctrl *gomock.Controller) *MockBlobStorage {
+	mock := &MockBlobStorage{ctrl: ctrl}
+	mock.recorder = &MockBlobStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobStorage) EXPECT() *MockBlobStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBlobStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBlobStorageMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlobStorage)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockBlobStorage) Get(ctx context.Context, key string) (*domain.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(*domain.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlobStorageMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlobStorage)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockBlobStorage) Put(ctx context.Context, key string, blob domain.Blob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, blob)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockBlobStorageMockRecorder) Put(ctx, key, blob any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockBlobStorage)(nil).Put), ctx, key, blob)
+}
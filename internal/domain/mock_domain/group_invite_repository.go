@@ -12,6 +12,7 @@ package mock_domain
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -55,6 +56,35 @@ func (mr *MockGroupInviteRepositoryMockRecorder) Create(ctx, groupInvite any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupInviteRepository)(nil).Create), ctx, groupInvite)
 }
 
+// Delete mocks base method.
+func (m *MockGroupInviteRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGroupInviteRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGroupInviteRepository)(nil).Delete), ctx, id)
+}
+
+// GetActiveByCode mocks base method.
+func (m *MockGroupInviteRepository) GetActiveByCode(ctx context.Context, code string) (*domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveByCode", ctx, code)
+	ret0, _ := ret[0].(*domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveByCode indicates an expected call of GetActiveByCode.
+func (mr *MockGroupInviteRepositoryMockRecorder) GetActiveByCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByCode", reflect.TypeOf((*MockGroupInviteRepository)(nil).GetActiveByCode), ctx, code)
+}
+
 // GetActiveByGroupID mocks base method.
 func (m *MockGroupInviteRepository) GetActiveByGroupID(ctx context.Context, groupID string) (*domain.GroupInvite, error) {
 	m.ctrl.T.Helper()
@@ -84,3 +114,105 @@ func (mr *MockGroupInviteRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGroupInviteRepository)(nil).GetByID), ctx, id)
 }
+
+// ListByGroupID mocks base method.
+func (m *MockGroupInviteRepository) ListByGroupID(ctx context.Context, groupID string) ([]domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByGroupID", ctx, groupID)
+	ret0, _ := ret[0].([]domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByGroupID indicates an expected call of ListByGroupID.
+func (mr *MockGroupInviteRepositoryMockRecorder) ListByGroupID(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByGroupID", reflect.TypeOf((*MockGroupInviteRepository)(nil).ListByGroupID), ctx, groupID)
+}
+
+// ListPendingPersonalByEmail mocks base method.
+func (m *MockGroupInviteRepository) ListPendingPersonalByEmail(ctx context.Context, email string) ([]domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingPersonalByEmail", ctx, email)
+	ret0, _ := ret[0].([]domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingPersonalByEmail indicates an expected call of ListPendingPersonalByEmail.
+func (mr *MockGroupInviteRepositoryMockRecorder) ListPendingPersonalByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingPersonalByEmail", reflect.TypeOf((*MockGroupInviteRepository)(nil).ListPendingPersonalByEmail), ctx, email)
+}
+
+// ListPendingPersonalByGroupID mocks base method.
+func (m *MockGroupInviteRepository) ListPendingPersonalByGroupID(ctx context.Context, groupID string) ([]domain.GroupInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingPersonalByGroupID", ctx, groupID)
+	ret0, _ := ret[0].([]domain.GroupInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingPersonalByGroupID indicates an expected call of ListPendingPersonalByGroupID.
+func (mr *MockGroupInviteRepositoryMockRecorder) ListPendingPersonalByGroupID(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingPersonalByGroupID", reflect.TypeOf((*MockGroupInviteRepository)(nil).ListPendingPersonalByGroupID), ctx, groupID)
+}
+
+// ListRedemptions mocks base method.
+func (m *MockGroupInviteRepository) ListRedemptions(ctx context.Context, inviteID string) ([]domain.GroupInviteRedemption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRedemptions", ctx, inviteID)
+	ret0, _ := ret[0].([]domain.GroupInviteRedemption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRedemptions indicates an expected call of ListRedemptions.
+func (mr *MockGroupInviteRepositoryMockRecorder) ListRedemptions(ctx, inviteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRedemptions", reflect.TypeOf((*MockGroupInviteRepository)(nil).ListRedemptions), ctx, inviteID)
+}
+
+// Redeem mocks base method.
+func (m *MockGroupInviteRepository) Redeem(ctx context.Context, inviteID, userID string, redeemedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, inviteID, userID, redeemedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockGroupInviteRepositoryMockRecorder) Redeem(ctx, inviteID, userID, redeemedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockGroupInviteRepository)(nil).Redeem), ctx, inviteID, userID, redeemedAt)
+}
+
+// Rotate mocks base method.
+func (m *MockGroupInviteRepository) Rotate(ctx context.Context, groupInvite domain.GroupInvite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, groupInvite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockGroupInviteRepositoryMockRecorder) Rotate(ctx, groupInvite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockGroupInviteRepository)(nil).Rotate), ctx, groupInvite)
+}
+
+// Update mocks base method.
+func (m *MockGroupInviteRepository) Update(ctx context.Context, groupInvite domain.GroupInvite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, groupInvite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockGroupInviteRepositoryMockRecorder) Update(ctx, groupInvite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockGroupInviteRepository)(nil).Update), ctx, groupInvite)
+}
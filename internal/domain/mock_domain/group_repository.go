@@ -70,6 +70,21 @@ func (mr *MockGroupRepositoryMockRecorder) GetByID(ctx, groupID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGroupRepository)(nil).GetByID), ctx, groupID)
 }
 
+// ListClaimableGuests mocks base method.
+func (m *MockGroupRepository) ListClaimableGuests(ctx context.Context, email string) ([]domain.ClaimableGuest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClaimableGuests", ctx, email)
+	ret0, _ := ret[0].([]domain.ClaimableGuest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClaimableGuests indicates an expected call of ListClaimableGuests.
+func (mr *MockGroupRepositoryMockRecorder) ListClaimableGuests(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClaimableGuests", reflect.TypeOf((*MockGroupRepository)(nil).ListClaimableGuests), ctx, email)
+}
+
 // Search mocks base method.
 func (m *MockGroupRepository) Search(ctx context.Context, filters domain.GroupFilters) (*domain.SearchResult[domain.GroupSummary], error) {
 	m.ctrl.T.Helper()
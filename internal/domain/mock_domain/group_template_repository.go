@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: GroupTemplateRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/group_template_repository.go . GroupTemplateRepository
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGroupTemplateRepository is a mock of GroupTemplateRepository interface.
+type MockGroupTemplateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupTemplateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockGroupTemplateRepositoryMockRecorder is the mock recorder for MockGroupTemplateRepository.
+type MockGroupTemplateRepositoryMockRecorder struct {
+	mock *MockGroupTemplateRepository
+}
+
+// NewMockGroupTemplateRepository creates a new mock instance.
+func NewMockGroupTemplateRepository(ctrl *gomock.Controller) *MockGroupTemplateRepository {
+	mock := &MockGroupTemplateRepository{ctrl: ctrl}
+	mock.recorder = &MockGroupTemplateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupTemplateRepository) EXPECT() *MockGroupTemplateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockGroupTemplateRepository) Create(ctx context.Context, groupTemplate domain.GroupTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, groupTemplate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockGroupTemplateRepositoryMockRecorder) Create(ctx, groupTemplate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockGroupTemplateRepository)(nil).Create), ctx, groupTemplate)
+}
+
+// Delete mocks base method.
+func (m *MockGroupTemplateRepository) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGroupTemplateRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGroupTemplateRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockGroupTemplateRepository) GetByID(ctx context.Context, id string) (*domain.GroupTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.GroupTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockGroupTemplateRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockGroupTemplateRepository)(nil).GetByID), ctx, id)
+}
+
+// ListByOwnerID mocks base method.
+func (m *MockGroupTemplateRepository) ListByOwnerID(ctx context.Context, ownerID string) ([]domain.GroupTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOwnerID", ctx, ownerID)
+	ret0, _ := ret[0].([]domain.GroupTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOwnerID indicates an expected call of ListByOwnerID.
+func (mr *MockGroupTemplateRepositoryMockRecorder) ListByOwnerID(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOwnerID", reflect.TypeOf((*MockGroupTemplateRepository)(nil).ListByOwnerID), ctx, ownerID)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: ImageProcessor)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/image_processor.go . ImageProcessor
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImageProcessor is a mock of ImageProcessor interface.
+type MockImageProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageProcessorMockRecorder
+	isgomock struct{}
+}
+
+// MockImageProcessorMockRecorder is the mock recorder for MockImageProcessor.
+type MockImageProcessorMockRecorder struct {
+	mock *MockImageProcessor
+}
+
+// NewMockImageProcessor creates a new mock instance.
+func NewMockImageProcessor(ctrl *gomock.Controller) *MockImageProcessor {
+	mock := &MockImageProcessor{ctrl: ctrl}
+	mock.recorder = &MockImageProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageProcessor) EXPECT() *MockImageProcessorMockRecorder {
+	return m.recorder
+}
+
+// Thumbnails mocks base method.
+func (m *MockImageProcessor) Thumbnails(content []byte, sizes []int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Thumbnails", content, sizes)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Thumbnails indicates an expected call of Thumbnails.
+func (mr *MockImageProcessorMockRecorder) Thumbnails(content, sizes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Thumbnails", reflect.TypeOf((*MockImageProcessor)(nil).Thumbnails), content, sizes)
+}
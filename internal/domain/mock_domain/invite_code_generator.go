@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: InviteCodeGenerator)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/invite_code_generator.go . InviteCodeGenerator
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInviteCodeGenerator is a mock of InviteCodeGenerator interface.
+type MockInviteCodeGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteCodeGeneratorMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteCodeGeneratorMockRecorder is the mock recorder for MockInviteCodeGenerator.
+type MockInviteCodeGeneratorMockRecorder struct {
+	mock *MockInviteCodeGenerator
+}
+
+// NewMockInviteCodeGenerator creates a new mock instance.
+func NewMockInviteCodeGenerator(ctrl *gomock.Controller) *MockInviteCodeGenerator {
+	mock := &MockInviteCodeGenerator{ctrl: ctrl}
+	mock.recorder = &MockInviteCodeGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteCodeGenerator) EXPECT() *MockInviteCodeGeneratorMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockInviteCodeGenerator) Generate() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockInviteCodeGeneratorMockRecorder) Generate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockInviteCodeGenerator)(nil).Generate))
+}
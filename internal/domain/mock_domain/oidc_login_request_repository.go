@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: OIDCLoginRequestRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/oidc_login_request_repository.go . OIDCLoginRequestRepository
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOIDCLoginRequestRepository is a mock of OIDCLoginRequestRepository interface.
+type MockOIDCLoginRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOIDCLoginRequestRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOIDCLoginRequestRepositoryMockRecorder is the mock recorder for MockOIDCLoginRequestRepository.
+type MockOIDCLoginRequestRepositoryMockRecorder struct {
+	mock *MockOIDCLoginRequestRepository
+}
+
+// NewMockOIDCLoginRequestRepository creates a new mock instance.
+func NewMockOIDCLoginRequestRepository(ctrl *gomock.Controller) *MockOIDCLoginRequestRepository {
+	mock := &MockOIDCLoginRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockOIDCLoginRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOIDCLoginRequestRepository) EXPECT() *MockOIDCLoginRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOIDCLoginRequestRepository) Create(ctx context.Context, loginRequest domain.OIDCLoginRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, loginRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOIDCLoginRequestRepositoryMockRecorder) Create(ctx, loginRequest any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOIDCLoginRequestRepository)(nil).Create), ctx, loginRequest)
+}
+
+// GetByStateHash mocks base method.
+func (m *MockOIDCLoginRequestRepository) GetByStateHash(ctx context.Context, stateHash string) (*domain.OIDCLoginRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByStateHash", ctx, stateHash)
+	ret0, _ := ret[0].(*domain.OIDCLoginRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByStateHash indicates an expected call of GetByStateHash.
+func (mr *MockOIDCLoginRequestRepositoryMockRecorder) GetByStateHash(ctx, stateHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByStateHash", reflect.TypeOf((*MockOIDCLoginRequestRepository)(nil).GetByStateHash), ctx, stateHash)
+}
+
+// Redeem mocks base method.
+func (m *MockOIDCLoginRequestRepository) Redeem(ctx context.Context, loginRequestID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, loginRequestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockOIDCLoginRequestRepositoryMockRecorder) Redeem(ctx, loginRequestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockOIDCLoginRequestRepository)(nil).Redeem), ctx, loginRequestID)
+}
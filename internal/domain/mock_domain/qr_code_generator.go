@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: QRCodeGenerator)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/qr_code_generator.go . QRCodeGenerator
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	reflect "reflect"
+
+	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQRCodeGenerator is a mock of QRCodeGenerator interface.
+type MockQRCodeGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockQRCodeGeneratorMockRecorder
+	isgomock struct{}
+}
+
+// MockQRCodeGeneratorMockRecorder is the mock recorder for MockQRCodeGenerator.
+type MockQRCodeGeneratorMockRecorder struct {
+	mock *MockQRCodeGenerator
+}
+
+// NewMockQRCodeGenerator creates a new mock instance.
+func NewMockQRCodeGenerator(ctrl *gomock.Controller) *MockQRCodeGenerator {
+	mock := &MockQRCodeGenerator{ctrl: ctrl}
+	mock.recorder = &MockQRCodeGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQRCodeGenerator) EXPECT() *MockQRCodeGeneratorMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockQRCodeGenerator) Generate(content string, format domain.QRCodeFormat) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", content, format)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockQRCodeGeneratorMockRecorder) Generate(content, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockQRCodeGenerator)(nil).Generate), content, format)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: RecoveryCodeGenerator)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/recovery_code_generator.go . RecoveryCodeGenerator
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecoveryCodeGenerator is a mock of RecoveryCodeGenerator interface.
+type MockRecoveryCodeGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecoveryCodeGeneratorMockRecorder
+	isgomock struct{}
+}
+
+// MockRecoveryCodeGeneratorMockRecorder is the mock recorder for MockRecoveryCodeGenerator.
+type MockRecoveryCodeGeneratorMockRecorder struct {
+	mock *MockRecoveryCodeGenerator
+}
+
+// NewMockRecoveryCodeGenerator creates a new mock instance.
+func NewMockRecoveryCodeGenerator(ctrl *gomock.Controller) *MockRecoveryCodeGenerator {
+	mock := &MockRecoveryCodeGenerator{ctrl: ctrl}
+	mock.recorder = &MockRecoveryCodeGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecoveryCodeGenerator) EXPECT() *MockRecoveryCodeGeneratorMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockRecoveryCodeGenerator) Generate() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockRecoveryCodeGeneratorMockRecorder) Generate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockRecoveryCodeGenerator)(nil).Generate))
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: SecretTokenGenerator)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/secret_token_generator.go . SecretTokenGenerator
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSecretTokenGenerator is a mock of SecretTokenGenerator interface.
+type MockSecretTokenGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretTokenGeneratorMockRecorder
+	isgomock struct{}
+}
+
+// MockSecretTokenGeneratorMockRecorder is the mock recorder for MockSecretTokenGenerator.
+type MockSecretTokenGeneratorMockRecorder struct {
+	mock *MockSecretTokenGenerator
+}
+
+// NewMockSecretTokenGenerator creates a new mock instance.
+func NewMockSecretTokenGenerator(ctrl *gomock.Controller) *MockSecretTokenGenerator {
+	mock := &MockSecretTokenGenerator{ctrl: ctrl}
+	mock.recorder = &MockSecretTokenGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretTokenGenerator) EXPECT() *MockSecretTokenGeneratorMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockSecretTokenGenerator) Generate() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockSecretTokenGeneratorMockRecorder) Generate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockSecretTokenGenerator)(nil).Generate))
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: TOTPManager)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/totp_manager.go . TOTPManager
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTOTPManager is a mock of TOTPManager interface.
+type MockTOTPManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTOTPManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockTOTPManagerMockRecorder is the mock recorder for MockTOTPManager.
+type MockTOTPManagerMockRecorder struct {
+	mock *MockTOTPManager
+}
+
+// NewMockTOTPManager creates a new mock instance.
+func NewMockTOTPManager(ctrl *gomock.Controller) *MockTOTPManager {
+	mock := &MockTOTPManager{ctrl: ctrl}
+	mock.recorder = &MockTOTPManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTOTPManager) EXPECT() *MockTOTPManagerMockRecorder {
+	return m.recorder
+}
+
+// GenerateSecret mocks base method.
+func (m *MockTOTPManager) GenerateSecret() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSecret")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSecret indicates an expected call of GenerateSecret.
+func (mr *MockTOTPManagerMockRecorder) GenerateSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSecret", reflect.TypeOf((*MockTOTPManager)(nil).GenerateSecret))
+}
+
+// URI mocks base method.
+func (m *MockTOTPManager) URI(secret, accountName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "URI", secret, accountName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// URI indicates an expected call of URI.
+func (mr *MockTOTPManagerMockRecorder) URI(secret, accountName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "URI", reflect.TypeOf((*MockTOTPManager)(nil).URI), secret, accountName)
+}
+
+// Validate mocks base method.
+func (m *MockTOTPManager) Validate(secret, code string, at time.Time) (int64, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", secret, code, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockTOTPManagerMockRecorder) Validate(secret, code, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockTOTPManager)(nil).Validate), secret, code, at)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/waliqueiroz/mystery-gifter-api/internal/domain (interfaces: TwoFactorChallengeRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination mock_domain/two_factor_challenge_repository.go . TwoFactorChallengeRepository
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTwoFactorChallengeRepository is a mock of TwoFactorChallengeRepository interface.
+type MockTwoFactorChallengeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTwoFactorChallengeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTwoFactorChallengeRepositoryMockRecorder is the mock recorder for MockTwoFactorChallengeRepository.
+type MockTwoFactorChallengeRepositoryMockRecorder struct {
+	mock *MockTwoFactorChallengeRepository
+}
+
+// NewMockTwoFactorChallengeRepository creates a new mock instance.
+func NewMockTwoFactorChallengeRepository(ctrl *gomock.Controller) *MockTwoFactorChallengeRepository {
+	mock := &MockTwoFactorChallengeRepository{ctrl: ctrl}
+	mock.recorder = &MockTwoFactorChallengeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTwoFactorChallengeRepository) EXPECT() *MockTwoFactorChallengeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTwoFactorChallengeRepository) Create(ctx context.Context, challenge domain.TwoFactorChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTwoFactorChallengeRepositoryMockRecorder) Create(ctx, challenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTwoFactorChallengeRepository)(nil).Create), ctx, challenge)
+}
+
+// GetByTokenHash mocks base method.
+func (m *MockTwoFactorChallengeRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.TwoFactorChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*domain.TwoFactorChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTokenHash indicates an expected call of GetByTokenHash.
+func (mr *MockTwoFactorChallengeRepositoryMockRecorder) GetByTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTokenHash", reflect.TypeOf((*MockTwoFactorChallengeRepository)(nil).GetByTokenHash), ctx, tokenHash)
+}
+
+// Redeem mocks base method.
+func (m *MockTwoFactorChallengeRepository) Redeem(ctx context.Context, challengeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeem", ctx, challengeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redeem indicates an expected call of Redeem.
+func (mr *MockTwoFactorChallengeRepositoryMockRecorder) Redeem(ctx, challengeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeem", reflect.TypeOf((*MockTwoFactorChallengeRepository)(nil).Redeem), ctx, challengeID)
+}
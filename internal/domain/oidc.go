@@ -0,0 +1,148 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/oidc_provider.go . OIDCProvider
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/oidc_login_request_repository.go . OIDCLoginRequestRepository
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/user_identity_repository.go . UserIdentityRepository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// OIDCLoginRequestDuration is how long a user has to sign in at the identity provider and come back.
+const OIDCLoginRequestDuration = 10 * time.Minute
+
+// OIDCProvider signs users in with an OpenID Connect identity provider, using the authorization code flow with PKCE.
+type OIDCProvider interface {
+	// AuthorizationURL returns the address of the provider sign-in page that users are sent to.
+	AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange redeems the authorization code and returns the identity from the verified ID token, failing with an
+	// unauthorized error when the provider rejects the code or the ID token is not valid.
+	Exchange(ctx context.Context, code, codeVerifier string) (*ExternalIdentity, error)
+}
+
+type OIDCLoginRequestRepository interface {
+	Create(ctx context.Context, loginRequest OIDCLoginRequest) error
+	GetByStateHash(ctx context.Context, stateHash string) (*OIDCLoginRequest, error)
+	// Redeem marks the login request as used, failing with an unauthorized error when it has already been used or has expired.
+	Redeem(ctx context.Context, loginRequestID string) error
+}
+
+type UserIdentityRepository interface {
+	// GetByProviderSubject fails with a ResourceNotFoundError when the external identity was never linked.
+	GetByProviderSubject(ctx context.Context, provider, subject string) (*UserIdentity, error)
+	// Create links the external identity, failing with a conflict when it, or another identity of the same provider,
+	// is already linked to the user.
+	Create(ctx context.Context, userIdentity UserIdentity) error
+}
+
+// ExternalIdentity is who the identity provider says signed in, taken from the claims of the ID token.
+type ExternalIdentity struct {
+	// Provider is the issuer of the ID token, which together with Subject identifies the user for good.
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Nonce         string
+}
+
+// OIDCAuthorization is where to send the user to sign in, along with the state the provider hands back.
+type OIDCAuthorization struct {
+	URL   string
+	State string
+}
+
+// OIDCLoginRequest keeps what is needed to finish a sign-in started with the identity provider. Only the hash of the
+// state is stored, so it can be looked up when the provider sends the user back.
+type OIDCLoginRequest struct {
+	ID           string `validate:"required,uuid"`
+	StateHash    string `validate:"required,len=64"`
+	Nonce        string `validate:"required"`
+	CodeVerifier string `validate:"required,min=43,max=128"`
+	UsedAt       *time.Time
+	ExpiresAt    time.Time `validate:"required"`
+	CreatedAt    time.Time `validate:"required"`
+}
+
+func NewOIDCLoginRequest(identityGenerator IdentityGenerator, state, nonce, codeVerifier string) (*OIDCLoginRequest, error) {
+	id, err := identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	loginRequest := OIDCLoginRequest{
+		ID:           id,
+		StateHash:    HashSecretToken(state),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    now.Add(OIDCLoginRequestDuration),
+		CreatedAt:    now,
+	}
+
+	if err := loginRequest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &loginRequest, nil
+}
+
+func (r *OIDCLoginRequest) Validate() error {
+	if errs := validator.Validate(r); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+// IsUsable reports whether the sign-in can still be finished.
+func (r *OIDCLoginRequest) IsUsable() bool {
+	return r.UsedAt == nil && time.Now().Before(r.ExpiresAt)
+}
+
+// PKCEChallenge derives the S256 code challenge of RFC 7636 from the code verifier.
+func PKCEChallenge(codeVerifier string) string {
+	hash := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// UserIdentity links an account of the identity provider to a user, so later sign-ins no longer depend on the email.
+type UserIdentity struct {
+	ID        string    `validate:"required,uuid"`
+	UserID    string    `validate:"required,uuid"`
+	Provider  string    `validate:"required"`
+	Subject   string    `validate:"required"`
+	CreatedAt time.Time `validate:"required"`
+}
+
+func NewUserIdentity(identityGenerator IdentityGenerator, userID string, externalIdentity ExternalIdentity) (*UserIdentity, error) {
+	id, err := identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	userIdentity := UserIdentity{
+		ID:        id,
+		UserID:    userID,
+		Provider:  externalIdentity.Provider,
+		Subject:   externalIdentity.Subject,
+		CreatedAt: time.Now(),
+	}
+
+	if err := userIdentity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &userIdentity, nil
+}
+
+func (i *UserIdentity) Validate() error {
+	if errs := validator.Validate(i); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_NewOIDCLoginRequest(t *testing.T) {
+	t.Run("should store only the hash of the state and expire shortly", func(t *testing.T) {
+		// given
+		id := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		codeVerifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(id, nil)
+
+		// when
+		loginRequest, err := domain.NewOIDCLoginRequest(mockedIdentityGenerator, "some-state", "some-nonce", codeVerifier)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, id, loginRequest.ID)
+		assert.Equal(t, domain.HashSecretToken("some-state"), loginRequest.StateHash)
+		assert.Equal(t, "some-nonce", loginRequest.Nonce)
+		assert.Equal(t, codeVerifier, loginRequest.CodeVerifier)
+		assert.WithinDuration(t, time.Now().Add(domain.OIDCLoginRequestDuration), loginRequest.ExpiresAt, time.Second)
+		assert.True(t, loginRequest.IsUsable())
+	})
+
+	t.Run("should return validation error when the code verifier is too short", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		// when
+		loginRequest, err := domain.NewOIDCLoginRequest(mockedIdentityGenerator, "some-state", "some-nonce", "short")
+
+		// then
+		assert.Nil(t, loginRequest)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_OIDCLoginRequest_IsUsable(t *testing.T) {
+	t.Run("should not be usable once used", func(t *testing.T) {
+		// given
+		usedAt := time.Now()
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().WithUsedAt(&usedAt).Build()
+
+		// when
+		usable := loginRequest.IsUsable()
+
+		// then
+		assert.False(t, usable)
+	})
+
+	t.Run("should not be usable once expired", func(t *testing.T) {
+		// given
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().WithExpiresAt(time.Now().Add(-time.Second)).Build()
+
+		// when
+		usable := loginRequest.IsUsable()
+
+		// then
+		assert.False(t, usable)
+	})
+}
+
+func Test_PKCEChallenge(t *testing.T) {
+	t.Run("should derive the S256 challenge of RFC 7636", func(t *testing.T) {
+		// when
+		challenge := domain.PKCEChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+
+		// then
+		assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", challenge)
+	})
+}
+
+func Test_NewUserIdentity(t *testing.T) {
+	t.Run("should link the issuer and subject of the external identity to the user", func(t *testing.T) {
+		// given
+		userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+		externalIdentity := domain.ExternalIdentity{Provider: "https://idp.example.com", Subject: "248289761001", Email: "user@example.com"}
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		// when
+		userIdentity, err := domain.NewUserIdentity(mockedIdentityGenerator, userID, externalIdentity)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, userID, userIdentity.UserID)
+		assert.Equal(t, "https://idp.example.com", userIdentity.Provider)
+		assert.Equal(t, "248289761001", userIdentity.Subject)
+	})
+
+	t.Run("should return validation error when the subject is missing", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		// when
+		userIdentity, err := domain.NewUserIdentity(mockedIdentityGenerator, "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e", domain.ExternalIdentity{Provider: "https://idp.example.com"})
+
+		// then
+		assert.Nil(t, userIdentity)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
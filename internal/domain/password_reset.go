@@ -0,0 +1,73 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/password_reset_repository.go . PasswordResetRepository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+type PasswordResetRepository interface {
+	Create(ctx context.Context, passwordResetToken PasswordResetToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
+	// Redeem marks the token as used, stores the new password of the user and revokes their sessions in a single
+	// transaction, failing with a conflict when the token has already been used or has expired.
+	Redeem(ctx context.Context, tokenID string, user User) error
+}
+
+// PasswordResetToken lets a user choose a new password once. Only the hash of the token sent by email is stored,
+// so a leaked database cannot be used to take over accounts.
+type PasswordResetToken struct {
+	ID        string `validate:"required,uuid"`
+	UserID    string `validate:"required,uuid"`
+	TokenHash string `validate:"required,len=64"`
+	UsedAt    *time.Time
+	ExpiresAt time.Time `validate:"required"`
+	CreatedAt time.Time `validate:"required"`
+}
+
+func NewPasswordResetToken(identityGenerator IdentityGenerator, userID, token string, expiration time.Duration) (*PasswordResetToken, error) {
+	id, err := identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	passwordResetToken := PasswordResetToken{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: HashSecretToken(token),
+		ExpiresAt: now.Add(expiration),
+		CreatedAt: now,
+	}
+
+	if err := passwordResetToken.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &passwordResetToken, nil
+}
+
+// HashSecretToken returns the hex encoded SHA-256 of a token. Secret tokens are long and random,
+// so a fast unsalted hash is enough to keep them out of the database.
+func HashSecretToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *PasswordResetToken) Validate() error {
+	if errs := validator.Validate(t); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+// IsUsable reports whether the token can still be exchanged for a new password.
+func (t *PasswordResetToken) IsUsable() bool {
+	return t.UsedAt == nil && time.Now().Before(t.ExpiresAt)
+}
@@ -0,0 +1,114 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_NewPasswordResetToken(t *testing.T) {
+	t.Run("should store only the hash of the token", func(t *testing.T) {
+		// given
+		id := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(id, nil)
+
+		// when
+		passwordResetToken, err := domain.NewPasswordResetToken(mockedIdentityGenerator, userID, "secret-token", time.Hour)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, id, passwordResetToken.ID)
+		assert.Equal(t, userID, passwordResetToken.UserID)
+		assert.Equal(t, domain.HashSecretToken("secret-token"), passwordResetToken.TokenHash)
+		assert.NotContains(t, passwordResetToken.TokenHash, "secret-token")
+		assert.WithinDuration(t, time.Now().Add(time.Hour), passwordResetToken.ExpiresAt, time.Second)
+		assert.Nil(t, passwordResetToken.UsedAt)
+	})
+
+	t.Run("should return error when identity generation fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
+
+		// when
+		passwordResetToken, err := domain.NewPasswordResetToken(mockedIdentityGenerator, "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e", "secret-token", time.Hour)
+
+		// then
+		assert.Nil(t, passwordResetToken)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return validation error when the user ID is invalid", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		// when
+		passwordResetToken, err := domain.NewPasswordResetToken(mockedIdentityGenerator, "invalid", "secret-token", time.Hour)
+
+		// then
+		assert.Nil(t, passwordResetToken)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_HashSecretToken(t *testing.T) {
+	t.Run("should return the same 64 character hash for the same token", func(t *testing.T) {
+		// when
+		first := domain.HashSecretToken("secret-token")
+		second := domain.HashSecretToken("secret-token")
+
+		// then
+		assert.Len(t, first, 64)
+		assert.Equal(t, first, second)
+		assert.NotEqual(t, first, domain.HashSecretToken("other-token"))
+	})
+}
+
+func Test_PasswordResetToken_IsUsable(t *testing.T) {
+	t.Run("should be usable when not used and not expired", func(t *testing.T) {
+		// given
+		passwordResetToken := build_domain.NewPasswordResetTokenBuilder().Build()
+
+		// when
+		result := passwordResetToken.IsUsable()
+
+		// then
+		assert.True(t, result)
+	})
+
+	t.Run("should not be usable once used", func(t *testing.T) {
+		// given
+		usedAt := time.Now()
+		passwordResetToken := build_domain.NewPasswordResetTokenBuilder().WithUsedAt(&usedAt).Build()
+
+		// when
+		result := passwordResetToken.IsUsable()
+
+		// then
+		assert.False(t, result)
+	})
+
+	t.Run("should not be usable once expired", func(t *testing.T) {
+		// given
+		passwordResetToken := build_domain.NewPasswordResetTokenBuilder().WithExpiresAt(time.Now().Add(-time.Minute)).Build()
+
+		// when
+		result := passwordResetToken.IsUsable()
+
+		// then
+		assert.False(t, result)
+	})
+}
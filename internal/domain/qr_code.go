@@ -0,0 +1,28 @@
+package domain
+
+import "github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/qr_code_generator.go . QRCodeGenerator
+
+type QRCodeFormat string
+
+const (
+	QRCodeFormatPNG QRCodeFormat = "png"
+	QRCodeFormatSVG QRCodeFormat = "svg"
+)
+
+// NewQRCodeFormat parses the requested image format, defaulting to PNG when none is given.
+func NewQRCodeFormat(format string) (QRCodeFormat, error) {
+	switch QRCodeFormat(format) {
+	case "", QRCodeFormatPNG:
+		return QRCodeFormatPNG, nil
+	case QRCodeFormatSVG:
+		return QRCodeFormatSVG, nil
+	default:
+		return "", NewValidationError(validator.ValidationErrors{{Field: "Format", Error: "Format must be one of [png svg]"}})
+	}
+}
+
+type QRCodeGenerator interface {
+	Generate(content string, format QRCodeFormat) ([]byte, error)
+}
@@ -0,0 +1,38 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+func Test_NewQRCodeFormat(t *testing.T) {
+	t.Run("should default to PNG when no format is given", func(t *testing.T) {
+		// when
+		format, err := domain.NewQRCodeFormat("")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.QRCodeFormatPNG, format)
+	})
+
+	t.Run("should accept SVG", func(t *testing.T) {
+		// when
+		format, err := domain.NewQRCodeFormat("svg")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.QRCodeFormatSVG, format)
+	})
+
+	t.Run("should return validation error for unsupported formats", func(t *testing.T) {
+		// when
+		format, err := domain.NewQRCodeFormat("gif")
+
+		// then
+		assert.Empty(t, format)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
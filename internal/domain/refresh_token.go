@@ -0,0 +1,83 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/refresh_token_repository.go . RefreshTokenRepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+type RefreshTokenRepository interface {
+	GetByTokenHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// Rotate marks the token as used, stores its replacement and extends the session until the replacement expires,
+	// in a single transaction. It fails with a conflict when the token has already been used, has been revoked or
+	// has expired.
+	Rotate(ctx context.Context, tokenID string, replacement RefreshToken) error
+}
+
+// RefreshToken lets a client get a new access token without asking for the password again. Every refresh replaces
+// the token with a new one of the same session, so a token presented twice means it was stolen and the whole session
+// is revoked. As with password reset tokens, only the hash of the token is stored.
+type RefreshToken struct {
+	ID        string `validate:"required,uuid"`
+	UserID    string `validate:"required,uuid"`
+	SessionID string `validate:"required,uuid"`
+	TokenHash string `validate:"required,len=64"`
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	ExpiresAt time.Time `validate:"required"`
+	CreatedAt time.Time `validate:"required"`
+}
+
+// NewRefreshToken creates the first token of a session, issued at login.
+func NewRefreshToken(identityGenerator IdentityGenerator, session Session, token string, expiration time.Duration) (*RefreshToken, error) {
+	return newRefreshToken(identityGenerator, session.UserID, session.ID, token, expiration)
+}
+
+func newRefreshToken(identityGenerator IdentityGenerator, userID, sessionID, token string, expiration time.Duration) (*RefreshToken, error) {
+	id, err := identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	refreshToken := RefreshToken{
+		ID:        id,
+		UserID:    userID,
+		SessionID: sessionID,
+		TokenHash: HashSecretToken(token),
+		ExpiresAt: now.Add(expiration),
+		CreatedAt: now,
+	}
+
+	if err := refreshToken.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &refreshToken, nil
+}
+
+func (t *RefreshToken) Validate() error {
+	if errs := validator.Validate(t); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+// Rotate creates the token that replaces this one, in the same session.
+func (t *RefreshToken) Rotate(identityGenerator IdentityGenerator, token string, expiration time.Duration) (*RefreshToken, error) {
+	return newRefreshToken(identityGenerator, t.UserID, t.SessionID, token, expiration)
+}
+
+// IsUsed reports whether the token has already been exchanged, which means it is being replayed.
+func (t *RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsUsable reports whether the token can still be exchanged for a new session.
+func (t *RefreshToken) IsUsable() bool {
+	return t.UsedAt == nil && t.RevokedAt == nil && time.Now().Before(t.ExpiresAt)
+}
@@ -0,0 +1,122 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_NewRefreshToken(t *testing.T) {
+	t.Run("should belong to the session and store only the hash of the token", func(t *testing.T) {
+		// given
+		id := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		session := build_domain.NewSessionBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(id, nil)
+
+		// when
+		refreshToken, err := domain.NewRefreshToken(mockedIdentityGenerator, session, "refresh-token", time.Hour)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, id, refreshToken.ID)
+		assert.Equal(t, session.ID, refreshToken.SessionID)
+		assert.Equal(t, session.UserID, refreshToken.UserID)
+		assert.Equal(t, domain.HashSecretToken("refresh-token"), refreshToken.TokenHash)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), refreshToken.ExpiresAt, time.Second)
+		assert.True(t, refreshToken.IsUsable())
+	})
+
+	t.Run("should return error when identity generation fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
+
+		// when
+		refreshToken, err := domain.NewRefreshToken(mockedIdentityGenerator, build_domain.NewSessionBuilder().Build(), "refresh-token", time.Hour)
+
+		// then
+		assert.Nil(t, refreshToken)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_RefreshToken_Rotate(t *testing.T) {
+	t.Run("should create a replacement in the same session", func(t *testing.T) {
+		// given
+		refreshToken := build_domain.NewRefreshTokenBuilder().Build()
+		id := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(id, nil)
+
+		// when
+		replacement, err := refreshToken.Rotate(mockedIdentityGenerator, "new-refresh-token", time.Hour)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, id, replacement.ID)
+		assert.Equal(t, refreshToken.SessionID, replacement.SessionID)
+		assert.Equal(t, refreshToken.UserID, replacement.UserID)
+		assert.Equal(t, domain.HashSecretToken("new-refresh-token"), replacement.TokenHash)
+	})
+}
+
+func Test_RefreshToken_IsUsable(t *testing.T) {
+	t.Run("should be usable when not used, not revoked and not expired", func(t *testing.T) {
+		// given
+		refreshToken := build_domain.NewRefreshTokenBuilder().Build()
+
+		// when
+		result := refreshToken.IsUsable()
+
+		// then
+		assert.True(t, result)
+	})
+
+	t.Run("should not be usable once used", func(t *testing.T) {
+		// given
+		usedAt := time.Now()
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithUsedAt(&usedAt).Build()
+
+		// when
+		result := refreshToken.IsUsable()
+
+		// then
+		assert.False(t, result)
+		assert.True(t, refreshToken.IsUsed())
+	})
+
+	t.Run("should not be usable once revoked", func(t *testing.T) {
+		// given
+		revokedAt := time.Now()
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithRevokedAt(&revokedAt).Build()
+
+		// when
+		result := refreshToken.IsUsable()
+
+		// then
+		assert.False(t, result)
+		assert.False(t, refreshToken.IsUsed())
+	})
+
+	t.Run("should not be usable once expired", func(t *testing.T) {
+		// given
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithExpiresAt(time.Now().Add(-time.Minute)).Build()
+
+		// when
+		result := refreshToken.IsUsable()
+
+		// then
+		assert.False(t, result)
+	})
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 //go:generate go run go.uber.org/mock/mockgen -destination mock_domain/auth_token_manager.go . AuthTokenManager
 //go:generate go run go.uber.org/mock/mockgen -destination mock_domain/password_manager.go . PasswordManager
 
@@ -9,7 +11,11 @@ type PasswordManager interface {
 }
 
 type AuthTokenManager interface {
-	Create(userID string, expiresIn int64) (string, error)
+	Create(userID, sessionID string, expiresIn int64) (string, error)
 	GetTokenType() string
 	GetAuthUserID(token any) (string, error)
+	// GetSessionID returns the session the token was issued for, or an empty string for tokens that do not carry it.
+	GetSessionID(token any) (string, error)
+	// GetIssuedAt returns when the token was created, or the zero time for tokens that do not carry it.
+	GetIssuedAt(token any) (time.Time, error)
 }
@@ -0,0 +1,122 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/session_repository.go . SessionRepository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+type SessionRepository interface {
+	// Create stores a new session together with its first refresh token in a single transaction.
+	Create(ctx context.Context, session Session, refreshToken RefreshToken) error
+	GetByID(ctx context.Context, id string) (*Session, error)
+	// ListActiveByUserID returns the sessions of the user that were neither revoked nor expired, most recently seen first.
+	ListActiveByUserID(ctx context.Context, userID string) ([]Session, error)
+	// ListByUserID returns every session of the user, including revoked and expired ones, most recent first.
+	ListByUserID(ctx context.Context, userID string) ([]Session, error)
+	// UpdateActivity stores the device and last-seen time of the session.
+	UpdateActivity(ctx context.Context, session Session) error
+	// Revoke ends the session and revokes its refresh tokens.
+	Revoke(ctx context.Context, id string) error
+	// RevokeAllByUserID ends every session of the user and revokes their refresh tokens.
+	RevokeAllByUserID(ctx context.Context, userID string) error
+}
+
+// sessionActivityInterval is how stale the last-seen time of a session may get before a request from the same device
+// updates it.
+const sessionActivityInterval = time.Minute
+
+// maxUserAgentLength is how much of the user agent is kept, since clients can send a header of any size.
+const maxUserAgentLength = 512
+
+// Device describes where a session is used from, as reported by the client.
+type Device struct {
+	UserAgent string
+	IPAddress string
+}
+
+func NewDevice(userAgent, ipAddress string) Device {
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = strings.ToValidUTF8(userAgent[:maxUserAgentLength], "")
+	}
+
+	return Device{
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+	}
+}
+
+// Session is a login on one device. Its ID is carried by every access token issued for it, so ending the session
+// rejects those tokens right away instead of waiting for them to expire. It lasts as long as its refresh tokens
+// keep being renewed.
+type Session struct {
+	ID         string `validate:"required,uuid"`
+	UserID     string `validate:"required,uuid"`
+	UserAgent  string
+	IPAddress  string
+	LastSeenAt time.Time `validate:"required"`
+	RevokedAt  *time.Time
+	ExpiresAt  time.Time `validate:"required"`
+	CreatedAt  time.Time `validate:"required"`
+}
+
+func NewSession(identityGenerator IdentityGenerator, userID string, device Device, expiration time.Duration) (*Session, error) {
+	id, err := identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	session := Session{
+		ID:         id,
+		UserID:     userID,
+		UserAgent:  device.UserAgent,
+		IPAddress:  device.IPAddress,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(expiration),
+		CreatedAt:  now,
+	}
+
+	if err := session.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (s *Session) Validate() error {
+	if errs := validator.Validate(s); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+func (s *Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+// BelongsTo reports whether the session was started by the user, so one user cannot act on the sessions of another.
+func (s *Session) BelongsTo(userID string) bool {
+	return s.UserID == userID
+}
+
+// RecordActivity notes that the session was just used from the device. It reports whether anything worth storing
+// changed, so a busy client does not cause a write on every request.
+func (s *Session) RecordActivity(device Device) bool {
+	now := time.Now()
+
+	if s.UserAgent == device.UserAgent && s.IPAddress == device.IPAddress && now.Sub(s.LastSeenAt) < sessionActivityInterval {
+		return false
+	}
+
+	s.UserAgent = device.UserAgent
+	s.IPAddress = device.IPAddress
+	s.LastSeenAt = now
+
+	return true
+}
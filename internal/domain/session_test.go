@@ -0,0 +1,133 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_NewSession(t *testing.T) {
+	t.Run("should create a session that lasts as long as its first refresh token", func(t *testing.T) {
+		// given
+		id := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+		device := domain.NewDevice("some-user-agent", "203.0.113.10")
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(id, nil)
+
+		// when
+		session, err := domain.NewSession(mockedIdentityGenerator, userID, device, time.Hour)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, id, session.ID)
+		assert.Equal(t, userID, session.UserID)
+		assert.Equal(t, "some-user-agent", session.UserAgent)
+		assert.Equal(t, "203.0.113.10", session.IPAddress)
+		assert.WithinDuration(t, time.Now(), session.LastSeenAt, time.Second)
+		assert.False(t, session.IsRevoked())
+		assert.WithinDuration(t, time.Now().Add(time.Hour), session.ExpiresAt, time.Second)
+	})
+
+	t.Run("should return error when identity generation fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
+
+		// when
+		session, err := domain.NewSession(mockedIdentityGenerator, "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e", domain.Device{}, time.Hour)
+
+		// then
+		assert.Nil(t, session)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should return validation error when the user ID is invalid", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		// when
+		session, err := domain.NewSession(mockedIdentityGenerator, "invalid", domain.Device{}, time.Hour)
+
+		// then
+		assert.Nil(t, session)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_Session_BelongsTo(t *testing.T) {
+	t.Run("should only belong to the user who started it", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().Build()
+
+		// when
+		owner := session.BelongsTo(session.UserID)
+		other := session.BelongsTo("some-other-user-id")
+
+		// then
+		assert.True(t, owner)
+		assert.False(t, other)
+	})
+}
+
+func Test_NewDevice(t *testing.T) {
+	t.Run("should cut a long user agent", func(t *testing.T) {
+		// when
+		device := domain.NewDevice(strings.Repeat("a", 1000), "203.0.113.10")
+
+		// then
+		assert.Len(t, device.UserAgent, 512)
+		assert.Equal(t, "203.0.113.10", device.IPAddress)
+	})
+}
+
+func Test_Session_RecordActivity(t *testing.T) {
+	t.Run("should not report a change when the same device was seen recently", func(t *testing.T) {
+		// given
+		lastSeenAt := time.Now().Add(-10 * time.Second)
+		session := build_domain.NewSessionBuilder().WithLastSeenAt(lastSeenAt).Build()
+
+		// when
+		changed := session.RecordActivity(domain.NewDevice(session.UserAgent, session.IPAddress))
+
+		// then
+		assert.False(t, changed)
+		assert.Equal(t, lastSeenAt, session.LastSeenAt)
+	})
+
+	t.Run("should record a new address right away", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().WithLastSeenAt(time.Now()).Build()
+
+		// when
+		changed := session.RecordActivity(domain.NewDevice(session.UserAgent, "198.51.100.7"))
+
+		// then
+		assert.True(t, changed)
+		assert.Equal(t, "198.51.100.7", session.IPAddress)
+	})
+
+	t.Run("should refresh a stale last-seen time", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().WithLastSeenAt(time.Now().Add(-time.Hour)).Build()
+
+		// when
+		changed := session.RecordActivity(domain.NewDevice(session.UserAgent, session.IPAddress))
+
+		// then
+		assert.True(t, changed)
+		assert.WithinDuration(t, time.Now(), session.LastSeenAt, time.Second)
+	})
+}
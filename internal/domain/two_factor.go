@@ -0,0 +1,210 @@
+package domain
+
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/two_factor_repository.go . TwoFactorRepository
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/two_factor_challenge_repository.go . TwoFactorChallengeRepository
+//go:generate go run go.uber.org/mock/mockgen -destination mock_domain/totp_manager.go . TOTPManager
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+const (
+	// RecoveryCodeCount is how many recovery codes are handed out when two-factor authentication is enabled.
+	RecoveryCodeCount = 10
+	// TwoFactorChallengeDuration is how long a user has to enter their code after the password was accepted.
+	TwoFactorChallengeDuration = 5 * time.Minute
+)
+
+var totpCodePattern = regexp.MustCompile(`^[0-9]{6}$`)
+
+type TwoFactorRepository interface {
+	// GetByUserID fails with a ResourceNotFoundError when the user never started an enrollment.
+	GetByUserID(ctx context.Context, userID string) (*TwoFactor, error)
+	// Save stores a pending enrollment, replacing an earlier one that was never enabled.
+	Save(ctx context.Context, twoFactor TwoFactor) error
+	// Enable turns on a pending enrollment and replaces the recovery codes of the user in a single transaction,
+	// failing with a conflict when it was enabled meanwhile.
+	Enable(ctx context.Context, twoFactor TwoFactor, recoveryCodes []RecoveryCode) error
+	// UseStep records the time step of an accepted code, failing with an unauthorized error when that step or a
+	// later one was already used, so a code cannot be replayed.
+	UseStep(ctx context.Context, userID string, step int64) error
+	// UseRecoveryCode marks an unused recovery code as used, failing with an unauthorized error when there is none.
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) error
+	// Delete turns two-factor authentication off, removing the secret and the recovery codes.
+	Delete(ctx context.Context, userID string) error
+}
+
+type TwoFactorChallengeRepository interface {
+	Create(ctx context.Context, challenge TwoFactorChallenge) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*TwoFactorChallenge, error)
+	// Redeem marks the challenge as used, failing with an unauthorized error when it has already been used or has expired.
+	Redeem(ctx context.Context, challengeID string) error
+}
+
+// TOTPManager implements the time-based one-time passwords of RFC 6238 shown by authenticator apps.
+type TOTPManager interface {
+	GenerateSecret() (string, error)
+	// URI returns the otpauth URI that authenticator apps read, usually from a QR code, to add the account.
+	URI(secret, accountName string) string
+	// Validate returns the time step matched by the code, tolerating one step of clock drift either way.
+	Validate(secret, code string, at time.Time) (int64, bool)
+}
+
+// TwoFactor holds the TOTP secret of a user. It stays pending, without EnabledAt, until the user proves their
+// authenticator app works by entering a code.
+type TwoFactor struct {
+	UserID       string `validate:"required,uuid"`
+	Secret       string `validate:"required"`
+	EnabledAt    *time.Time
+	LastUsedStep int64
+	CreatedAt    time.Time `validate:"required"`
+}
+
+func NewTwoFactor(userID, secret string) (*TwoFactor, error) {
+	twoFactor := TwoFactor{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	}
+
+	if err := twoFactor.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &twoFactor, nil
+}
+
+func (t *TwoFactor) Validate() error {
+	if errs := validator.Validate(t); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+func (t *TwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// Enable turns the enrollment on with the step of the code that confirmed it, so that code cannot be used to log in.
+func (t *TwoFactor) Enable(step int64) error {
+	if t.IsEnabled() {
+		return NewConflictError("two-factor authentication is already enabled")
+	}
+
+	now := time.Now()
+	t.EnabledAt = &now
+	t.LastUsedStep = step
+
+	return nil
+}
+
+// TwoFactorEnrollment is what the user needs to add the account to an authenticator app.
+type TwoFactorEnrollment struct {
+	Secret string
+	URI    string
+}
+
+// RecoveryCode lets a user who lost their authenticator app log in once. Only the hash is stored, like secret tokens.
+type RecoveryCode struct {
+	ID        string `validate:"required,uuid"`
+	UserID    string `validate:"required,uuid"`
+	CodeHash  string `validate:"required,len=64"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `validate:"required"`
+}
+
+// NewRecoveryCodes generates a fresh set of recovery codes, returning them along with the plain codes to show the user.
+func NewRecoveryCodes(identityGenerator IdentityGenerator, recoveryCodeGenerator RecoveryCodeGenerator, userID string) ([]RecoveryCode, []string, error) {
+	recoveryCodes := make([]RecoveryCode, 0, RecoveryCodeCount)
+	plainCodes := make([]string, 0, RecoveryCodeCount)
+	now := time.Now()
+
+	for range RecoveryCodeCount {
+		id, err := identityGenerator.Generate()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		code, err := recoveryCodeGenerator.Generate()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		recoveryCode := RecoveryCode{
+			ID:        id,
+			UserID:    userID,
+			CodeHash:  HashRecoveryCode(code),
+			CreatedAt: now,
+		}
+
+		if errs := validator.Validate(recoveryCode); len(errs) > 0 {
+			return nil, nil, NewValidationError(errs)
+		}
+
+		recoveryCodes = append(recoveryCodes, recoveryCode)
+		plainCodes = append(plainCodes, code)
+	}
+
+	return recoveryCodes, plainCodes, nil
+}
+
+// HashRecoveryCode hashes a recovery code the way it was typed, ignoring case, spaces and dashes.
+func HashRecoveryCode(code string) string {
+	return HashSecretToken(strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code)))
+}
+
+// IsTOTPCode tells codes from authenticator apps, six digits, apart from recovery codes.
+func IsTOTPCode(code string) bool {
+	return totpCodePattern.MatchString(strings.ReplaceAll(code, " ", ""))
+}
+
+// TwoFactorChallenge is issued when the password of a user with two-factor authentication is accepted, and is
+// exchanged for a session together with a code. Only the hash of the token given to the client is stored.
+type TwoFactorChallenge struct {
+	ID        string `validate:"required,uuid"`
+	UserID    string `validate:"required,uuid"`
+	TokenHash string `validate:"required,len=64"`
+	UsedAt    *time.Time
+	ExpiresAt time.Time `validate:"required"`
+	CreatedAt time.Time `validate:"required"`
+}
+
+func NewTwoFactorChallenge(identityGenerator IdentityGenerator, userID, token string) (*TwoFactorChallenge, error) {
+	id, err := identityGenerator.Generate()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	challenge := TwoFactorChallenge{
+		ID:        id,
+		UserID:    userID,
+		TokenHash: HashSecretToken(token),
+		ExpiresAt: now.Add(TwoFactorChallengeDuration),
+		CreatedAt: now,
+	}
+
+	if err := challenge.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &challenge, nil
+}
+
+func (c *TwoFactorChallenge) Validate() error {
+	if errs := validator.Validate(c); len(errs) > 0 {
+		return NewValidationError(errs)
+	}
+	return nil
+}
+
+// IsUsable reports whether the challenge can still be answered.
+func (c *TwoFactorChallenge) IsUsable() bool {
+	return c.UsedAt == nil && time.Now().Before(c.ExpiresAt)
+}
@@ -0,0 +1,200 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_NewTwoFactor(t *testing.T) {
+	t.Run("should create a pending enrollment", func(t *testing.T) {
+		// given
+		userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+
+		// when
+		twoFactor, err := domain.NewTwoFactor(userID, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, userID, twoFactor.UserID)
+		assert.False(t, twoFactor.IsEnabled())
+	})
+
+	t.Run("should return validation error when the secret is empty", func(t *testing.T) {
+		// when
+		twoFactor, err := domain.NewTwoFactor("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e", "")
+
+		// then
+		assert.Nil(t, twoFactor)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func Test_TwoFactor_Enable(t *testing.T) {
+	t.Run("should enable the enrollment and remember the step that confirmed it", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().Build()
+
+		// when
+		err := twoFactor.Enable(42)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, twoFactor.IsEnabled())
+		assert.Equal(t, int64(42), twoFactor.LastUsedStep)
+	})
+
+	t.Run("should return conflict error when it is already enabled", func(t *testing.T) {
+		// given
+		enabledAt := time.Now()
+		twoFactor := build_domain.NewTwoFactorBuilder().WithEnabledAt(&enabledAt).Build()
+
+		// when
+		err := twoFactor.Enable(42)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "two-factor authentication is already enabled")
+	})
+}
+
+func Test_NewRecoveryCodes(t *testing.T) {
+	t.Run("should generate the recovery codes storing only their hashes", func(t *testing.T) {
+		// given
+		userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil).Times(domain.RecoveryCodeCount)
+
+		mockedRecoveryCodeGenerator := mock_domain.NewMockRecoveryCodeGenerator(mockCtrl)
+		mockedRecoveryCodeGenerator.EXPECT().Generate().Return("7KQ2-MX9D-4HRT-ZP3W", nil).Times(domain.RecoveryCodeCount)
+
+		// when
+		recoveryCodes, plainCodes, err := domain.NewRecoveryCodes(mockedIdentityGenerator, mockedRecoveryCodeGenerator, userID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, recoveryCodes, domain.RecoveryCodeCount)
+		assert.Len(t, plainCodes, domain.RecoveryCodeCount)
+		assert.Equal(t, "7KQ2-MX9D-4HRT-ZP3W", plainCodes[0])
+		assert.Equal(t, userID, recoveryCodes[0].UserID)
+		assert.Equal(t, domain.HashRecoveryCode("7KQ2-MX9D-4HRT-ZP3W"), recoveryCodes[0].CodeHash)
+	})
+
+	t.Run("should return error when code generation fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d", nil)
+
+		mockedRecoveryCodeGenerator := mock_domain.NewMockRecoveryCodeGenerator(mockCtrl)
+		mockedRecoveryCodeGenerator.EXPECT().Generate().Return("", assert.AnError)
+
+		// when
+		recoveryCodes, plainCodes, err := domain.NewRecoveryCodes(mockedIdentityGenerator, mockedRecoveryCodeGenerator, "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e")
+
+		// then
+		assert.Nil(t, recoveryCodes)
+		assert.Nil(t, plainCodes)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_HashRecoveryCode(t *testing.T) {
+	t.Run("should ignore case, spaces and dashes", func(t *testing.T) {
+		// when
+		hash := domain.HashRecoveryCode("7kq2 mx9d-4hrt-zp3w")
+
+		// then
+		assert.Equal(t, domain.HashRecoveryCode("7KQ2-MX9D-4HRT-ZP3W"), hash)
+		assert.Equal(t, domain.HashSecretToken("7KQ2MX9D4HRTZP3W"), hash)
+	})
+}
+
+func Test_IsTOTPCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected bool
+	}{
+		{"123456", true},
+		{"123 456", true},
+		{"12345", false},
+		{"7KQ2-MX9D-4HRT-ZP3W", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			assert.Equal(t, tt.expected, domain.IsTOTPCode(tt.code))
+		})
+	}
+}
+
+func Test_NewTwoFactorChallenge(t *testing.T) {
+	t.Run("should store only the hash of the token and expire shortly", func(t *testing.T) {
+		// given
+		id := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return(id, nil)
+
+		// when
+		challenge, err := domain.NewTwoFactorChallenge(mockedIdentityGenerator, userID, "challenge-token")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, id, challenge.ID)
+		assert.Equal(t, userID, challenge.UserID)
+		assert.Equal(t, domain.HashSecretToken("challenge-token"), challenge.TokenHash)
+		assert.WithinDuration(t, time.Now().Add(domain.TwoFactorChallengeDuration), challenge.ExpiresAt, time.Second)
+		assert.True(t, challenge.IsUsable())
+	})
+
+	t.Run("should return error when identity generation fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedIdentityGenerator := mock_domain.NewMockIdentityGenerator(mockCtrl)
+		mockedIdentityGenerator.EXPECT().Generate().Return("", assert.AnError)
+
+		// when
+		challenge, err := domain.NewTwoFactorChallenge(mockedIdentityGenerator, "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e", "challenge-token")
+
+		// then
+		assert.Nil(t, challenge)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_TwoFactorChallenge_IsUsable(t *testing.T) {
+	t.Run("should not be usable once used", func(t *testing.T) {
+		// given
+		usedAt := time.Now()
+		challenge := build_domain.NewTwoFactorChallengeBuilder().WithUsedAt(&usedAt).Build()
+
+		// when
+		usable := challenge.IsUsable()
+
+		// then
+		assert.False(t, usable)
+	})
+
+	t.Run("should not be usable once expired", func(t *testing.T) {
+		// given
+		challenge := build_domain.NewTwoFactorChallengeBuilder().WithExpiresAt(time.Now().Add(-time.Second)).Build()
+
+		// when
+		usable := challenge.IsUsable()
+
+		// then
+		assert.False(t, usable)
+	})
+}
@@ -4,6 +4,8 @@ package domain
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
@@ -13,17 +15,31 @@ type UserRepository interface {
 	Create(ctx context.Context, user User) error
 	GetByID(ctx context.Context, userID string) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
-	// Update(ctx context.Context, user User) error
+	Update(ctx context.Context, user User) error
+	Anonymize(ctx context.Context, user User) error
 }
 
+const (
+	DeletedUserName    = "Deleted"
+	DeletedUserSurname = "User"
+
+	// deletedUserEmailDomain uses the reserved .invalid TLD, so no email is ever delivered to a deleted account.
+	deletedUserEmailDomain = "deleted.invalid"
+	deletedUserPassword    = "!"
+)
+
 type User struct {
-	ID        string    `validate:"required,uuid"`
-	Name      string    `validate:"required"`
-	Surname   string    `validate:"required"`
-	Email     string    `validate:"required,email"`
-	Password  string    `validate:"required"`
-	CreatedAt time.Time `validate:"required"`
-	UpdatedAt time.Time `validate:"required"`
+	ID                string `validate:"required,uuid"`
+	Name              string `validate:"required"`
+	Surname           string `validate:"required"`
+	Email             string `validate:"required,email"`
+	Password          string `validate:"required"`
+	PasswordChangedAt *time.Time
+	EmailVerifiedAt   *time.Time
+	DeletedAt         *time.Time
+	AvatarID          string
+	CreatedAt         time.Time `validate:"required"`
+	UpdatedAt         time.Time `validate:"required"`
 }
 
 func NewUser(identity IdentityGenerator, passwordManager PasswordManager, name, surname, email, password string) (*User, error) {
@@ -56,10 +72,136 @@ func NewUser(identity IdentityGenerator, passwordManager PasswordManager, name,
 	return &user, err
 }
 
+// UpdateProfile changes the given fields, leaving the nil ones untouched. The user is only modified when the result is valid.
+func (u *User) UpdateProfile(name, surname, email *string) error {
+	updatedUser := *u
+
+	if name != nil {
+		updatedUser.Name = *name
+	}
+	if surname != nil {
+		updatedUser.Surname = *surname
+	}
+	if email != nil {
+		// a new address has to be verified again
+		if !strings.EqualFold(updatedUser.Email, *email) {
+			updatedUser.EmailVerifiedAt = nil
+		}
+		updatedUser.Email = *email
+	}
+
+	updatedUser.UpdatedAt = time.Now()
+
+	if err := updatedUser.Validate(); err != nil {
+		return err
+	}
+
+	*u = updatedUser
+
+	return nil
+}
+
+// CheckPassword confirms that the user knows the current password before an action that cannot be undone.
+func (u *User) CheckPassword(passwordManager PasswordManager, password string) error {
+	if err := passwordManager.Compare(u.Password, password); err != nil {
+		return NewValidationError(validator.ValidationErrors{{Field: "Password", Error: "Password is incorrect"}})
+	}
+	return nil
+}
+
+// ChangePassword replaces the password after checking the current one, which ends every session started before the change.
+func (u *User) ChangePassword(passwordManager PasswordManager, currentPassword, newPassword string) error {
+	if err := passwordManager.Compare(u.Password, currentPassword); err != nil {
+		return NewValidationError(validator.ValidationErrors{{Field: "CurrentPassword", Error: "CurrentPassword is incorrect"}})
+	}
+
+	return u.setPassword(passwordManager, newPassword)
+}
+
+// ResetPassword replaces the password without the current one, for users who proved they own the account email.
+func (u *User) ResetPassword(passwordManager PasswordManager, newPassword string) error {
+	return u.setPassword(passwordManager, newPassword)
+}
+
+func (u *User) setPassword(passwordManager PasswordManager, password string) error {
+	hashedPassword, err := passwordManager.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	u.Password = hashedPassword
+	u.PasswordChangedAt = &now
+	u.UpdatedAt = now
+
+	return nil
+}
+
+// IssuedBeforePasswordChange reports whether a session token was issued before the last password change.
+// Tokens only carry whole seconds, so the comparison is made at that precision.
+func (u *User) IssuedBeforePasswordChange(issuedAt time.Time) bool {
+	if u.PasswordChangedAt == nil {
+		return false
+	}
+	return issuedAt.Before(u.PasswordChangedAt.Truncate(time.Second))
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+func (u *User) VerifyEmail() {
+	now := time.Now()
+
+	u.EmailVerifiedAt = &now
+	u.UpdatedAt = now
+}
+
+// HasAvatar reports whether the user uploaded an avatar. AvatarID changes on every upload.
+func (u *User) HasAvatar() bool {
+	return u.AvatarID != ""
+}
+
+// AvatarKey is where the thumbnail of the given size of the current avatar is stored.
+func (u *User) AvatarKey(size AvatarSize) string {
+	return AvatarKey(u.ID, u.AvatarID, size)
+}
+
+func (u *User) SetAvatar(avatarID string) {
+	u.AvatarID = avatarID
+	u.UpdatedAt = time.Now()
+}
+
+func (u *User) RemoveAvatar() {
+	u.AvatarID = ""
+	u.UpdatedAt = time.Now()
+}
+
+func (u *User) IsDeleted() bool {
+	return u.DeletedAt != nil
+}
+
+// Anonymize removes the personal data of a user who deleted their account. The record itself is kept, so the
+// groups and matches the user took part in stay consistent, but it can no longer be used to sign in: the email
+// is replaced by an unreachable address and the password by a value no password manager produces.
+func (u *User) Anonymize() {
+	now := time.Now()
+
+	u.Name = DeletedUserName
+	u.Surname = DeletedUserSurname
+	u.Email = fmt.Sprintf("deleted-%s@%s", u.ID, deletedUserEmailDomain)
+	u.Password = deletedUserPassword
+	u.PasswordChangedAt = &now
+	u.EmailVerifiedAt = nil
+	u.AvatarID = ""
+	u.DeletedAt = &now
+	u.UpdatedAt = now
+}
+
 func (u *User) Validate() error {
 	if errs := validator.Validate(u); len(errs) > 0 {
 		return NewValidationError(errs)
 	}
 	return nil
 }
-
@@ -128,3 +128,281 @@ func Test_NewUser(t *testing.T) {
 		assert.Contains(t, errors, validator.FieldError{Field: "Name", Error: "Name is a required field"})
 	})
 }
+
+func Test_User_UpdateProfile(t *testing.T) {
+	t.Run("should update only the provided fields", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithName("Jonh").WithSurname("Doe").WithUpdatedAt(time.Now().Add(-time.Hour)).Build()
+		originalEmail := user.Email
+		name := "John"
+
+		// when
+		err := user.UpdateProfile(&name, nil, nil)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "John", user.Name)
+		assert.Equal(t, "Doe", user.Surname)
+		assert.Equal(t, originalEmail, user.Email)
+		assert.WithinDuration(t, time.Now(), user.UpdatedAt, time.Second)
+	})
+
+	t.Run("should return validation error and keep the user unchanged when the result is invalid", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		originalUser := user
+		name := "John"
+		email := "not-an-email"
+
+		// when
+		err := user.UpdateProfile(&name, nil, &email)
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Email", Error: "Email must be a valid email address"})
+		assert.Equal(t, originalUser, user)
+	})
+
+	t.Run("should clear the email verification when the email changes", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now().Add(-time.Hour)
+		user := build_domain.NewUserBuilder().WithEmail("john@example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+		email := "john.doe@example.com"
+
+		// when
+		err := user.UpdateProfile(nil, nil, &email)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, user.IsEmailVerified())
+	})
+
+	t.Run("should keep the email verification when only the case of the email changes", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now().Add(-time.Hour)
+		user := build_domain.NewUserBuilder().WithEmail("john@example.com").WithEmailVerifiedAt(&verifiedAt).Build()
+		email := "John@Example.com"
+
+		// when
+		err := user.UpdateProfile(nil, nil, &email)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, user.IsEmailVerified())
+	})
+}
+
+func Test_User_VerifyEmail(t *testing.T) {
+	t.Run("should record when the email was verified", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		// when
+		user.VerifyEmail()
+
+		// then
+		assert.True(t, user.IsEmailVerified())
+		assert.WithinDuration(t, time.Now(), *user.EmailVerifiedAt, time.Second)
+	})
+}
+
+func Test_User_CheckPassword(t *testing.T) {
+	t.Run("should accept the current password", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("hashed").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("hashed", "password").Return(nil)
+
+		// when
+		err := user.CheckPassword(mockedPasswordManager, "password")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return validation error when the password is incorrect", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("hashed").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("hashed", "wrong-password").Return(assert.AnError)
+
+		// when
+		err := user.CheckPassword(mockedPasswordManager, "wrong-password")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Password", Error: "Password is incorrect"})
+	})
+}
+
+func Test_User_ChangePassword(t *testing.T) {
+	t.Run("should hash the new password and record when it changed", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("old_hash").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("old_hash", "current-password").Return(nil)
+		mockedPasswordManager.EXPECT().Hash("new-password").Return("new_hash", nil)
+
+		// when
+		err := user.ChangePassword(mockedPasswordManager, "current-password", "new-password")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "new_hash", user.Password)
+		assert.NotNil(t, user.PasswordChangedAt)
+		assert.WithinDuration(t, time.Now(), *user.PasswordChangedAt, time.Second)
+	})
+
+	t.Run("should return validation error when the current password is wrong", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("old_hash").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("old_hash", "wrong-password").Return(assert.AnError)
+
+		// when
+		err := user.ChangePassword(mockedPasswordManager, "wrong-password", "new-password")
+
+		// then
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "CurrentPassword", Error: "CurrentPassword is incorrect"})
+		assert.Equal(t, "old_hash", user.Password)
+		assert.Nil(t, user.PasswordChangedAt)
+	})
+
+	t.Run("should return error when hashing fails", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("old_hash").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Compare("old_hash", "current-password").Return(nil)
+		mockedPasswordManager.EXPECT().Hash("new-password").Return("", assert.AnError)
+
+		// when
+		err := user.ChangePassword(mockedPasswordManager, "current-password", "new-password")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, "old_hash", user.Password)
+	})
+}
+
+func Test_User_ResetPassword(t *testing.T) {
+	t.Run("should hash the new password without checking the current one", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithPassword("old_hash").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedPasswordManager := mock_domain.NewMockPasswordManager(mockCtrl)
+		mockedPasswordManager.EXPECT().Hash("new-password").Return("new_hash", nil)
+
+		// when
+		err := user.ResetPassword(mockedPasswordManager, "new-password")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "new_hash", user.Password)
+		assert.NotNil(t, user.PasswordChangedAt)
+	})
+}
+
+func Test_User_IssuedBeforePasswordChange(t *testing.T) {
+	t.Run("should accept any token when the password never changed", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		// when
+		result := user.IssuedBeforePasswordChange(time.Time{})
+
+		// then
+		assert.False(t, result)
+	})
+
+	t.Run("should reject tokens issued before the change and accept the ones issued after it", func(t *testing.T) {
+		// given
+		changedAt := time.Date(2024, 12, 1, 10, 0, 0, 500, time.UTC)
+		user := build_domain.NewUserBuilder().WithPasswordChangedAt(&changedAt).Build()
+
+		// then
+		assert.True(t, user.IssuedBeforePasswordChange(changedAt.Add(-time.Minute)))
+		assert.False(t, user.IssuedBeforePasswordChange(changedAt.Truncate(time.Second)))
+		assert.False(t, user.IssuedBeforePasswordChange(changedAt.Add(time.Minute)))
+	})
+}
+
+func Test_User_Anonymize(t *testing.T) {
+	t.Run("should replace the personal data and keep the user valid", func(t *testing.T) {
+		// given
+		now := time.Now()
+		user := build_domain.NewUserBuilder().
+			WithName("John").
+			WithSurname("Doe").
+			WithEmail("john@example.com").
+			WithEmailVerifiedAt(&now).
+			WithAvatarID("some-avatar-id").
+			Build()
+
+		// when
+		user.Anonymize()
+
+		// then
+		assert.True(t, user.IsDeleted())
+		assert.Equal(t, domain.DeletedUserName, user.Name)
+		assert.Equal(t, domain.DeletedUserSurname, user.Surname)
+		assert.Equal(t, "deleted-"+user.ID+"@deleted.invalid", user.Email)
+		assert.NotEqual(t, "defaultpassword", user.Password)
+		assert.False(t, user.IsEmailVerified())
+		assert.False(t, user.HasAvatar())
+		assert.NoError(t, user.Validate())
+	})
+
+	t.Run("should end every session issued before the deletion", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		issuedAt := time.Now().Add(-time.Minute)
+
+		// when
+		user.Anonymize()
+
+		// then
+		assert.True(t, user.IssuedBeforePasswordChange(issuedAt))
+	})
+}
+
+func Test_User_SetAvatar(t *testing.T) {
+	t.Run("should point the thumbnail keys to the new avatar", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithAvatarID("old-avatar-id").Build()
+
+		// when
+		user.SetAvatar("new-avatar-id")
+
+		// then
+		assert.True(t, user.HasAvatar())
+		assert.Equal(t, "avatars/"+user.ID+"/new-avatar-id/small.png", user.AvatarKey(domain.AvatarSizeSmall))
+	})
+}
+
+func Test_User_RemoveAvatar(t *testing.T) {
+	t.Run("should leave the user without an avatar", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().WithAvatarID("some-avatar-id").Build()
+
+		// when
+		user.RemoveAvatar()
+
+		// then
+		assert.False(t, user.HasAvatar())
+	})
+}
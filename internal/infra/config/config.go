@@ -8,12 +8,88 @@ import (
 
 type InviteConfig struct {
 	LinkExpiration time.Duration `env:"INVITE_LINK_EXPIRATION" envDefault:"24h"`
+	JoinBaseURL    string        `env:"INVITE_JOIN_BASE_URL" envDefault:"http://localhost:3000/invites"`
+	QRCodeSize     int           `env:"INVITE_QR_CODE_SIZE" envDefault:"512"`
+}
+
+type PasswordResetConfig struct {
+	TokenExpiration time.Duration `env:"PASSWORD_RESET_TOKEN_EXPIRATION" envDefault:"1h"`
+	BaseURL         string        `env:"PASSWORD_RESET_BASE_URL" envDefault:"http://localhost:3000/reset-password"`
+}
+
+type EmailVerificationConfig struct {
+	TokenExpiration   time.Duration `env:"EMAIL_VERIFICATION_TOKEN_EXPIRATION" envDefault:"48h"`
+	BaseURL           string        `env:"EMAIL_VERIFICATION_BASE_URL" envDefault:"http://localhost:3000/verify-email"`
+	RestrictedActions []string      `env:"EMAIL_VERIFICATION_RESTRICTED_ACTIONS" envDefault:"JOIN_GROUP,CREATE_INVITE"`
+}
+
+type MailConfig struct {
+	Driver       string `env:"MAIL_DRIVER" envDefault:"log"`
+	From         string `env:"MAIL_FROM" envDefault:"no-reply@mysterygifter.local"`
+	LogPath      string `env:"MAIL_LOG_PATH" envDefault:""`
+	SMTPHost     string `env:"SMTP_HOST" envDefault:"localhost"`
+	SMTPPort     int    `env:"SMTP_PORT" envDefault:"587"`
+	SMTPUsername string `env:"SMTP_USERNAME" envDefault:""`
+	SMTPPassword string `env:"SMTP_PASSWORD" envDefault:""`
+}
+
+type StorageConfig struct {
+	Driver   string `env:"STORAGE_DRIVER" envDefault:"local"`
+	LocalDir string `env:"STORAGE_LOCAL_DIR" envDefault:"./storage"`
+}
+
+type AvatarConfig struct {
+	MaxUploadSize int `env:"AVATAR_MAX_UPLOAD_SIZE" envDefault:"2097152"`
+	MaxPixels     int `env:"AVATAR_MAX_PIXELS" envDefault:"16777216"`
+}
+
+// ServerConfig tells the server whether it runs behind a reverse proxy. Client addresses, recorded for each session,
+// are only read from ProxyHeader when the request comes from one of the TrustedProxies, so clients cannot forge them.
+type ServerConfig struct {
+	ProxyHeader    string   `env:"SERVER_PROXY_HEADER" envDefault:""`
+	TrustedProxies []string `env:"SERVER_TRUSTED_PROXIES" envDefault:""`
+}
+
+// RateLimitConfig throttles failed logins and invite joins, and every password reset or email verification link sent.
+// After FreeAttempts failures every attempt waits BaseDelay, doubled for each further failure up to MaxDelay, and
+// LockoutThreshold failures lock the client address, account or invite for LockoutDuration.
+type RateLimitConfig struct {
+	Driver           string        `env:"RATE_LIMIT_DRIVER" envDefault:"memory"`
+	FreeAttempts     int           `env:"RATE_LIMIT_FREE_ATTEMPTS" envDefault:"5"`
+	BaseDelay        time.Duration `env:"RATE_LIMIT_BASE_DELAY" envDefault:"1s"`
+	MaxDelay         time.Duration `env:"RATE_LIMIT_MAX_DELAY" envDefault:"5m"`
+	LockoutThreshold int           `env:"RATE_LIMIT_LOCKOUT_THRESHOLD" envDefault:"10"`
+	LockoutDuration  time.Duration `env:"RATE_LIMIT_LOCKOUT_DURATION" envDefault:"15m"`
+}
+
+// TwoFactorConfig sets the issuer that authenticator apps show next to the account of a user.
+type TwoFactorConfig struct {
+	Issuer string `env:"TWO_FACTOR_ISSUER" envDefault:"Mystery Gifter"`
+}
+
+// OIDCConfig sets up single sign-on with an OpenID Connect identity provider, which stays off while IssuerURL is
+// empty. RedirectURL is the frontend page the provider sends users back to, which then posts the code to the API.
+type OIDCConfig struct {
+	IssuerURL    string   `env:"OIDC_ISSUER_URL" envDefault:""`
+	ClientID     string   `env:"OIDC_CLIENT_ID" envDefault:""`
+	ClientSecret string   `env:"OIDC_CLIENT_SECRET" envDefault:""`
+	RedirectURL  string   `env:"OIDC_REDIRECT_URL" envDefault:"http://localhost:3000/auth/oidc/callback"`
+	Scopes       []string `env:"OIDC_SCOPES" envDefault:"openid,email,profile"`
 }
 
 type Config struct {
-	Database DatabaseConfig
-	Auth     AuthConfig
-	Invite   InviteConfig
+	Server            ServerConfig
+	Database          DatabaseConfig
+	Auth              AuthConfig
+	Invite            InviteConfig
+	PasswordReset     PasswordResetConfig
+	EmailVerification EmailVerificationConfig
+	Mail              MailConfig
+	Storage           StorageConfig
+	Avatar            AvatarConfig
+	RateLimit         RateLimitConfig
+	TwoFactor         TwoFactorConfig
+	OIDC              OIDCConfig
 }
 
 type DatabaseConfig struct {
@@ -25,9 +101,10 @@ type DatabaseConfig struct {
 }
 
 type AuthConfig struct {
-	SecretKey       string        `env:"AUTH_SECRET_KEY"`
-	SessionDuration time.Duration `env:"AUTH_SESSION_DURATION"`
-	CookieSecure    bool          `env:"AUTH_COOKIE_SECURE" envDefault:"true"`
+	SecretKey            string        `env:"AUTH_SECRET_KEY"`
+	SessionDuration      time.Duration `env:"AUTH_SESSION_DURATION"`
+	RefreshTokenDuration time.Duration `env:"AUTH_REFRESH_TOKEN_DURATION" envDefault:"720h"`
+	CookieSecure         bool          `env:"AUTH_COOKIE_SECURE" envDefault:"true"`
 }
 
 func Load() (*Config, error) {
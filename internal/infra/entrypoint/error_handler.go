@@ -2,6 +2,7 @@ package entrypoint
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gofiber/fiber/v3"
@@ -28,6 +29,9 @@ func CustomErrorHandler(ctx fiber.Ctx, err error) error {
 	switch e := err.(type) {
 	case *fiber.Error:
 		return sendError(ctx, e.Code, e.Error(), nil)
+	case *domain.TooManyRequestsError:
+		ctx.Set(fiber.HeaderRetryAfter, strconv.Itoa(e.RetryAfterSeconds()))
+		return sendError(ctx, e.StatusCode(), e.Error(), e.Details())
 	case domain.CustomError:
 		return sendError(ctx, e.StatusCode(), e.Error(), e.Details())
 	default:
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/stretchr/testify/assert"
@@ -135,6 +136,32 @@ func TestCustomErrorHandler(t *testing.T) {
 		assert.Nil(t, result.Details)
 	})
 
+	t.Run("should handle domain.TooManyRequestsError and set the Retry-After header", func(t *testing.T) {
+		// given
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get("/test", func(c fiber.Ctx) error {
+			return domain.NewTooManyRequestsError("too many failed attempts, try again later", 1500*time.Millisecond)
+		})
+
+		req := httptest.NewRequest(fiber.MethodGet, "/test", nil)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusTooManyRequests, response.StatusCode)
+		assert.Equal(t, "2", response.Header.Get(fiber.HeaderRetryAfter))
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "too_many_requests", result.Code)
+		assert.Equal(t, "too many failed attempts, try again later", result.Message)
+	})
+
 	t.Run("should handle unexpected errors and return the correct WebError response", func(t *testing.T) {
 		// given
 		app := fiber.New(fiber.Config{
@@ -6,6 +6,8 @@ import (
 	jwtware "github.com/gofiber/contrib/v3/jwt"
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/extractors"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 )
 
 const (
@@ -16,10 +18,7 @@ const (
 func NewAuthMiddleware(secretKey string) fiber.Handler {
 	return jwtware.New(jwtware.Config{
 		SigningKey: jwtware.SigningKey{Key: []byte(secretKey)},
-		Extractor: extractors.Chain(
-			extractors.FromCookie(authCookieName),
-			extractors.FromAuthHeader(authHeaderScheme),
-		),
+		Extractor:  authTokenExtractor(),
 		ErrorHandler: func(c fiber.Ctx, err error) error {
 			if errors.Is(err, extractors.ErrNotFound) {
 				return fiber.NewError(fiber.StatusBadRequest, jwtware.ErrMissingToken.Error())
@@ -28,3 +27,52 @@ func NewAuthMiddleware(secretKey string) fiber.Handler {
 		},
 	})
 }
+
+// NewOptionalAuthMiddleware reads the access token like the auth middleware, but lets requests without a valid
+// token through, leaving no token in the context. It serves routes such as logout that signed out clients call too.
+func NewOptionalAuthMiddleware(secretKey string) fiber.Handler {
+	return jwtware.New(jwtware.Config{
+		SigningKey: jwtware.SigningKey{Key: []byte(secretKey)},
+		Extractor:  authTokenExtractor(),
+		ErrorHandler: func(c fiber.Ctx, err error) error {
+			return c.Next()
+		},
+	})
+}
+
+func authTokenExtractor() extractors.Extractor {
+	return extractors.Chain(
+		extractors.FromCookie(authCookieName),
+		extractors.FromAuthHeader(authHeaderScheme),
+	)
+}
+
+// NewSessionMiddleware rejects tokens that are still validly signed but belong to a session revoked on the server,
+// either explicitly or by a later password change. It must run after the auth middleware.
+func NewSessionMiddleware(authTokenManager domain.AuthTokenManager, authService application.AuthService) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		token := jwtware.FromContext(c)
+
+		userID, err := authTokenManager.GetAuthUserID(token)
+		if err != nil {
+			return err
+		}
+
+		issuedAt, err := authTokenManager.GetIssuedAt(token)
+		if err != nil {
+			return err
+		}
+
+		sessionID, err := authTokenManager.GetSessionID(token)
+		if err != nil {
+			return err
+		}
+
+		device := domain.NewDevice(c.Get(fiber.HeaderUserAgent), c.IP())
+		if err := authService.ValidateSession(c.Context(), userID, sessionID, issuedAt, device); err != nil {
+			return err
+		}
+
+		return c.Next()
+	}
+}
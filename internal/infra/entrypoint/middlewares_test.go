@@ -1,15 +1,21 @@
 package entrypoint_test
 
 import (
+	"context"
 	"fmt"
 	"net/http/httptest"
 	"testing"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
+	jwtware "github.com/gofiber/contrib/v3/jwt"
 	"github.com/gofiber/fiber/v3"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
+	"go.uber.org/mock/gomock"
 )
 
 const testSecretKey = "test-secret-key"
@@ -108,3 +114,164 @@ func Test_NewAuthMiddleware(t *testing.T) {
 		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
 	})
 }
+
+func Test_NewOptionalAuthMiddleware(t *testing.T) {
+	tokenHandler := func(ctx fiber.Ctx) error {
+		if jwtware.FromContext(ctx) == nil {
+			return ctx.SendStatus(fiber.StatusNoContent)
+		}
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+
+	t.Run("should keep the token in the context when it is valid", func(t *testing.T) {
+		// given
+		token := makeTestToken(t, testSecretKey, time.Hour)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Use(entrypoint.NewOptionalAuthMiddleware(testSecretKey))
+		app.Post("/logout", tokenHandler)
+
+		req := httptest.NewRequest(fiber.MethodPost, "/logout", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+	})
+
+	t.Run("should let the request through without a token when none is sent", func(t *testing.T) {
+		// given
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Use(entrypoint.NewOptionalAuthMiddleware(testSecretKey))
+		app.Post("/logout", tokenHandler)
+
+		req := httptest.NewRequest(fiber.MethodPost, "/logout", nil)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+	})
+
+	t.Run("should let the request through without a token when it is expired", func(t *testing.T) {
+		// given
+		token := makeTestToken(t, testSecretKey, -time.Hour)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Use(entrypoint.NewOptionalAuthMiddleware(testSecretKey))
+		app.Post("/logout", tokenHandler)
+
+		req := httptest.NewRequest(fiber.MethodPost, "/logout", nil)
+		req.Header.Set("Cookie", fmt.Sprintf("access_token=%s", token))
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+	})
+}
+
+func Test_NewSessionMiddleware(t *testing.T) {
+	successHandler := func(ctx fiber.Ctx) error {
+		return ctx.SendStatus(fiber.StatusOK)
+	}
+
+	t.Run("should let the request through when the session is still valid", func(t *testing.T) {
+		// given
+		token := makeTestToken(t, testSecretKey, time.Hour)
+		issuedAt := time.Now()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+		mockedAuthTokenManager.EXPECT().GetIssuedAt(gomock.Any()).Return(issuedAt, nil)
+		mockedAuthTokenManager.EXPECT().GetSessionID(gomock.Any()).Return("some-session-id", nil)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().ValidateSession(gomock.Any(), "some-user-id", "some-session-id", issuedAt, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, _ time.Time, device domain.Device) error {
+			assert.Equal(t, "some-user-agent", device.UserAgent)
+			assert.NotEmpty(t, device.IPAddress)
+			return nil
+		})
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Use(entrypoint.NewAuthMiddleware(testSecretKey))
+		app.Use(entrypoint.NewSessionMiddleware(mockedAuthTokenManager, mockedAuthService))
+		app.Get("/protected", successHandler)
+
+		req := httptest.NewRequest(fiber.MethodGet, "/protected", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		req.Header.Set("User-Agent", "some-user-agent")
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+	})
+
+	t.Run("should return unauthorized when the session has been revoked", func(t *testing.T) {
+		// given
+		token := makeTestToken(t, testSecretKey, time.Hour)
+		issuedAt := time.Now()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+		mockedAuthTokenManager.EXPECT().GetIssuedAt(gomock.Any()).Return(issuedAt, nil)
+		mockedAuthTokenManager.EXPECT().GetSessionID(gomock.Any()).Return("some-session-id", nil)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().ValidateSession(gomock.Any(), "some-user-id", "some-session-id", issuedAt, gomock.Any()).Return(domain.NewUnauthorizedError("session has been revoked"))
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Use(entrypoint.NewAuthMiddleware(testSecretKey))
+		app.Use(entrypoint.NewSessionMiddleware(mockedAuthTokenManager, mockedAuthService))
+		app.Get("/protected", successHandler)
+
+		req := httptest.NewRequest(fiber.MethodGet, "/protected", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, response.StatusCode)
+	})
+
+	t.Run("should return unauthorized when the token has no user", func(t *testing.T) {
+		// given
+		token := makeTestToken(t, testSecretKey, time.Hour)
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("", domain.NewUnauthorizedError("invalid token"))
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Use(entrypoint.NewAuthMiddleware(testSecretKey))
+		app.Use(entrypoint.NewSessionMiddleware(mockedAuthTokenManager, nil))
+		app.Get("/protected", successHandler)
+
+		req := httptest.NewRequest(fiber.MethodGet, "/protected", nil)
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, response.StatusCode)
+	})
+}
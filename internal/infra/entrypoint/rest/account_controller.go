@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	jwtware "github.com/gofiber/contrib/v3/jwt"
+	"github.com/gofiber/fiber/v3"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+const (
+	accountExportFormatJSON = "json"
+	accountExportFormatZip  = "zip"
+
+	accountExportFileName = "mystery-gifter-export"
+)
+
+type AccountController struct {
+	accountService   application.AccountService
+	authTokenManager domain.AuthTokenManager
+}
+
+func NewAccountController(accountService application.AccountService, authTokenManager domain.AuthTokenManager) *AccountController {
+	return &AccountController{
+		accountService:   accountService,
+		authTokenManager: authTokenManager,
+	}
+}
+
+func (c *AccountController) Delete(ctx fiber.Ctx) error {
+	var deleteAccountDTO DeleteAccountDTO
+	if err := ctx.Bind().Body(&deleteAccountDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := deleteAccountDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	if err := c.accountService.Delete(ctx.Context(), authUserID, deleteAccountDTO.Password); err != nil {
+		return err
+	}
+
+	clearCookie(ctx)
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *AccountController) Export(ctx fiber.Ctx) error {
+	format := ctx.Query("format", accountExportFormatJSON)
+	if format != accountExportFormatJSON && format != accountExportFormatZip {
+		return domain.NewValidationError(validator.ValidationErrors{{Field: "Format", Error: "Format must be one of [json zip]"}})
+	}
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	export, err := c.accountService.Export(ctx.Context(), authUserID)
+	if err != nil {
+		return err
+	}
+
+	exportDTO, err := mapAccountExportFromDomain(*export)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(exportDTO, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if format == accountExportFormatJSON {
+		ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.json"`, accountExportFileName))
+		ctx.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+		return ctx.Send(content)
+	}
+
+	archive, err := zipAccountExport(content)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.zip"`, accountExportFileName))
+	ctx.Set(fiber.HeaderContentType, "application/zip")
+	return ctx.Send(archive)
+}
+
+// zipAccountExport packs the JSON export into a zip archive with a single file.
+func zipAccountExport(content []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	writer := zip.NewWriter(&buffer)
+
+	file, err := writer.Create(accountExportFileName + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("error creating export archive entry: %w", err)
+	}
+
+	if _, err := file.Write(content); err != nil {
+		return nil, fmt.Errorf("error writing export archive entry: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error closing export archive: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,236 @@
+package rest_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+	"github.com/waliqueiroz/mystery-gifter-api/test/helper"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_AccountController_Delete(t *testing.T) {
+	route := "/api/v1/users/me"
+
+	t.Run("should return status 204 and clear the auth cookie after deleting the account", func(t *testing.T) {
+		// given
+		userID := "some-user-id"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(userID, nil)
+
+		mockedAccountService := mock_application.NewMockAccountService(mockCtrl)
+		mockedAccountService.EXPECT().Delete(gomock.Any(), userID, "mypassword123").Return(nil)
+
+		accountController := rest.NewAccountController(mockedAccountService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, rest.DeleteAccountDTO{Password: "mypassword123"})
+		req := httptest.NewRequest(fiber.MethodDelete, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Delete(route, accountController.Delete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+		assert.Contains(t, response.Header.Get("Set-Cookie"), "access_token=;")
+	})
+
+	t.Run("should return bad_request when the password is missing", func(t *testing.T) {
+		// given
+		accountController := rest.NewAccountController(nil, nil)
+
+		payload := helper.EncodeJSON(t, rest.DeleteAccountDTO{})
+		req := httptest.NewRequest(fiber.MethodDelete, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Delete(route, accountController.Delete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the password is incorrect", func(t *testing.T) {
+		// given
+		userID := "some-user-id"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(userID, nil)
+
+		mockedAccountService := mock_application.NewMockAccountService(mockCtrl)
+		mockedAccountService.EXPECT().Delete(gomock.Any(), userID, "wrong-password").
+			Return(domain.NewValidationError(validator.ValidationErrors{{Field: "Password", Error: "Password is incorrect"}}))
+
+		accountController := rest.NewAccountController(mockedAccountService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, rest.DeleteAccountDTO{Password: "wrong-password"})
+		req := httptest.NewRequest(fiber.MethodDelete, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Delete(route, accountController.Delete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+		assert.Empty(t, response.Header.Get("Set-Cookie"))
+	})
+}
+
+func Test_AccountController_Export(t *testing.T) {
+	route := "/api/v1/users/me/export"
+
+	newExport := func(t *testing.T) (*domain.AccountExport, domain.User) {
+		user := build_domain.NewUserBuilder().Build()
+		receiver := build_domain.NewUserBuilder().Build()
+		third := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithOwnerID(user.ID).
+			WithUsers([]domain.User{user, receiver, third}).
+			WithMatches([]domain.Match{
+				{GiverID: user.ID, ReceiverID: receiver.ID},
+				{GiverID: receiver.ID, ReceiverID: third.ID},
+				{GiverID: third.ID, ReceiverID: user.ID},
+			}).
+			WithStatus(domain.GroupStatusMatched).
+			Build()
+		invite := build_domain.NewGroupInviteBuilder().WithGroupID(group.ID).Build()
+		session := build_domain.NewSessionBuilder().WithUserID(user.ID).WithUserAgent("Mozilla/5.0").WithIPAddress("203.0.113.10").Build()
+
+		export, err := domain.NewAccountExport(user, []domain.Group{group}, []domain.GroupInvite{invite}, []domain.Session{session})
+		assert.NoError(t, err)
+
+		return export, receiver
+	}
+
+	t.Run("should return the export as a JSON attachment", func(t *testing.T) {
+		// given
+		export, receiver := newExport(t)
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(export.User.ID, nil)
+
+		mockedAccountService := mock_application.NewMockAccountService(mockCtrl)
+		mockedAccountService.EXPECT().Export(gomock.Any(), export.User.ID).Return(export, nil)
+
+		accountController := rest.NewAccountController(mockedAccountService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Get(route, accountController.Export)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+		assert.Equal(t, `attachment; filename="mystery-gifter-export.json"`, response.Header.Get(fiber.HeaderContentDisposition))
+
+		var result rest.AccountExportDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, export.User.ID, result.User.ID)
+		assert.Len(t, result.GroupMemberships, 1)
+		assert.True(t, result.GroupMemberships[0].IsOwner)
+		assert.Len(t, result.OwnedGroups, 1)
+		assert.Equal(t, 3, result.OwnedGroups[0].ParticipantCount)
+		assert.Len(t, result.Invites, 1)
+		assert.Len(t, result.Matches, 1)
+		assert.Equal(t, receiver.ID, result.Matches[0].Receiver.ID)
+		assert.Len(t, result.Sessions, 1)
+		assert.Equal(t, "Mozilla/5.0", result.Sessions[0].UserAgent)
+		assert.Equal(t, "203.0.113.10", result.Sessions[0].IPAddress)
+	})
+
+	t.Run("should return the export zipped when requested", func(t *testing.T) {
+		// given
+		export, _ := newExport(t)
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(export.User.ID, nil)
+
+		mockedAccountService := mock_application.NewMockAccountService(mockCtrl)
+		mockedAccountService.EXPECT().Export(gomock.Any(), export.User.ID).Return(export, nil)
+
+		accountController := rest.NewAccountController(mockedAccountService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, route+"?format=zip", nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Get(route, accountController.Export)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+		assert.Equal(t, "application/zip", response.Header.Get(fiber.HeaderContentType))
+
+		body, err := io.ReadAll(response.Body)
+		assert.NoError(t, err)
+
+		archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		assert.NoError(t, err)
+		assert.Len(t, archive.File, 1)
+		assert.Equal(t, "mystery-gifter-export.json", archive.File[0].Name)
+
+		file, err := archive.File[0].Open()
+		assert.NoError(t, err)
+		defer file.Close()
+
+		var result rest.AccountExportDTO
+		assert.NoError(t, json.NewDecoder(file).Decode(&result))
+		assert.Equal(t, export.User.ID, result.User.ID)
+	})
+
+	t.Run("should return bad_request when the format is not supported", func(t *testing.T) {
+		// given
+		accountController := rest.NewAccountController(nil, nil)
+
+		req := httptest.NewRequest(fiber.MethodGet, route+"?format=xml", nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Get(route, accountController.Export)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+}
@@ -0,0 +1,266 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// DeleteAccountDTO represents the request body to delete the authenticated user account
+// swagger:model DeleteAccountDTO
+type DeleteAccountDTO struct {
+	// Current password of the user, to confirm the deletion
+	// required: true
+	// example: mypassword123
+	Password string `json:"password" validate:"required"`
+}
+
+func (d *DeleteAccountDTO) Validate() error {
+	if errs := validator.Validate(d); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+// AccountExportDTO represents everything stored about the authenticated user
+// swagger:model AccountExportDTO
+type AccountExportDTO struct {
+	// Profile of the user
+	// required: true
+	User UserDTO `json:"user"`
+
+	// Groups the user is a member of
+	// required: true
+	GroupMemberships []GroupMembershipDTO `json:"group_memberships"`
+
+	// Groups owned by the user, with their settings
+	// required: true
+	OwnedGroups []OwnedGroupDTO `json:"owned_groups"`
+
+	// Invites of the groups owned by the user
+	// required: true
+	Invites []GroupInviteDTO `json:"invites"`
+
+	// Participants the user was drawn to give a gift to
+	// required: true
+	Matches []OutgoingMatchDTO `json:"matches"`
+
+	// Every session of the user, including ended ones, most recent first
+	// required: true
+	Sessions []AccountSessionDTO `json:"sessions"`
+
+	// When the export was generated (UTC)
+	// required: true
+	// example: 2024-01-01T00:00:00Z
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// GroupMembershipDTO represents a group the user is a member of
+// swagger:model GroupMembershipDTO
+type GroupMembershipDTO struct {
+	// ID of the group
+	// required: true
+	// example: 01234567-89ab-cdef-0123-456789abcdef
+	GroupID string `json:"group_id"`
+
+	// Name of the group
+	// required: true
+	// example: Secret Santa 2024
+	GroupName string `json:"group_name"`
+
+	// Status of the group
+	// required: true
+	// example: OPEN
+	// enum: DRAFT,OPEN,REGISTRATION_CLOSED,MATCHED,COMPLETED,ARCHIVED
+	Status string `json:"status"`
+
+	// Whether the user owns the group
+	// required: true
+	// example: true
+	IsOwner bool `json:"is_owner"`
+}
+
+// OwnedGroupDTO represents a group owned by the user, with the settings the user chose
+// swagger:model OwnedGroupDTO
+type OwnedGroupDTO struct {
+	// ID of the group
+	// required: true
+	// example: 01234567-89ab-cdef-0123-456789abcdef
+	ID string `json:"id"`
+
+	// Name of the group
+	// required: true
+	// example: Secret Santa 2024
+	Name string `json:"name"`
+
+	// Description of the group
+	// example: A fun gift exchange for the office
+	Description string `json:"description"`
+
+	// Status of the group
+	// required: true
+	// example: OPEN
+	Status string `json:"status"`
+
+	// Maximum number of participants; 0 means no limit
+	// required: true
+	// example: 30
+	MaxMembers int `json:"max_members"`
+
+	// Suggested gift value in the smallest currency unit; 0 means no budget
+	// required: true
+	// example: 5000
+	Budget int `json:"budget"`
+
+	// Extra rules of the exchange
+	// example: No gift cards
+	Rules string `json:"rules"`
+
+	// When the gifts are exchanged
+	ExchangeDate *time.Time `json:"exchange_date,omitempty"`
+
+	// How the draw pairs givers with receivers
+	// required: true
+	// example: CYCLE
+	MatchStrategy string `json:"match_strategy"`
+
+	// Number of participants, including guests
+	// required: true
+	// example: 8
+	ParticipantCount int `json:"participant_count"`
+
+	// When the group was created (UTC)
+	// required: true
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OutgoingMatchDTO represents the participant the user was drawn to give a gift to in a group
+// swagger:model OutgoingMatchDTO
+type OutgoingMatchDTO struct {
+	// ID of the group
+	// required: true
+	// example: 01234567-89ab-cdef-0123-456789abcdef
+	GroupID string `json:"group_id"`
+
+	// Name of the group
+	// required: true
+	// example: Secret Santa 2024
+	GroupName string `json:"group_name"`
+
+	// Participant who receives the gift from the user
+	// required: true
+	Receiver ParticipantDTO `json:"receiver"`
+}
+
+// AccountSessionDTO represents a login of the user and the device it was used from
+// swagger:model AccountSessionDTO
+type AccountSessionDTO struct {
+	// Session identifier
+	// required: true
+	// example: 0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d
+	ID string `json:"id"`
+
+	// User agent of the device, as last reported by it
+	// example: Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0
+	UserAgent string `json:"user_agent"`
+
+	// IP address the session was last used from
+	// example: 203.0.113.10
+	IPAddress string `json:"ip_address"`
+
+	// When the session was last used
+	// required: true
+	// example: 2024-01-01T12:30:00Z
+	LastSeenAt time.Time `json:"last_seen_at"`
+
+	// Login timestamp
+	// required: true
+	// example: 2024-01-01T00:00:00Z
+	CreatedAt time.Time `json:"created_at"`
+
+	// When the session ends unless it is renewed
+	// required: true
+	// example: 2024-01-31T00:00:00Z
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// When the session was ended by a logout
+	// example: 2024-01-15T00:00:00Z
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+func mapAccountExportFromDomain(export domain.AccountExport) (*AccountExportDTO, error) {
+	userDTO, err := mapUserFromDomain(export.User)
+	if err != nil {
+		return nil, err
+	}
+
+	inviteDTOs, err := mapGroupInvitesFromDomain(export.Invites)
+	if err != nil {
+		return nil, err
+	}
+
+	membershipDTOs := make([]GroupMembershipDTO, 0, len(export.Memberships))
+	for _, membership := range export.Memberships {
+		membershipDTOs = append(membershipDTOs, GroupMembershipDTO{
+			GroupID:   membership.GroupID,
+			GroupName: membership.GroupName,
+			Status:    string(membership.Status),
+			IsOwner:   membership.IsOwner,
+		})
+	}
+
+	ownedGroupDTOs := make([]OwnedGroupDTO, 0, len(export.OwnedGroups))
+	for _, group := range export.OwnedGroups {
+		ownedGroupDTOs = append(ownedGroupDTOs, OwnedGroupDTO{
+			ID:               group.ID,
+			Name:             group.Name,
+			Description:      group.Description,
+			Status:           string(group.Status),
+			MaxMembers:       group.MaxMembers,
+			Budget:           group.Budget,
+			Rules:            group.Rules,
+			ExchangeDate:     group.ExchangeDate,
+			MatchStrategy:    string(group.MatchStrategy),
+			ParticipantCount: len(group.Users) + len(group.Guests),
+			CreatedAt:        group.CreatedAt,
+		})
+	}
+
+	matchDTOs := make([]OutgoingMatchDTO, 0, len(export.Matches))
+	for _, match := range export.Matches {
+		receiverDTO, err := mapParticipantFromDomain(match.Receiver)
+		if err != nil {
+			return nil, err
+		}
+
+		matchDTOs = append(matchDTOs, OutgoingMatchDTO{
+			GroupID:   match.GroupID,
+			GroupName: match.GroupName,
+			Receiver:  *receiverDTO,
+		})
+	}
+
+	sessionDTOs := make([]AccountSessionDTO, 0, len(export.Sessions))
+	for _, session := range export.Sessions {
+		sessionDTOs = append(sessionDTOs, AccountSessionDTO{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			LastSeenAt: session.LastSeenAt,
+			CreatedAt:  session.CreatedAt,
+			ExpiresAt:  session.ExpiresAt,
+			RevokedAt:  session.RevokedAt,
+		})
+	}
+
+	return &AccountExportDTO{
+		User:             *userDTO,
+		GroupMemberships: membershipDTOs,
+		OwnedGroups:      ownedGroupDTOs,
+		Invites:          inviteDTOs,
+		Matches:          matchDTOs,
+		Sessions:         sessionDTOs,
+		GeneratedAt:      export.GeneratedAt,
+	}, nil
+}
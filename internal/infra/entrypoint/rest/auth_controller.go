@@ -1,27 +1,78 @@
 package rest
 
 import (
+	jwtware "github.com/gofiber/contrib/v3/jwt"
 	"github.com/gofiber/fiber/v3"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 )
 
 type AuthController struct {
-	authService  application.AuthService
-	cookieSecure bool
+	authService      application.AuthService
+	authTokenManager domain.AuthTokenManager
+	cookieSecure     bool
 }
 
-func NewAuthController(authService application.AuthService, cookieSecure bool) *AuthController {
+func NewAuthController(authService application.AuthService, authTokenManager domain.AuthTokenManager, cookieSecure bool) *AuthController {
 	return &AuthController{
-		authService:  authService,
-		cookieSecure: cookieSecure,
+		authService:      authService,
+		authTokenManager: authTokenManager,
+		cookieSecure:     cookieSecure,
 	}
 }
 
+// Logout revokes the session of the refresh token and the session of the access token, whichever are sent, and
+// clears the auth cookies. The access token is only in the context when the optional auth middleware accepted it.
 func (c *AuthController) Logout(ctx fiber.Ctx) error {
+	refreshToken, err := getRefreshToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	var userID, sessionID string
+	if token := jwtware.FromContext(ctx); token != nil {
+		userID, err = c.authTokenManager.GetAuthUserID(token)
+		if err != nil {
+			return err
+		}
+
+		sessionID, err = c.authTokenManager.GetSessionID(token)
+		if err != nil {
+			return err
+		}
+	}
+
+	if refreshToken != "" || sessionID != "" {
+		if err := c.authService.Logout(ctx.Context(), refreshToken, userID, sessionID); err != nil {
+			return err
+		}
+	}
+
 	clearCookie(ctx)
 	return ctx.SendStatus(fiber.StatusNoContent)
 }
 
+func (c *AuthController) Refresh(ctx fiber.Ctx) error {
+	refreshToken, err := getRefreshToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	authSession, err := c.authService.Refresh(ctx.Context(), refreshToken)
+	if err != nil {
+		return err
+	}
+
+	authSessionDTO, err := mapAuthSessionFromDomain(*authSession)
+	if err != nil {
+		return err
+	}
+
+	setSessionCookies(ctx, *authSession, c.cookieSecure)
+
+	return ctx.JSON(authSessionDTO)
+}
+
 func (c *AuthController) Login(ctx fiber.Ctx) error {
 	var credentialsDTO CredentialsDTO
 
@@ -34,7 +85,72 @@ func (c *AuthController) Login(ctx fiber.Ctx) error {
 		return err
 	}
 
-	authSession, err := c.authService.Login(ctx.Context(), *credentials)
+	loginResult, err := c.authService.Login(ctx.Context(), *credentials, getDevice(ctx))
+	if err != nil {
+		return err
+	}
+
+	return sendLoginResult(ctx, *loginResult, c.cookieSecure)
+}
+
+// sendLoginResult answers with the session, or with the two-factor challenge when the user still has to send a code.
+func sendLoginResult(ctx fiber.Ctx, loginResult domain.LoginResult, cookieSecure bool) error {
+	if loginResult.RequiresTwoFactor() {
+		return ctx.Status(fiber.StatusAccepted).JSON(mapTwoFactorChallengeFromDomain(loginResult))
+	}
+
+	authSessionDTO, err := mapAuthSessionFromDomain(*loginResult.Session)
+	if err != nil {
+		return err
+	}
+
+	setSessionCookies(ctx, *loginResult.Session, cookieSecure)
+
+	return ctx.JSON(authSessionDTO)
+}
+
+// VerifyTwoFactor finishes the login of a user with two-factor authentication, exchanging the challenge for a session.
+func (c *AuthController) VerifyTwoFactor(ctx fiber.Ctx) error {
+	var verifyTwoFactorDTO VerifyTwoFactorDTO
+	if err := ctx.Bind().Body(&verifyTwoFactorDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := verifyTwoFactorDTO.Validate(); err != nil {
+		return err
+	}
+
+	authSession, err := c.authService.VerifyTwoFactor(ctx.Context(), verifyTwoFactorDTO.ChallengeToken, verifyTwoFactorDTO.Code, getDevice(ctx))
+	if err != nil {
+		return err
+	}
+
+	authSessionDTO, err := mapAuthSessionFromDomain(*authSession)
+	if err != nil {
+		return err
+	}
+
+	setSessionCookies(ctx, *authSession, c.cookieSecure)
+
+	return ctx.JSON(authSessionDTO)
+}
+
+func (c *AuthController) ChangePassword(ctx fiber.Ctx) error {
+	var changePasswordDTO ChangePasswordDTO
+	if err := ctx.Bind().Body(&changePasswordDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := changePasswordDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	authSession, err := c.authService.ChangePassword(ctx.Context(), authUserID, changePasswordDTO.CurrentPassword, changePasswordDTO.NewPassword, getDevice(ctx))
 	if err != nil {
 		return err
 	}
@@ -44,7 +160,24 @@ func (c *AuthController) Login(ctx fiber.Ctx) error {
 		return err
 	}
 
-	setCookie(ctx, authSession.AccessToken, authSession.ExpiresIn, c.cookieSecure)
+	setSessionCookies(ctx, *authSession, c.cookieSecure)
 
 	return ctx.JSON(authSessionDTO)
 }
+
+// getRefreshToken reads the refresh token from the request body, falling back to the refresh_token cookie.
+func getRefreshToken(ctx fiber.Ctx) (string, error) {
+	var refreshTokenDTO RefreshTokenDTO
+
+	if len(ctx.Body()) > 0 {
+		if err := ctx.Bind().Body(&refreshTokenDTO); err != nil {
+			return "", fiber.NewError(fiber.StatusUnprocessableEntity)
+		}
+	}
+
+	if refreshTokenDTO.RefreshToken != "" {
+		return refreshTokenDTO.RefreshToken, nil
+	}
+
+	return ctx.Cookies(refreshCookieName), nil
+}
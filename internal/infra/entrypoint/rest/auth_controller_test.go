@@ -1,16 +1,24 @@
 package rest_test
 
 import (
+	"context"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest/build_rest"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
 	"github.com/waliqueiroz/mystery-gifter-api/test/helper"
 	"go.uber.org/mock/gomock"
 )
@@ -20,7 +28,7 @@ func Test_AuthController_Logout(t *testing.T) {
 
 	t.Run("should clear auth cookie and return 204 on logout", func(t *testing.T) {
 		// given
-		authController := rest.NewAuthController(nil, false)
+		authController := rest.NewAuthController(nil, nil, false)
 
 		req := httptest.NewRequest(fiber.MethodPost, route, nil)
 
@@ -40,6 +48,234 @@ func Test_AuthController_Logout(t *testing.T) {
 		assert.Contains(t, setCookieHeader, "access_token=;")
 		assert.Contains(t, setCookieHeader, "max-age=0")
 	})
+
+	t.Run("should revoke the refresh token sent in the cookie", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().Logout(gomock.Any(), "some_refresh_token", "", "").Return(nil)
+
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "some_refresh_token"})
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.Logout)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+
+		setCookieHeaders := response.Header.Values("Set-Cookie")
+		assert.Len(t, setCookieHeaders, 2)
+		assert.Contains(t, setCookieHeaders[1], "refresh_token=;")
+	})
+
+	t.Run("should revoke the session of the access token sent in the authorization header", func(t *testing.T) {
+		// given
+		accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"jti":    "some-session-id",
+			"userID": "some-user-id",
+		}).SignedString([]byte("test-secret-key"))
+		assert.NoError(t, err)
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+		mockedAuthTokenManager.EXPECT().GetSessionID(gomock.Any()).Return("some-session-id", nil)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().Logout(gomock.Any(), "", "some-user-id", "some-session-id").Return(nil)
+
+		authController := rest.NewAuthController(mockedAuthService, mockedAuthTokenManager, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, entrypoint.NewOptionalAuthMiddleware("test-secret-key"), authController.Logout)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+	})
+
+	t.Run("should still log out when the access token is not valid", func(t *testing.T) {
+		// given
+		authController := rest.NewAuthController(nil, nil, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+		req.Header.Set("Authorization", "Bearer not-a-token")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, entrypoint.NewOptionalAuthMiddleware("test-secret-key"), authController.Logout)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+	})
+
+	t.Run("should return internal_server_error when revoking the refresh token fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().Logout(gomock.Any(), "some_refresh_token", "", "").Return(assert.AnError)
+
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
+
+		payload := helper.EncodeJSON(t, rest.RefreshTokenDTO{RefreshToken: "some_refresh_token"})
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.Logout)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusInternalServerError, response.StatusCode)
+	})
+}
+
+func Test_AuthController_Refresh(t *testing.T) {
+	route := "/api/v1/auth/refresh"
+
+	t.Run("should return the new session and set both cookies when the token is in the body", func(t *testing.T) {
+		// given
+		authSession := build_domain.NewAuthSessionBuilder().WithAccessToken("new_token").WithRefreshToken("new_refresh_token").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().Refresh(gomock.Any(), "some_refresh_token").Return(&authSession, nil)
+
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
+
+		payload := helper.EncodeJSON(t, rest.RefreshTokenDTO{RefreshToken: "some_refresh_token"})
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.Refresh)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.AuthSessionDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, "new_token", result.AccessToken)
+		assert.Equal(t, "new_refresh_token", result.RefreshToken)
+		assert.Equal(t, authSession.RefreshTokenExpiresIn, result.RefreshTokenExpiresIn)
+
+		setCookieHeaders := response.Header.Values("Set-Cookie")
+		assert.Len(t, setCookieHeaders, 2)
+		assert.Contains(t, setCookieHeaders[0], "access_token=new_token")
+		assert.Contains(t, setCookieHeaders[1], "refresh_token=new_refresh_token")
+	})
+
+	t.Run("should fall back to the refresh token cookie", func(t *testing.T) {
+		// given
+		authSession := build_domain.NewAuthSessionBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().Refresh(gomock.Any(), "cookie_refresh_token").Return(&authSession, nil)
+
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "cookie_refresh_token"})
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.Refresh)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+	})
+
+	t.Run("should return unauthorized when the refresh token was already used", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().Refresh(gomock.Any(), "used_refresh_token").Return(nil, domain.NewUnauthorizedError("refresh token has already been used"))
+
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
+
+		payload := helper.EncodeJSON(t, rest.RefreshTokenDTO{RefreshToken: "used_refresh_token"})
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.Refresh)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, response.StatusCode)
+		assert.Empty(t, response.Header.Values("Set-Cookie"))
+	})
+
+	t.Run("should return unprocessable_entity when the body is malformed", func(t *testing.T) {
+		// given
+		authController := rest.NewAuthController(nil, nil, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, strings.NewReader("{invalid"))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.Refresh)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnprocessableEntity, response.StatusCode)
+	})
 }
 
 func Test_AuthController_Login(t *testing.T) {
@@ -56,19 +292,30 @@ func Test_AuthController_Login(t *testing.T) {
 		credentialsDTO := build_rest.NewCredentialsDTOBuilder().WithEmail(email).WithPassword(password).Build()
 
 		authSession := build_domain.NewAuthSessionBuilder().WithUser(user).Build()
-		expectedAuthSession := build_rest.NewAuthSessionDTOBuilder().WithUser(userDTO).WithAccessToken(authSession.AccessToken).WithTokenType(authSession.TokenType).WithExpiresIn(authSession.ExpiresIn).Build()
+		expectedAuthSession := build_rest.NewAuthSessionDTOBuilder().
+			WithUser(userDTO).
+			WithAccessToken(authSession.AccessToken).
+			WithTokenType(authSession.TokenType).
+			WithExpiresIn(authSession.ExpiresIn).
+			WithRefreshToken(authSession.RefreshToken).
+			WithRefreshTokenExpiresIn(authSession.RefreshTokenExpiresIn).
+			Build()
 
 		mockCtrl := gomock.NewController(t)
 
 		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
-		mockedAuthService.EXPECT().Login(gomock.Any(), credentials).Return(&authSession, nil)
+		mockedAuthService.EXPECT().Login(gomock.Any(), credentials, gomock.Any()).DoAndReturn(func(_ context.Context, _ domain.Credentials, device domain.Device) (*domain.LoginResult, error) {
+			assert.Equal(t, "some-user-agent", device.UserAgent)
+			return &domain.LoginResult{Session: &authSession}, nil
+		})
 
-		authController := rest.NewAuthController(mockedAuthService, false)
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
 
 		payload := helper.EncodeJSON(t, credentialsDTO)
 
 		req := httptest.NewRequest(fiber.MethodPost, route, payload)
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "some-user-agent")
 
 		app := fiber.New(fiber.Config{
 			ErrorHandler: entrypoint.CustomErrorHandler,
@@ -101,9 +348,9 @@ func Test_AuthController_Login(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
-		mockedAuthService.EXPECT().Login(gomock.Any(), credentials).Return(&authSession, nil)
+		mockedAuthService.EXPECT().Login(gomock.Any(), credentials, gomock.Any()).Return(&domain.LoginResult{Session: &authSession}, nil)
 
-		authController := rest.NewAuthController(mockedAuthService, false)
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
 
 		payload := helper.EncodeJSON(t, credentialsDTO)
 
@@ -145,9 +392,9 @@ func Test_AuthController_Login(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
-		mockedAuthService.EXPECT().Login(gomock.Any(), credentials).Return(nil, assert.AnError)
+		mockedAuthService.EXPECT().Login(gomock.Any(), credentials, gomock.Any()).Return(nil, assert.AnError)
 
-		authController := rest.NewAuthController(mockedAuthService, false)
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
 
 		payload := helper.EncodeJSON(t, credentialsDTO)
 
@@ -179,7 +426,7 @@ func Test_AuthController_Login(t *testing.T) {
 
 		credentialsDTO := build_rest.NewCredentialsDTOBuilder().WithEmail("").WithPassword(password).Build()
 
-		authController := rest.NewAuthController(nil, false)
+		authController := rest.NewAuthController(nil, nil, false)
 
 		payload := helper.EncodeJSON(t, credentialsDTO)
 
@@ -212,7 +459,7 @@ func Test_AuthController_Login(t *testing.T) {
 
 	t.Run("should return unprocessable_entity when payload is malformed", func(t *testing.T) {
 		// given
-		authController := rest.NewAuthController(nil, false)
+		authController := rest.NewAuthController(nil, nil, false)
 
 		payload := helper.EncodeJSON(t, "invalid_payload")
 
@@ -238,6 +485,46 @@ func Test_AuthController_Login(t *testing.T) {
 		assert.Equal(t, "Unprocessable Entity", result.Message)
 	})
 
+	t.Run("should return the challenge without cookies when two-factor authentication is enabled", func(t *testing.T) {
+		// given
+		email := "test@mail.com"
+		password := "some_password"
+
+		credentials := build_domain.NewCredentialsBuilder().WithEmail(email).WithPassword(password).Build()
+		credentialsDTO := build_rest.NewCredentialsDTOBuilder().WithEmail(email).WithPassword(password).Build()
+
+		loginResult := domain.LoginResult{ChallengeToken: "some-challenge-token", ChallengeExpiresIn: 1767225600}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().Login(gomock.Any(), credentials, gomock.Any()).Return(&loginResult, nil)
+
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
+
+		payload := helper.EncodeJSON(t, credentialsDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.Login)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusAccepted, response.StatusCode)
+		assert.Empty(t, response.Header.Values("Set-Cookie"))
+
+		var result rest.TwoFactorChallengeDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, rest.TwoFactorChallengeDTO{TwoFactorRequired: true, ChallengeToken: "some-challenge-token", ExpiresIn: 1767225600}, result)
+	})
+
 	t.Run("should set auth cookie on successful login", func(t *testing.T) {
 		// given
 		email := "test@mail.com"
@@ -252,9 +539,9 @@ func Test_AuthController_Login(t *testing.T) {
 		mockCtrl := gomock.NewController(t)
 
 		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
-		mockedAuthService.EXPECT().Login(gomock.Any(), credentials).Return(&authSession, nil)
+		mockedAuthService.EXPECT().Login(gomock.Any(), credentials, gomock.Any()).Return(&domain.LoginResult{Session: &authSession}, nil)
 
-		authController := rest.NewAuthController(mockedAuthService, false)
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
 
 		payload := helper.EncodeJSON(t, credentialsDTO)
 
@@ -277,5 +564,238 @@ func Test_AuthController_Login(t *testing.T) {
 		assert.Contains(t, setCookieHeader, "access_token=")
 		assert.Contains(t, setCookieHeader, "HttpOnly")
 		assert.Contains(t, setCookieHeader, "SameSite=Lax")
+
+		setCookieHeaders := response.Header.Values("Set-Cookie")
+		assert.Len(t, setCookieHeaders, 2)
+		assert.Contains(t, setCookieHeaders[1], "refresh_token="+authSession.RefreshToken)
+		assert.Contains(t, setCookieHeaders[1], "HttpOnly")
+	})
+}
+
+func Test_AuthController_VerifyTwoFactor(t *testing.T) {
+	route := "/api/v1/login/two-factor"
+
+	t.Run("should exchange the challenge for a session and set the auth cookies", func(t *testing.T) {
+		// given
+		authSession := build_domain.NewAuthSessionBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().VerifyTwoFactor(gomock.Any(), "some-challenge-token", "123456", gomock.Any()).Return(&authSession, nil)
+
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
+
+		payload := helper.EncodeJSON(t, rest.VerifyTwoFactorDTO{ChallengeToken: "some-challenge-token", Code: "123456"})
+
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.VerifyTwoFactor)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+		assert.Len(t, response.Header.Values("Set-Cookie"), 2)
+
+		var result rest.AuthSessionDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, authSession.AccessToken, result.AccessToken)
+	})
+
+	t.Run("should return bad_request when the code is missing", func(t *testing.T) {
+		// given
+		authController := rest.NewAuthController(nil, nil, false)
+
+		payload := helper.EncodeJSON(t, rest.VerifyTwoFactorDTO{ChallengeToken: "some-challenge-token"})
+
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.VerifyTwoFactor)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Contains(t, result.Details, map[string]any{
+			"field": "code",
+			"error": "code is a required field",
+		})
+	})
+
+	t.Run("should return unauthorized when the challenge is invalid", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().VerifyTwoFactor(gomock.Any(), "some-challenge-token", "123456", gomock.Any()).Return(nil, domain.NewUnauthorizedError("invalid or expired two-factor challenge"))
+
+		authController := rest.NewAuthController(mockedAuthService, nil, false)
+
+		payload := helper.EncodeJSON(t, rest.VerifyTwoFactorDTO{ChallengeToken: "some-challenge-token", Code: "123456"})
+
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, authController.VerifyTwoFactor)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, response.StatusCode)
+		assert.Empty(t, response.Header.Values("Set-Cookie"))
+	})
+}
+
+func Test_AuthController_ChangePassword(t *testing.T) {
+	route := "/api/v1/users/me/password"
+
+	t.Run("should change the password, set a new cookie and return the new session", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		changePasswordDTO := build_rest.NewChangePasswordDTOBuilder().Build()
+		authSession := build_domain.NewAuthSessionBuilder().WithUser(user).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(user.ID, nil)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().ChangePassword(gomock.Any(), user.ID, changePasswordDTO.CurrentPassword, changePasswordDTO.NewPassword, gomock.Any()).Return(&authSession, nil)
+
+		authController := rest.NewAuthController(mockedAuthService, mockedAuthTokenManager, false)
+
+		payload := helper.EncodeJSON(t, changePasswordDTO)
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, authController.ChangePassword)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+		assert.Contains(t, response.Header.Get("Set-Cookie"), "access_token="+authSession.AccessToken)
+
+		var result rest.AuthSessionDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, authSession.AccessToken, result.AccessToken)
+		assert.Equal(t, user.ID, result.User.ID)
+	})
+
+	t.Run("should return bad_request when the confirmation does not match", func(t *testing.T) {
+		// given
+		changePasswordDTO := build_rest.NewChangePasswordDTOBuilder().WithNewPasswordConfirm("something-else").Build()
+
+		authController := rest.NewAuthController(nil, nil, false)
+
+		payload := helper.EncodeJSON(t, changePasswordDTO)
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, authController.ChangePassword)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the new password is too short", func(t *testing.T) {
+		// given
+		changePasswordDTO := build_rest.NewChangePasswordDTOBuilder().WithNewPassword("short").WithNewPasswordConfirm("short").Build()
+
+		authController := rest.NewAuthController(nil, nil, false)
+
+		payload := helper.EncodeJSON(t, changePasswordDTO)
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, authController.ChangePassword)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the current password is wrong", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		changePasswordDTO := build_rest.NewChangePasswordDTOBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(user.ID, nil)
+
+		mockedAuthService := mock_application.NewMockAuthService(mockCtrl)
+		mockedAuthService.EXPECT().ChangePassword(gomock.Any(), user.ID, gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, domain.NewValidationError(validator.ValidationErrors{{Field: "CurrentPassword", Error: "CurrentPassword is incorrect"}}))
+
+		authController := rest.NewAuthController(mockedAuthService, mockedAuthTokenManager, false)
+
+		payload := helper.EncodeJSON(t, changePasswordDTO)
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, authController.ChangePassword)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+		assert.Empty(t, response.Header.Get("Set-Cookie"))
+	})
+
+	t.Run("should return unprocessable_entity when the body is invalid", func(t *testing.T) {
+		// given
+		authController := rest.NewAuthController(nil, nil, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, strings.NewReader("{invalid"))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, authController.ChangePassword)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnprocessableEntity, response.StatusCode)
 	})
 }
@@ -39,6 +39,41 @@ func mapCredentialsToDomain(credentialsDTO CredentialsDTO) (*domain.Credentials,
 	return credentials, nil
 }
 
+// ChangePasswordDTO represents the request body to change the authenticated user password
+// swagger:model ChangePasswordDTO
+type ChangePasswordDTO struct {
+	// Current password of the user
+	// required: true
+	// example: mypassword123
+	CurrentPassword string `json:"current_password" validate:"required"`
+
+	// New password, at least 8 characters long
+	// required: true
+	// example: mynewpassword123
+	NewPassword string `json:"new_password" validate:"required,min=8,eqfield=NewPasswordConfirm"`
+
+	// Confirmation of the new password, must match new_password
+	// required: true
+	// example: mynewpassword123
+	NewPasswordConfirm string `json:"new_password_confirm" validate:"required"`
+}
+
+func (c *ChangePasswordDTO) Validate() error {
+	if errs := validator.Validate(c); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+// RefreshTokenDTO represents the request body to renew or end a session. Web clients can leave it out
+// and rely on the refresh_token cookie instead.
+// swagger:model RefreshTokenDTO
+type RefreshTokenDTO struct {
+	// Refresh token received with the current session
+	// example: 3q2-7wD9kE4Jx0l3Z8n6bQ1rT5vY2uW9sA4cF7hK0mP
+	RefreshToken string `json:"refresh_token"`
+}
+
 // AuthSessionDTO represents the authentication session response
 // swagger:model AuthSessionDTO
 type AuthSessionDTO struct {
@@ -60,6 +95,16 @@ type AuthSessionDTO struct {
 	// required: true
 	// example: 3600
 	ExpiresIn int64 `json:"expires_in" validate:"required"`
+
+	// Opaque token used to get a new access token at /api/v1/auth/refresh. It can only be used once.
+	// required: true
+	// example: 3q2-7wD9kE4Jx0l3Z8n6bQ1rT5vY2uW9sA4cF7hK0mP
+	RefreshToken string `json:"refresh_token" validate:"required"`
+
+	// Refresh token expiration time, as a Unix timestamp
+	// required: true
+	// example: 1767225600
+	RefreshTokenExpiresIn int64 `json:"refresh_token_expires_in" validate:"required"`
 }
 
 func (a *AuthSessionDTO) Validate() error {
@@ -76,10 +121,12 @@ func mapAuthSessionFromDomain(authSession domain.AuthSession) (*AuthSessionDTO,
 	}
 
 	authSessionDTO := AuthSessionDTO{
-		User:        *userDTO,
-		AccessToken: authSession.AccessToken,
-		TokenType:   authSession.TokenType,
-		ExpiresIn:   authSession.ExpiresIn,
+		User:                  *userDTO,
+		AccessToken:           authSession.AccessToken,
+		TokenType:             authSession.TokenType,
+		ExpiresIn:             authSession.ExpiresIn,
+		RefreshToken:          authSession.RefreshToken,
+		RefreshTokenExpiresIn: authSession.RefreshTokenExpiresIn,
 	}
 
 	if err := authSessionDTO.Validate(); err != nil {
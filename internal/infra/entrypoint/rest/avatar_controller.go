@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"io"
+
+	jwtware "github.com/gofiber/contrib/v3/jwt"
+	"github.com/gofiber/fiber/v3"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// avatarFormField is the multipart field the avatar picture is uploaded in.
+const avatarFormField = "avatar"
+
+type AvatarController struct {
+	avatarService    application.AvatarService
+	authTokenManager domain.AuthTokenManager
+}
+
+func NewAvatarController(avatarService application.AvatarService, authTokenManager domain.AuthTokenManager) *AvatarController {
+	return &AvatarController{
+		avatarService:    avatarService,
+		authTokenManager: authTokenManager,
+	}
+}
+
+func (c *AvatarController) Upload(ctx fiber.Ctx) error {
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	fileHeader, err := ctx.FormFile(avatarFormField)
+	if err != nil {
+		return domain.NewValidationError(validator.ValidationErrors{{Field: "Avatar", Error: "Avatar is a required field"}})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return err
+	}
+
+	user, err := c.avatarService.Upload(ctx.Context(), authUserID, content)
+	if err != nil {
+		return err
+	}
+
+	userDTO, err := mapUserFromDomain(*user)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(userDTO)
+}
+
+func (c *AvatarController) Delete(ctx fiber.Ctx) error {
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	user, err := c.avatarService.Remove(ctx.Context(), authUserID)
+	if err != nil {
+		return err
+	}
+
+	userDTO, err := mapUserFromDomain(*user)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(userDTO)
+}
+
+func (c *AvatarController) Get(ctx fiber.Ctx) error {
+	userID := ctx.Params("userID")
+
+	size, err := domain.NewAvatarSize(ctx.Params("size"))
+	if err != nil {
+		return err
+	}
+
+	blob, err := c.avatarService.Get(ctx.Context(), userID, size)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentType, blob.ContentType)
+	ctx.Set(fiber.HeaderCacheControl, "public, max-age=86400")
+	return ctx.Send(blob.Content)
+}
@@ -0,0 +1,210 @@
+package rest_test
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+	"github.com/waliqueiroz/mystery-gifter-api/test/helper"
+	"go.uber.org/mock/gomock"
+)
+
+func encodeMultipartFile(t *testing.T, field string, content []byte) (*bytes.Buffer, string) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile(field, "avatar.png")
+	assert.NoError(t, err)
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	return &body, writer.FormDataContentType()
+}
+
+func Test_AvatarController_Upload(t *testing.T) {
+	route := "/api/v1/users/me/avatar"
+
+	t.Run("should return status 200 and the user with the avatar URLs", func(t *testing.T) {
+		// given
+		content := []byte("some picture")
+		user := build_domain.NewUserBuilder().WithAvatarID("some-avatar-id").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(user.ID, nil)
+
+		mockedAvatarService := mock_application.NewMockAvatarService(mockCtrl)
+		mockedAvatarService.EXPECT().Upload(gomock.Any(), user.ID, content).Return(&user, nil)
+
+		avatarController := rest.NewAvatarController(mockedAvatarService, mockedAuthTokenManager)
+
+		body, contentType := encodeMultipartFile(t, "avatar", content)
+		req := httptest.NewRequest(fiber.MethodPut, route, body)
+		req.Header.Set("Content-Type", contentType)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Put(route, avatarController.Upload)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.UserDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, user.ID, result.ID)
+		assert.Equal(t, &rest.AvatarDTO{
+			Small:  "/api/v1/users/" + user.ID + "/avatar/small?v=some-avatar-id",
+			Medium: "/api/v1/users/" + user.ID + "/avatar/medium?v=some-avatar-id",
+			Large:  "/api/v1/users/" + user.ID + "/avatar/large?v=some-avatar-id",
+		}, result.Avatar)
+	})
+
+	t.Run("should return bad_request when the avatar file is missing", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+
+		avatarController := rest.NewAvatarController(nil, mockedAuthTokenManager)
+
+		body, contentType := encodeMultipartFile(t, "picture", []byte("some picture"))
+		req := httptest.NewRequest(fiber.MethodPut, route, body)
+		req.Header.Set("Content-Type", contentType)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Put(route, avatarController.Upload)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+}
+
+func Test_AvatarController_Delete(t *testing.T) {
+	route := "/api/v1/users/me/avatar"
+
+	t.Run("should return status 200 and the user without avatar", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(user.ID, nil)
+
+		mockedAvatarService := mock_application.NewMockAvatarService(mockCtrl)
+		mockedAvatarService.EXPECT().Remove(gomock.Any(), user.ID).Return(&user, nil)
+
+		avatarController := rest.NewAvatarController(mockedAvatarService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Delete(route, avatarController.Delete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.UserDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, user.ID, result.ID)
+		assert.Nil(t, result.Avatar)
+	})
+}
+
+func Test_AvatarController_Get(t *testing.T) {
+	route := "/api/v1/users/:userID/avatar/:size"
+
+	t.Run("should return the thumbnail with its content type", func(t *testing.T) {
+		// given
+		blob := domain.Blob{Content: []byte("thumbnail"), ContentType: "image/png"}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAvatarService := mock_application.NewMockAvatarService(mockCtrl)
+		mockedAvatarService.EXPECT().Get(gomock.Any(), "some-user-id", domain.AvatarSizeMedium).Return(&blob, nil)
+
+		avatarController := rest.NewAvatarController(mockedAvatarService, nil)
+
+		req := httptest.NewRequest(fiber.MethodGet, "/api/v1/users/some-user-id/avatar/medium", nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Get(route, avatarController.Get)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+		assert.Equal(t, "image/png", response.Header.Get(fiber.HeaderContentType))
+		assert.Equal(t, "public, max-age=86400", response.Header.Get(fiber.HeaderCacheControl))
+
+		content, err := io.ReadAll(response.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, blob.Content, content)
+	})
+
+	t.Run("should return bad_request when the size is invalid", func(t *testing.T) {
+		// given
+		avatarController := rest.NewAvatarController(nil, nil)
+
+		req := httptest.NewRequest(fiber.MethodGet, "/api/v1/users/some-user-id/avatar/huge", nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Get(route, avatarController.Get)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return not_found when the user has no avatar", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAvatarService := mock_application.NewMockAvatarService(mockCtrl)
+		mockedAvatarService.EXPECT().Get(gomock.Any(), "some-user-id", domain.AvatarSizeSmall).
+			Return(nil, domain.NewResourceNotFoundError("avatar not found"))
+
+		avatarController := rest.NewAvatarController(mockedAvatarService, nil)
+
+		req := httptest.NewRequest(fiber.MethodGet, "/api/v1/users/some-user-id/avatar/small", nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Get(route, avatarController.Get)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, response.StatusCode)
+	})
+}
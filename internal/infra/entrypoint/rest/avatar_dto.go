@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"fmt"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// AvatarDTO holds the URLs of the avatar thumbnails. The URLs change whenever a new avatar is uploaded.
+// swagger:model AvatarDTO
+type AvatarDTO struct {
+	// URL of the 64x64 thumbnail
+	// required: true
+	// example: /api/v1/users/01234567-89ab-cdef-0123-456789abcdef/avatar/small?v=01234567-89ab-cdef-0123-456789abcdef
+	Small string `json:"small"`
+
+	// URL of the 128x128 thumbnail
+	// required: true
+	// example: /api/v1/users/01234567-89ab-cdef-0123-456789abcdef/avatar/medium?v=01234567-89ab-cdef-0123-456789abcdef
+	Medium string `json:"medium"`
+
+	// URL of the 256x256 thumbnail
+	// required: true
+	// example: /api/v1/users/01234567-89ab-cdef-0123-456789abcdef/avatar/large?v=01234567-89ab-cdef-0123-456789abcdef
+	Large string `json:"large"`
+}
+
+func mapAvatarFromDomain(user domain.User) *AvatarDTO {
+	if !user.HasAvatar() {
+		return nil
+	}
+
+	return &AvatarDTO{
+		Small:  avatarURL(user, domain.AvatarSizeSmall),
+		Medium: avatarURL(user, domain.AvatarSizeMedium),
+		Large:  avatarURL(user, domain.AvatarSizeLarge),
+	}
+}
+
+// avatarURL carries the avatar ID, so clients and proxies never serve a cached thumbnail of a replaced avatar.
+func avatarURL(user domain.User, size domain.AvatarSize) string {
+	return fmt.Sprintf("/api/v1/users/%s/avatar/%s?v=%s", user.ID, size, user.AvatarID)
+}
@@ -22,6 +22,11 @@ func (b *AddUserDTOBuilder) WithUserID(userID string) *AddUserDTOBuilder {
 	return b
 }
 
+func (b *AddUserDTOBuilder) WithEmail(email string) *AddUserDTOBuilder {
+	b.addUserDTO.Email = email
+	return b
+}
+
 func (b *AddUserDTOBuilder) Build() rest.AddUserDTO {
 	return b.addUserDTO
 }
@@ -22,9 +22,11 @@ func NewAuthSessionDTOBuilder() *AuthSessionDTOBuilder {
 				CreatedAt: time.Now().UTC(),
 				UpdatedAt: time.Now().UTC(),
 			},
-			AccessToken: "DefaultAccessToken",
-			TokenType:   "Bearer",
-			ExpiresIn:   time.Now().Add(time.Hour * 24).Unix(), // Expires in 24 hours by default
+			AccessToken:           "DefaultAccessToken",
+			TokenType:             "Bearer",
+			ExpiresIn:             time.Now().Add(time.Hour * 24).Unix(), // Expires in 24 hours by default
+			RefreshToken:          "DefaultRefreshToken",
+			RefreshTokenExpiresIn: time.Now().Add(time.Hour * 24 * 30).Unix(),
 		},
 	}
 }
@@ -49,6 +51,16 @@ func (b *AuthSessionDTOBuilder) WithExpiresIn(expiresIn int64) *AuthSessionDTOBu
 	return b
 }
 
+func (b *AuthSessionDTOBuilder) WithRefreshToken(refreshToken string) *AuthSessionDTOBuilder {
+	b.authSessionDTO.RefreshToken = refreshToken
+	return b
+}
+
+func (b *AuthSessionDTOBuilder) WithRefreshTokenExpiresIn(refreshTokenExpiresIn int64) *AuthSessionDTOBuilder {
+	b.authSessionDTO.RefreshTokenExpiresIn = refreshTokenExpiresIn
+	return b
+}
+
 func (b *AuthSessionDTOBuilder) Build() rest.AuthSessionDTO {
 	return b.authSessionDTO
 }
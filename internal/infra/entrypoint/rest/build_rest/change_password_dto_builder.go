@@ -0,0 +1,36 @@
+package build_rest
+
+import "github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+
+type ChangePasswordDTOBuilder struct {
+	changePasswordDTO rest.ChangePasswordDTO
+}
+
+func NewChangePasswordDTOBuilder() *ChangePasswordDTOBuilder {
+	return &ChangePasswordDTOBuilder{
+		changePasswordDTO: rest.ChangePasswordDTO{
+			CurrentPassword:    "mypassword123",
+			NewPassword:        "mynewpassword123",
+			NewPasswordConfirm: "mynewpassword123",
+		},
+	}
+}
+
+func (b *ChangePasswordDTOBuilder) WithCurrentPassword(currentPassword string) *ChangePasswordDTOBuilder {
+	b.changePasswordDTO.CurrentPassword = currentPassword
+	return b
+}
+
+func (b *ChangePasswordDTOBuilder) WithNewPassword(newPassword string) *ChangePasswordDTOBuilder {
+	b.changePasswordDTO.NewPassword = newPassword
+	return b
+}
+
+func (b *ChangePasswordDTOBuilder) WithNewPasswordConfirm(newPasswordConfirm string) *ChangePasswordDTOBuilder {
+	b.changePasswordDTO.NewPasswordConfirm = newPasswordConfirm
+	return b
+}
+
+func (b *ChangePasswordDTOBuilder) Build() rest.ChangePasswordDTO {
+	return b.changePasswordDTO
+}
@@ -0,0 +1,36 @@
+package build_rest
+
+import "github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+
+type ConfirmPasswordResetDTOBuilder struct {
+	confirmPasswordResetDTO rest.ConfirmPasswordResetDTO
+}
+
+func NewConfirmPasswordResetDTOBuilder() *ConfirmPasswordResetDTOBuilder {
+	return &ConfirmPasswordResetDTOBuilder{
+		confirmPasswordResetDTO: rest.ConfirmPasswordResetDTO{
+			Token:              "some-secret-token",
+			NewPassword:        "mynewpassword123",
+			NewPasswordConfirm: "mynewpassword123",
+		},
+	}
+}
+
+func (b *ConfirmPasswordResetDTOBuilder) WithToken(token string) *ConfirmPasswordResetDTOBuilder {
+	b.confirmPasswordResetDTO.Token = token
+	return b
+}
+
+func (b *ConfirmPasswordResetDTOBuilder) WithNewPassword(newPassword string) *ConfirmPasswordResetDTOBuilder {
+	b.confirmPasswordResetDTO.NewPassword = newPassword
+	return b
+}
+
+func (b *ConfirmPasswordResetDTOBuilder) WithNewPasswordConfirm(newPasswordConfirm string) *ConfirmPasswordResetDTOBuilder {
+	b.confirmPasswordResetDTO.NewPasswordConfirm = newPasswordConfirm
+	return b
+}
+
+func (b *ConfirmPasswordResetDTOBuilder) Build() rest.ConfirmPasswordResetDTO {
+	return b.confirmPasswordResetDTO
+}
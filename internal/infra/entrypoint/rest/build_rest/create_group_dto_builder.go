@@ -7,10 +7,12 @@ type CreateGroupDTOBuilder struct {
 }
 
 func NewCreateGroupDTOBuilder() *CreateGroupDTOBuilder {
+	description := "Test Group Description"
+
 	return &CreateGroupDTOBuilder{
 		createGroupDTO: rest.CreateGroupDTO{
 			Name:        "Test Group",
-			Description: "Test Group Description",
+			Description: &description,
 		},
 	}
 }
@@ -21,7 +23,32 @@ func (b *CreateGroupDTOBuilder) WithName(name string) *CreateGroupDTOBuilder {
 }
 
 func (b *CreateGroupDTOBuilder) WithDescription(description string) *CreateGroupDTOBuilder {
-	b.createGroupDTO.Description = description
+	b.createGroupDTO.Description = &description
+	return b
+}
+
+func (b *CreateGroupDTOBuilder) WithMaxMembers(maxMembers int) *CreateGroupDTOBuilder {
+	b.createGroupDTO.MaxMembers = &maxMembers
+	return b
+}
+
+func (b *CreateGroupDTOBuilder) WithBudget(budget int) *CreateGroupDTOBuilder {
+	b.createGroupDTO.Budget = &budget
+	return b
+}
+
+func (b *CreateGroupDTOBuilder) WithRules(rules string) *CreateGroupDTOBuilder {
+	b.createGroupDTO.Rules = &rules
+	return b
+}
+
+func (b *CreateGroupDTOBuilder) WithMatchStrategy(matchStrategy string) *CreateGroupDTOBuilder {
+	b.createGroupDTO.MatchStrategy = &matchStrategy
+	return b
+}
+
+func (b *CreateGroupDTOBuilder) WithTemplateID(templateID string) *CreateGroupDTOBuilder {
+	b.createGroupDTO.TemplateID = templateID
 	return b
 }
 
@@ -0,0 +1,35 @@
+package build_rest
+
+import "github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+
+type CreateGroupTemplateDTOBuilder struct {
+	createGroupTemplateDTO rest.CreateGroupTemplateDTO
+}
+
+func NewCreateGroupTemplateDTOBuilder() *CreateGroupTemplateDTOBuilder {
+	return &CreateGroupTemplateDTOBuilder{
+		createGroupTemplateDTO: rest.CreateGroupTemplateDTO{
+			Name:           "Book club exchange",
+			Description:    "Everyone brings a book they loved",
+			MaxMembers:     12,
+			Budget:         3000,
+			Rules:          "Wrap the book so nobody can guess the title",
+			ExchangeInDays: 21,
+			MatchStrategy:  "RANDOM",
+		},
+	}
+}
+
+func (b *CreateGroupTemplateDTOBuilder) WithName(name string) *CreateGroupTemplateDTOBuilder {
+	b.createGroupTemplateDTO.Name = name
+	return b
+}
+
+func (b *CreateGroupTemplateDTOBuilder) WithBudget(budget int) *CreateGroupTemplateDTOBuilder {
+	b.createGroupTemplateDTO.Budget = budget
+	return b
+}
+
+func (b *CreateGroupTemplateDTOBuilder) Build() rest.CreateGroupTemplateDTO {
+	return b.createGroupTemplateDTO
+}
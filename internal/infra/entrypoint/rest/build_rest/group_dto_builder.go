@@ -18,14 +18,23 @@ func NewGroupDTOBuilder() *GroupDTOBuilder {
 
 	return &GroupDTOBuilder{
 		groupDTO: rest.GroupDTO{
-			ID:          uuid.NewString(),
-			Name:        "Default Group",
-			Description: "Test Group Description",
-			Users:       []rest.UserDTO{user},
-			OwnerID:     user.ID,
-			Status:      string(domain.GroupStatusOpen),
-			CreatedAt:   now,
-			UpdatedAt:   now,
+			ID:            uuid.NewString(),
+			Name:          "Default Group",
+			Description:   "Test Group Description",
+			Users:         []rest.UserDTO{user},
+			Guests:        []rest.GuestDTO{},
+			Waitlist:      []rest.UserDTO{},
+			JoinRequests:  []rest.JoinRequestDTO{},
+			MatchStrategy: string(domain.MatchStrategyCycle),
+			OwnerID:       user.ID,
+			Status:        string(domain.GroupStatusOpen),
+			AllowedActions: []string{
+				string(domain.GroupActionCloseRegistration),
+				string(domain.GroupActionGenerateMatches),
+				string(domain.GroupActionArchive),
+			},
+			CreatedAt: now,
+			UpdatedAt: now,
 		},
 	}
 }
@@ -50,6 +59,11 @@ func (b *GroupDTOBuilder) WithUsers(users []rest.UserDTO) *GroupDTOBuilder {
 	return b
 }
 
+func (b *GroupDTOBuilder) WithGuests(guests []rest.GuestDTO) *GroupDTOBuilder {
+	b.groupDTO.Guests = guests
+	return b
+}
+
 func (b *GroupDTOBuilder) WithOwnerID(ownerID string) *GroupDTOBuilder {
 	b.groupDTO.OwnerID = ownerID
 	return b
@@ -70,6 +84,26 @@ func (b *GroupDTOBuilder) WithStatus(status string) *GroupDTOBuilder {
 	return b
 }
 
+func (b *GroupDTOBuilder) WithMaxMembers(maxMembers int) *GroupDTOBuilder {
+	b.groupDTO.MaxMembers = maxMembers
+	return b
+}
+
+func (b *GroupDTOBuilder) WithWaitlist(waitlist []rest.UserDTO) *GroupDTOBuilder {
+	b.groupDTO.Waitlist = waitlist
+	return b
+}
+
+func (b *GroupDTOBuilder) WithJoinRequests(joinRequests []rest.JoinRequestDTO) *GroupDTOBuilder {
+	b.groupDTO.JoinRequests = joinRequests
+	return b
+}
+
+func (b *GroupDTOBuilder) WithAllowedActions(allowedActions []string) *GroupDTOBuilder {
+	b.groupDTO.AllowedActions = allowedActions
+	return b
+}
+
 func (b *GroupDTOBuilder) Build() rest.GroupDTO {
 	return b.groupDTO
 }
@@ -54,6 +54,11 @@ func (b *GroupSummaryDTOBuilder) WithUserCount(userCount int) *GroupSummaryDTOBu
 	return b
 }
 
+func (b *GroupSummaryDTOBuilder) WithGuestCount(guestCount int) *GroupSummaryDTOBuilder {
+	b.groupSummaryDTO.GuestCount = guestCount
+	return b
+}
+
 func (b *GroupSummaryDTOBuilder) WithCreatedAt(createdAt time.Time) *GroupSummaryDTOBuilder {
 	b.groupSummaryDTO.CreatedAt = createdAt
 	return b
@@ -64,6 +69,11 @@ func (b *GroupSummaryDTOBuilder) WithUpdatedAt(updatedAt time.Time) *GroupSummar
 	return b
 }
 
+func (b *GroupSummaryDTOBuilder) WithMaxMembers(maxMembers int) *GroupSummaryDTOBuilder {
+	b.groupSummaryDTO.MaxMembers = maxMembers
+	return b
+}
+
 func (b *GroupSummaryDTOBuilder) Build() rest.GroupSummaryDTO {
 	return b.groupSummaryDTO
 }
@@ -0,0 +1,54 @@
+package build_rest
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+)
+
+type GuestDTOBuilder struct {
+	guestDTO rest.GuestDTO
+}
+
+func NewGuestDTOBuilder() *GuestDTOBuilder {
+	now := time.Now().UTC()
+	return &GuestDTOBuilder{
+		guestDTO: rest.GuestDTO{
+			ID:        uuid.NewString(),
+			Name:      "Grandma",
+			Email:     "grandma@example.com",
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+}
+
+func (b *GuestDTOBuilder) WithID(id string) *GuestDTOBuilder {
+	b.guestDTO.ID = id
+	return b
+}
+
+func (b *GuestDTOBuilder) WithName(name string) *GuestDTOBuilder {
+	b.guestDTO.Name = name
+	return b
+}
+
+func (b *GuestDTOBuilder) WithEmail(email string) *GuestDTOBuilder {
+	b.guestDTO.Email = email
+	return b
+}
+
+func (b *GuestDTOBuilder) WithCreatedAt(createdAt time.Time) *GuestDTOBuilder {
+	b.guestDTO.CreatedAt = createdAt
+	return b
+}
+
+func (b *GuestDTOBuilder) WithUpdatedAt(updatedAt time.Time) *GuestDTOBuilder {
+	b.guestDTO.UpdatedAt = updatedAt
+	return b
+}
+
+func (b *GuestDTOBuilder) Build() rest.GuestDTO {
+	return b.guestDTO
+}
@@ -0,0 +1,32 @@
+package build_rest
+
+import (
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+)
+
+type GuestInputDTOBuilder struct {
+	guestInputDTO rest.GuestInputDTO
+}
+
+func NewGuestInputDTOBuilder() *GuestInputDTOBuilder {
+	return &GuestInputDTOBuilder{
+		guestInputDTO: rest.GuestInputDTO{
+			Name:  "Grandma",
+			Email: "grandma@example.com",
+		},
+	}
+}
+
+func (b *GuestInputDTOBuilder) WithName(name string) *GuestInputDTOBuilder {
+	b.guestInputDTO.Name = name
+	return b
+}
+
+func (b *GuestInputDTOBuilder) WithEmail(email string) *GuestInputDTOBuilder {
+	b.guestInputDTO.Email = email
+	return b
+}
+
+func (b *GuestInputDTOBuilder) Build() rest.GuestInputDTO {
+	return b.guestInputDTO
+}
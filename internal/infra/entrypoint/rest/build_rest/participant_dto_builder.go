@@ -0,0 +1,50 @@
+package build_rest
+
+import (
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+)
+
+type ParticipantDTOBuilder struct {
+	participantDTO rest.ParticipantDTO
+}
+
+func NewParticipantDTOBuilder() *ParticipantDTOBuilder {
+	return &ParticipantDTOBuilder{
+		participantDTO: rest.ParticipantDTO{
+			ID:      uuid.NewString(),
+			Name:    "DefaultName",
+			Surname: "DefaultSurname",
+			Email:   "default@example.com",
+		},
+	}
+}
+
+func (b *ParticipantDTOBuilder) WithID(id string) *ParticipantDTOBuilder {
+	b.participantDTO.ID = id
+	return b
+}
+
+func (b *ParticipantDTOBuilder) WithName(name string) *ParticipantDTOBuilder {
+	b.participantDTO.Name = name
+	return b
+}
+
+func (b *ParticipantDTOBuilder) WithSurname(surname string) *ParticipantDTOBuilder {
+	b.participantDTO.Surname = surname
+	return b
+}
+
+func (b *ParticipantDTOBuilder) WithEmail(email string) *ParticipantDTOBuilder {
+	b.participantDTO.Email = email
+	return b
+}
+
+func (b *ParticipantDTOBuilder) WithIsGuest(isGuest bool) *ParticipantDTOBuilder {
+	b.participantDTO.IsGuest = isGuest
+	return b
+}
+
+func (b *ParticipantDTOBuilder) Build() rest.ParticipantDTO {
+	return b.participantDTO
+}
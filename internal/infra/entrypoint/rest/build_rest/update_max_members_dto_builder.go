@@ -0,0 +1,24 @@
+package build_rest
+
+import "github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+
+type UpdateMaxMembersDTOBuilder struct {
+	updateMaxMembersDTO rest.UpdateMaxMembersDTO
+}
+
+func NewUpdateMaxMembersDTOBuilder() *UpdateMaxMembersDTOBuilder {
+	return &UpdateMaxMembersDTOBuilder{
+		updateMaxMembersDTO: rest.UpdateMaxMembersDTO{
+			MaxMembers: 30,
+		},
+	}
+}
+
+func (b *UpdateMaxMembersDTOBuilder) WithMaxMembers(maxMembers int) *UpdateMaxMembersDTOBuilder {
+	b.updateMaxMembersDTO.MaxMembers = maxMembers
+	return b
+}
+
+func (b *UpdateMaxMembersDTOBuilder) Build() rest.UpdateMaxMembersDTO {
+	return b.updateMaxMembersDTO
+}
@@ -0,0 +1,32 @@
+package build_rest
+
+import "github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+
+type UpdateUserDTOBuilder struct {
+	updateUserDTO rest.UpdateUserDTO
+}
+
+func NewUpdateUserDTOBuilder() *UpdateUserDTOBuilder {
+	return &UpdateUserDTOBuilder{
+		updateUserDTO: rest.UpdateUserDTO{},
+	}
+}
+
+func (b *UpdateUserDTOBuilder) WithName(name string) *UpdateUserDTOBuilder {
+	b.updateUserDTO.Name = &name
+	return b
+}
+
+func (b *UpdateUserDTOBuilder) WithSurname(surname string) *UpdateUserDTOBuilder {
+	b.updateUserDTO.Surname = &surname
+	return b
+}
+
+func (b *UpdateUserDTOBuilder) WithEmail(email string) *UpdateUserDTOBuilder {
+	b.updateUserDTO.Email = &email
+	return b
+}
+
+func (b *UpdateUserDTOBuilder) Build() rest.UpdateUserDTO {
+	return b.updateUserDTO
+}
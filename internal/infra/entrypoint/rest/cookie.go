@@ -4,25 +4,68 @@ import (
 	"time"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 )
 
-const authCookieName = "access_token"
+const (
+	authCookieName      = "access_token"
+	refreshCookieName   = "refresh_token"
+	oidcStateCookieName = "oidc_state"
+	oidcStateCookiePath = "/api/v1/auth/oidc"
+)
 
 func setCookie(ctx fiber.Ctx, token string, expiresIn int64, secure bool) {
-	ctx.Cookie(&fiber.Cookie{
-		Name:     authCookieName,
-		Value:    token,
+	ctx.Cookie(newCookie(authCookieName, token, expiresIn, secure))
+}
+
+// setRefreshCookie stores the refresh token next to the access token, so web clients can renew their session
+// without ever reading either token.
+func setRefreshCookie(ctx fiber.Ctx, token string, expiresIn int64, secure bool) {
+	ctx.Cookie(newCookie(refreshCookieName, token, expiresIn, secure))
+}
+
+func setSessionCookies(ctx fiber.Ctx, authSession domain.AuthSession, secure bool) {
+	setCookie(ctx, authSession.AccessToken, authSession.ExpiresIn, secure)
+	setRefreshCookie(ctx, authSession.RefreshToken, authSession.RefreshTokenExpiresIn, secure)
+}
+
+func newCookie(name, value string, expiresIn int64, secure bool) *fiber.Cookie {
+	return &fiber.Cookie{
+		Name:     name,
+		Value:    value,
 		Expires:  time.Unix(expiresIn, 0),
 		HTTPOnly: true,
 		Secure:   secure,
 		SameSite: "Lax",
-	})
+	}
 }
 
 func clearCookie(ctx fiber.Ctx) {
+	for _, name := range []string{authCookieName, refreshCookieName} {
+		ctx.Cookie(&fiber.Cookie{
+			Name:     name,
+			Value:    "",
+			Expires:  time.Unix(0, 0),
+			MaxAge:   -1,
+			HTTPOnly: true,
+			SameSite: "Lax",
+		})
+	}
+}
+
+// setOIDCStateCookie binds a single sign-on to the browser that started it, so a code obtained by someone else cannot
+// be used to log this browser in to their account.
+func setOIDCStateCookie(ctx fiber.Ctx, state string, secure bool) {
+	cookie := newCookie(oidcStateCookieName, state, time.Now().Add(domain.OIDCLoginRequestDuration).Unix(), secure)
+	cookie.Path = oidcStateCookiePath
+	ctx.Cookie(cookie)
+}
+
+func clearOIDCStateCookie(ctx fiber.Ctx) {
 	ctx.Cookie(&fiber.Cookie{
-		Name:     authCookieName,
+		Name:     oidcStateCookieName,
 		Value:    "",
+		Path:     oidcStateCookiePath,
 		Expires:  time.Unix(0, 0),
 		MaxAge:   -1,
 		HTTPOnly: true,
@@ -0,0 +1,50 @@
+package rest
+
+import (
+	jwtware "github.com/gofiber/contrib/v3/jwt"
+	"github.com/gofiber/fiber/v3"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type EmailVerificationController struct {
+	emailVerificationService application.EmailVerificationService
+	authTokenManager         domain.AuthTokenManager
+}
+
+func NewEmailVerificationController(emailVerificationService application.EmailVerificationService, authTokenManager domain.AuthTokenManager) *EmailVerificationController {
+	return &EmailVerificationController{
+		emailVerificationService: emailVerificationService,
+		authTokenManager:         authTokenManager,
+	}
+}
+
+func (c *EmailVerificationController) Send(ctx fiber.Ctx) error {
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	if err := c.emailVerificationService.SendVerification(ctx.Context(), authUserID, ctx.IP()); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusAccepted)
+}
+
+func (c *EmailVerificationController) Confirm(ctx fiber.Ctx) error {
+	var confirmEmailVerificationDTO ConfirmEmailVerificationDTO
+	if err := ctx.Bind().Body(&confirmEmailVerificationDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := confirmEmailVerificationDTO.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.emailVerificationService.Confirm(ctx.Context(), confirmEmailVerificationDTO.Token); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
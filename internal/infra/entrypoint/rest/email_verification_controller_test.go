@@ -0,0 +1,147 @@
+package rest_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+	"github.com/waliqueiroz/mystery-gifter-api/test/helper"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_EmailVerificationController_Send(t *testing.T) {
+	route := "/api/v1/users/me/email-verification"
+
+	t.Run("should return status 202 after sending the verification email", func(t *testing.T) {
+		// given
+		userID := "some-user-id"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(userID, nil)
+
+		mockedEmailVerificationService := mock_application.NewMockEmailVerificationService(mockCtrl)
+		mockedEmailVerificationService.EXPECT().SendVerification(gomock.Any(), userID, gomock.Any()).Return(nil)
+
+		emailVerificationController := rest.NewEmailVerificationController(mockedEmailVerificationService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, emailVerificationController.Send)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusAccepted, response.StatusCode)
+	})
+
+	t.Run("should return conflict when the email is already verified", func(t *testing.T) {
+		// given
+		userID := "some-user-id"
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(userID, nil)
+
+		mockedEmailVerificationService := mock_application.NewMockEmailVerificationService(mockCtrl)
+		mockedEmailVerificationService.EXPECT().SendVerification(gomock.Any(), userID, gomock.Any()).Return(domain.NewConflictError("email address is already verified"))
+
+		emailVerificationController := rest.NewEmailVerificationController(mockedEmailVerificationService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, emailVerificationController.Send)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+	})
+}
+
+func Test_EmailVerificationController_Confirm(t *testing.T) {
+	route := "/api/v1/email-verification/confirm"
+
+	t.Run("should return status 204 after confirming the email", func(t *testing.T) {
+		// given
+		token := "some-secret-token"
+
+		mockCtrl := gomock.NewController(t)
+		mockedEmailVerificationService := mock_application.NewMockEmailVerificationService(mockCtrl)
+		mockedEmailVerificationService.EXPECT().Confirm(gomock.Any(), token).Return(nil)
+
+		emailVerificationController := rest.NewEmailVerificationController(mockedEmailVerificationService, nil)
+
+		payload := helper.EncodeJSON(t, rest.ConfirmEmailVerificationDTO{Token: token})
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, emailVerificationController.Confirm)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the token is missing", func(t *testing.T) {
+		// given
+		emailVerificationController := rest.NewEmailVerificationController(nil, nil)
+
+		payload := helper.EncodeJSON(t, rest.ConfirmEmailVerificationDTO{})
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, emailVerificationController.Confirm)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the token is invalid", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedEmailVerificationService := mock_application.NewMockEmailVerificationService(mockCtrl)
+		mockedEmailVerificationService.EXPECT().Confirm(gomock.Any(), gomock.Any()).
+			Return(domain.NewValidationError(validator.ValidationErrors{{Field: "Token", Error: "Token is invalid or has expired"}}))
+
+		emailVerificationController := rest.NewEmailVerificationController(mockedEmailVerificationService, nil)
+
+		payload := helper.EncodeJSON(t, rest.ConfirmEmailVerificationDTO{Token: "unknown-token"})
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, emailVerificationController.Confirm)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+}
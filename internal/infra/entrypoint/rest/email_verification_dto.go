@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// ConfirmEmailVerificationDTO represents the request body to verify an email address with a verification token
+// swagger:model ConfirmEmailVerificationDTO
+type ConfirmEmailVerificationDTO struct {
+	// Token received in the verification link
+	// required: true
+	// example: q3Jk0sX8m2b9YtP4vL7wZr1nC6dF5gH0aE2iU8oK3yM
+	Token string `json:"token" validate:"required"`
+}
+
+func (c *ConfirmEmailVerificationDTO) Validate() error {
+	if errs := validator.Validate(c); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
@@ -38,7 +38,19 @@ func (c *GroupController) Create(ctx fiber.Ctx) error {
 		return err
 	}
 
-	group, err := c.groupService.Create(ctx.Context(), createGroupDTO.Name, createGroupDTO.Description, authUserID)
+	settings := domain.GroupSettings{
+		MaxMembers:   createGroupDTO.MaxMembers,
+		Budget:       createGroupDTO.Budget,
+		Rules:        createGroupDTO.Rules,
+		ExchangeDate: createGroupDTO.ExchangeDate,
+	}
+
+	if createGroupDTO.MatchStrategy != nil {
+		matchStrategy := domain.MatchStrategy(*createGroupDTO.MatchStrategy)
+		settings.MatchStrategy = &matchStrategy
+	}
+
+	group, err := c.groupService.Create(ctx.Context(), createGroupDTO.Name, createGroupDTO.Description, settings, createGroupDTO.Draft, createGroupDTO.TemplateID, authUserID)
 	if err != nil {
 		return err
 	}
@@ -168,17 +180,26 @@ func (c *GroupController) AddUser(ctx fiber.Ctx) error {
 		return err
 	}
 
-	group, err := c.groupService.AddUser(ctx.Context(), groupID, authUserID, addUserDTO.UserID)
-	if err != nil {
-		return err
+	if addUserDTO.Email == "" {
+		group, err := c.groupService.AddUser(ctx.Context(), groupID, authUserID, addUserDTO.UserID)
+		if err != nil {
+			return err
+		}
+
+		groupDTO, err := mapGroupFromDomain(*group)
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(groupDTO)
 	}
 
-	groupDTO, err := mapGroupFromDomain(*group)
-	if err != nil {
+	// the same answer is given whether the person was added or invited, so it does not reveal who has an account
+	if err := c.groupService.AddUserByEmail(ctx.Context(), groupID, authUserID, addUserDTO.Email); err != nil {
 		return err
 	}
 
-	return ctx.JSON(groupDTO)
+	return ctx.SendStatus(fiber.StatusAccepted)
 }
 
 func (c *GroupController) RemoveUser(ctx fiber.Ctx) error {
@@ -224,6 +245,100 @@ func (c *GroupController) GenerateMatches(ctx fiber.Ctx) error {
 	return ctx.JSON(groupDTO)
 }
 
+func (c *GroupController) Publish(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.Publish(ctx.Context(), groupID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupController) CloseRegistration(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.CloseRegistration(ctx.Context(), groupID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupController) Complete(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.Complete(ctx.Context(), groupID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupController) SetMaxMembers(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	var updateMaxMembersDTO UpdateMaxMembersDTO
+
+	if err := ctx.Bind().Body(&updateMaxMembersDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := updateMaxMembersDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.SetMaxMembers(ctx.Context(), groupID, authUserID, updateMaxMembersDTO.MaxMembers)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
 func (c *GroupController) GetUserMatch(ctx fiber.Ctx) error {
 	groupID := ctx.Params("groupID")
 
@@ -232,15 +347,260 @@ func (c *GroupController) GetUserMatch(ctx fiber.Ctx) error {
 		return err
 	}
 
-	user, err := c.groupService.GetUserMatch(ctx.Context(), groupID, authUserID)
+	participant, err := c.groupService.GetUserMatch(ctx.Context(), groupID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	participantDTO, err := mapParticipantFromDomain(*participant)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(participantDTO)
+}
+
+func (c *GroupController) RevealMatches(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	revealedMatches, err := c.groupService.RevealMatches(ctx.Context(), groupID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	revealedMatchDTOs, err := mapRevealedMatchesFromDomain(revealedMatches)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(revealedMatchDTOs)
+}
+
+func (c *GroupController) AddGuest(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	var guestInputDTO GuestInputDTO
+
+	if err := ctx.Bind().Body(&guestInputDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := guestInputDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.AddGuest(ctx.Context(), groupID, authUserID, guestInputDTO.Name, guestInputDTO.Email)
 	if err != nil {
 		return err
 	}
 
-	userDTO, err := mapUserFromDomain(*user)
+	groupDTO, err := mapGroupFromDomain(*group)
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(userDTO)
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupController) UpdateGuest(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	guestID := ctx.Params("guestID")
+
+	var guestInputDTO GuestInputDTO
+
+	if err := ctx.Bind().Body(&guestInputDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := guestInputDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.UpdateGuest(ctx.Context(), groupID, authUserID, guestID, guestInputDTO.Name, guestInputDTO.Email)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupController) RemoveGuest(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	guestID := ctx.Params("guestID")
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.RemoveGuest(ctx.Context(), groupID, authUserID, guestID)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupController) GetGuestMatch(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	guestID := ctx.Params("guestID")
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	participant, err := c.groupService.GetGuestMatch(ctx.Context(), groupID, authUserID, guestID)
+	if err != nil {
+		return err
+	}
+
+	participantDTO, err := mapParticipantFromDomain(*participant)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(participantDTO)
+}
+
+func (c *GroupController) ClaimGuest(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	guestID := ctx.Params("guestID")
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.ClaimGuest(ctx.Context(), groupID, authUserID, guestID)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupController) MergeGuest(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	guestID := ctx.Params("guestID")
+
+	var mergeGuestDTO MergeGuestDTO
+
+	if err := ctx.Bind().Body(&mergeGuestDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := mergeGuestDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.MergeGuest(ctx.Context(), groupID, authUserID, guestID, mergeGuestDTO.UserID)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupController) ListClaimableGuests(ctx fiber.Ctx) error {
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	claimableGuests, err := c.groupService.ListClaimableGuests(ctx.Context(), authUserID)
+	if err != nil {
+		return err
+	}
+
+	claimableGuestDTOs, err := mapClaimableGuestsFromDomain(claimableGuests)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(claimableGuestDTOs)
+}
+
+func (c *GroupController) ApproveJoinRequest(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	userID := ctx.Params("userID")
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.ApproveJoinRequest(ctx.Context(), groupID, authUserID, userID)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupController) RejectJoinRequest(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	userID := ctx.Params("userID")
+
+	authUserID, err := c.AuthTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupService.RejectJoinRequest(ctx.Context(), groupID, authUserID, userID)
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
 }
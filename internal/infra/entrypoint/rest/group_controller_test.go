@@ -28,7 +28,7 @@ func Test_GroupController_Create(t *testing.T) {
 		createGroupDTO := build_rest.NewCreateGroupDTOBuilder().Build()
 
 		user := build_domain.NewUserBuilder().WithID(authUserID).Build()
-		group := build_domain.NewGroupBuilder().WithName(createGroupDTO.Name).WithDescription(createGroupDTO.Description).WithOwnerID(user.ID).WithUsers([]domain.User{user}).Build()
+		group := build_domain.NewGroupBuilder().WithName(createGroupDTO.Name).WithDescription(*createGroupDTO.Description).WithOwnerID(user.ID).WithUsers([]domain.User{user}).Build()
 
 		mockCtrl := gomock.NewController(t)
 
@@ -36,7 +36,7 @@ func Test_GroupController_Create(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
-		mockedGroupService.EXPECT().Create(gomock.Any(), createGroupDTO.Name, createGroupDTO.Description, authUserID).Return(&group, nil)
+		mockedGroupService.EXPECT().Create(gomock.Any(), createGroupDTO.Name, createGroupDTO.Description, domain.GroupSettings{MaxMembers: createGroupDTO.MaxMembers}, createGroupDTO.Draft, createGroupDTO.TemplateID, authUserID).Return(&group, nil)
 
 		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
 
@@ -191,7 +191,7 @@ func Test_GroupController_Create(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
-		mockedGroupService.EXPECT().Create(gomock.Any(), createGroupDTO.Name, createGroupDTO.Description, authUserID).Return(nil, assert.AnError)
+		mockedGroupService.EXPECT().Create(gomock.Any(), createGroupDTO.Name, createGroupDTO.Description, domain.GroupSettings{MaxMembers: createGroupDTO.MaxMembers}, createGroupDTO.Draft, createGroupDTO.TemplateID, authUserID).Return(nil, assert.AnError)
 
 		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
 
@@ -233,7 +233,7 @@ func Test_GroupController_Create(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
-		mockedGroupService.EXPECT().Create(gomock.Any(), createGroupDTO.Name, createGroupDTO.Description, authUserID).Return(&group, nil)
+		mockedGroupService.EXPECT().Create(gomock.Any(), createGroupDTO.Name, createGroupDTO.Description, domain.GroupSettings{MaxMembers: createGroupDTO.MaxMembers}, createGroupDTO.Draft, createGroupDTO.TemplateID, authUserID).Return(&group, nil)
 
 		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
 
@@ -265,6 +265,49 @@ func Test_GroupController_Create(t *testing.T) {
 			"error": "name is a required field",
 		})
 	})
+
+	t.Run("should pass the template and explicit settings to the service", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		createGroupDTO := build_rest.NewCreateGroupDTOBuilder().WithMaxMembers(0).WithBudget(1500).WithMatchStrategy("CYCLE").WithTemplateID("office-secret-santa").Build()
+		matchStrategy := domain.MatchStrategyCycle
+
+		user := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		group := build_domain.NewGroupBuilder().WithName(createGroupDTO.Name).WithOwnerID(user.ID).WithUsers([]domain.User{user}).WithBudget(1500).WithRules("Keep it light").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().Create(gomock.Any(), createGroupDTO.Name, createGroupDTO.Description, domain.GroupSettings{MaxMembers: createGroupDTO.MaxMembers, Budget: createGroupDTO.Budget, MatchStrategy: &matchStrategy}, false, "office-secret-santa", authUserID).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, createGroupDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.Create)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusCreated, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, 1500, result.Budget)
+		assert.Equal(t, "Keep it light", result.Rules)
+	})
 }
 
 func Test_GroupController_GetByID(t *testing.T) {
@@ -697,6 +740,146 @@ func Test_GroupController_AddUser(t *testing.T) {
 			"error": "name is a required field",
 		})
 	})
+
+	t.Run("should return status 202 when adding by email", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+		email := "friend@example.com"
+		addUserDTO := build_rest.NewAddUserDTOBuilder().WithUserID("").WithEmail(email).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().AddUserByEmail(gomock.Any(), groupID, authUserID, email).Return(nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, addUserDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/users", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.AddUser)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusAccepted, response.StatusCode)
+	})
+
+	t.Run("should return the error when adding by email fails", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+		email := "friend@example.com"
+		addUserDTO := build_rest.NewAddUserDTOBuilder().WithUserID("").WithEmail(email).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().AddUserByEmail(gomock.Any(), groupID, authUserID, email).Return(domain.NewForbiddenError("only the group owner can add other users"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, addUserDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/users", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.AddUser)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when both the email and the user ID are given", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		addUserDTO := build_rest.NewAddUserDTOBuilder().WithEmail("friend@example.com").Build()
+
+		groupController := rest.NewGroupController(nil, nil)
+
+		payload := helper.EncodeJSON(t, addUserDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/users", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.AddUser)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "bad_request", result.Code)
+		assert.Len(t, result.Details, 1)
+		assert.Contains(t, result.Details, map[string]any{
+			"field": "email",
+			"error": "email is an excluded field",
+		})
+	})
+
+	t.Run("should return bad_request when neither the email nor the user ID is given", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		addUserDTO := build_rest.NewAddUserDTOBuilder().WithUserID("").Build()
+
+		groupController := rest.NewGroupController(nil, nil)
+
+		payload := helper.EncodeJSON(t, addUserDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/users", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.AddUser)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "bad_request", result.Code)
+		assert.Len(t, result.Details, 1)
+		assert.Contains(t, result.Details, map[string]any{
+			"field": "email",
+			"error": "email is a required field",
+		})
+	})
 }
 
 func Test_GroupController_RemoveUser(t *testing.T) {
@@ -1071,7 +1254,7 @@ func Test_GroupController_GetUserMatch(t *testing.T) {
 		groupID := uuid.New().String()
 		authUserID := uuid.New().String()
 
-		userMatch := build_domain.NewUserBuilder().WithID(uuid.New().String()).Build()
+		userMatch := domain.NewParticipantFromUser(build_domain.NewUserBuilder().WithID(uuid.New().String()).Build())
 
 		mockCtrl := gomock.NewController(t)
 
@@ -1097,18 +1280,17 @@ func Test_GroupController_GetUserMatch(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, fiber.StatusOK, response.StatusCode)
 
-		var result rest.UserDTO
+		var result rest.ParticipantDTO
 		helper.DecodeJSON(t, response.Body, &result)
 
-		expectedUserDTO := build_rest.NewUserDTOBuilder().
+		expectedParticipantDTO := build_rest.NewParticipantDTOBuilder().
 			WithID(userMatch.ID).
 			WithName(userMatch.Name).
+			WithSurname(userMatch.Surname).
 			WithEmail(userMatch.Email).
-			WithCreatedAt(userMatch.CreatedAt).
-			WithUpdatedAt(userMatch.UpdatedAt).
 			Build()
 
-		assert.Equal(t, expectedUserDTO, result)
+		assert.Equal(t, expectedParticipantDTO, result)
 	})
 
 	t.Run("should return internal_server_error when auth token manager fails", func(t *testing.T) {
@@ -1179,12 +1361,12 @@ func Test_GroupController_GetUserMatch(t *testing.T) {
 		assert.Equal(t, assert.AnError.Error(), result.Message)
 	})
 
-	t.Run("should return bad_request with an error message when fails to map user from domain", func(t *testing.T) {
+	t.Run("should return bad_request with an error message when fails to map participant from domain", func(t *testing.T) {
 		// given
 		groupID := uuid.New().String()
 		authUserID := uuid.New().String()
 
-		userMatch := build_domain.NewUserBuilder().WithID(authUserID).WithName("").Build() // Invalid name for mapping to DTO
+		userMatch := domain.NewParticipantFromUser(build_domain.NewUserBuilder().WithID(authUserID).WithName("").Build()) // Invalid name for mapping to DTO
 
 		mockCtrl := gomock.NewController(t)
 
@@ -1405,6 +1587,7 @@ func Test_GroupController_Archive(t *testing.T) {
 			WithUsers([]rest.UserDTO{expectedUserDTO}).
 			WithOwnerID(archivedGroup.OwnerID).
 			WithStatus(string(archivedGroup.Status)).
+			WithAllowedActions([]string{}).
 			WithCreatedAt(archivedGroup.CreatedAt).
 			WithUpdatedAt(archivedGroup.UpdatedAt).
 			Build()
@@ -1489,7 +1672,7 @@ func Test_GroupController_Search(t *testing.T) {
 		authUserID := uuid.New().String()
 
 		groupSummaries := []domain.GroupSummary{
-			build_domain.NewGroupSummaryBuilder().WithName("Birthday Party").WithStatus(domain.GroupStatusOpen).WithUserCount(5).Build(),
+			build_domain.NewGroupSummaryBuilder().WithName("Birthday Party").WithStatus(domain.GroupStatusOpen).WithUserCount(5).WithGuestCount(2).Build(),
 			build_domain.NewGroupSummaryBuilder().WithName("Christmas Exchange").WithStatus(domain.GroupStatusMatched).WithUserCount(8).Build(),
 		}
 
@@ -1533,6 +1716,7 @@ func Test_GroupController_Search(t *testing.T) {
 				WithStatus(string(groupSummaries[0].Status)).
 				WithOwnerID(groupSummaries[0].OwnerID).
 				WithUserCount(groupSummaries[0].UserCount).
+				WithGuestCount(groupSummaries[0].GuestCount).
 				WithCreatedAt(groupSummaries[0].CreatedAt).
 				WithUpdatedAt(groupSummaries[0].UpdatedAt).
 				Build(),
@@ -1915,3 +2099,1419 @@ func Test_GroupController_Search(t *testing.T) {
 		assert.Equal(t, "bad_request", result.Code)
 	})
 }
+
+func Test_GroupController_AddGuest(t *testing.T) {
+	route := "/api/v1/groups/:groupID/guests"
+
+	t.Run("should return status 200 and the updated group when the guest is added successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+		guestInputDTO := build_rest.NewGuestInputDTOBuilder().Build()
+
+		owner := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		guest := build_domain.NewGuestBuilder().WithName(guestInputDTO.Name).WithEmail(guestInputDTO.Email).Build()
+		group := build_domain.NewGroupBuilder().WithID(groupID).WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().AddGuest(gomock.Any(), groupID, authUserID, guestInputDTO.Name, guestInputDTO.Email).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, guestInputDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.AddGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		expectedUserDTO := build_rest.NewUserDTOBuilder().
+			WithID(owner.ID).
+			WithName(owner.Name).
+			WithEmail(owner.Email).
+			WithCreatedAt(owner.CreatedAt).
+			WithUpdatedAt(owner.UpdatedAt).
+			Build()
+
+		expectedGuestDTO := build_rest.NewGuestDTOBuilder().
+			WithID(guest.ID).
+			WithName(guest.Name).
+			WithEmail(guest.Email).
+			WithCreatedAt(guest.CreatedAt).
+			WithUpdatedAt(guest.UpdatedAt).
+			Build()
+
+		expectedGroupDTO := build_rest.NewGroupDTOBuilder().
+			WithID(group.ID).
+			WithName(group.Name).
+			WithDescription(group.Description).
+			WithUsers([]rest.UserDTO{expectedUserDTO}).
+			WithGuests([]rest.GuestDTO{expectedGuestDTO}).
+			WithOwnerID(group.OwnerID).
+			WithStatus(string(group.Status)).
+			WithCreatedAt(group.CreatedAt).
+			WithUpdatedAt(group.UpdatedAt).
+			Build()
+
+		assert.Equal(t, expectedGroupDTO, result)
+	})
+
+	t.Run("should return unprocessable_entity when payload is malformed", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		groupController := rest.NewGroupController(nil, nil)
+
+		payload := helper.EncodeJSON(t, "invalid_payload")
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.AddGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnprocessableEntity, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "unprocessable_entity", result.Code)
+		assert.Equal(t, "Unprocessable Entity", result.Message)
+	})
+
+	t.Run("should return bad_request when guestInputDTO is invalid", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestInputDTO := build_rest.NewGuestInputDTOBuilder().WithName("").WithEmail("invalid-email").Build()
+
+		groupController := rest.NewGroupController(nil, nil)
+
+		payload := helper.EncodeJSON(t, guestInputDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.AddGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "bad_request", result.Code)
+		assert.Equal(t, "validation failed", result.Message)
+		assert.Len(t, result.Details, 2)
+		assert.Contains(t, result.Details, map[string]any{
+			"field": "name",
+			"error": "name is a required field",
+		})
+		assert.Contains(t, result.Details, map[string]any{
+			"field": "email",
+			"error": "email must be a valid email address",
+		})
+	})
+
+	t.Run("should return internal_server_error when auth token manager fails", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestInputDTO := build_rest.NewGuestInputDTOBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("", assert.AnError)
+
+		groupController := rest.NewGroupController(nil, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, guestInputDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.AddGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusInternalServerError, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "internal_server_error", result.Code)
+		assert.Equal(t, assert.AnError.Error(), result.Message)
+	})
+
+	t.Run("should return forbidden when the requester is not the group owner", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+		guestInputDTO := build_rest.NewGuestInputDTOBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().AddGuest(gomock.Any(), groupID, authUserID, guestInputDTO.Name, guestInputDTO.Email).Return(nil, domain.NewForbiddenError("only the group owner can add guests"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, guestInputDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.AddGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "forbidden", result.Code)
+		assert.Equal(t, "only the group owner can add guests", result.Message)
+	})
+}
+
+func Test_GroupController_UpdateGuest(t *testing.T) {
+	route := "/api/v1/groups/:groupID/guests/:guestID"
+
+	t.Run("should return status 200 and the updated group when the guest is updated successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+		guestInputDTO := build_rest.NewGuestInputDTOBuilder().WithName("Grandpa").WithEmail("grandpa@example.com").Build()
+
+		owner := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		guest := build_domain.NewGuestBuilder().WithName(guestInputDTO.Name).WithEmail(guestInputDTO.Email).Build()
+		group := build_domain.NewGroupBuilder().WithID(groupID).WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).WithGuests([]domain.Guest{guest}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().UpdateGuest(gomock.Any(), groupID, authUserID, guest.ID, guestInputDTO.Name, guestInputDTO.Email).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, guestInputDTO)
+
+		req := httptest.NewRequest(fiber.MethodPut, fmt.Sprintf("/api/v1/groups/%s/guests/%s", groupID, guest.ID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Put(route, groupController.UpdateGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Len(t, result.Guests, 1)
+		assert.Equal(t, guest.ID, result.Guests[0].ID)
+		assert.Equal(t, guestInputDTO.Name, result.Guests[0].Name)
+		assert.Equal(t, guestInputDTO.Email, result.Guests[0].Email)
+	})
+
+	t.Run("should return bad_request when guestInputDTO is invalid", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		guestInputDTO := build_rest.NewGuestInputDTOBuilder().WithName("").Build()
+
+		groupController := rest.NewGroupController(nil, nil)
+
+		payload := helper.EncodeJSON(t, guestInputDTO)
+
+		req := httptest.NewRequest(fiber.MethodPut, fmt.Sprintf("/api/v1/groups/%s/guests/%s", groupID, guestID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Put(route, groupController.UpdateGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "bad_request", result.Code)
+		assert.Equal(t, "validation failed", result.Message)
+		assert.Contains(t, result.Details, map[string]any{
+			"field": "name",
+			"error": "name is a required field",
+		})
+	})
+
+	t.Run("should return not_found when the guest does not exist", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		authUserID := uuid.New().String()
+		guestInputDTO := build_rest.NewGuestInputDTOBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().UpdateGuest(gomock.Any(), groupID, authUserID, guestID, guestInputDTO.Name, guestInputDTO.Email).Return(nil, domain.NewResourceNotFoundError("guest not found"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, guestInputDTO)
+
+		req := httptest.NewRequest(fiber.MethodPut, fmt.Sprintf("/api/v1/groups/%s/guests/%s", groupID, guestID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Put(route, groupController.UpdateGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "not_found", result.Code)
+		assert.Equal(t, "guest not found", result.Message)
+	})
+}
+
+func Test_GroupController_RemoveGuest(t *testing.T) {
+	route := "/api/v1/groups/:groupID/guests/:guestID"
+
+	t.Run("should return status 200 and the updated group when the guest is removed successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		owner := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		group := build_domain.NewGroupBuilder().WithID(groupID).WithOwnerID(owner.ID).WithUsers([]domain.User{owner}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().RemoveGuest(gomock.Any(), groupID, authUserID, guestID).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/groups/%s/guests/%s", groupID, guestID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Delete(route, groupController.RemoveGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, group.ID, result.ID)
+		assert.Empty(t, result.Guests)
+	})
+
+	t.Run("should return internal_server_error when group service fails", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().RemoveGuest(gomock.Any(), groupID, authUserID, guestID).Return(nil, assert.AnError)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/groups/%s/guests/%s", groupID, guestID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Delete(route, groupController.RemoveGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusInternalServerError, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "internal_server_error", result.Code)
+		assert.Equal(t, assert.AnError.Error(), result.Message)
+	})
+}
+
+func Test_GroupController_GetGuestMatch(t *testing.T) {
+	route := "/api/v1/groups/:groupID/guests/:guestID/match"
+
+	t.Run("should return status 200 and the guest match when found successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		guestMatch := domain.NewParticipantFromGuest(build_domain.NewGuestBuilder().Build())
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().GetGuestMatch(gomock.Any(), groupID, authUserID, guestID).Return(&guestMatch, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/guests/%s/match", groupID, guestID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupController.GetGuestMatch)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.ParticipantDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		expectedParticipantDTO := build_rest.NewParticipantDTOBuilder().
+			WithID(guestMatch.ID).
+			WithName(guestMatch.Name).
+			WithSurname("").
+			WithEmail(guestMatch.Email).
+			WithIsGuest(true).
+			Build()
+
+		assert.Equal(t, expectedParticipantDTO, result)
+	})
+
+	t.Run("should return forbidden when the requester is not the group owner", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().GetGuestMatch(gomock.Any(), groupID, authUserID, guestID).Return(nil, domain.NewForbiddenError("only the group owner can view guest matches"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/guests/%s/match", groupID, guestID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupController.GetGuestMatch)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "forbidden", result.Code)
+		assert.Equal(t, "only the group owner can view guest matches", result.Message)
+	})
+}
+
+func Test_GroupController_ClaimGuest(t *testing.T) {
+	route := "/api/v1/groups/:groupID/guests/:guestID/claim"
+
+	t.Run("should return status 200 and the updated group when the guest is claimed successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		user := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		group := build_domain.NewGroupBuilder().WithID(groupID).WithUsers([]domain.User{user}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().ClaimGuest(gomock.Any(), groupID, authUserID, guestID).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests/%s/claim", groupID, guestID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.ClaimGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, group.ID, result.ID)
+		assert.Len(t, result.Users, 1)
+		assert.Equal(t, authUserID, result.Users[0].ID)
+	})
+
+	t.Run("should return forbidden when the guest email does not match the user email", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().ClaimGuest(gomock.Any(), groupID, authUserID, guestID).Return(nil, domain.NewForbiddenError("guest can only be claimed by a user with the same email"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests/%s/claim", groupID, guestID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.ClaimGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "forbidden", result.Code)
+		assert.Equal(t, "guest can only be claimed by a user with the same email", result.Message)
+	})
+}
+
+func Test_GroupController_MergeGuest(t *testing.T) {
+	route := "/api/v1/groups/:groupID/guests/:guestID/merge"
+
+	t.Run("should return status 200 and the updated group when the guest is merged successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		owner := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		user := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithID(groupID).WithOwnerID(owner.ID).WithUsers([]domain.User{owner, user}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().MergeGuest(gomock.Any(), groupID, authUserID, guestID, user.ID).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, rest.MergeGuestDTO{UserID: user.ID})
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests/%s/merge", groupID, guestID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.MergeGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, group.ID, result.ID)
+		assert.Len(t, result.Users, 2)
+	})
+
+	t.Run("should return status 400 when the user ID is missing", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().MergeGuest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, rest.MergeGuestDTO{})
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests/%s/merge", groupID, guestID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.MergeGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return forbidden when the requester is not the owner", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		guestID := uuid.New().String()
+		authUserID := uuid.New().String()
+		userID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().MergeGuest(gomock.Any(), groupID, authUserID, guestID, userID).Return(nil, domain.NewForbiddenError("only the group owner can merge guests"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, rest.MergeGuestDTO{UserID: userID})
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/guests/%s/merge", groupID, guestID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.MergeGuest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+	})
+}
+
+func Test_GroupController_ListClaimableGuests(t *testing.T) {
+	route := "/api/v1/users/me/guests"
+
+	t.Run("should return status 200 and the guests the user can claim", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		claimableGuests := []domain.ClaimableGuest{
+			{
+				GroupID:   uuid.New().String(),
+				GroupName: "Secret Santa",
+				Guest:     build_domain.NewGuestBuilder().Build(),
+			},
+		}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().ListClaimableGuests(gomock.Any(), authUserID).Return(claimableGuests, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupController.ListClaimableGuests)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result []rest.ClaimableGuestDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Len(t, result, 1)
+		assert.Equal(t, claimableGuests[0].GroupID, result[0].GroupID)
+		assert.Equal(t, claimableGuests[0].GroupName, result[0].GroupName)
+		assert.Equal(t, claimableGuests[0].Guest.ID, result[0].Guest.ID)
+	})
+
+	t.Run("should return forbidden when the user has not verified their email", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().ListClaimableGuests(gomock.Any(), authUserID).Return(nil, domain.NewForbiddenError("verify your email address to claim a guest"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupController.ListClaimableGuests)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+	})
+}
+
+func Test_GroupController_SetMaxMembers(t *testing.T) {
+	route := "/api/v1/groups/:groupID/max-members"
+
+	t.Run("should return status 200 and the updated group when the member limit is changed successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+		updateMaxMembersDTO := build_rest.NewUpdateMaxMembersDTOBuilder().WithMaxMembers(2).Build()
+
+		owner := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		waitlisted := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().
+			WithID(groupID).
+			WithOwnerID(owner.ID).
+			WithUsers([]domain.User{owner}).
+			WithWaitlist([]domain.User{waitlisted}).
+			WithMaxMembers(updateMaxMembersDTO.MaxMembers).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().SetMaxMembers(gomock.Any(), groupID, authUserID, updateMaxMembersDTO.MaxMembers).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, updateMaxMembersDTO)
+
+		req := httptest.NewRequest(fiber.MethodPut, fmt.Sprintf("/api/v1/groups/%s/max-members", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Put(route, groupController.SetMaxMembers)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, updateMaxMembersDTO.MaxMembers, result.MaxMembers)
+		assert.Len(t, result.Waitlist, 1)
+		assert.Equal(t, waitlisted.ID, result.Waitlist[0].ID)
+	})
+
+	t.Run("should return bad_request when the member limit is negative", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		updateMaxMembersDTO := build_rest.NewUpdateMaxMembersDTOBuilder().WithMaxMembers(-1).Build()
+
+		groupController := rest.NewGroupController(nil, nil)
+
+		payload := helper.EncodeJSON(t, updateMaxMembersDTO)
+
+		req := httptest.NewRequest(fiber.MethodPut, fmt.Sprintf("/api/v1/groups/%s/max-members", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Put(route, groupController.SetMaxMembers)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "bad_request", result.Code)
+		assert.Equal(t, "validation failed", result.Message)
+	})
+
+	t.Run("should return unprocessable_entity when payload is malformed", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		groupController := rest.NewGroupController(nil, nil)
+
+		payload := helper.EncodeJSON(t, "invalid_payload")
+
+		req := httptest.NewRequest(fiber.MethodPut, fmt.Sprintf("/api/v1/groups/%s/max-members", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Put(route, groupController.SetMaxMembers)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnprocessableEntity, response.StatusCode)
+	})
+
+	t.Run("should return conflict when the limit is lower than the current number of participants", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+		updateMaxMembersDTO := build_rest.NewUpdateMaxMembersDTOBuilder().WithMaxMembers(1).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().SetMaxMembers(gomock.Any(), groupID, authUserID, updateMaxMembersDTO.MaxMembers).Return(nil, domain.NewConflictError("member limit cannot be lower than the current number of participants"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, updateMaxMembersDTO)
+
+		req := httptest.NewRequest(fiber.MethodPut, fmt.Sprintf("/api/v1/groups/%s/max-members", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Put(route, groupController.SetMaxMembers)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "conflict", result.Code)
+		assert.Equal(t, "member limit cannot be lower than the current number of participants", result.Message)
+	})
+}
+
+func Test_GroupController_ApproveJoinRequest(t *testing.T) {
+	route := "/api/v1/groups/:groupID/join-requests/:userID/approve"
+
+	t.Run("should return status 200 and the updated group when the join request is approved", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		userID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		owner := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		user := build_domain.NewUserBuilder().WithID(userID).Build()
+		group := build_domain.NewGroupBuilder().WithID(groupID).WithOwnerID(authUserID).WithUsers([]domain.User{owner, user}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().ApproveJoinRequest(gomock.Any(), groupID, authUserID, userID).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/join-requests/%s/approve", groupID, userID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.ApproveJoinRequest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, group.ID, result.ID)
+		assert.Len(t, result.Users, 2)
+		assert.Empty(t, result.JoinRequests)
+	})
+
+	t.Run("should return not_found when there is no pending join request", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		userID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().ApproveJoinRequest(gomock.Any(), groupID, authUserID, userID).Return(nil, domain.NewResourceNotFoundError("join request not found"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/join-requests/%s/approve", groupID, userID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.ApproveJoinRequest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "not_found", result.Code)
+		assert.Equal(t, "join request not found", result.Message)
+	})
+}
+
+func Test_GroupController_RejectJoinRequest(t *testing.T) {
+	route := "/api/v1/groups/:groupID/join-requests/:userID/reject"
+
+	t.Run("should return status 200 and the updated group when the join request is rejected", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		userID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		owner := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		group := build_domain.NewGroupBuilder().WithID(groupID).WithOwnerID(authUserID).WithUsers([]domain.User{owner}).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().RejectJoinRequest(gomock.Any(), groupID, authUserID, userID).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/join-requests/%s/reject", groupID, userID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.RejectJoinRequest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, group.ID, result.ID)
+		assert.Len(t, result.Users, 1)
+	})
+
+	t.Run("should return forbidden when the requester is not the group owner", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		userID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().RejectJoinRequest(gomock.Any(), groupID, authUserID, userID).Return(nil, domain.NewForbiddenError("only the group owner can reject join requests"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/join-requests/%s/reject", groupID, userID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.RejectJoinRequest)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "forbidden", result.Code)
+		assert.Equal(t, "only the group owner can reject join requests", result.Message)
+	})
+}
+
+func Test_GroupController_Publish(t *testing.T) {
+	route := "/api/v1/groups/:groupID/publish"
+
+	t.Run("should return status 200 and the group with its new status", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		user := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(authUserID).WithUsers([]domain.User{user}).WithStatus(domain.GroupStatusOpen).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().Publish(gomock.Any(), group.ID, authUserID).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/publish", group.ID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.Publish)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, string(domain.GroupStatusOpen), result.Status)
+		assert.Equal(t, []string{"CLOSE_REGISTRATION", "GENERATE_MATCHES", "ARCHIVE"}, result.AllowedActions)
+	})
+
+	t.Run("should return conflict when the transition is not allowed", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().Publish(gomock.Any(), groupID, authUserID).Return(nil, domain.NewConflictError("action PUBLISH is not allowed for a group in OPEN status"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/publish", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.Publish)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "conflict", result.Code)
+		assert.Equal(t, "action PUBLISH is not allowed for a group in OPEN status", result.Message)
+	})
+}
+
+func Test_GroupController_CloseRegistration(t *testing.T) {
+	route := "/api/v1/groups/:groupID/close-registration"
+
+	t.Run("should return status 200 and the group with its new status", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		user := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(authUserID).WithUsers([]domain.User{user}).WithStatus(domain.GroupStatusRegistrationClosed).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().CloseRegistration(gomock.Any(), group.ID, authUserID).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/close-registration", group.ID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.CloseRegistration)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, string(domain.GroupStatusRegistrationClosed), result.Status)
+		assert.Equal(t, []string{"REOPEN", "GENERATE_MATCHES", "ARCHIVE"}, result.AllowedActions)
+	})
+
+	t.Run("should return conflict when the transition is not allowed", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().CloseRegistration(gomock.Any(), groupID, authUserID).Return(nil, domain.NewConflictError("action CLOSE_REGISTRATION is not allowed for a group in DRAFT status"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/close-registration", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.CloseRegistration)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "conflict", result.Code)
+		assert.Equal(t, "action CLOSE_REGISTRATION is not allowed for a group in DRAFT status", result.Message)
+	})
+}
+
+func Test_GroupController_Complete(t *testing.T) {
+	route := "/api/v1/groups/:groupID/complete"
+
+	t.Run("should return status 200 and the group with its new status", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		user := build_domain.NewUserBuilder().WithID(authUserID).Build()
+		group := build_domain.NewGroupBuilder().WithOwnerID(authUserID).WithUsers([]domain.User{user}).WithStatus(domain.GroupStatusCompleted).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().Complete(gomock.Any(), group.ID, authUserID).Return(&group, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/complete", group.ID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.Complete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, string(domain.GroupStatusCompleted), result.Status)
+		assert.Equal(t, []string{"ARCHIVE"}, result.AllowedActions)
+	})
+
+	t.Run("should return conflict when the transition is not allowed", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().Complete(gomock.Any(), groupID, authUserID).Return(nil, domain.NewConflictError("action COMPLETE is not allowed for a group in OPEN status"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/complete", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupController.Complete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "conflict", result.Code)
+		assert.Equal(t, "action COMPLETE is not allowed for a group in OPEN status", result.Message)
+	})
+}
+
+func Test_GroupController_RevealMatches(t *testing.T) {
+	route := "/api/v1/groups/:groupID/matches"
+
+	t.Run("should return status 200 and every revealed match", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+		giver := domain.NewParticipantFromUser(build_domain.NewUserBuilder().WithID(authUserID).Build())
+		receiver := domain.NewParticipantFromGuest(build_domain.NewGuestBuilder().Build())
+		revealedMatches := []domain.RevealedMatch{
+			{Giver: giver, Receiver: receiver},
+			{Giver: receiver, Receiver: giver},
+		}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().RevealMatches(gomock.Any(), groupID, authUserID).Return(revealedMatches, nil)
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/matches", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupController.RevealMatches)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result []rest.RevealedMatchDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Len(t, result, 2)
+		assert.Equal(t, giver.ID, result[0].Giver.ID)
+		assert.Equal(t, receiver.ID, result[0].Receiver.ID)
+		assert.True(t, result[0].Receiver.IsGuest)
+	})
+
+	t.Run("should return conflict when the group is not completed", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupService := mock_application.NewMockGroupService(mockCtrl)
+		mockedGroupService.EXPECT().RevealMatches(gomock.Any(), groupID, authUserID).Return(nil, domain.NewConflictError("matches can only be revealed once the group is completed"))
+
+		groupController := rest.NewGroupController(mockedGroupService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/matches", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupController.RevealMatches)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, "conflict", result.Code)
+		assert.Equal(t, "matches can only be revealed once the group is completed", result.Message)
+	})
+}
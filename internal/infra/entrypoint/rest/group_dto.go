@@ -7,7 +7,8 @@ import (
 	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
 )
 
-// CreateGroupDTO represents the data needed to create a new group
+// CreateGroupDTO represents the data needed to create a new group. Fields left out are filled by the template, if
+// any; fields sent, even as 0 or empty, override it.
 // swagger:model CreateGroupDTO
 type CreateGroupDTO struct {
 	// Group name
@@ -18,7 +19,41 @@ type CreateGroupDTO struct {
 	// Group description
 	// max length: 255
 	// example: A group for our annual Secret Santa event
-	Description string `json:"description" validate:"omitempty,max=255"`
+	Description *string `json:"description" validate:"omitnil,max=255"`
+
+	// Maximum number of participants (users and guests); 0 means no limit.
+	// Users joining a full group are placed on a waitlist.
+	// minimum: 0
+	// example: 30
+	MaxMembers *int `json:"max_members" validate:"omitnil,min=0"`
+
+	// Suggested gift value in the smallest currency unit (e.g. cents); 0 means no budget
+	// minimum: 0
+	// example: 2500
+	Budget *int `json:"budget" validate:"omitnil,min=0"`
+
+	// Extra rules for the exchange
+	// max length: 1000
+	// example: Keep gifts work-appropriate
+	Rules *string `json:"rules" validate:"omitnil,max=1000"`
+
+	// When the gifts will be exchanged
+	// example: 2024-12-20T18:00:00Z
+	ExchangeDate *time.Time `json:"exchange_date"`
+
+	// How the draw pairs givers with receivers: CYCLE chains everyone into a single loop, RANDOM allows any draw where
+	// nobody gives to themselves, including pairs giving to each other. Defaults to CYCLE.
+	// example: CYCLE
+	// enum: CYCLE,RANDOM
+	MatchStrategy *string `json:"match_strategy" validate:"omitnil,oneof=CYCLE RANDOM"`
+
+	// Create the group as a draft, so it can be set up before being published for registration
+	// example: false
+	Draft bool `json:"draft"`
+
+	// ID of a built-in or user-defined template used to pre-fill the settings that were not provided
+	// example: office-secret-santa
+	TemplateID string `json:"template_id"`
 }
 
 func (g *CreateGroupDTO) Validate() error {
@@ -49,6 +84,42 @@ type GroupDTO struct {
 	// required: true
 	Users []UserDTO `json:"users" validate:"required,min=1"`
 
+	// List of guests (participants without an account) in the group
+	// required: true
+	Guests []GuestDTO `json:"guests" validate:"required"`
+
+	// Users waiting for a free spot, in the order they will be promoted
+	// required: true
+	Waitlist []UserDTO `json:"waitlist" validate:"required"`
+
+	// Maximum number of participants; 0 means no limit
+	// required: true
+	// example: 30
+	MaxMembers int `json:"max_members" validate:"min=0"`
+
+	// Pending requests to join the group, awaiting owner approval
+	// required: true
+	JoinRequests []JoinRequestDTO `json:"join_requests" validate:"required"`
+
+	// Suggested gift value in the smallest currency unit (e.g. cents); 0 means no budget
+	// required: true
+	// example: 2500
+	Budget int `json:"budget" validate:"min=0"`
+
+	// Extra rules for the exchange
+	// example: Keep gifts work-appropriate
+	Rules string `json:"rules" validate:"omitempty,max=1000"`
+
+	// When the gifts will be exchanged
+	// example: 2024-12-20T18:00:00Z
+	ExchangeDate *time.Time `json:"exchange_date"`
+
+	// How the draw pairs givers with receivers
+	// required: true
+	// example: CYCLE
+	// enum: CYCLE,RANDOM
+	MatchStrategy string `json:"match_strategy" validate:"required,oneof=CYCLE RANDOM"`
+
 	// ID of the group owner
 	// required: true
 	// example: 01234567-89ab-cdef-0123-456789abcdef
@@ -57,8 +128,13 @@ type GroupDTO struct {
 	// Group status
 	// required: true
 	// example: OPEN
-	// enum: OPEN,MATCHED,ARCHIVED
-	Status string `json:"status" validate:"required,oneof=OPEN MATCHED ARCHIVED"`
+	// enum: DRAFT,OPEN,REGISTRATION_CLOSED,MATCHED,COMPLETED,ARCHIVED
+	Status string `json:"status" validate:"required,oneof=DRAFT OPEN REGISTRATION_CLOSED MATCHED COMPLETED ARCHIVED"`
+
+	// Lifecycle actions that can be taken from the current status
+	// required: true
+	// example: ["CLOSE_REGISTRATION","GENERATE_MATCHES","ARCHIVE"]
+	AllowedActions []string `json:"allowed_actions" validate:"required,dive,oneof=PUBLISH CLOSE_REGISTRATION REOPEN GENERATE_MATCHES COMPLETE ARCHIVE"`
 
 	// Group creation timestamp
 	// required: true
@@ -98,8 +174,8 @@ type GroupSummaryDTO struct {
 	// Group status
 	// required: true
 	// example: OPEN
-	// enum: OPEN,MATCHED,ARCHIVED
-	Status string `json:"status" validate:"required,oneof=OPEN MATCHED ARCHIVED"`
+	// enum: DRAFT,OPEN,REGISTRATION_CLOSED,MATCHED,COMPLETED,ARCHIVED
+	Status string `json:"status" validate:"required,oneof=DRAFT OPEN REGISTRATION_CLOSED MATCHED COMPLETED ARCHIVED"`
 
 	// ID of the group owner
 	// required: true
@@ -111,6 +187,16 @@ type GroupSummaryDTO struct {
 	// example: 5
 	UserCount int `json:"user_count" validate:"required,min=0"`
 
+	// Number of guests without an account in the group; users and guests together count towards max_members
+	// required: true
+	// example: 2
+	GuestCount int `json:"guest_count" validate:"min=0"`
+
+	// Maximum number of participants; 0 means no limit
+	// required: true
+	// example: 30
+	MaxMembers int `json:"max_members" validate:"min=0"`
+
 	// Group creation timestamp
 	// required: true
 	// example: 2024-01-01T00:00:00Z
@@ -135,15 +221,39 @@ func mapGroupFromDomain(group domain.Group) (*GroupDTO, error) {
 		return nil, err
 	}
 
+	guests, err := mapGuestsFromDomain(group.Guests)
+	if err != nil {
+		return nil, err
+	}
+
+	waitlist, err := mapUsersFromDomain(group.Waitlist)
+	if err != nil {
+		return nil, err
+	}
+
+	joinRequests, err := mapJoinRequestsFromDomain(group.JoinRequests)
+	if err != nil {
+		return nil, err
+	}
+
 	groupDTO := GroupDTO{
-		ID:          group.ID,
-		Name:        group.Name,
-		Description: group.Description,
-		Users:       users,
-		OwnerID:     group.OwnerID,
-		Status:      string(group.Status),
-		CreatedAt:   group.CreatedAt,
-		UpdatedAt:   group.UpdatedAt,
+		ID:             group.ID,
+		Name:           group.Name,
+		Description:    group.Description,
+		Users:          users,
+		Guests:         guests,
+		Waitlist:       waitlist,
+		MaxMembers:     group.MaxMembers,
+		JoinRequests:   joinRequests,
+		Budget:         group.Budget,
+		Rules:          group.Rules,
+		ExchangeDate:   group.ExchangeDate,
+		MatchStrategy:  string(group.MatchStrategy),
+		AllowedActions: mapGroupActionsFromDomain(group.AllowedActions()),
+		OwnerID:        group.OwnerID,
+		Status:         string(group.Status),
+		CreatedAt:      group.CreatedAt,
+		UpdatedAt:      group.UpdatedAt,
 	}
 
 	if err := groupDTO.Validate(); err != nil {
@@ -153,6 +263,14 @@ func mapGroupFromDomain(group domain.Group) (*GroupDTO, error) {
 	return &groupDTO, nil
 }
 
+func mapGroupActionsFromDomain(actions []domain.GroupAction) []string {
+	actionDTOs := make([]string, 0, len(actions))
+	for _, action := range actions {
+		actionDTOs = append(actionDTOs, string(action))
+	}
+	return actionDTOs
+}
+
 func mapGroupSummaryFromDomain(groupSummary domain.GroupSummary) (*GroupSummaryDTO, error) {
 	groupSummaryDTO := GroupSummaryDTO{
 		ID:          groupSummary.ID,
@@ -161,6 +279,8 @@ func mapGroupSummaryFromDomain(groupSummary domain.GroupSummary) (*GroupSummaryD
 		Status:      string(groupSummary.Status),
 		OwnerID:     groupSummary.OwnerID,
 		UserCount:   groupSummary.UserCount,
+		GuestCount:  groupSummary.GuestCount,
+		MaxMembers:  groupSummary.MaxMembers,
 		CreatedAt:   groupSummary.CreatedAt,
 		UpdatedAt:   groupSummary.UpdatedAt,
 	}
@@ -172,6 +292,23 @@ func mapGroupSummaryFromDomain(groupSummary domain.GroupSummary) (*GroupSummaryD
 	return &groupSummaryDTO, nil
 }
 
+// UpdateMaxMembersDTO represents the data needed to change a group's member limit
+// swagger:model UpdateMaxMembersDTO
+type UpdateMaxMembersDTO struct {
+	// Maximum number of participants (users and guests); 0 removes the limit
+	// required: true
+	// minimum: 0
+	// example: 30
+	MaxMembers int `json:"max_members" validate:"min=0"`
+}
+
+func (u *UpdateMaxMembersDTO) Validate() error {
+	if errs := validator.Validate(u); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
 // GroupFiltersDTO represents filters for searching groups
 // swagger:model GroupFiltersDTO
 type GroupFiltersDTO struct {
@@ -189,7 +326,7 @@ type GroupFiltersDTO struct {
 
 	// Filter by group status (multiple values allowed)
 	// example: OPEN
-	Statuses []string `query:"status" json:"status" validate:"omitempty,dive,oneof=OPEN MATCHED ARCHIVED"`
+	Statuses []string `query:"status" json:"status" validate:"omitempty,dive,oneof=DRAFT OPEN REGISTRATION_CLOSED MATCHED COMPLETED ARCHIVED"`
 
 	// Maximum number of results to return
 	// example: 10
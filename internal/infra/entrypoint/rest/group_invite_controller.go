@@ -25,12 +25,25 @@ func NewGroupInviteController(
 func (c *GroupInviteController) Create(ctx fiber.Ctx) error {
 	groupID := ctx.Params("groupID")
 
+	var createGroupInviteDTO CreateGroupInviteDTO
+
+	// the body is optional, an empty request creates a regular invite
+	if len(ctx.Body()) > 0 {
+		if err := ctx.Bind().Body(&createGroupInviteDTO); err != nil {
+			return fiber.NewError(fiber.StatusUnprocessableEntity)
+		}
+	}
+
+	if err := createGroupInviteDTO.Validate(); err != nil {
+		return err
+	}
+
 	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
 	if err != nil {
 		return err
 	}
 
-	groupInvite, err := c.groupInviteService.Create(ctx.Context(), groupID, authUserID)
+	groupInvite, err := c.groupInviteService.Create(ctx.Context(), groupID, authUserID, createGroupInviteDTO.RequiresApproval, createGroupInviteDTO.MaxUses)
 	if err != nil {
 		return err
 	}
@@ -64,6 +77,44 @@ func (c *GroupInviteController) GetActive(ctx fiber.Ctx) error {
 	return ctx.JSON(groupInviteDTO)
 }
 
+func (c *GroupInviteController) GetPreview(ctx fiber.Ctx) error {
+	inviteID := ctx.Params("inviteID")
+
+	preview, err := c.groupInviteService.GetPreview(ctx.Context(), inviteID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(mapGroupInvitePreviewFromDomain(*preview))
+}
+
+var qrCodeContentTypes = map[domain.QRCodeFormat]string{
+	domain.QRCodeFormatPNG: "image/png",
+	domain.QRCodeFormatSVG: "image/svg+xml",
+}
+
+func (c *GroupInviteController) GetActiveQRCode(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	format, err := domain.NewQRCodeFormat(ctx.Query("format"))
+	if err != nil {
+		return err
+	}
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	image, err := c.groupInviteService.GetActiveQRCode(ctx.Context(), groupID, authUserID, format)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentType, qrCodeContentTypes[format])
+	return ctx.Send(image)
+}
+
 func (c *GroupInviteController) Join(ctx fiber.Ctx) error {
 	inviteID := ctx.Params("inviteID")
 
@@ -72,7 +123,28 @@ func (c *GroupInviteController) Join(ctx fiber.Ctx) error {
 		return err
 	}
 
-	group, err := c.groupInviteService.JoinGroup(ctx.Context(), inviteID, authUserID)
+	group, err := c.groupInviteService.JoinGroup(ctx.Context(), inviteID, authUserID, ctx.IP())
+	if err != nil {
+		return err
+	}
+
+	groupDTO, err := mapGroupFromDomain(*group)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupDTO)
+}
+
+func (c *GroupInviteController) JoinByCode(ctx fiber.Ctx) error {
+	code := ctx.Params("code")
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	group, err := c.groupInviteService.JoinGroupByCode(ctx.Context(), code, authUserID, ctx.IP())
 	if err != nil {
 		return err
 	}
@@ -84,3 +156,178 @@ func (c *GroupInviteController) Join(ctx fiber.Ctx) error {
 
 	return ctx.JSON(groupDTO)
 }
+
+func (c *GroupInviteController) CreatePersonal(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	var createPersonalInviteDTO CreatePersonalInviteDTO
+	if err := ctx.Bind().Body(&createPersonalInviteDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := createPersonalInviteDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	groupInvite, err := c.groupInviteService.CreatePersonal(ctx.Context(), groupID, authUserID, createPersonalInviteDTO.Email)
+	if err != nil {
+		return err
+	}
+
+	groupInviteDTO, err := mapGroupInviteFromDomain(*groupInvite)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(groupInviteDTO)
+}
+
+func (c *GroupInviteController) ListPersonal(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	groupInvites, err := c.groupInviteService.ListPersonal(ctx.Context(), groupID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	groupInviteDTOs, err := mapGroupInvitesFromDomain(groupInvites)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupInviteDTOs)
+}
+
+func (c *GroupInviteController) ResendPersonal(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	inviteID := ctx.Params("inviteID")
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	groupInvite, err := c.groupInviteService.ResendPersonal(ctx.Context(), groupID, inviteID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	groupInviteDTO, err := mapGroupInviteFromDomain(*groupInvite)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupInviteDTO)
+}
+
+func (c *GroupInviteController) CancelPersonal(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	inviteID := ctx.Params("inviteID")
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	if err := c.groupInviteService.CancelPersonal(ctx.Context(), groupID, inviteID, authUserID); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *GroupInviteController) List(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	groupInvites, err := c.groupInviteService.List(ctx.Context(), groupID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	groupInviteDTOs, err := mapGroupInvitesFromDomain(groupInvites)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupInviteDTOs)
+}
+
+func (c *GroupInviteController) Revoke(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	inviteID := ctx.Params("inviteID")
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	groupInvite, err := c.groupInviteService.Revoke(ctx.Context(), groupID, inviteID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	groupInviteDTO, err := mapGroupInviteFromDomain(*groupInvite)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(groupInviteDTO)
+}
+
+func (c *GroupInviteController) Rotate(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	groupInvite, err := c.groupInviteService.Rotate(ctx.Context(), groupID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	groupInviteDTO, err := mapGroupInviteFromDomain(*groupInvite)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(groupInviteDTO)
+}
+
+func (c *GroupInviteController) ListRedemptions(ctx fiber.Ctx) error {
+	groupID := ctx.Params("groupID")
+	inviteID := ctx.Params("inviteID")
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	redemptions, err := c.groupInviteService.ListRedemptions(ctx.Context(), groupID, inviteID, authUserID)
+	if err != nil {
+		return err
+	}
+
+	redemptionDTOs, err := mapGroupInviteRedemptionsFromDomain(redemptions)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(redemptionDTOs)
+}
@@ -2,8 +2,10 @@ package rest_test
 
 import (
 	"fmt"
+	"io"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
@@ -33,7 +35,7 @@ func Test_GroupInviteController_Create(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
-		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID).Return(&groupInvite, nil)
+		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID, false, 0).Return(&groupInvite, nil)
 
 		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
 
@@ -58,6 +60,68 @@ func Test_GroupInviteController_Create(t *testing.T) {
 		assert.Equal(t, groupInvite.GroupID, result.GroupID)
 	})
 
+	t.Run("should create an invite that requires approval when requested", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(groupID).WithRequiresApproval(true).Build()
+		createGroupInviteDTO := rest.CreateGroupInviteDTO{RequiresApproval: true}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID, true, 0).Return(&groupInvite, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, createGroupInviteDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/invites", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.Create)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusCreated, response.StatusCode)
+
+		var result rest.GroupInviteDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.True(t, result.RequiresApproval)
+	})
+
+	t.Run("should return unprocessable_entity when payload is malformed", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		groupInviteController := rest.NewGroupInviteController(nil, nil)
+
+		payload := helper.EncodeJSON(t, "invalid_payload")
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/invites", groupID), payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.Create)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnprocessableEntity, response.StatusCode)
+	})
+
 	t.Run("should return status 403 when user is not group owner", func(t *testing.T) {
 		// given
 		authUserID := uuid.New().String()
@@ -69,7 +133,7 @@ func Test_GroupInviteController_Create(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
-		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID).Return(nil, domain.NewForbiddenError("only the group owner can create invites"))
+		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID, false, 0).Return(nil, domain.NewForbiddenError("only the group owner can create invites"))
 
 		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
 
@@ -105,7 +169,7 @@ func Test_GroupInviteController_Create(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
-		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID).Return(nil, domain.NewResourceNotFoundError("group not found"))
+		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID, false, 0).Return(nil, domain.NewResourceNotFoundError("group not found"))
 
 		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
 
@@ -141,7 +205,7 @@ func Test_GroupInviteController_Create(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
-		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID).Return(nil, domain.NewConflictError("group is not open for invites"))
+		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID, false, 0).Return(nil, domain.NewConflictError("group is not open for invites"))
 
 		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
 
@@ -165,6 +229,72 @@ func Test_GroupInviteController_Create(t *testing.T) {
 		assert.Equal(t, "conflict", result.Code)
 		assert.Equal(t, "group is not open for invites", result.Message)
 	})
+
+	t.Run("should create an invite limited to a number of uses", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(groupID).WithMaxUses(10).Build()
+		createGroupInviteDTO := rest.CreateGroupInviteDTO{MaxUses: 10}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().Create(gomock.Any(), groupID, authUserID, false, 10).Return(&groupInvite, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/invites", groupID), helper.EncodeJSON(t, createGroupInviteDTO))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.Create)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusCreated, response.StatusCode)
+
+		var result rest.GroupInviteDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, 10, result.MaxUses)
+		assert.Equal(t, 0, result.Uses)
+	})
+
+	t.Run("should return status 400 when max uses is negative", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		createGroupInviteDTO := rest.CreateGroupInviteDTO{MaxUses: -1}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/invites", groupID), helper.EncodeJSON(t, createGroupInviteDTO))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.Create)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
 }
 
 func Test_GroupInviteController_GetActive(t *testing.T) {
@@ -316,6 +446,171 @@ func Test_GroupInviteController_GetActive(t *testing.T) {
 	})
 }
 
+func Test_GroupInviteController_GetPreview(t *testing.T) {
+	route := "/api/v1/invites/:inviteID"
+
+	t.Run("should return status 200 and the invite preview", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+		preview := domain.GroupInvitePreview{
+			GroupName:   "Secret Santa",
+			GroupStatus: domain.GroupStatusOpen,
+			OwnerName:   "Maria Silva",
+			MemberCount: 4,
+			InviteState: domain.GroupInviteStateActive,
+			ExpiresAt:   time.Now().UTC().Add(time.Hour),
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().GetPreview(gomock.Any(), inviteID).Return(&preview, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, nil)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/invites/%s", inviteID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.GetPreview)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupInvitePreviewDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, "Secret Santa", result.GroupName)
+		assert.Equal(t, "OPEN", result.GroupStatus)
+		assert.Equal(t, "Maria Silva", result.OwnerName)
+		assert.Equal(t, 4, result.MemberCount)
+		assert.Equal(t, "ACTIVE", result.InviteState)
+	})
+
+	t.Run("should return status 404 when invite is not found", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().GetPreview(gomock.Any(), inviteID).Return(nil, domain.NewResourceNotFoundError("group invite not found"))
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, nil)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/invites/%s", inviteID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.GetPreview)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, response.StatusCode)
+	})
+}
+
+func Test_GroupInviteController_GetActiveQRCode(t *testing.T) {
+	route := "/api/v1/groups/:groupID/invites/active/qr"
+
+	t.Run("should return status 200 and a PNG image by default", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		image := []byte("png-image")
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().GetActiveQRCode(gomock.Any(), groupID, authUserID, domain.QRCodeFormatPNG).Return(image, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/invites/active/qr", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.GetActiveQRCode)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+		assert.Equal(t, "image/png", response.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(response.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, image, body)
+	})
+
+	t.Run("should return an SVG image when requested", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().GetActiveQRCode(gomock.Any(), groupID, authUserID, domain.QRCodeFormatSVG).Return([]byte("<svg></svg>"), nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/invites/active/qr?format=svg", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.GetActiveQRCode)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+		assert.Equal(t, "image/svg+xml", response.Header.Get("Content-Type"))
+	})
+
+	t.Run("should return status 400 when the format is not supported", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, nil)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/invites/active/qr?format=gif", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.GetActiveQRCode)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+}
+
 func Test_GroupInviteController_Join(t *testing.T) {
 	route := "/api/v1/invites/:inviteID/join"
 
@@ -332,7 +627,7 @@ func Test_GroupInviteController_Join(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
-		mockedGroupInviteService.EXPECT().JoinGroup(gomock.Any(), inviteID, authUserID).Return(&group, nil)
+		mockedGroupInviteService.EXPECT().JoinGroup(gomock.Any(), inviteID, authUserID, gomock.Any()).Return(&group, nil)
 
 		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
 
@@ -367,7 +662,7 @@ func Test_GroupInviteController_Join(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
-		mockedGroupInviteService.EXPECT().JoinGroup(gomock.Any(), inviteID, authUserID).Return(nil, domain.NewResourceNotFoundError("group invite not found"))
+		mockedGroupInviteService.EXPECT().JoinGroup(gomock.Any(), inviteID, authUserID, gomock.Any()).Return(nil, domain.NewResourceNotFoundError("group invite not found"))
 
 		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
 
@@ -403,7 +698,7 @@ func Test_GroupInviteController_Join(t *testing.T) {
 		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
 
 		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
-		mockedGroupInviteService.EXPECT().JoinGroup(gomock.Any(), inviteID, authUserID).Return(nil, domain.NewConflictError("invite has expired"))
+		mockedGroupInviteService.EXPECT().JoinGroup(gomock.Any(), inviteID, authUserID, gomock.Any()).Return(nil, domain.NewConflictError("invite has expired"))
 
 		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
 
@@ -428,3 +723,618 @@ func Test_GroupInviteController_Join(t *testing.T) {
 		assert.Equal(t, "invite has expired", result.Message)
 	})
 }
+
+func Test_GroupInviteController_JoinByCode(t *testing.T) {
+	route := "/api/v1/invites/code/:code/join"
+
+	t.Run("should return status 200 and the group when joined successfully", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		group := build_domain.NewGroupBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().JoinGroupByCode(gomock.Any(), "ABCD2345", authUserID, gomock.Any()).Return(&group, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, "/api/v1/invites/code/ABCD2345/join", nil)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.JoinByCode)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, group.ID, result.ID)
+	})
+
+	t.Run("should return status 404 when no active invite has the code", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().JoinGroupByCode(gomock.Any(), "ABCD2345", authUserID, gomock.Any()).Return(nil, domain.NewResourceNotFoundError("no active invite found for this code"))
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, "/api/v1/invites/code/ABCD2345/join", nil)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.JoinByCode)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, response.StatusCode)
+
+		var result entrypoint.WebError
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, "no active invite found for this code", result.Message)
+	})
+
+	t.Run("should return status 429 with Retry-After after too many failed attempts", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().JoinGroupByCode(gomock.Any(), "ABCD2345", authUserID, gomock.Any()).Return(nil, domain.NewTooManyRequestsError("too many failed attempts, try again later", 30*time.Second))
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, "/api/v1/invites/code/ABCD2345/join", nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.JoinByCode)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusTooManyRequests, response.StatusCode)
+		assert.Equal(t, "30", response.Header.Get(fiber.HeaderRetryAfter))
+	})
+}
+
+func Test_GroupInviteController_CreatePersonal(t *testing.T) {
+	route := "/api/v1/groups/:groupID/personal-invites"
+
+	t.Run("should return status 201 and the personal invite when created successfully", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		createPersonalInviteDTO := rest.CreatePersonalInviteDTO{Email: "friend@example.com"}
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(groupID).WithEmail(createPersonalInviteDTO.Email).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().CreatePersonal(gomock.Any(), groupID, authUserID, createPersonalInviteDTO.Email).Return(&groupInvite, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/personal-invites", groupID), helper.EncodeJSON(t, createPersonalInviteDTO))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.CreatePersonal)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusCreated, response.StatusCode)
+
+		var result rest.GroupInviteDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, groupInvite.ID, result.ID)
+		assert.Equal(t, createPersonalInviteDTO.Email, result.Email)
+	})
+
+	t.Run("should return status 400 when the email is invalid", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		createPersonalInviteDTO := rest.CreatePersonalInviteDTO{Email: "not-an-email"}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/personal-invites", groupID), helper.EncodeJSON(t, createPersonalInviteDTO))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.CreatePersonal)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return status 409 when a pending invite already exists for the email", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		createPersonalInviteDTO := rest.CreatePersonalInviteDTO{Email: "friend@example.com"}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().CreatePersonal(gomock.Any(), groupID, authUserID, createPersonalInviteDTO.Email).Return(nil, domain.NewConflictError("a pending invite already exists for this email"))
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/personal-invites", groupID), helper.EncodeJSON(t, createPersonalInviteDTO))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.CreatePersonal)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+	})
+}
+
+func Test_GroupInviteController_ListPersonal(t *testing.T) {
+	route := "/api/v1/groups/:groupID/personal-invites"
+
+	t.Run("should return status 200 with the pending personal invites", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		groupInvites := []domain.GroupInvite{
+			build_domain.NewGroupInviteBuilder().WithGroupID(groupID).WithEmail("first@example.com").Build(),
+			build_domain.NewGroupInviteBuilder().WithGroupID(groupID).WithEmail("second@example.com").Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().ListPersonal(gomock.Any(), groupID, authUserID).Return(groupInvites, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/personal-invites", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.ListPersonal)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result []rest.GroupInviteDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "first@example.com", result[0].Email)
+	})
+}
+
+func Test_GroupInviteController_ResendPersonal(t *testing.T) {
+	route := "/api/v1/groups/:groupID/personal-invites/:inviteID/resend"
+
+	t.Run("should return status 200 with the refreshed invite", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(groupID).WithEmail("friend@example.com").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().ResendPersonal(gomock.Any(), groupID, groupInvite.ID, authUserID).Return(&groupInvite, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/personal-invites/%s/resend", groupID, groupInvite.ID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.ResendPersonal)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupInviteDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, groupInvite.ID, result.ID)
+	})
+}
+
+func Test_GroupInviteController_CancelPersonal(t *testing.T) {
+	route := "/api/v1/groups/:groupID/personal-invites/:inviteID"
+
+	t.Run("should return status 204 when the invite is cancelled", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().CancelPersonal(gomock.Any(), groupID, inviteID, authUserID).Return(nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/groups/%s/personal-invites/%s", groupID, inviteID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Delete(route, groupInviteController.CancelPersonal)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+	})
+
+	t.Run("should return status 409 when the invite was already used", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().CancelPersonal(gomock.Any(), groupID, inviteID, authUserID).Return(domain.NewConflictError("only pending personal invites can be cancelled"))
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/groups/%s/personal-invites/%s", groupID, inviteID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Delete(route, groupInviteController.CancelPersonal)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+	})
+}
+
+func Test_GroupInviteController_List(t *testing.T) {
+	route := "/api/v1/groups/:groupID/invites"
+
+	t.Run("should return status 200 with every invite and its state", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		revokedAt := time.Now()
+		groupInvites := []domain.GroupInvite{
+			build_domain.NewGroupInviteBuilder().WithGroupID(groupID).Build(),
+			build_domain.NewGroupInviteBuilder().WithGroupID(groupID).WithRevokedAt(&revokedAt).Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().List(gomock.Any(), groupID, authUserID).Return(groupInvites, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/invites", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.List)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result []rest.GroupInviteDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "ACTIVE", result[0].State)
+		assert.Equal(t, "REVOKED", result[1].State)
+		assert.NotNil(t, result[1].RevokedAt)
+	})
+
+	t.Run("should return status 403 when requester is not the owner", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().List(gomock.Any(), groupID, authUserID).Return(nil, domain.NewForbiddenError("only the group owner can manage invites"))
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/invites", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.List)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+	})
+}
+
+func Test_GroupInviteController_Revoke(t *testing.T) {
+	route := "/api/v1/groups/:groupID/invites/:inviteID/revoke"
+
+	t.Run("should return status 200 with the revoked invite", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		revokedAt := time.Now()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(groupID).WithRevokedAt(&revokedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().Revoke(gomock.Any(), groupID, groupInvite.ID, authUserID).Return(&groupInvite, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/invites/%s/revoke", groupID, groupInvite.ID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.Revoke)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.GroupInviteDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, "REVOKED", result.State)
+	})
+
+	t.Run("should return status 409 when the invite is already revoked", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().Revoke(gomock.Any(), groupID, inviteID, authUserID).Return(nil, domain.NewConflictError("invite has already been revoked"))
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/invites/%s/revoke", groupID, inviteID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.Revoke)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+	})
+}
+
+func Test_GroupInviteController_Rotate(t *testing.T) {
+	route := "/api/v1/groups/:groupID/invites/rotate"
+
+	t.Run("should return status 201 with the new invite", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithGroupID(groupID).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().Rotate(gomock.Any(), groupID, authUserID).Return(&groupInvite, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, fmt.Sprintf("/api/v1/groups/%s/invites/rotate", groupID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupInviteController.Rotate)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusCreated, response.StatusCode)
+
+		var result rest.GroupInviteDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, groupInvite.ID, result.ID)
+		assert.Equal(t, "ACTIVE", result.State)
+	})
+}
+
+func Test_GroupInviteController_ListRedemptions(t *testing.T) {
+	route := "/api/v1/groups/:groupID/invites/:inviteID/redemptions"
+
+	t.Run("should return status 200 with the users who joined through the invite", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		inviteID := uuid.New().String()
+		joinedUser := build_domain.NewUserBuilder().Build()
+		redemptions := []domain.GroupInviteRedemption{
+			{InviteID: inviteID, User: joinedUser, RedeemedAt: time.Now()},
+		}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().ListRedemptions(gomock.Any(), groupID, inviteID, authUserID).Return(redemptions, nil)
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/invites/%s/redemptions", groupID, inviteID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.ListRedemptions)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result []rest.GroupInviteRedemptionDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Len(t, result, 1)
+		assert.Equal(t, joinedUser.ID, result[0].User.ID)
+	})
+
+	t.Run("should return status 403 when requester is not the owner", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		groupID := uuid.New().String()
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupInviteService := mock_application.NewMockGroupInviteService(mockCtrl)
+		mockedGroupInviteService.EXPECT().ListRedemptions(gomock.Any(), groupID, inviteID, authUserID).Return(nil, domain.NewForbiddenError("only the group owner can manage invites"))
+
+		groupInviteController := rest.NewGroupInviteController(mockedGroupInviteService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, fmt.Sprintf("/api/v1/groups/%s/invites/%s/redemptions", groupID, inviteID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupInviteController.ListRedemptions)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+	})
+}
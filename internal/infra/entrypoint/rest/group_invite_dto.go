@@ -4,8 +4,45 @@ import (
 	"time"
 
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
 )
 
+// CreateGroupInviteDTO represents the optional settings for a new invite link
+// swagger:model CreateGroupInviteDTO
+type CreateGroupInviteDTO struct {
+	// When true, users joining through the invite are held as join requests until the owner approves them
+	// example: true
+	RequiresApproval bool `json:"requires_approval"`
+
+	// Maximum number of times the invite can be used; 0 means unlimited
+	// minimum: 0
+	// example: 10
+	MaxUses int `json:"max_uses" validate:"min=0"`
+}
+
+func (i *CreateGroupInviteDTO) Validate() error {
+	if errs := validator.Validate(i); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+// CreatePersonalInviteDTO represents the data needed to invite a specific person by email
+// swagger:model CreatePersonalInviteDTO
+type CreatePersonalInviteDTO struct {
+	// Email address of the person being invited. Only a user with this email can use the invite.
+	// required: true
+	// example: friend@example.com
+	Email string `json:"email" validate:"required,email"`
+}
+
+func (i *CreatePersonalInviteDTO) Validate() error {
+	if errs := validator.Validate(i); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
 // GroupInviteDTO represents an invite link for joining a group
 // swagger:model GroupInviteDTO
 type GroupInviteDTO struct {
@@ -19,6 +56,41 @@ type GroupInviteDTO struct {
 	// example: 018e1234-abcd-7000-8000-000000000002
 	GroupID string `json:"group_id"`
 
+	// Short code that can be typed instead of the invite ID; it leaves out 0, O, 1 and I to avoid confusion
+	// example: K7M2QX9P
+	Code string `json:"code,omitempty"`
+
+	// Email the invite was sent to; empty for link invites that anyone can use
+	// example: friend@example.com
+	Email string `json:"email,omitempty"`
+
+	// When the personal invite was used; personal invites can only be used once
+	UsedAt *time.Time `json:"used_at,omitempty"`
+
+	// When the invite was revoked by the group owner
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+
+	// Whether the invite can still be used
+	// required: true
+	// example: ACTIVE
+	// enum: ACTIVE,EXPIRED,REVOKED,USED,EXHAUSTED
+	State string `json:"state"`
+
+	// Whether joining through this invite creates a join request that the owner must approve
+	// required: true
+	// example: false
+	RequiresApproval bool `json:"requires_approval"`
+
+	// Maximum number of times the invite can be used; 0 means unlimited
+	// required: true
+	// example: 10
+	MaxUses int `json:"max_uses"`
+
+	// Number of times the invite has been used
+	// required: true
+	// example: 3
+	Uses int `json:"uses"`
+
 	// When the invite expires (UTC)
 	// required: true
 	ExpiresAt time.Time `json:"expires_at"`
@@ -30,11 +102,93 @@ type GroupInviteDTO struct {
 
 func mapGroupInviteFromDomain(groupInvite domain.GroupInvite) (*GroupInviteDTO, error) {
 	dto := &GroupInviteDTO{
-		ID:        groupInvite.ID,
-		GroupID:   groupInvite.GroupID,
-		ExpiresAt: groupInvite.ExpiresAt,
-		CreatedAt: groupInvite.CreatedAt,
+		ID:               groupInvite.ID,
+		GroupID:          groupInvite.GroupID,
+		Code:             groupInvite.Code,
+		Email:            groupInvite.Email,
+		UsedAt:           groupInvite.UsedAt,
+		RevokedAt:        groupInvite.RevokedAt,
+		State:            string(groupInvite.State()),
+		RequiresApproval: groupInvite.RequiresApproval,
+		MaxUses:          groupInvite.MaxUses,
+		Uses:             groupInvite.Uses,
+		ExpiresAt:        groupInvite.ExpiresAt,
+		CreatedAt:        groupInvite.CreatedAt,
 	}
 
 	return dto, nil
 }
+
+func mapGroupInvitesFromDomain(groupInvites []domain.GroupInvite) ([]GroupInviteDTO, error) {
+	dtos := make([]GroupInviteDTO, 0, len(groupInvites))
+	for _, groupInvite := range groupInvites {
+		dto, err := mapGroupInviteFromDomain(groupInvite)
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, *dto)
+	}
+	return dtos, nil
+}
+
+// GroupInvitePreviewDTO represents what can be seen about a group before joining it through an invite
+// swagger:model GroupInvitePreviewDTO
+type GroupInvitePreviewDTO struct {
+	// Group name
+	// required: true
+	// example: Secret Santa 2024
+	GroupName string `json:"group_name"`
+
+	// Group description
+	// example: A group for our annual Secret Santa event
+	GroupDescription string `json:"group_description"`
+
+	// Current group status
+	// required: true
+	// example: OPEN
+	GroupStatus string `json:"group_status"`
+
+	// Display name of the group owner
+	// required: true
+	// example: Maria Silva
+	OwnerName string `json:"owner_name"`
+
+	// Number of participants (users and guests) in the group
+	// required: true
+	// example: 12
+	MemberCount int `json:"member_count"`
+
+	// Maximum number of participants; 0 means no limit
+	// required: true
+	// example: 30
+	MaxMembers int `json:"max_members"`
+
+	// Whether the invite can still be used
+	// required: true
+	// example: ACTIVE
+	// enum: ACTIVE,EXPIRED,REVOKED,USED,EXHAUSTED
+	InviteState string `json:"invite_state"`
+
+	// Whether joining through this invite creates a join request that the owner must approve
+	// required: true
+	// example: false
+	RequiresApproval bool `json:"requires_approval"`
+
+	// When the invite expires (UTC)
+	// required: true
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func mapGroupInvitePreviewFromDomain(preview domain.GroupInvitePreview) GroupInvitePreviewDTO {
+	return GroupInvitePreviewDTO{
+		GroupName:        preview.GroupName,
+		GroupDescription: preview.GroupDescription,
+		GroupStatus:      string(preview.GroupStatus),
+		OwnerName:        preview.OwnerName,
+		MemberCount:      preview.MemberCount,
+		MaxMembers:       preview.MaxMembers,
+		InviteState:      string(preview.InviteState),
+		RequiresApproval: preview.RequiresApproval,
+		ExpiresAt:        preview.ExpiresAt,
+	}
+}
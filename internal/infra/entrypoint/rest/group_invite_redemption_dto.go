@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// GroupInviteRedemptionDTO represents a user who joined a group through an invite
+// swagger:model GroupInviteRedemptionDTO
+type GroupInviteRedemptionDTO struct {
+	// User who used the invite
+	// required: true
+	User UserDTO `json:"user" validate:"required"`
+
+	// When the invite was used
+	// required: true
+	// example: 2023-12-01T10:00:00Z
+	RedeemedAt time.Time `json:"redeemed_at" validate:"required"`
+}
+
+func (r *GroupInviteRedemptionDTO) Validate() error {
+	if errs := validator.Validate(r); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+func mapGroupInviteRedemptionFromDomain(redemption domain.GroupInviteRedemption) (*GroupInviteRedemptionDTO, error) {
+	user, err := mapUserFromDomain(redemption.User)
+	if err != nil {
+		return nil, err
+	}
+
+	redemptionDTO := GroupInviteRedemptionDTO{
+		User:       *user,
+		RedeemedAt: redemption.RedeemedAt,
+	}
+
+	if err := redemptionDTO.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &redemptionDTO, nil
+}
+
+func mapGroupInviteRedemptionsFromDomain(redemptions []domain.GroupInviteRedemption) ([]GroupInviteRedemptionDTO, error) {
+	redemptionDTOs := make([]GroupInviteRedemptionDTO, 0, len(redemptions))
+
+	for _, redemption := range redemptions {
+		redemptionDTO, err := mapGroupInviteRedemptionFromDomain(redemption)
+		if err != nil {
+			return nil, err
+		}
+		redemptionDTOs = append(redemptionDTOs, *redemptionDTO)
+	}
+
+	return redemptionDTOs, nil
+}
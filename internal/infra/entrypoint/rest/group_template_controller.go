@@ -0,0 +1,85 @@
+package rest
+
+import (
+	jwtware "github.com/gofiber/contrib/v3/jwt"
+	"github.com/gofiber/fiber/v3"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type GroupTemplateController struct {
+	groupTemplateService application.GroupTemplateService
+	authTokenManager     domain.AuthTokenManager
+}
+
+func NewGroupTemplateController(
+	groupTemplateService application.GroupTemplateService,
+	authTokenManager domain.AuthTokenManager,
+) *GroupTemplateController {
+	return &GroupTemplateController{
+		groupTemplateService: groupTemplateService,
+		authTokenManager:     authTokenManager,
+	}
+}
+
+func (c *GroupTemplateController) List(ctx fiber.Ctx) error {
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	groupTemplates, err := c.groupTemplateService.List(ctx.Context(), authUserID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(mapGroupTemplatesFromDomain(groupTemplates))
+}
+
+func (c *GroupTemplateController) Create(ctx fiber.Ctx) error {
+	var createGroupTemplateDTO CreateGroupTemplateDTO
+	if err := ctx.Bind().Body(&createGroupTemplateDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := createGroupTemplateDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	groupTemplate, err := c.groupTemplateService.Create(
+		ctx.Context(),
+		authUserID,
+		createGroupTemplateDTO.Name,
+		createGroupTemplateDTO.Description,
+		createGroupTemplateDTO.MaxMembers,
+		createGroupTemplateDTO.Budget,
+		createGroupTemplateDTO.Rules,
+		createGroupTemplateDTO.ExchangeInDays,
+		domain.MatchStrategy(createGroupTemplateDTO.MatchStrategy),
+	)
+	if err != nil {
+		return err
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(mapGroupTemplateFromDomain(*groupTemplate))
+}
+
+func (c *GroupTemplateController) Delete(ctx fiber.Ctx) error {
+	templateID := ctx.Params("templateID")
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	if err := c.groupTemplateService.Delete(ctx.Context(), templateID, authUserID); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
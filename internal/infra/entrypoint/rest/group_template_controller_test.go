@@ -0,0 +1,266 @@
+package rest_test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest/build_rest"
+	"github.com/waliqueiroz/mystery-gifter-api/test/helper"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_GroupTemplateController_List(t *testing.T) {
+	route := "/api/v1/group-templates"
+
+	t.Run("should return status 200 with built-in and user-defined templates", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		userTemplate := build_domain.NewGroupTemplateBuilder().WithOwnerID(authUserID).Build()
+		groupTemplates := []domain.GroupTemplate{domain.BuiltInGroupTemplates[0], userTemplate}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupTemplateService := mock_application.NewMockGroupTemplateService(mockCtrl)
+		mockedGroupTemplateService.EXPECT().List(gomock.Any(), authUserID).Return(groupTemplates, nil)
+
+		groupTemplateController := rest.NewGroupTemplateController(mockedGroupTemplateService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupTemplateController.List)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result []rest.GroupTemplateDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Len(t, result, 2)
+		assert.Equal(t, domain.BuiltInGroupTemplates[0].ID, result[0].ID)
+		assert.True(t, result[0].BuiltIn)
+		assert.Equal(t, userTemplate.ID, result[1].ID)
+		assert.False(t, result[1].BuiltIn)
+	})
+
+	t.Run("should return status 500 when the service fails", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupTemplateService := mock_application.NewMockGroupTemplateService(mockCtrl)
+		mockedGroupTemplateService.EXPECT().List(gomock.Any(), authUserID).Return(nil, assert.AnError)
+
+		groupTemplateController := rest.NewGroupTemplateController(mockedGroupTemplateService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Get(route, groupTemplateController.List)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusInternalServerError, response.StatusCode)
+	})
+}
+
+func Test_GroupTemplateController_Create(t *testing.T) {
+	route := "/api/v1/group-templates"
+
+	t.Run("should return status 201 and the created template", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		createGroupTemplateDTO := build_rest.NewCreateGroupTemplateDTOBuilder().Build()
+		groupTemplate := build_domain.NewGroupTemplateBuilder().WithOwnerID(authUserID).WithName(createGroupTemplateDTO.Name).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupTemplateService := mock_application.NewMockGroupTemplateService(mockCtrl)
+		mockedGroupTemplateService.EXPECT().Create(
+			gomock.Any(),
+			authUserID,
+			createGroupTemplateDTO.Name,
+			createGroupTemplateDTO.Description,
+			createGroupTemplateDTO.MaxMembers,
+			createGroupTemplateDTO.Budget,
+			createGroupTemplateDTO.Rules,
+			createGroupTemplateDTO.ExchangeInDays,
+			domain.MatchStrategy(createGroupTemplateDTO.MatchStrategy),
+		).Return(&groupTemplate, nil)
+
+		groupTemplateController := rest.NewGroupTemplateController(mockedGroupTemplateService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, createGroupTemplateDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupTemplateController.Create)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusCreated, response.StatusCode)
+
+		var result rest.GroupTemplateDTO
+		helper.DecodeJSON(t, response.Body, &result)
+
+		assert.Equal(t, groupTemplate.ID, result.ID)
+		assert.Equal(t, groupTemplate.Name, result.Name)
+		assert.False(t, result.BuiltIn)
+	})
+
+	t.Run("should return status 400 when the payload is invalid", func(t *testing.T) {
+		// given
+		createGroupTemplateDTO := build_rest.NewCreateGroupTemplateDTOBuilder().WithName("").WithBudget(-1).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedGroupTemplateService := mock_application.NewMockGroupTemplateService(mockCtrl)
+
+		groupTemplateController := rest.NewGroupTemplateController(mockedGroupTemplateService, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, createGroupTemplateDTO)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupTemplateController.Create)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return status 422 when the body is malformed", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedGroupTemplateService := mock_application.NewMockGroupTemplateService(mockCtrl)
+
+		groupTemplateController := rest.NewGroupTemplateController(mockedGroupTemplateService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, "invalid"))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Post(route, groupTemplateController.Create)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnprocessableEntity, response.StatusCode)
+	})
+}
+
+func Test_GroupTemplateController_Delete(t *testing.T) {
+	route := "/api/v1/group-templates/:templateID"
+
+	t.Run("should return status 204 when the template is deleted", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		templateID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupTemplateService := mock_application.NewMockGroupTemplateService(mockCtrl)
+		mockedGroupTemplateService.EXPECT().Delete(gomock.Any(), templateID, authUserID).Return(nil)
+
+		groupTemplateController := rest.NewGroupTemplateController(mockedGroupTemplateService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/group-templates/%s", templateID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Delete(route, groupTemplateController.Delete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+	})
+
+	t.Run("should return status 403 when deleting a built-in template", func(t *testing.T) {
+		// given
+		authUserID := uuid.New().String()
+		templateID := domain.BuiltInGroupTemplates[0].ID
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(authUserID, nil)
+
+		mockedGroupTemplateService := mock_application.NewMockGroupTemplateService(mockCtrl)
+		mockedGroupTemplateService.EXPECT().Delete(gomock.Any(), templateID, authUserID).Return(domain.NewForbiddenError("built-in templates cannot be deleted"))
+
+		groupTemplateController := rest.NewGroupTemplateController(mockedGroupTemplateService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, fmt.Sprintf("/api/v1/group-templates/%s", templateID), nil)
+
+		app := fiber.New(fiber.Config{
+			ErrorHandler: entrypoint.CustomErrorHandler,
+		})
+		app.Delete(route, groupTemplateController.Delete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, response.StatusCode)
+	})
+}
@@ -0,0 +1,135 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// CreateGroupTemplateDTO represents the data needed to create a user-defined group template
+// swagger:model CreateGroupTemplateDTO
+type CreateGroupTemplateDTO struct {
+	// Template name
+	// required: true
+	// example: Book club exchange
+	Name string `json:"name" validate:"required,max=255"`
+
+	// Description applied to groups created from the template
+	// max length: 255
+	// example: Everyone brings a book they loved
+	Description string `json:"description" validate:"omitempty,max=255"`
+
+	// Maximum number of participants; 0 means no limit
+	// minimum: 0
+	// example: 12
+	MaxMembers int `json:"max_members" validate:"min=0"`
+
+	// Suggested gift value in the smallest currency unit (e.g. cents); 0 means no budget
+	// minimum: 0
+	// example: 3000
+	Budget int `json:"budget" validate:"min=0"`
+
+	// Extra rules for the exchange
+	// max length: 1000
+	// example: Wrap the book so nobody can guess the title
+	Rules string `json:"rules" validate:"omitempty,max=1000"`
+
+	// Number of days after the group creation when the gifts are exchanged; 0 leaves the date unset
+	// minimum: 0
+	// example: 21
+	ExchangeInDays int `json:"exchange_in_days" validate:"min=0"`
+
+	// How the draw pairs givers with receivers in groups created from the template; empty keeps the default (CYCLE)
+	// example: RANDOM
+	// enum: CYCLE,RANDOM
+	MatchStrategy string `json:"match_strategy" validate:"omitempty,oneof=CYCLE RANDOM"`
+}
+
+func (t *CreateGroupTemplateDTO) Validate() error {
+	if errs := validator.Validate(t); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+// GroupTemplateDTO represents preset settings that can be used to create a group
+// swagger:model GroupTemplateDTO
+type GroupTemplateDTO struct {
+	// Template identifier. Built-in templates use a stable slug, user-defined templates use a UUID.
+	// required: true
+	// example: office-secret-santa
+	ID string `json:"id"`
+
+	// Template name
+	// required: true
+	// example: Office Secret Santa
+	Name string `json:"name"`
+
+	// Description applied to groups created from the template
+	// example: A light-hearted gift exchange among coworkers
+	Description string `json:"description"`
+
+	// Maximum number of participants; 0 means no limit
+	// required: true
+	// example: 0
+	MaxMembers int `json:"max_members"`
+
+	// Suggested gift value in the smallest currency unit (e.g. cents); 0 means no budget
+	// required: true
+	// example: 2500
+	Budget int `json:"budget"`
+
+	// Extra rules for the exchange
+	// example: Keep gifts work-appropriate and within the budget.
+	Rules string `json:"rules"`
+
+	// Number of days after the group creation when the gifts are exchanged; 0 leaves the date unset
+	// required: true
+	// example: 21
+	ExchangeInDays int `json:"exchange_in_days"`
+
+	// How the draw pairs givers with receivers in groups created from the template; empty keeps the default (CYCLE)
+	// example: RANDOM
+	// enum: CYCLE,RANDOM
+	MatchStrategy string `json:"match_strategy"`
+
+	// Whether the template is shipped with the application and available to everyone
+	// required: true
+	// example: true
+	BuiltIn bool `json:"built_in"`
+
+	// Template creation timestamp
+	// required: true
+	// example: 2024-01-01T00:00:00Z
+	CreatedAt time.Time `json:"created_at"`
+
+	// Template last update timestamp
+	// required: true
+	// example: 2024-01-01T00:00:00Z
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func mapGroupTemplateFromDomain(groupTemplate domain.GroupTemplate) GroupTemplateDTO {
+	return GroupTemplateDTO{
+		ID:             groupTemplate.ID,
+		Name:           groupTemplate.Name,
+		Description:    groupTemplate.Description,
+		MaxMembers:     groupTemplate.MaxMembers,
+		Budget:         groupTemplate.Budget,
+		Rules:          groupTemplate.Rules,
+		ExchangeInDays: groupTemplate.ExchangeInDays,
+		MatchStrategy:  string(groupTemplate.MatchStrategy),
+		BuiltIn:        groupTemplate.IsBuiltIn(),
+		CreatedAt:      groupTemplate.CreatedAt,
+		UpdatedAt:      groupTemplate.UpdatedAt,
+	}
+}
+
+func mapGroupTemplatesFromDomain(groupTemplates []domain.GroupTemplate) []GroupTemplateDTO {
+	dtos := make([]GroupTemplateDTO, 0, len(groupTemplates))
+	for _, groupTemplate := range groupTemplates {
+		dtos = append(dtos, mapGroupTemplateFromDomain(groupTemplate))
+	}
+	return dtos
+}
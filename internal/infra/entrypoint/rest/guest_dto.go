@@ -0,0 +1,204 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// GuestDTO represents a guest participant without an account
+// swagger:model GuestDTO
+type GuestDTO struct {
+	// Unique guest identifier
+	// required: true
+	// example: 01234567-89ab-cdef-0123-456789abcdef
+	ID string `json:"id" validate:"required,uuid"`
+
+	// Guest name
+	// required: true
+	// example: Grandma
+	Name string `json:"name" validate:"required,max=255"`
+
+	// Guest email address, used to claim the guest spot after signing up
+	// example: grandma@example.com
+	Email string `json:"email" validate:"omitempty,email,max=255"`
+
+	// When the guest was added
+	// required: true
+	// example: 2023-12-01T10:00:00Z
+	CreatedAt time.Time `json:"created_at" validate:"required"`
+
+	// When the guest was last updated
+	// required: true
+	// example: 2023-12-01T10:00:00Z
+	UpdatedAt time.Time `json:"updated_at" validate:"required"`
+}
+
+func (g *GuestDTO) Validate() error {
+	if errs := validator.Validate(g); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+func mapGuestFromDomain(guest domain.Guest) (*GuestDTO, error) {
+	guestDTO := GuestDTO{
+		ID:        guest.ID,
+		Name:      guest.Name,
+		Email:     guest.Email,
+		CreatedAt: guest.CreatedAt,
+		UpdatedAt: guest.UpdatedAt,
+	}
+
+	if err := guestDTO.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &guestDTO, nil
+}
+
+func mapGuestsFromDomain(guests []domain.Guest) ([]GuestDTO, error) {
+	guestDTOs := make([]GuestDTO, 0, len(guests))
+
+	for _, guest := range guests {
+		guestDTO, err := mapGuestFromDomain(guest)
+		if err != nil {
+			return nil, err
+		}
+		guestDTOs = append(guestDTOs, *guestDTO)
+	}
+
+	return guestDTOs, nil
+}
+
+// GuestInputDTO represents the data needed to add or update a guest
+// swagger:model GuestInputDTO
+type GuestInputDTO struct {
+	// Guest name
+	// required: true
+	// max length: 255
+	// example: Grandma
+	Name string `json:"name" validate:"required,max=255"`
+
+	// Guest email address
+	// max length: 255
+	// example: grandma@example.com
+	Email string `json:"email" validate:"omitempty,email,max=255"`
+}
+
+func (g *GuestInputDTO) Validate() error {
+	if errs := validator.Validate(g); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+// MergeGuestDTO represents the user who takes the place of a guest
+// swagger:model MergeGuestDTO
+type MergeGuestDTO struct {
+	// ID of the user who replaces the guest
+	// required: true
+	// example: 01234567-89ab-cdef-0123-456789abcdef
+	UserID string `json:"user_id" validate:"required,uuid"`
+}
+
+func (m *MergeGuestDTO) Validate() error {
+	if errs := validator.Validate(m); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+// ClaimableGuestDTO represents a guest spot the authenticated user can claim
+// swagger:model ClaimableGuestDTO
+type ClaimableGuestDTO struct {
+	// Unique identifier of the group the guest belongs to
+	// required: true
+	// example: 01234567-89ab-cdef-0123-456789abcdef
+	GroupID string `json:"group_id" validate:"required,uuid"`
+
+	// Name of the group the guest belongs to
+	// required: true
+	// example: Secret Santa 2024
+	GroupName string `json:"group_name" validate:"required"`
+
+	// Guest that can be claimed
+	// required: true
+	Guest GuestDTO `json:"guest"`
+}
+
+func mapClaimableGuestsFromDomain(claimableGuests []domain.ClaimableGuest) ([]ClaimableGuestDTO, error) {
+	claimableGuestDTOs := make([]ClaimableGuestDTO, 0, len(claimableGuests))
+
+	for _, claimableGuest := range claimableGuests {
+		guestDTO, err := mapGuestFromDomain(claimableGuest.Guest)
+		if err != nil {
+			return nil, err
+		}
+
+		claimableGuestDTO := ClaimableGuestDTO{
+			GroupID:   claimableGuest.GroupID,
+			GroupName: claimableGuest.GroupName,
+			Guest:     *guestDTO,
+		}
+
+		if errs := validator.Validate(claimableGuestDTO); len(errs) > 0 {
+			return nil, domain.NewValidationError(errs)
+		}
+
+		claimableGuestDTOs = append(claimableGuestDTOs, claimableGuestDTO)
+	}
+
+	return claimableGuestDTOs, nil
+}
+
+// ParticipantDTO represents a group participant, either a registered user or a guest
+// swagger:model ParticipantDTO
+type ParticipantDTO struct {
+	// Unique participant identifier
+	// required: true
+	// example: 01234567-89ab-cdef-0123-456789abcdef
+	ID string `json:"id" validate:"required,uuid"`
+
+	// Participant first name
+	// required: true
+	// example: João
+	Name string `json:"name" validate:"required"`
+
+	// Participant last name, empty for guests
+	// example: Silva
+	Surname string `json:"surname"`
+
+	// Participant email address
+	// example: joao.silva@example.com
+	Email string `json:"email" validate:"omitempty,email"`
+
+	// Whether the participant is a guest without an account
+	// required: true
+	// example: false
+	IsGuest bool `json:"is_guest"`
+}
+
+func (p *ParticipantDTO) Validate() error {
+	if errs := validator.Validate(p); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+func mapParticipantFromDomain(participant domain.Participant) (*ParticipantDTO, error) {
+	participantDTO := ParticipantDTO{
+		ID:      participant.ID,
+		Name:    participant.Name,
+		Surname: participant.Surname,
+		Email:   participant.Email,
+		IsGuest: participant.IsGuest,
+	}
+
+	if err := participantDTO.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &participantDTO, nil
+}
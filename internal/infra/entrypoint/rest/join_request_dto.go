@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// JoinRequestDTO represents a pending request to join a group
+// swagger:model JoinRequestDTO
+type JoinRequestDTO struct {
+	// User asking to join the group
+	// required: true
+	User UserDTO `json:"user" validate:"required"`
+
+	// When the request was made
+	// required: true
+	// example: 2023-12-01T10:00:00Z
+	CreatedAt time.Time `json:"created_at" validate:"required"`
+}
+
+func (j *JoinRequestDTO) Validate() error {
+	if errs := validator.Validate(j); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+func mapJoinRequestFromDomain(joinRequest domain.JoinRequest) (*JoinRequestDTO, error) {
+	user, err := mapUserFromDomain(joinRequest.User)
+	if err != nil {
+		return nil, err
+	}
+
+	joinRequestDTO := JoinRequestDTO{
+		User:      *user,
+		CreatedAt: joinRequest.CreatedAt,
+	}
+
+	if err := joinRequestDTO.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &joinRequestDTO, nil
+}
+
+func mapJoinRequestsFromDomain(joinRequests []domain.JoinRequest) ([]JoinRequestDTO, error) {
+	joinRequestDTOs := make([]JoinRequestDTO, 0, len(joinRequests))
+
+	for _, joinRequest := range joinRequests {
+		joinRequestDTO, err := mapJoinRequestFromDomain(joinRequest)
+		if err != nil {
+			return nil, err
+		}
+		joinRequestDTOs = append(joinRequestDTOs, *joinRequestDTO)
+	}
+
+	return joinRequestDTOs, nil
+}
@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"crypto/subtle"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type OIDCController struct {
+	oidcService  application.OIDCService
+	cookieSecure bool
+}
+
+func NewOIDCController(oidcService application.OIDCService, cookieSecure bool) *OIDCController {
+	return &OIDCController{
+		oidcService:  oidcService,
+		cookieSecure: cookieSecure,
+	}
+}
+
+// Authorize starts a sign-in with the identity provider. The state is also kept in a cookie, which the callback
+// requires to match.
+func (c *OIDCController) Authorize(ctx fiber.Ctx) error {
+	authorization, err := c.oidcService.Start(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	setOIDCStateCookie(ctx, authorization.State, c.cookieSecure)
+
+	return ctx.JSON(mapOIDCAuthorizationFromDomain(*authorization))
+}
+
+// Callback finishes a sign-in with the code the identity provider sent back, answering like a password login.
+func (c *OIDCController) Callback(ctx fiber.Ctx) error {
+	var callbackDTO OIDCCallbackDTO
+	if err := ctx.Bind().Body(&callbackDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := callbackDTO.Validate(); err != nil {
+		return err
+	}
+
+	stateCookie := ctx.Cookies(oidcStateCookieName)
+	if stateCookie == "" || subtle.ConstantTimeCompare([]byte(stateCookie), []byte(callbackDTO.State)) != 1 {
+		return domain.NewUnauthorizedError("the sign-in was not started in this browser")
+	}
+
+	clearOIDCStateCookie(ctx)
+
+	loginResult, err := c.oidcService.Finish(ctx.Context(), callbackDTO.State, callbackDTO.Code, getDevice(ctx))
+	if err != nil {
+		return err
+	}
+
+	return sendLoginResult(ctx, *loginResult, c.cookieSecure)
+}
@@ -0,0 +1,227 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+	"github.com/waliqueiroz/mystery-gifter-api/test/helper"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_OIDCController_Authorize(t *testing.T) {
+	route := "/api/v1/auth/oidc/authorize"
+
+	t.Run("should return status 200 with the authorization URL and the state", func(t *testing.T) {
+		// given
+		authorization := domain.OIDCAuthorization{URL: "https://idp.example.com/authorize?state=some-state", State: "some-state"}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedOIDCService := mock_application.NewMockOIDCService(mockCtrl)
+		mockedOIDCService.EXPECT().Start(gomock.Any()).Return(&authorization, nil)
+
+		oidcController := rest.NewOIDCController(mockedOIDCService, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, oidcController.Authorize)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.OIDCAuthorizationDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, rest.OIDCAuthorizationDTO{AuthorizationURL: authorization.URL, State: authorization.State}, result)
+
+		setCookieHeader := response.Header.Get("Set-Cookie")
+		assert.Contains(t, setCookieHeader, "oidc_state=some-state")
+		assert.Contains(t, setCookieHeader, "path=/api/v1/auth/oidc")
+		assert.Contains(t, setCookieHeader, "HttpOnly")
+		assert.Contains(t, setCookieHeader, "SameSite=Lax")
+	})
+
+	t.Run("should return not_found when single sign-on is not configured", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedOIDCService := mock_application.NewMockOIDCService(mockCtrl)
+		mockedOIDCService.EXPECT().Start(gomock.Any()).Return(nil, domain.NewResourceNotFoundError("single sign-on is not configured"))
+
+		oidcController := rest.NewOIDCController(mockedOIDCService, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, oidcController.Authorize)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, response.StatusCode)
+	})
+}
+
+func Test_OIDCController_Callback(t *testing.T) {
+	route := "/api/v1/auth/oidc/callback"
+
+	t.Run("should return the session and set both cookies", func(t *testing.T) {
+		// given
+		authSession := build_domain.NewAuthSessionBuilder().WithAccessToken("some_token").WithRefreshToken("some_refresh_token").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedOIDCService := mock_application.NewMockOIDCService(mockCtrl)
+		mockedOIDCService.EXPECT().Finish(gomock.Any(), "some-state", "some-code", gomock.Any()).Return(&domain.LoginResult{Session: &authSession}, nil)
+
+		oidcController := rest.NewOIDCController(mockedOIDCService, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.OIDCCallbackDTO{Code: "some-code", State: "some-state"}))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: "some-state"})
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, oidcController.Callback)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.AuthSessionDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, "some_token", result.AccessToken)
+
+		setCookieHeaders := response.Header.Values("Set-Cookie")
+		assert.Len(t, setCookieHeaders, 3)
+		assert.Contains(t, setCookieHeaders[0], "oidc_state=;")
+		assert.Contains(t, setCookieHeaders[1], "access_token=some_token")
+		assert.Contains(t, setCookieHeaders[2], "refresh_token=some_refresh_token")
+	})
+
+	t.Run("should return status 202 with a two-factor challenge when the user enabled it", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedOIDCService := mock_application.NewMockOIDCService(mockCtrl)
+		mockedOIDCService.EXPECT().Finish(gomock.Any(), "some-state", "some-code", gomock.Any()).Return(&domain.LoginResult{ChallengeToken: "some_challenge_token", ChallengeExpiresIn: 1767225600}, nil)
+
+		oidcController := rest.NewOIDCController(mockedOIDCService, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.OIDCCallbackDTO{Code: "some-code", State: "some-state"}))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: "some-state"})
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, oidcController.Callback)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusAccepted, response.StatusCode)
+		setCookieHeaders := response.Header.Values("Set-Cookie")
+		assert.Len(t, setCookieHeaders, 1)
+		assert.Contains(t, setCookieHeaders[0], "oidc_state=;")
+
+		var result rest.TwoFactorChallengeDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, "some_challenge_token", result.ChallengeToken)
+	})
+
+	t.Run("should return unauthorized when the login request is invalid or expired", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedOIDCService := mock_application.NewMockOIDCService(mockCtrl)
+		mockedOIDCService.EXPECT().Finish(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.NewUnauthorizedError("invalid or expired login request"))
+
+		oidcController := rest.NewOIDCController(mockedOIDCService, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.OIDCCallbackDTO{Code: "some-code", State: "some-state"}))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: "some-state"})
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, oidcController.Callback)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, response.StatusCode)
+	})
+
+	t.Run("should return unauthorized when the state cookie does not match", func(t *testing.T) {
+		// given
+		oidcController := rest.NewOIDCController(nil, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.OIDCCallbackDTO{Code: "attacker-code", State: "attacker-state"}))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "oidc_state", Value: "some-state"})
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, oidcController.Callback)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, response.StatusCode)
+	})
+
+	t.Run("should return unauthorized when the state cookie is missing", func(t *testing.T) {
+		// given
+		oidcController := rest.NewOIDCController(nil, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.OIDCCallbackDTO{Code: "some-code", State: "some-state"}))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, oidcController.Callback)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the code is missing", func(t *testing.T) {
+		// given
+		oidcController := rest.NewOIDCController(nil, false)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.OIDCCallbackDTO{State: "some-state"}))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, oidcController.Callback)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+}
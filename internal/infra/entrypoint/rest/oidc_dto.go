@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// OIDCAuthorizationDTO represents where to send the user to sign in with the identity provider
+// swagger:model OIDCAuthorizationDTO
+type OIDCAuthorizationDTO struct {
+	// Sign-in page of the identity provider, which sends the user back to the configured redirect URL
+	// required: true
+	// example: https://idp.example.com/authorize?client_id=mystery-gifter&code_challenge=E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM&code_challenge_method=S256&nonce=n-0S6_WzA2Mj&redirect_uri=http%3A%2F%2Flocalhost%3A3000%2Fauth%2Foidc%2Fcallback&response_type=code&scope=openid+email+profile&state=af0ifjsldkj
+	AuthorizationURL string `json:"authorization_url"`
+
+	// Value the identity provider hands back with the code, which the redirect page should check before posting it
+	// required: true
+	// example: af0ifjsldkj
+	State string `json:"state"`
+}
+
+func mapOIDCAuthorizationFromDomain(authorization domain.OIDCAuthorization) OIDCAuthorizationDTO {
+	return OIDCAuthorizationDTO{
+		AuthorizationURL: authorization.URL,
+		State:            authorization.State,
+	}
+}
+
+// OIDCCallbackDTO represents the request body to finish a sign-in with the identity provider
+// swagger:model OIDCCallbackDTO
+type OIDCCallbackDTO struct {
+	// Authorization code the identity provider added to the redirect URL
+	// required: true
+	// example: SplxlOBeZQQYbYS6WxSbIA
+	Code string `json:"code" validate:"required"`
+
+	// State the identity provider added to the redirect URL
+	// required: true
+	// example: af0ifjsldkj
+	State string `json:"state" validate:"required"`
+}
+
+func (o *OIDCCallbackDTO) Validate() error {
+	if errs := validator.Validate(o); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+)
+
+type PasswordResetController struct {
+	passwordResetService application.PasswordResetService
+}
+
+func NewPasswordResetController(passwordResetService application.PasswordResetService) *PasswordResetController {
+	return &PasswordResetController{
+		passwordResetService: passwordResetService,
+	}
+}
+
+func (c *PasswordResetController) Request(ctx fiber.Ctx) error {
+	var requestPasswordResetDTO RequestPasswordResetDTO
+	if err := ctx.Bind().Body(&requestPasswordResetDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := requestPasswordResetDTO.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.passwordResetService.RequestReset(ctx.Context(), requestPasswordResetDTO.Email, ctx.IP()); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusAccepted)
+}
+
+func (c *PasswordResetController) Confirm(ctx fiber.Ctx) error {
+	var confirmPasswordResetDTO ConfirmPasswordResetDTO
+	if err := ctx.Bind().Body(&confirmPasswordResetDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := confirmPasswordResetDTO.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.passwordResetService.ConfirmReset(ctx.Context(), confirmPasswordResetDTO.Token, confirmPasswordResetDTO.NewPassword); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
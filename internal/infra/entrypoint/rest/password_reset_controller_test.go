@@ -0,0 +1,141 @@
+package rest_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest/build_rest"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+	"github.com/waliqueiroz/mystery-gifter-api/test/helper"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_PasswordResetController_Request(t *testing.T) {
+	route := "/api/v1/password-reset/request"
+
+	t.Run("should return status 202 after requesting the reset", func(t *testing.T) {
+		// given
+		email := "john@example.com"
+
+		mockCtrl := gomock.NewController(t)
+		mockedPasswordResetService := mock_application.NewMockPasswordResetService(mockCtrl)
+		mockedPasswordResetService.EXPECT().RequestReset(gomock.Any(), email, gomock.Any()).Return(nil)
+
+		passwordResetController := rest.NewPasswordResetController(mockedPasswordResetService)
+
+		payload := helper.EncodeJSON(t, rest.RequestPasswordResetDTO{Email: email})
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, passwordResetController.Request)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusAccepted, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the email is invalid", func(t *testing.T) {
+		// given
+		passwordResetController := rest.NewPasswordResetController(nil)
+
+		payload := helper.EncodeJSON(t, rest.RequestPasswordResetDTO{Email: "not-an-email"})
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, passwordResetController.Request)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+}
+
+func Test_PasswordResetController_Confirm(t *testing.T) {
+	route := "/api/v1/password-reset/confirm"
+
+	t.Run("should return status 204 after setting the new password", func(t *testing.T) {
+		// given
+		confirmPasswordResetDTO := build_rest.NewConfirmPasswordResetDTOBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedPasswordResetService := mock_application.NewMockPasswordResetService(mockCtrl)
+		mockedPasswordResetService.EXPECT().ConfirmReset(gomock.Any(), confirmPasswordResetDTO.Token, confirmPasswordResetDTO.NewPassword).Return(nil)
+
+		passwordResetController := rest.NewPasswordResetController(mockedPasswordResetService)
+
+		payload := helper.EncodeJSON(t, confirmPasswordResetDTO)
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, passwordResetController.Confirm)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the confirmation does not match", func(t *testing.T) {
+		// given
+		confirmPasswordResetDTO := build_rest.NewConfirmPasswordResetDTOBuilder().WithNewPasswordConfirm("something-else").Build()
+
+		passwordResetController := rest.NewPasswordResetController(nil)
+
+		payload := helper.EncodeJSON(t, confirmPasswordResetDTO)
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, passwordResetController.Confirm)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the token is invalid", func(t *testing.T) {
+		// given
+		confirmPasswordResetDTO := build_rest.NewConfirmPasswordResetDTOBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedPasswordResetService := mock_application.NewMockPasswordResetService(mockCtrl)
+		mockedPasswordResetService.EXPECT().ConfirmReset(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(domain.NewValidationError(validator.ValidationErrors{{Field: "Token", Error: "Token is invalid or has expired"}}))
+
+		passwordResetController := rest.NewPasswordResetController(mockedPasswordResetService)
+
+		payload := helper.EncodeJSON(t, confirmPasswordResetDTO)
+		req := httptest.NewRequest(fiber.MethodPost, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, passwordResetController.Confirm)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+}
@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// RequestPasswordResetDTO represents the request body to ask for a password reset link
+// swagger:model RequestPasswordResetDTO
+type RequestPasswordResetDTO struct {
+	// Email address of the account
+	// required: true
+	// example: user@example.com
+	Email string `json:"email" validate:"required,email"`
+}
+
+func (r *RequestPasswordResetDTO) Validate() error {
+	if errs := validator.Validate(r); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+// ConfirmPasswordResetDTO represents the request body to choose a new password with a reset token
+// swagger:model ConfirmPasswordResetDTO
+type ConfirmPasswordResetDTO struct {
+	// Token received in the reset link
+	// required: true
+	// example: q3Jk0sX8m2b9YtP4vL7wZr1nC6dF5gH0aE2iU8oK3yM
+	Token string `json:"token" validate:"required"`
+
+	// New password, at least 8 characters long
+	// required: true
+	// example: mynewpassword123
+	NewPassword string `json:"new_password" validate:"required,min=8,eqfield=NewPasswordConfirm"`
+
+	// Confirmation of the new password, must match new_password
+	// required: true
+	// example: mynewpassword123
+	NewPasswordConfirm string `json:"new_password_confirm" validate:"required"`
+}
+
+func (c *ConfirmPasswordResetDTO) Validate() error {
+	if errs := validator.Validate(c); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
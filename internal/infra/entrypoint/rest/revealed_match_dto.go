@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// RevealedMatchDTO represents a giver and receiver pair, revealed once the group is completed
+// swagger:model RevealedMatchDTO
+type RevealedMatchDTO struct {
+	// Participant who gave the gift
+	// required: true
+	Giver ParticipantDTO `json:"giver"`
+
+	// Participant who received the gift
+	// required: true
+	Receiver ParticipantDTO `json:"receiver"`
+}
+
+func mapRevealedMatchFromDomain(revealedMatch domain.RevealedMatch) (*RevealedMatchDTO, error) {
+	giver, err := mapParticipantFromDomain(revealedMatch.Giver)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := mapParticipantFromDomain(revealedMatch.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevealedMatchDTO{
+		Giver:    *giver,
+		Receiver: *receiver,
+	}, nil
+}
+
+func mapRevealedMatchesFromDomain(revealedMatches []domain.RevealedMatch) ([]RevealedMatchDTO, error) {
+	revealedMatchDTOs := make([]RevealedMatchDTO, 0, len(revealedMatches))
+
+	for _, revealedMatch := range revealedMatches {
+		revealedMatchDTO, err := mapRevealedMatchFromDomain(revealedMatch)
+		if err != nil {
+			return nil, err
+		}
+		revealedMatchDTOs = append(revealedMatchDTOs, *revealedMatchDTO)
+	}
+
+	return revealedMatchDTOs, nil
+}
@@ -0,0 +1,86 @@
+package rest
+
+import (
+	jwtware "github.com/gofiber/contrib/v3/jwt"
+	"github.com/gofiber/fiber/v3"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type SessionController struct {
+	sessionService   application.SessionService
+	authTokenManager domain.AuthTokenManager
+}
+
+func NewSessionController(sessionService application.SessionService, authTokenManager domain.AuthTokenManager) *SessionController {
+	return &SessionController{
+		sessionService:   sessionService,
+		authTokenManager: authTokenManager,
+	}
+}
+
+func (c *SessionController) List(ctx fiber.Ctx) error {
+	token := jwtware.FromContext(ctx)
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(token)
+	if err != nil {
+		return err
+	}
+
+	currentSessionID, err := c.authTokenManager.GetSessionID(token)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := c.sessionService.List(ctx.Context(), authUserID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(mapSessionsFromDomain(sessions, currentSessionID))
+}
+
+// DeleteAll logs the user out everywhere, including this device.
+func (c *SessionController) DeleteAll(ctx fiber.Ctx) error {
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	if err := c.sessionService.RevokeAll(ctx.Context(), authUserID); err != nil {
+		return err
+	}
+
+	clearCookie(ctx)
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (c *SessionController) Delete(ctx fiber.Ctx) error {
+	token := jwtware.FromContext(ctx)
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(token)
+	if err != nil {
+		return err
+	}
+
+	currentSessionID, err := c.authTokenManager.GetSessionID(token)
+	if err != nil {
+		return err
+	}
+
+	sessionID := ctx.Params("sessionID")
+	if err := c.sessionService.Revoke(ctx.Context(), authUserID, sessionID); err != nil {
+		return err
+	}
+
+	if sessionID == currentSessionID {
+		clearCookie(ctx)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func getDevice(ctx fiber.Ctx) domain.Device {
+	return domain.NewDevice(ctx.Get(fiber.HeaderUserAgent), ctx.IP())
+}
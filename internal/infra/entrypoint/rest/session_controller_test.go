@@ -0,0 +1,225 @@
+package rest_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+	"github.com/waliqueiroz/mystery-gifter-api/test/helper"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_SessionController_List(t *testing.T) {
+	route := "/api/v1/users/me/sessions"
+
+	t.Run("should return status 200 with the sessions and the current one flagged", func(t *testing.T) {
+		// given
+		currentSession := build_domain.NewSessionBuilder().WithUserID("some-user-id").Build()
+		otherSession := build_domain.NewSessionBuilder().WithUserID("some-user-id").WithUserAgent("Mobile Safari").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+		mockedAuthTokenManager.EXPECT().GetSessionID(gomock.Any()).Return(currentSession.ID, nil)
+
+		mockedSessionService := mock_application.NewMockSessionService(mockCtrl)
+		mockedSessionService.EXPECT().List(gomock.Any(), "some-user-id").Return([]domain.Session{otherSession, currentSession}, nil)
+
+		sessionController := rest.NewSessionController(mockedSessionService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Get(route, sessionController.List)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result []rest.SessionDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Len(t, result, 2)
+		assert.Equal(t, otherSession.ID, result[0].ID)
+		assert.Equal(t, "Mobile Safari", result[0].UserAgent)
+		assert.False(t, result[0].Current)
+		assert.Equal(t, currentSession.ID, result[1].ID)
+		assert.Equal(t, currentSession.IPAddress, result[1].IPAddress)
+		assert.True(t, result[1].Current)
+	})
+
+	t.Run("should return internal_server_error when listing fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+		mockedAuthTokenManager.EXPECT().GetSessionID(gomock.Any()).Return("some-session-id", nil)
+
+		mockedSessionService := mock_application.NewMockSessionService(mockCtrl)
+		mockedSessionService.EXPECT().List(gomock.Any(), "some-user-id").Return(nil, assert.AnError)
+
+		sessionController := rest.NewSessionController(mockedSessionService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodGet, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Get(route, sessionController.List)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusInternalServerError, response.StatusCode)
+	})
+}
+
+func Test_SessionController_DeleteAll(t *testing.T) {
+	route := "/api/v1/users/me/sessions"
+
+	t.Run("should return status 204 and clear the auth cookies after revoking every session", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+
+		mockedSessionService := mock_application.NewMockSessionService(mockCtrl)
+		mockedSessionService.EXPECT().RevokeAll(gomock.Any(), "some-user-id").Return(nil)
+
+		sessionController := rest.NewSessionController(mockedSessionService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Delete(route, sessionController.DeleteAll)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+		assert.Contains(t, response.Header.Get("Set-Cookie"), "access_token=;")
+	})
+
+	t.Run("should return internal_server_error when revoking fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+
+		mockedSessionService := mock_application.NewMockSessionService(mockCtrl)
+		mockedSessionService.EXPECT().RevokeAll(gomock.Any(), "some-user-id").Return(assert.AnError)
+
+		sessionController := rest.NewSessionController(mockedSessionService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Delete(route, sessionController.DeleteAll)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusInternalServerError, response.StatusCode)
+	})
+}
+
+func Test_SessionController_Delete(t *testing.T) {
+	route := "/api/v1/users/me/sessions/:sessionID"
+
+	t.Run("should return status 204 and keep the auth cookies when revoking another session", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+		mockedAuthTokenManager.EXPECT().GetSessionID(gomock.Any()).Return("current-session-id", nil)
+
+		mockedSessionService := mock_application.NewMockSessionService(mockCtrl)
+		mockedSessionService.EXPECT().Revoke(gomock.Any(), "some-user-id", "other-session-id").Return(nil)
+
+		sessionController := rest.NewSessionController(mockedSessionService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, "/api/v1/users/me/sessions/other-session-id", nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Delete(route, sessionController.Delete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+		assert.Empty(t, response.Header.Get("Set-Cookie"))
+	})
+
+	t.Run("should clear the auth cookies when revoking the current session", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+		mockedAuthTokenManager.EXPECT().GetSessionID(gomock.Any()).Return("current-session-id", nil)
+
+		mockedSessionService := mock_application.NewMockSessionService(mockCtrl)
+		mockedSessionService.EXPECT().Revoke(gomock.Any(), "some-user-id", "current-session-id").Return(nil)
+
+		sessionController := rest.NewSessionController(mockedSessionService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, "/api/v1/users/me/sessions/current-session-id", nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Delete(route, sessionController.Delete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+		assert.Contains(t, response.Header.Get("Set-Cookie"), "access_token=;")
+	})
+
+	t.Run("should return not_found when the session does not belong to the user", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+		mockedAuthTokenManager.EXPECT().GetSessionID(gomock.Any()).Return("current-session-id", nil)
+
+		mockedSessionService := mock_application.NewMockSessionService(mockCtrl)
+		mockedSessionService.EXPECT().Revoke(gomock.Any(), "some-user-id", "other-session-id").Return(domain.NewResourceNotFoundError("session not found"))
+
+		sessionController := rest.NewSessionController(mockedSessionService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodDelete, "/api/v1/users/me/sessions/other-session-id", nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Delete(route, sessionController.Delete)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, response.StatusCode)
+	})
+}
@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// SessionDTO represents a device where the user is logged in
+// swagger:model SessionDTO
+type SessionDTO struct {
+	// Session identifier
+	// required: true
+	// example: 0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d
+	ID string `json:"id"`
+
+	// User agent of the device, as last reported by it
+	// example: Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0
+	UserAgent string `json:"user_agent"`
+
+	// IP address the session was last used from
+	// example: 203.0.113.10
+	IPAddress string `json:"ip_address"`
+
+	// Whether this is the session making the request
+	// required: true
+	// example: true
+	Current bool `json:"current"`
+
+	// When the session was last used
+	// required: true
+	// example: 2024-01-01T12:30:00Z
+	LastSeenAt time.Time `json:"last_seen_at"`
+
+	// Login timestamp
+	// required: true
+	// example: 2024-01-01T00:00:00Z
+	CreatedAt time.Time `json:"created_at"`
+
+	// When the session ends unless it is renewed
+	// required: true
+	// example: 2024-01-31T00:00:00Z
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func mapSessionFromDomain(session domain.Session, currentSessionID string) SessionDTO {
+	return SessionDTO{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		Current:    session.ID == currentSessionID,
+		LastSeenAt: session.LastSeenAt,
+		CreatedAt:  session.CreatedAt,
+		ExpiresAt:  session.ExpiresAt,
+	}
+}
+
+func mapSessionsFromDomain(sessions []domain.Session, currentSessionID string) []SessionDTO {
+	dtos := make([]SessionDTO, 0, len(sessions))
+	for _, session := range sessions {
+		dtos = append(dtos, mapSessionFromDomain(session, currentSessionID))
+	}
+	return dtos
+}
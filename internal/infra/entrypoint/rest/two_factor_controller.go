@@ -0,0 +1,79 @@
+package rest
+
+import (
+	jwtware "github.com/gofiber/contrib/v3/jwt"
+	"github.com/gofiber/fiber/v3"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type TwoFactorController struct {
+	twoFactorService application.TwoFactorService
+	authTokenManager domain.AuthTokenManager
+}
+
+func NewTwoFactorController(twoFactorService application.TwoFactorService, authTokenManager domain.AuthTokenManager) *TwoFactorController {
+	return &TwoFactorController{
+		twoFactorService: twoFactorService,
+		authTokenManager: authTokenManager,
+	}
+}
+
+func (c *TwoFactorController) Enroll(ctx fiber.Ctx) error {
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	enrollment, err := c.twoFactorService.Enroll(ctx.Context(), authUserID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(mapTwoFactorEnrollmentFromDomain(*enrollment))
+}
+
+func (c *TwoFactorController) Enable(ctx fiber.Ctx) error {
+	var twoFactorCodeDTO TwoFactorCodeDTO
+	if err := ctx.Bind().Body(&twoFactorCodeDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := twoFactorCodeDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	recoveryCodes, err := c.twoFactorService.Enable(ctx.Context(), authUserID, twoFactorCodeDTO.Code)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(RecoveryCodesDTO{RecoveryCodes: recoveryCodes})
+}
+
+func (c *TwoFactorController) Disable(ctx fiber.Ctx) error {
+	var twoFactorCodeDTO TwoFactorCodeDTO
+	if err := ctx.Bind().Body(&twoFactorCodeDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := twoFactorCodeDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	if err := c.twoFactorService.Disable(ctx.Context(), authUserID, twoFactorCodeDTO.Code); err != nil {
+		return err
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,205 @@
+package rest_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/application/mock_application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/mock_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+	"github.com/waliqueiroz/mystery-gifter-api/test/helper"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_TwoFactorController_Enroll(t *testing.T) {
+	route := "/api/v1/users/me/two-factor"
+
+	t.Run("should return status 200 with the secret and the otpauth URI", func(t *testing.T) {
+		// given
+		enrollment := domain.TwoFactorEnrollment{Secret: "JBSWY3DPEHPK3PXP", URI: "otpauth://totp/some-uri"}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().Enroll(gomock.Any(), "some-user-id").Return(&enrollment, nil)
+
+		twoFactorController := rest.NewTwoFactorController(mockedTwoFactorService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, twoFactorController.Enroll)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.TwoFactorEnrollmentDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, rest.TwoFactorEnrollmentDTO{Secret: enrollment.Secret, OTPAuthURI: enrollment.URI}, result)
+	})
+
+	t.Run("should return conflict when two-factor authentication is already enabled", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().Enroll(gomock.Any(), "some-user-id").Return(nil, domain.NewConflictError("two-factor authentication is already enabled"))
+
+		twoFactorController := rest.NewTwoFactorController(mockedTwoFactorService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, nil)
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, twoFactorController.Enroll)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+	})
+}
+
+func Test_TwoFactorController_Enable(t *testing.T) {
+	route := "/api/v1/users/me/two-factor/enable"
+
+	t.Run("should return status 200 with the recovery codes", func(t *testing.T) {
+		// given
+		recoveryCodes := []string{"7KQ2-MX9D-4HRT-ZP3W", "M4TR-9XKD-2HQP-ZW7C"}
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().Enable(gomock.Any(), "some-user-id", "123456").Return(recoveryCodes, nil)
+
+		twoFactorController := rest.NewTwoFactorController(mockedTwoFactorService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.TwoFactorCodeDTO{Code: "123456"}))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, twoFactorController.Enable)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.RecoveryCodesDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, recoveryCodes, result.RecoveryCodes)
+	})
+
+	t.Run("should return bad_request when the code is incorrect", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().Enable(gomock.Any(), "some-user-id", "000000").Return(nil, domain.NewValidationError(validator.ValidationErrors{{Field: "Code", Error: "Code is incorrect"}}))
+
+		twoFactorController := rest.NewTwoFactorController(mockedTwoFactorService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.TwoFactorCodeDTO{Code: "000000"}))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, twoFactorController.Enable)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return unprocessable_entity when payload is malformed", func(t *testing.T) {
+		// given
+		twoFactorController := rest.NewTwoFactorController(nil, nil)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, "invalid_payload"))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, twoFactorController.Enable)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnprocessableEntity, response.StatusCode)
+	})
+}
+
+func Test_TwoFactorController_Disable(t *testing.T) {
+	route := "/api/v1/users/me/two-factor/disable"
+
+	t.Run("should return status 204 when two-factor authentication is disabled", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return("some-user-id", nil)
+
+		mockedTwoFactorService := mock_application.NewMockTwoFactorService(mockCtrl)
+		mockedTwoFactorService.EXPECT().Disable(gomock.Any(), "some-user-id", "7KQ2-MX9D-4HRT-ZP3W").Return(nil)
+
+		twoFactorController := rest.NewTwoFactorController(mockedTwoFactorService, mockedAuthTokenManager)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.TwoFactorCodeDTO{Code: "7KQ2-MX9D-4HRT-ZP3W"}))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, twoFactorController.Disable)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNoContent, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the code is missing", func(t *testing.T) {
+		// given
+		twoFactorController := rest.NewTwoFactorController(nil, nil)
+
+		req := httptest.NewRequest(fiber.MethodPost, route, helper.EncodeJSON(t, rest.TwoFactorCodeDTO{}))
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Post(route, twoFactorController.Disable)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+// TwoFactorChallengeDTO represents the login response of a user with two-factor authentication enabled
+// swagger:model TwoFactorChallengeDTO
+type TwoFactorChallengeDTO struct {
+	// Always true, tells this response apart from a session
+	// required: true
+	// example: true
+	TwoFactorRequired bool `json:"two_factor_required"`
+
+	// Token to send along with the code to /api/v1/login/two-factor. It can only be used once.
+	// required: true
+	// example: Zt4c9QeX2mL7kB0vR5nH8wJ3sD6fA1gY4uP0iO9lK2e
+	ChallengeToken string `json:"challenge_token"`
+
+	// Challenge expiration time, as a Unix timestamp
+	// required: true
+	// example: 1767225600
+	ExpiresIn int64 `json:"expires_in"`
+}
+
+func mapTwoFactorChallengeFromDomain(loginResult domain.LoginResult) TwoFactorChallengeDTO {
+	return TwoFactorChallengeDTO{
+		TwoFactorRequired: true,
+		ChallengeToken:    loginResult.ChallengeToken,
+		ExpiresIn:         loginResult.ChallengeExpiresIn,
+	}
+}
+
+// VerifyTwoFactorDTO represents the request body to finish a login with a two-factor code
+// swagger:model VerifyTwoFactorDTO
+type VerifyTwoFactorDTO struct {
+	// Token received from /api/v1/login
+	// required: true
+	// example: Zt4c9QeX2mL7kB0vR5nH8wJ3sD6fA1gY4uP0iO9lK2e
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+
+	// Six digit code from the authenticator app, or one of the recovery codes
+	// required: true
+	// example: 123456
+	Code string `json:"code" validate:"required"`
+}
+
+func (v *VerifyTwoFactorDTO) Validate() error {
+	if errs := validator.Validate(v); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+// TwoFactorCodeDTO represents the request body to enable or disable two-factor authentication
+// swagger:model TwoFactorCodeDTO
+type TwoFactorCodeDTO struct {
+	// Six digit code from the authenticator app; recovery codes are also accepted to disable it
+	// required: true
+	// example: 123456
+	Code string `json:"code" validate:"required"`
+}
+
+func (t *TwoFactorCodeDTO) Validate() error {
+	if errs := validator.Validate(t); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
+// TwoFactorEnrollmentDTO represents what is needed to add the account to an authenticator app
+// swagger:model TwoFactorEnrollmentDTO
+type TwoFactorEnrollmentDTO struct {
+	// Base32 secret, for apps where the account is typed in
+	// required: true
+	// example: JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	Secret string `json:"secret"`
+
+	// otpauth URI, usually shown as a QR code
+	// required: true
+	// example: otpauth://totp/Mystery%20Gifter:user@example.com?algorithm=SHA1&digits=6&issuer=Mystery+Gifter&period=30&secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+func mapTwoFactorEnrollmentFromDomain(enrollment domain.TwoFactorEnrollment) TwoFactorEnrollmentDTO {
+	return TwoFactorEnrollmentDTO{
+		Secret:     enrollment.Secret,
+		OTPAuthURI: enrollment.URI,
+	}
+}
+
+// RecoveryCodesDTO represents the recovery codes handed out when two-factor authentication is enabled
+// swagger:model RecoveryCodesDTO
+type RecoveryCodesDTO struct {
+	// Single-use codes to log in without the authenticator app. They are only shown this once.
+	// required: true
+	// example: ["7KQ2-MX9D-4HRT-ZP3W"]
+	RecoveryCodes []string `json:"recovery_codes"`
+}
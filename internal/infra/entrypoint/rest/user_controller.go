@@ -42,6 +42,34 @@ func (c *UserController) GetMe(ctx fiber.Ctx) error {
 	return ctx.JSON(userDTO)
 }
 
+func (c *UserController) UpdateMe(ctx fiber.Ctx) error {
+	var updateUserDTO UpdateUserDTO
+	if err := ctx.Bind().Body(&updateUserDTO); err != nil {
+		return fiber.NewError(fiber.StatusUnprocessableEntity)
+	}
+
+	if err := updateUserDTO.Validate(); err != nil {
+		return err
+	}
+
+	authUserID, err := c.authTokenManager.GetAuthUserID(jwtware.FromContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	user, err := c.userService.UpdateProfile(ctx.Context(), authUserID, updateUserDTO.Name, updateUserDTO.Surname, updateUserDTO.Email)
+	if err != nil {
+		return err
+	}
+
+	userDTO, err := mapUserFromDomain(*user)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(userDTO)
+}
+
 func (c *UserController) Create(ctx fiber.Ctx) error {
 	var createUserDTO CreateUserDTO
 
@@ -66,4 +94,3 @@ func (c *UserController) Create(ctx fiber.Ctx) error {
 
 	return ctx.Status(fiber.StatusCreated).JSON(userDTO)
 }
-
@@ -479,3 +479,149 @@ func Test_UserController_GetMe(t *testing.T) {
 		assert.Equal(t, "not_found", result.Code)
 	})
 }
+
+func Test_UserController_UpdateMe(t *testing.T) {
+	route := "/api/v1/users/me"
+
+	t.Run("should return status 200 and the updated user", func(t *testing.T) {
+		// given
+		updateUserDTO := build_rest.NewUpdateUserDTOBuilder().WithName("John").Build()
+		user := build_domain.NewUserBuilder().WithName("John").Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(user.ID, nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().UpdateProfile(gomock.Any(), user.ID, updateUserDTO.Name, nil, nil).Return(&user, nil)
+
+		userController := rest.NewUserController(mockedUserService, nil, nil, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, updateUserDTO)
+		req := httptest.NewRequest(fiber.MethodPatch, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Patch(route, userController.UpdateMe)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.UserDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, "John", result.Name)
+	})
+
+	t.Run("should return the changed email as not verified", func(t *testing.T) {
+		// given
+		email := "new@example.com"
+		updateUserDTO := build_rest.NewUpdateUserDTOBuilder().WithEmail(email).Build()
+		user := build_domain.NewUserBuilder().WithEmail(email).Build()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(user.ID, nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().UpdateProfile(gomock.Any(), user.ID, nil, nil, updateUserDTO.Email).Return(&user, nil)
+
+		userController := rest.NewUserController(mockedUserService, nil, nil, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, updateUserDTO)
+		req := httptest.NewRequest(fiber.MethodPatch, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Patch(route, userController.UpdateMe)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, response.StatusCode)
+
+		var result rest.UserDTO
+		helper.DecodeJSON(t, response.Body, &result)
+		assert.Equal(t, email, result.Email)
+		assert.False(t, result.EmailVerified)
+	})
+
+	t.Run("should return bad_request when the email is invalid", func(t *testing.T) {
+		// given
+		updateUserDTO := build_rest.NewUpdateUserDTOBuilder().WithEmail("not-an-email").Build()
+
+		userController := rest.NewUserController(nil, nil, nil, nil)
+
+		payload := helper.EncodeJSON(t, updateUserDTO)
+		req := httptest.NewRequest(fiber.MethodPatch, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Patch(route, userController.UpdateMe)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return bad_request when the name is empty", func(t *testing.T) {
+		// given
+		updateUserDTO := build_rest.NewUpdateUserDTOBuilder().WithName("").Build()
+
+		userController := rest.NewUserController(nil, nil, nil, nil)
+
+		payload := helper.EncodeJSON(t, updateUserDTO)
+		req := httptest.NewRequest(fiber.MethodPatch, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Patch(route, userController.UpdateMe)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("should return conflict when the email is already registered", func(t *testing.T) {
+		// given
+		updateUserDTO := build_rest.NewUpdateUserDTOBuilder().WithEmail("taken@example.com").Build()
+		userID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+
+		mockedAuthTokenManager := mock_domain.NewMockAuthTokenManager(mockCtrl)
+		mockedAuthTokenManager.EXPECT().GetAuthUserID(gomock.Any()).Return(userID, nil)
+
+		mockedUserService := mock_application.NewMockUserService(mockCtrl)
+		mockedUserService.EXPECT().UpdateProfile(gomock.Any(), userID, nil, nil, updateUserDTO.Email).Return(nil, domain.NewConflictError("the email is already registered"))
+
+		userController := rest.NewUserController(mockedUserService, nil, nil, mockedAuthTokenManager)
+
+		payload := helper.EncodeJSON(t, updateUserDTO)
+		req := httptest.NewRequest(fiber.MethodPatch, route, payload)
+		req.Header.Set("Content-Type", "application/json")
+
+		app := fiber.New(fiber.Config{ErrorHandler: entrypoint.CustomErrorHandler})
+		app.Patch(route, userController.UpdateMe)
+
+		// when
+		response, err := app.Test(req)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusConflict, response.StatusCode)
+	})
+}
@@ -57,6 +57,29 @@ func mapCreateUserDTOToDomain(identity domain.IdentityGenerator, passwordManager
 	return user, nil
 }
 
+// UpdateUserDTO represents the profile fields a user can change; omitted fields are left as they are
+// swagger:model UpdateUserDTO
+type UpdateUserDTO struct {
+	// User's first name
+	// example: João
+	Name *string `json:"name" validate:"omitnil,min=1"`
+
+	// User's last name
+	// example: Silva
+	Surname *string `json:"surname" validate:"omitnil,min=1"`
+
+	// User's email address
+	// example: joao.silva@example.com
+	Email *string `json:"email" validate:"omitnil,email"`
+}
+
+func (u *UpdateUserDTO) Validate() error {
+	if errs := validator.Validate(u); len(errs) > 0 {
+		return domain.NewValidationError(errs)
+	}
+	return nil
+}
+
 // UserDTO represents a user in the system
 // swagger:model UserDTO
 type UserDTO struct {
@@ -80,6 +103,13 @@ type UserDTO struct {
 	// example: joao.silva@example.com
 	Email string `json:"email" validate:"required,email"`
 
+	// Whether the user has confirmed their email address
+	// example: true
+	EmailVerified bool `json:"email_verified"`
+
+	// Thumbnails of the user's avatar, omitted when the user has none
+	Avatar *AvatarDTO `json:"avatar,omitempty"`
+
 	// When the user was created
 	// required: true
 	// example: 2023-12-01T10:00:00Z
@@ -100,12 +130,14 @@ func (u *UserDTO) Validate() error {
 
 func mapUserFromDomain(user domain.User) (*UserDTO, error) {
 	userDTO := UserDTO{
-		ID:        user.ID,
-		Name:      user.Name,
-		Surname:   user.Surname,
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:            user.ID,
+		Name:          user.Name,
+		Surname:       user.Surname,
+		Email:         user.Email,
+		EmailVerified: user.IsEmailVerified(),
+		Avatar:        mapAvatarFromDomain(user),
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
 	}
 
 	if err := userDTO.Validate(); err != nil {
@@ -129,13 +161,15 @@ func mapUsersFromDomain(users []domain.User) ([]UserDTO, error) {
 	return userDTOs, nil
 }
 
-// AddUserDTO represents the data needed to add a user to a group
+// AddUserDTO represents the data needed to add a user to a group. Either the email or the user ID must be given.
 // swagger:model AddUserDTO
 type AddUserDTO struct {
+	// Email of the person to add to the group. When nobody is registered with it, a personal invite is sent instead.
+	// example: friend@example.com
+	Email string `json:"email" validate:"required_without=UserID,excluded_with=UserID,omitempty,email"`
 	// ID of the user to add to the group
-	// required: true
 	// example: 01234567-89ab-cdef-0123-456789abcdef
-	UserID string `json:"user_id" validate:"required,uuid"`
+	UserID string `json:"user_id" validate:"omitempty,uuid"`
 }
 
 func (a *AddUserDTO) Validate() error {
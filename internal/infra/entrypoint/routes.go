@@ -5,15 +5,19 @@ import (
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
 )
 
-func CreateRoutes(router fiber.Router, authMiddleware fiber.Handler, userController *rest.UserController, authController *rest.AuthController, groupController *rest.GroupController, groupInviteController *rest.GroupInviteController) {
+func CreateRoutes(router fiber.Router, authMiddleware fiber.Handler, optionalAuthMiddleware fiber.Handler, sessionMiddleware fiber.Handler, userController *rest.UserController, authController *rest.AuthController, passwordResetController *rest.PasswordResetController, emailVerificationController *rest.EmailVerificationController, accountController *rest.AccountController, avatarController *rest.AvatarController, sessionController *rest.SessionController, twoFactorController *rest.TwoFactorController, oidcController *rest.OIDCController, groupController *rest.GroupController, groupInviteController *rest.GroupInviteController, groupTemplateController *rest.GroupTemplateController) {
 	api := router.Group("/api/v1")
 
 	// swagger:operation POST /api/v1/login Login
 	//
 	// Authenticate user and get access token
 	//
-	// This endpoint authenticates a user with email and password and returns a JWT token.
-	// On success, it also sets an httpOnly cookie (access_token) with SameSite=Lax for web clients.
+	// This endpoint authenticates a user with email and password and returns a short-lived JWT token and a refresh token.
+	// On success, it also sets httpOnly cookies (access_token and refresh_token) with SameSite=Lax for web clients.
+	// When the user has two-factor authentication enabled, no session is created yet: the response carries a challenge
+	// token to send along with a code to /api/v1/login/two-factor.
+	// Repeated failures from the same address or for the same account are slowed down with growing delays and
+	// eventually lock the account for a while; the Retry-After header tells when to try again.
 	//
 	// ---
 	// tags:
@@ -34,30 +38,188 @@ func CreateRoutes(router fiber.Router, authMiddleware fiber.Handler, userControl
 	//     description: Authentication successful
 	//     schema:
 	//       "$ref": '#/definitions/AuthSessionDTO'
+	//   '202':
+	//     description: Password accepted, a two-factor code is required to finish the login
+	//     schema:
+	//       "$ref": '#/definitions/TwoFactorChallengeDTO'
 	//   '400':
 	//     description: Invalid credentials
 	//   '401':
 	//     description: Authentication failed
 	//   '422':
 	//     description: Invalid request body
+	//   '429':
+	//     description: Too many failed attempts, retry after the number of seconds in the Retry-After header
 	api.Post("/login", authController.Login)
 
+	// swagger:operation POST /api/v1/login/two-factor VerifyTwoFactorLogin
+	//
+	// Finish a login with a two-factor code
+	//
+	// This endpoint exchanges the challenge token returned by /api/v1/login, together with a code from the
+	// authenticator app or an unused recovery code, for a session. Challenges expire after five minutes and can only
+	// be used once. On success, the auth cookies are set like on a regular login.
+	//
+	// ---
+	// tags:
+	// - auth
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// parameters:
+	// - name: VerifyTwoFactorDTO
+	//   in: body
+	//   description: Challenge token and two-factor code
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/VerifyTwoFactorDTO'
+	// responses:
+	//   '200':
+	//     description: Authentication successful
+	//     schema:
+	//       "$ref": '#/definitions/AuthSessionDTO'
+	//   '400':
+	//     description: Invalid data or incorrect code
+	//   '401':
+	//     description: Invalid or expired challenge
+	//   '422':
+	//     description: Invalid request body
+	//   '429':
+	//     description: Too many incorrect codes, retry after the number of seconds in the Retry-After header
+	api.Post("/login/two-factor", authController.VerifyTwoFactor)
+
 	// swagger:operation POST /api/v1/logout Logout
 	//
-	// Log out and clear authentication cookie
+	// Log out and clear authentication cookies
 	//
-	// This endpoint clears the authentication cookie from the client.
-	// Authentication is not required — cookie removal happens on the client side.
+	// This endpoint revokes the session of the refresh token, taken from the body or the refresh_token cookie, and
+	// the session of the access token, taken from the Bearer header or the access_token cookie, and clears the
+	// authentication cookies. Authentication is not required: a missing or invalid access token is ignored.
 	//
 	// ---
 	// tags:
 	// - auth
 	// produces:
 	// - application/json
+	// consumes:
+	// - application/json
+	// parameters:
+	// - name: RefreshTokenDTO
+	//   in: body
+	//   description: Refresh token of the session, optional when the refresh_token cookie is sent
+	//   required: false
+	//   schema:
+	//     "$ref": '#/definitions/RefreshTokenDTO'
 	// responses:
 	//   '204':
 	//     description: Logged out successfully
-	api.Post("/logout", authController.Logout)
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/logout", optionalAuthMiddleware, authController.Logout)
+
+	// swagger:operation POST /api/v1/auth/refresh RefreshSession
+	//
+	// Renew the session with a refresh token
+	//
+	// This endpoint exchanges a refresh token, taken from the body or the refresh_token cookie, for a new access token
+	// and a new refresh token. Each refresh token can only be used once: presenting it again revokes every token
+	// issued since the login, ending the session. On success, both cookies are set again.
+	//
+	// ---
+	// tags:
+	// - auth
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// parameters:
+	// - name: RefreshTokenDTO
+	//   in: body
+	//   description: Refresh token of the session, optional when the refresh_token cookie is sent
+	//   required: false
+	//   schema:
+	//     "$ref": '#/definitions/RefreshTokenDTO'
+	// responses:
+	//   '200':
+	//     description: Session renewed
+	//     schema:
+	//       "$ref": '#/definitions/AuthSessionDTO'
+	//   '401':
+	//     description: Refresh token is invalid, expired, revoked or already used
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/auth/refresh", authController.Refresh)
+
+	// swagger:operation POST /api/v1/auth/oidc/authorize AuthorizeOIDC
+	//
+	// Start a single sign-on with the identity provider
+	//
+	// This endpoint starts an OpenID Connect authorization code flow with PKCE and returns the sign-in page of the
+	// identity provider to send the user to. The provider then redirects the user to the configured redirect URL with
+	// a code and the state, which the page there posts to /api/v1/auth/oidc/callback. The sign-in must be finished
+	// within ten minutes, from the same browser: the state is also set in an httpOnly oidc_state cookie that the
+	// callback requires.
+	//
+	// ---
+	// tags:
+	// - auth
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     description: Sign-in started
+	//     schema:
+	//       "$ref": '#/definitions/OIDCAuthorizationDTO'
+	//   '404':
+	//     description: Single sign-on is not configured
+	api.Post("/auth/oidc/authorize", oidcController.Authorize)
+
+	// swagger:operation POST /api/v1/auth/oidc/callback FinishOIDC
+	//
+	// Finish a single sign-on with the identity provider
+	//
+	// This endpoint exchanges the code sent back by the identity provider for a session. The first sign-in links the
+	// provider account to the user registered with the same email, which both the provider and the user must have
+	// verified; accounts are never created here. Users with two-factor authentication enabled still get a challenge, like on a password
+	// login. On success, the auth cookies are set like on a regular login.
+	//
+	// ---
+	// tags:
+	// - auth
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// parameters:
+	// - name: OIDCCallbackDTO
+	//   in: body
+	//   description: Code and state sent back by the identity provider
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/OIDCCallbackDTO'
+	// responses:
+	//   '200':
+	//     description: Authentication successful
+	//     schema:
+	//       "$ref": '#/definitions/AuthSessionDTO'
+	//   '202':
+	//     description: Identity accepted, a two-factor code is required to finish the login
+	//     schema:
+	//       "$ref": '#/definitions/TwoFactorChallengeDTO'
+	//   '400':
+	//     description: Invalid data
+	//   '401':
+	//     description: Invalid or expired sign-in, sign-in started in another browser, rejected code or invalid ID token
+	//   '403':
+	//     description: The identity provider or the account has not verified the email address
+	//   '404':
+	//     description: No account is registered with the email address, or single sign-on is not configured
+	//   '409':
+	//     description: The account is already linked to another identity of the provider
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/auth/oidc/callback", oidcController.Callback)
 
 	// swagger:operation POST /api/v1/users CreateUser
 	//
@@ -92,7 +254,159 @@ func CreateRoutes(router fiber.Router, authMiddleware fiber.Handler, userControl
 	//     description: Invalid request body
 	api.Post("/users", userController.Create)
 
+	// swagger:operation POST /api/v1/password-reset/request RequestPasswordReset
+	//
+	// Request a password reset link
+	//
+	// This endpoint emails a single-use link to choose a new password when the email belongs to an account.
+	// The response is the same whether or not the account exists, so it cannot be used to discover registered emails.
+	// Repeated requests for the same email or from the same address are throttled, and throttled requests get the same
+	// response without any email being sent.
+	//
+	// ---
+	// tags:
+	// - auth
+	// consumes:
+	// - application/json
+	// parameters:
+	// - name: RequestPasswordResetDTO
+	//   in: body
+	//   description: Email address of the account
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/RequestPasswordResetDTO'
+	// responses:
+	//   '202':
+	//     description: Request accepted, a link is sent if the account exists
+	//   '400':
+	//     description: Invalid email
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/password-reset/request", passwordResetController.Request)
+
+	// swagger:operation POST /api/v1/password-reset/confirm ConfirmPasswordReset
+	//
+	// Choose a new password with a reset token
+	//
+	// This endpoint sets a new password using the token from the reset link. The token can only be used once
+	// and every session of the user is ended.
+	//
+	// ---
+	// tags:
+	// - auth
+	// consumes:
+	// - application/json
+	// parameters:
+	// - name: ConfirmPasswordResetDTO
+	//   in: body
+	//   description: Reset token and the new password with its confirmation
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/ConfirmPasswordResetDTO'
+	// responses:
+	//   '204':
+	//     description: Password changed successfully
+	//   '400':
+	//     description: Invalid data, or the token is invalid or has expired
+	//   '409':
+	//     description: The token was used by a concurrent request
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/password-reset/confirm", passwordResetController.Confirm)
+
+	// swagger:operation POST /api/v1/email-verification/confirm ConfirmEmailVerification
+	//
+	// Verify an email address with a verification token
+	//
+	// This endpoint marks the email address of the user as verified using the token from the verification link.
+	// The token can only be used once and stops working if the user changes the email address.
+	//
+	// ---
+	// tags:
+	// - users
+	// consumes:
+	// - application/json
+	// parameters:
+	// - name: ConfirmEmailVerificationDTO
+	//   in: body
+	//   description: Verification token
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/ConfirmEmailVerificationDTO'
+	// responses:
+	//   '204':
+	//     description: Email address verified successfully
+	//   '400':
+	//     description: Missing token, or the token is invalid or has expired
+	//   '409':
+	//     description: The token was used by a concurrent request or the email address has changed
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/email-verification/confirm", emailVerificationController.Confirm)
+
+	// swagger:operation GET /api/v1/invites/{inviteID} GetGroupInvitePreview
+	//
+	// Preview the group behind an invite
+	//
+	// This endpoint lets anyone holding an invite see what they are about to join before logging in.
+	// Authentication is not required. The preview never includes member emails or IDs.
+	// Expired, revoked or used invites are still previewed, with their state, so the client can explain why joining is not possible.
+	//
+	// ---
+	// tags:
+	// - invites
+	// produces:
+	// - application/json
+	// parameters:
+	// - name: inviteID
+	//   in: path
+	//   description: Invite ID received from the group owner
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Invite preview
+	//     schema:
+	//       "$ref": '#/definitions/GroupInvitePreviewDTO'
+	//   '404':
+	//     description: Invite not found
+	api.Get("/invites/:inviteID", groupInviteController.GetPreview)
+
+	// swagger:operation GET /api/v1/users/{userID}/avatar/{size} GetUserAvatar
+	//
+	// Get a user avatar thumbnail
+	//
+	// This endpoint returns a square PNG thumbnail of the user's avatar. Authentication is not required, so the
+	// URLs in UserDTO can be used directly as image sources.
+	//
+	// ---
+	// tags:
+	// - users
+	// produces:
+	// - image/png
+	// parameters:
+	// - name: userID
+	//   in: path
+	//   description: Unique user identifier
+	//   required: true
+	//   type: string
+	// - name: size
+	//   in: path
+	//   description: Thumbnail size, small (64x64), medium (128x128) or large (256x256)
+	//   required: true
+	//   type: string
+	//   enum: [small, medium, large]
+	// responses:
+	//   '200':
+	//     description: Avatar thumbnail
+	//   '400':
+	//     description: Unsupported size
+	//   '404':
+	//     description: User not found or the user has no avatar
+	api.Get("/users/:userID/avatar/:size", avatarController.Get)
+
 	api.Use(authMiddleware) // from now on, all routes will require authentication
+	api.Use(sessionMiddleware)
 
 	// swagger:operation GET /api/v1/users/me GetMe
 	//
@@ -121,125 +435,1521 @@ func CreateRoutes(router fiber.Router, authMiddleware fiber.Handler, userControl
 	//     description: User not found
 	api.Get("/users/me", userController.GetMe)
 
-	// swagger:operation GET /api/v1/groups SearchGroups
+	// swagger:operation PATCH /api/v1/users/me UpdateMe
 	//
-	// Search groups with filters and pagination
+	// Update authenticated user profile
 	//
-	// Returns groups where the authenticated user is a member.
-	// The user_id filter is always set to the authenticated user automatically.
-	// If owner_id is provided, it must match the authenticated user — otherwise 403.
+	// This endpoint changes the name, surname or email of the currently authenticated user.
+	// Only the fields present in the body are changed.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - users
 	// produces:
 	// - application/json
+	// consumes:
+	// - application/json
 	// security:
 	// - Bearer: []
 	// parameters:
-	// - name: name
-	//   in: query
-	//   description: Filter by group name
-	//   required: false
-	//   type: string
-	// - name: owner_id
-	//   in: query
-	//   description: Filter by group owner ID — must equal the authenticated user ID, otherwise 403
-	//   required: false
-	//   type: string
-	// - name: status
-	//   in: query
-	//   description: Filter by group status (can be specified multiple times)
-	//   required: false
-	//   type: array
-	//   items:
-	//     type: string
-	//     enum:
-	//     - OPEN
-	//     - MATCHED
-	//     - ARCHIVED
-	//   collectionFormat: multi
-	// - name: limit
-	//   in: query
-	//   description: Number of results per page
-	//   required: false
-	//   type: integer
-	// - name: offset
-	//   in: query
-	//   description: Number of results to skip
-	//   required: false
-	//   type: integer
-	// - name: sort_direction
-	//   in: query
-	//   description: Sort direction (ASC or DESC)
-	//   required: false
-	//   type: string
-	// - name: sort_by
-	//   in: query
-	//   description: Field to sort by
-	//   required: false
-	//   type: string
+	// - name: UpdateUserDTO
+	//   in: body
+	//   description: Profile fields to change
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/UpdateUserDTO'
 	// responses:
 	//   '200':
-	//     description: Search completed successfully
+	//     description: User updated successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupSearchResultDTO'
+	//       "$ref": '#/definitions/UserDTO'
 	//   '400':
-	//     description: Invalid search parameters
+	//     description: Invalid user data
 	//   '401':
 	//     description: Authentication required
-	//   '403':
-	//     description: owner_id does not match authenticated user
+	//   '404':
+	//     description: User not found
+	//   '409':
+	//     description: Email is already registered
+	//   '422':
+	//     description: Invalid request body
+	api.Patch("/users/me", userController.UpdateMe)
+
+	// swagger:operation DELETE /api/v1/users/me DeleteMe
+	//
+	// Delete authenticated user account
+	//
+	// This endpoint deletes the account of the currently authenticated user. The personal data is anonymised,
+	// groups owned by the user are handed over to another member (or archived when there is none) and get a numbered
+	// suffix when the new owner already has a group with the same name, the user leaves groups whose matches have
+	// not been drawn yet and stays, anonymised, in matched or archived history.
+	// Every session of the user is ended.
+	//
+	// ---
+	// tags:
+	// - users
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: DeleteAccountDTO
+	//   in: body
+	//   description: Current password to confirm the deletion
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/DeleteAccountDTO'
+	// responses:
+	//   '204':
+	//     description: Account deleted successfully and the auth cookie is cleared
+	//   '400':
+	//     description: Missing or incorrect password
+	//   '401':
+	//     description: Authentication required
+	//   '422':
+	//     description: Invalid request body
+	api.Delete("/users/me", accountController.Delete)
+
+	// swagger:operation GET /api/v1/users/me/export ExportMe
+	//
+	// Export authenticated user data
+	//
+	// This endpoint returns everything stored about the currently authenticated user as a downloadable file:
+	// the profile, the groups the user is a member of, the groups the user owns, the invites of those groups,
+	// the participants the user was drawn to give a gift to and every session with its IP address and user agent.
+	//
+	// ---
+	// tags:
+	// - users
+	// produces:
+	// - application/json
+	// - application/zip
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: format
+	//   in: query
+	//   description: File format, json by default or zip for the same JSON file inside a zip archive
+	//   type: string
+	//   enum: [json, zip]
+	// responses:
+	//   '200':
+	//     description: Export generated successfully
+	//     schema:
+	//       "$ref": '#/definitions/AccountExportDTO'
+	//   '400':
+	//     description: Unsupported format
+	//   '401':
+	//     description: Authentication required
+	api.Get("/users/me/export", accountController.Export)
+
+	// swagger:operation POST /api/v1/users/me/password ChangePassword
+	//
+	// Change authenticated user password
+	//
+	// This endpoint replaces the password of the currently authenticated user.
+	// Every other session of the user is ended and a new session is returned for the caller.
+	//
+	// ---
+	// tags:
+	// - auth
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: ChangePasswordDTO
+	//   in: body
+	//   description: Current password and the new password with its confirmation
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/ChangePasswordDTO'
+	// responses:
+	//   '200':
+	//     description: Password changed successfully, a new session is returned and the auth cookie is replaced
+	//     schema:
+	//       "$ref": '#/definitions/AuthSessionDTO'
+	//   '400':
+	//     description: Invalid data or incorrect current password
+	//   '401':
+	//     description: Authentication required
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/users/me/password", authController.ChangePassword)
+
+	// swagger:operation GET /api/v1/users/me/sessions ListMySessions
+	//
+	// List active sessions
+	//
+	// This endpoint lists the devices where the authenticated user is logged in, most recently used first, with the
+	// user agent, IP address and last-seen time of each. The session making the request is flagged as current.
+	//
+	// ---
+	// tags:
+	// - users
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// responses:
+	//   '200':
+	//     description: Sessions returned successfully
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": '#/definitions/SessionDTO'
+	//   '401':
+	//     description: Authentication required
+	api.Get("/users/me/sessions", sessionController.List)
+
+	// swagger:operation DELETE /api/v1/users/me/sessions DeleteMySessions
+	//
+	// Log out everywhere
+	//
+	// This endpoint revokes every session of the authenticated user, including the current one. Access tokens of those
+	// sessions are rejected right away and their refresh tokens can no longer be used. The auth cookies are cleared.
+	//
+	// ---
+	// tags:
+	// - users
+	// security:
+	// - Bearer: []
+	// responses:
+	//   '204':
+	//     description: Every session revoked successfully
+	//   '401':
+	//     description: Authentication required
+	api.Delete("/users/me/sessions", sessionController.DeleteAll)
+
+	// swagger:operation DELETE /api/v1/users/me/sessions/{sessionID} DeleteMySession
+	//
+	// Revoke a session
+	//
+	// This endpoint revokes one session of the authenticated user, such as the one of a lost device. When it is the
+	// current session, the auth cookies are cleared as well.
+	//
+	// ---
+	// tags:
+	// - users
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: sessionID
+	//   in: path
+	//   description: Session ID
+	//   required: true
+	//   type: string
+	// responses:
+	//   '204':
+	//     description: Session revoked successfully
+	//   '401':
+	//     description: Authentication required
+	//   '404':
+	//     description: Session not found
+	api.Delete("/users/me/sessions/:sessionID", sessionController.Delete)
+
+	// swagger:operation POST /api/v1/users/me/two-factor EnrollTwoFactor
+	//
+	// Start two-factor authentication enrollment
+	//
+	// This endpoint generates a new TOTP secret for the authenticated user and returns it along with the otpauth URI
+	// that authenticator apps read from a QR code. Two-factor authentication stays off until it is enabled with a code;
+	// calling this again before that replaces the secret.
+	//
+	// ---
+	// tags:
+	// - users
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// responses:
+	//   '200':
+	//     description: Enrollment started
+	//     schema:
+	//       "$ref": '#/definitions/TwoFactorEnrollmentDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '409':
+	//     description: Two-factor authentication is already enabled
+	api.Post("/users/me/two-factor", twoFactorController.Enroll)
+
+	// swagger:operation POST /api/v1/users/me/two-factor/enable EnableTwoFactor
+	//
+	// Enable two-factor authentication
+	//
+	// This endpoint confirms the enrollment with a code from the authenticator app and turns two-factor authentication
+	// on. The response holds the recovery codes, which are only shown this once.
+	//
+	// ---
+	// tags:
+	// - users
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: TwoFactorCodeDTO
+	//   in: body
+	//   description: Code from the authenticator app
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/TwoFactorCodeDTO'
+	// responses:
+	//   '200':
+	//     description: Two-factor authentication enabled
+	//     schema:
+	//       "$ref": '#/definitions/RecoveryCodesDTO'
+	//   '400':
+	//     description: Invalid data or incorrect code
+	//   '401':
+	//     description: Authentication required
+	//   '404':
+	//     description: Enrollment not started
+	//   '409':
+	//     description: Two-factor authentication is already enabled
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/users/me/two-factor/enable", twoFactorController.Enable)
+
+	// swagger:operation POST /api/v1/users/me/two-factor/disable DisableTwoFactor
+	//
+	// Disable two-factor authentication
+	//
+	// This endpoint turns two-factor authentication off, removing the secret and the recovery codes. It asks for a
+	// code from the authenticator app or a recovery code, so a stolen session alone cannot turn it off.
+	//
+	// ---
+	// tags:
+	// - users
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: TwoFactorCodeDTO
+	//   in: body
+	//   description: Code from the authenticator app or a recovery code
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/TwoFactorCodeDTO'
+	// responses:
+	//   '204':
+	//     description: Two-factor authentication disabled
+	//   '400':
+	//     description: Invalid data or incorrect code
+	//   '401':
+	//     description: Authentication required
+	//   '404':
+	//     description: Two-factor authentication is not enabled
+	//   '422':
+	//     description: Invalid request body
+	//   '429':
+	//     description: Too many incorrect codes, retry after the number of seconds in the Retry-After header
+	api.Post("/users/me/two-factor/disable", twoFactorController.Disable)
+
+	// swagger:operation POST /api/v1/users/me/email-verification SendEmailVerification
+	//
+	// Send a new verification email
+	//
+	// This endpoint emails a new verification link to the current address of the authenticated user. Repeated requests
+	// for the same email or from the same address are throttled, and throttled requests get the same response without any
+	// email being sent.
+	//
+	// ---
+	// tags:
+	// - users
+	// security:
+	// - Bearer: []
+	// responses:
+	//   '202':
+	//     description: Verification email sent
+	//   '401':
+	//     description: Authentication required
+	//   '409':
+	//     description: Email address is already verified
+	api.Post("/users/me/email-verification", emailVerificationController.Send)
+
+	// swagger:operation PUT /api/v1/users/me/avatar UploadAvatar
+	//
+	// Upload authenticated user avatar
+	//
+	// This endpoint replaces the avatar of the currently authenticated user. The picture is sent as a multipart
+	// form file in the avatar field and must be a JPEG, PNG or GIF image within the configured size limit.
+	// It is cropped to a square and stored as small, medium and large thumbnails.
+	//
+	// ---
+	// tags:
+	// - users
+	// produces:
+	// - application/json
+	// consumes:
+	// - multipart/form-data
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: avatar
+	//   in: formData
+	//   description: Picture to use as avatar
+	//   required: true
+	//   type: file
+	// responses:
+	//   '200':
+	//     description: Avatar uploaded successfully
+	//     schema:
+	//       "$ref": '#/definitions/UserDTO'
+	//   '400':
+	//     description: Missing picture, unsupported type, or the picture is too large
+	//   '401':
+	//     description: Authentication required
+	api.Put("/users/me/avatar", avatarController.Upload)
+
+	// swagger:operation DELETE /api/v1/users/me/avatar DeleteAvatar
+	//
+	// Remove authenticated user avatar
+	//
+	// This endpoint removes the avatar of the currently authenticated user. Removing a missing avatar is not an error.
+	//
+	// ---
+	// tags:
+	// - users
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// responses:
+	//   '200':
+	//     description: Avatar removed successfully
+	//     schema:
+	//       "$ref": '#/definitions/UserDTO'
+	//   '401':
+	//     description: Authentication required
+	api.Delete("/users/me/avatar", avatarController.Delete)
+
+	// swagger:operation GET /api/v1/users/me/guests ListMyClaimableGuests
+	//
+	// List claimable guest spots
+	//
+	// This endpoint lists the guest spots, in groups that are not archived, whose email matches the verified email of
+	// the authenticated user, with the group each belongs to. Any of them can be claimed through the claim endpoint.
+	//
+	// ---
+	// tags:
+	// - users
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// responses:
+	//   '200':
+	//     description: Claimable guests returned successfully
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": '#/definitions/ClaimableGuestDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: The user has not verified their email
+	api.Get("/users/me/guests", groupController.ListClaimableGuests)
+
+	// swagger:operation GET /api/v1/groups SearchGroups
+	//
+	// Search groups with filters and pagination
+	//
+	// Returns groups where the authenticated user is a member.
+	// The user_id filter is always set to the authenticated user automatically.
+	// If owner_id is provided, it must match the authenticated user — otherwise 403.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: name
+	//   in: query
+	//   description: Filter by group name
+	//   required: false
+	//   type: string
+	// - name: owner_id
+	//   in: query
+	//   description: Filter by group owner ID — must equal the authenticated user ID, otherwise 403
+	//   required: false
+	//   type: string
+	// - name: status
+	//   in: query
+	//   description: Filter by group status (can be specified multiple times)
+	//   required: false
+	//   type: array
+	//   items:
+	//     type: string
+	//     enum:
+	//     - DRAFT
+	//     - OPEN
+	//     - REGISTRATION_CLOSED
+	//     - MATCHED
+	//     - COMPLETED
+	//     - ARCHIVED
+	//   collectionFormat: multi
+	// - name: limit
+	//   in: query
+	//   description: Number of results per page
+	//   required: false
+	//   type: integer
+	// - name: offset
+	//   in: query
+	//   description: Number of results to skip
+	//   required: false
+	//   type: integer
+	// - name: sort_direction
+	//   in: query
+	//   description: Sort direction (ASC or DESC)
+	//   required: false
+	//   type: string
+	// - name: sort_by
+	//   in: query
+	//   description: Field to sort by
+	//   required: false
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Search completed successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupSearchResultDTO'
+	//   '400':
+	//     description: Invalid search parameters
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: owner_id does not match authenticated user
 	//   '422':
 	//     description: Invalid query parameters
 	api.Get("/groups", groupController.Search)
 
-	// swagger:operation POST /api/v1/groups CreateGroup
+	// swagger:operation POST /api/v1/groups CreateGroup
+	//
+	// Create a new group
+	//
+	// This endpoint creates a new secret santa group.
+	// The authenticated user becomes the group owner.
+	// When template_id is given, the description, member limit, budget, rules and exchange date
+	// that were not provided are pre-filled from the template.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: CreateGroupDTO
+	//   in: body
+	//   description: Group information for creation
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/CreateGroupDTO'
+	// responses:
+	//   '201':
+	//     description: Group created successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '400':
+	//     description: Invalid group data
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Template belongs to another user
+	//   '404':
+	//     description: Template not found
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/groups", groupController.Create)
+
+	// swagger:operation GET /api/v1/groups/{groupID} GetGroupByID
+	//
+	// Get group by ID
+	//
+	// This endpoint retrieves a specific group by its ID.
+	// Requires authentication. The authenticated user must be a member of the group.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Group found successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: User is not a member of this group
+	//   '404':
+	//     description: Group not found
+	api.Get("/groups/:groupID", groupController.GetByID)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/users AddUserToGroup
+	//
+	// Add user to group
+	//
+	// This endpoint adds a user to an existing group, identified by email or by user ID.
+	// Only the group owner can add users. Self-join is not supported via this endpoint — use the invite flow instead.
+	// If the group has reached its member limit, the user is placed on the waitlist instead.
+	// When nobody is registered with the email, a personal invite is created and emailed to it instead, and the person
+	// becomes a member once they sign up (and verify their email, when the verification policy restricts joining groups).
+	// Adding by email answers 202 either way, so it does not reveal whether the email has an account.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: AddUserDTO
+	//   in: body
+	//   description: User information to add to group
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/AddUserDTO'
+	// responses:
+	//   '200':
+	//     description: User added by ID successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '202':
+	//     description: The person with the email was added to the group or invited to it
+	//   '400':
+	//     description: Invalid request data
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can add users
+	//   '404':
+	//     description: Group or user not found
+	//   '409':
+	//     description: Group is not open, or a pending invite already exists for this email
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/groups/:groupID/users", groupController.AddUser)
+
+	// swagger:operation DELETE /api/v1/groups/{groupID}/users/{userID} RemoveUserFromGroup
+	//
+	// Remove user from group
+	//
+	// This endpoint removes a user from a group or from its waitlist.
+	// Only the group owner can remove users. When a member leaves, the first waitlisted user is promoted.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: userID
+	//   in: path
+	//   description: Unique user identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: User removed successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Insufficient permissions
+	//   '404':
+	//     description: Group or user not found
+	api.Delete("/groups/:groupID/users/:userID", groupController.RemoveUser)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/matches GenerateMatches
+	//
+	// Generate matches for the group
+	//
+	// This endpoint generates random matches between users in the group.
+	// Only the group owner can generate matches, while the group is OPEN or REGISTRATION_CLOSED.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Matches generated successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Insufficient permissions
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Cannot generate matches (insufficient users)
+	api.Post("/groups/:groupID/matches", groupController.GenerateMatches)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/reopen ReopenGroup
+	//
+	// Reopen a group with MATCHED or REGISTRATION_CLOSED status
+	//
+	// This endpoint reopens a group with MATCHED or REGISTRATION_CLOSED status, clearing all draw results and returning the group to OPEN status.
+	// Only the group owner can reopen groups.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Group reopened successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Insufficient permissions
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Group cannot be reopened
+	api.Post("/groups/:groupID/reopen", groupController.Reopen)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/archive ArchiveGroup
+	//
+	// Archive a group
+	//
+	// This endpoint archives a group.
+	// Only the group owner can archive groups.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Group archived successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Insufficient permissions
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Group cannot be archived
+	api.Post("/groups/:groupID/archive", groupController.Archive)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/publish PublishGroup
+	//
+	// Publish a draft group
+	//
+	// This endpoint moves a group from DRAFT to OPEN status, allowing invites and registration.
+	// Only the group owner can perform this action.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Group published successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Insufficient permissions
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Group is not a draft
+	api.Post("/groups/:groupID/publish", groupController.Publish)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/close-registration CloseGroupRegistration
+	//
+	// Close group registration
+	//
+	// This endpoint moves a group from OPEN to REGISTRATION_CLOSED status. No one else can join,
+	// but matches have not been drawn yet. The group can be reopened or matched afterwards.
+	// Only the group owner can perform this action.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Registration closed successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Insufficient permissions
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Group is not open
+	api.Post("/groups/:groupID/close-registration", groupController.CloseRegistration)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/complete CompleteGroup
+	//
+	// Complete a matched group
+	//
+	// This endpoint moves a group from MATCHED to COMPLETED status once the gifts have been exchanged.
+	// Completed groups allow members to reveal every match.
+	// Only the group owner can perform this action.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Group completed successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Insufficient permissions
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Group is not matched
+	api.Post("/groups/:groupID/complete", groupController.Complete)
+
+	// swagger:operation PUT /api/v1/groups/{groupID}/max-members SetGroupMaxMembers
+	//
+	// Change the group member limit
+	//
+	// This endpoint changes the maximum number of participants (users and guests) in the group.
+	// Only the group owner can change it. A value of 0 removes the limit.
+	// When the limit is raised on an open group, waitlisted users are promoted in the order they joined.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: UpdateMaxMembersDTO
+	//   in: body
+	//   description: New member limit
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/UpdateMaxMembersDTO'
+	// responses:
+	//   '200':
+	//     description: Member limit changed successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '400':
+	//     description: Invalid request data
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can change the member limit
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Group is archived or limit is lower than the current number of participants
+	//   '422':
+	//     description: Invalid request body
+	api.Put("/groups/:groupID/max-members", groupController.SetMaxMembers)
+
+	// swagger:operation GET /api/v1/groups/{groupID}/matches/user GetUserMatch
+	//
+	// Get user's match in the group
+	//
+	// This endpoint returns the user that the authenticated user should give a gift to.
+	// Requires authentication and the user must be a member of the group.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: User match found successfully
+	//     schema:
+	//       "$ref": '#/definitions/ParticipantDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: User not a member of group
+	//   '404':
+	//     description: Group not found or no match available
+	api.Get("/groups/:groupID/matches/user", groupController.GetUserMatch)
+
+	// swagger:operation GET /api/v1/groups/{groupID}/matches RevealGroupMatches
+	//
+	// Reveal every match in the group
+	//
+	// This endpoint lists who gave a gift to whom. It is only available once the group is COMPLETED.
+	// Requires authentication and the user must be a member of the group.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Matches revealed successfully
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": '#/definitions/RevealedMatchDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: User not a member of group
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Group is not completed
+	api.Get("/groups/:groupID/matches", groupController.RevealMatches)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/guests AddGuestToGroup
+	//
+	// Add guest to group
+	//
+	// This endpoint adds a guest (a participant without an account) to the group.
+	// Only the group owner can add guests and the group must be in DRAFT or OPEN status.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: GuestInputDTO
+	//   in: body
+	//   description: Guest information
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/GuestInputDTO'
+	// responses:
+	//   '200':
+	//     description: Guest added successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '400':
+	//     description: Invalid request data
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can add guests
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Group is not open or email already in use by another participant
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/groups/:groupID/guests", groupController.AddGuest)
+
+	// swagger:operation PUT /api/v1/groups/{groupID}/guests/{guestID} UpdateGroupGuest
+	//
+	// Update a guest
+	//
+	// This endpoint updates the name and email of a guest.
+	// Only the group owner can update guests. Archived groups cannot be changed.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: guestID
+	//   in: path
+	//   description: Unique guest identifier
+	//   required: true
+	//   type: string
+	// - name: GuestInputDTO
+	//   in: body
+	//   description: Guest information
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/GuestInputDTO'
+	// responses:
+	//   '200':
+	//     description: Guest updated successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '400':
+	//     description: Invalid request data
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can update guests
+	//   '404':
+	//     description: Group or guest not found
+	//   '409':
+	//     description: Group is archived or email already in use by another participant
+	//   '422':
+	//     description: Invalid request body
+	api.Put("/groups/:groupID/guests/:guestID", groupController.UpdateGuest)
+
+	// swagger:operation DELETE /api/v1/groups/{groupID}/guests/{guestID} RemoveGuestFromGroup
+	//
+	// Remove guest from group
+	//
+	// This endpoint removes a guest from a group.
+	// Only the group owner can remove guests and the group must be in OPEN status.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: guestID
+	//   in: path
+	//   description: Unique guest identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Guest removed successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can remove guests
+	//   '404':
+	//     description: Group or guest not found
+	//   '409':
+	//     description: Group is not open
+	api.Delete("/groups/:groupID/guests/:guestID", groupController.RemoveGuest)
+
+	// swagger:operation GET /api/v1/groups/{groupID}/guests/{guestID}/match GetGuestMatch
+	//
+	// Get a guest's match in the group
+	//
+	// This endpoint returns the participant that the guest should give a gift to,
+	// so the owner can pass it on. Only the group owner can view guest matches.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: guestID
+	//   in: path
+	//   description: Unique guest identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Guest match found successfully
+	//     schema:
+	//       "$ref": '#/definitions/ParticipantDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can view guest matches
+	//   '404':
+	//     description: Group or guest not found
+	//   '409':
+	//     description: Group is not matched
+	api.Get("/groups/:groupID/guests/:guestID/match", groupController.GetGuestMatch)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/guests/{guestID}/claim ClaimGroupGuest
+	//
+	// Claim a guest spot
+	//
+	// This endpoint lets a registered user take over a guest spot whose email matches their own, once they have
+	// verified it. The guest is replaced by the authenticated user, keeping any existing matches.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: guestID
+	//   in: path
+	//   description: Unique guest identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Guest claimed successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Guest email does not match the user email, or the user has not verified their email
+	//   '404':
+	//     description: Group or guest not found
+	//   '409':
+	//     description: Group is archived or user is already a member
+	api.Post("/groups/:groupID/guests/:guestID/claim", groupController.ClaimGuest)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/guests/{guestID}/merge MergeGroupGuest
+	//
+	// Merge a guest into a user
+	//
+	// This endpoint lets the group owner replace a guest with a registered user, keeping any existing matches. Unlike
+	// claiming, the guest does not need an email, so it also covers guests added without one.
+	//
+	// ---
+	// tags:
+	// - groups
+	// consumes:
+	// - application/json
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: guestID
+	//   in: path
+	//   description: Unique guest identifier
+	//   required: true
+	//   type: string
+	// - name: MergeGuestDTO
+	//   in: body
+	//   description: User taking the place of the guest
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/MergeGuestDTO'
+	// responses:
+	//   '200':
+	//     description: Guest merged successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can merge guests
+	//   '404':
+	//     description: Group, guest or user not found
+	//   '409':
+	//     description: Group is archived or user is already a member
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/groups/:groupID/guests/:guestID/merge", groupController.MergeGuest)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/join-requests/{userID}/approve ApproveGroupJoinRequest
+	//
+	// Approve a pending join request
+	//
+	// This endpoint admits a user who asked to join through an invite that requires approval.
+	// Only the group owner can approve requests and the group must be in OPEN status.
+	// If the group has reached its member limit, the user is placed on the waitlist instead.
+	// The use of the invite the request was made through is only counted here, so rejected requests never
+	// consume it; when the invite has run out of uses the request stays pending.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: userID
+	//   in: path
+	//   description: ID of the user who requested to join
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Join request approved successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can approve join requests
+	//   '404':
+	//     description: Group or join request not found
+	//   '409':
+	//     description: Group is not open or the invite has reached its maximum number of uses
+	api.Post("/groups/:groupID/join-requests/:userID/approve", groupController.ApproveJoinRequest)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/join-requests/{userID}/reject RejectGroupJoinRequest
+	//
+	// Reject a pending join request
+	//
+	// This endpoint discards a pending join request without adding the user to the group.
+	// Only the group owner can reject requests.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: userID
+	//   in: path
+	//   description: ID of the user who requested to join
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Join request rejectd successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can reject join requests
+	//   '404':
+	//     description: Group or join request not found
+	api.Post("/groups/:groupID/join-requests/:userID/reject", groupController.RejectJoinRequest)
+
+	// swagger:operation GET /api/v1/groups/{groupID}/invites/active GetActiveGroupInvite
+	//
+	// Get the active invite link for a group
+	//
+	// This endpoint retrieves the current active (non-expired) invite link for the group.
+	// Requires authentication. The authenticated user must be a member of the group.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// responses:
+	//   '200':
+	//     description: Active invite found successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupInviteDTO'
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: User is not a member of this group
+	//   '404':
+	//     description: Group not found or no active invite exists
+	api.Get("/groups/:groupID/invites/active", groupInviteController.GetActive)
+
+	// swagger:operation GET /api/v1/groups/{groupID}/invites/active/qr GetActiveGroupInviteQRCode
+	//
+	// Get a QR code for the active invite link
+	//
+	// This endpoint renders the active invite as a QR code that can be shown on a screen at in-person events.
+	// The QR code encodes the frontend join URL (configured with INVITE_JOIN_BASE_URL) followed by the invite ID.
+	// The authenticated user must be a member of the group.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - image/png
+	// - image/svg+xml
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: format
+	//   in: query
+	//   description: Image format of the QR code
+	//   required: false
+	//   type: string
+	//   enum: [png, svg]
+	//   default: png
+	// responses:
+	//   '200':
+	//     description: QR code image
+	//     schema:
+	//       type: file
+	//   '400':
+	//     description: Unsupported image format
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: User is not a member of this group
+	//   '404':
+	//     description: Group not found or no active invite exists
+	api.Get("/groups/:groupID/invites/active/qr", groupInviteController.GetActiveQRCode)
+
+	// swagger:operation POST /api/v1/groups/{groupID}/invites CreateGroupInvite
+	//
+	// Create a group invite link
+	//
+	// This endpoint creates a time-limited invite link for the group.
+	// Only the group owner can create invites. The group must be in OPEN status.
+	// The body is optional; set requires_approval to hold new members as join requests
+	// until the owner approves them, and max_uses to limit how many times the link can be used.
+	//
+	// ---
+	// tags:
+	// - groups
+	// produces:
+	// - application/json
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: groupID
+	//   in: path
+	//   description: Unique group identifier
+	//   required: true
+	//   type: string
+	// - name: CreateGroupInviteDTO
+	//   in: body
+	//   description: Invite settings
+	//   required: false
+	//   schema:
+	//     "$ref": '#/definitions/CreateGroupInviteDTO'
+	// responses:
+	//   '201':
+	//     description: Invite created successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupInviteDTO'
+	//   '400':
+	//     description: Invalid invite settings
+	//   '401':
+	//     description: Authentication required
+	//   '403':
+	//     description: Only the group owner can create invites
+	//   '404':
+	//     description: Group not found
+	//   '409':
+	//     description: Group is not in OPEN status
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/groups/:groupID/invites", groupInviteController.Create)
+
+	// swagger:operation GET /api/v1/groups/{groupID}/invites ListGroupInvites
 	//
-	// Create a new group
+	// List group invites
 	//
-	// This endpoint creates a new secret santa group.
-	// The authenticated user becomes the group owner.
+	// This endpoint lists every invite of the group, newest first, with its current state
+	// (ACTIVE, EXPIRED, REVOKED, USED or EXHAUSTED) and how many times it was used.
+	// Only the group owner can list invites.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - invites
 	// produces:
 	// - application/json
-	// consumes:
-	// - application/json
 	// security:
 	// - Bearer: []
 	// parameters:
-	// - name: CreateGroupDTO
-	//   in: body
-	//   description: Group information for creation
+	// - name: groupID
+	//   in: path
+	//   description: Group ID
 	//   required: true
-	//   schema:
-	//     "$ref": '#/definitions/CreateGroupDTO'
+	//   type: string
 	// responses:
-	//   '201':
-	//     description: Group created successfully
+	//   '200':
+	//     description: Invites retrieved successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupDTO'
-	//   '400':
-	//     description: Invalid group data
+	//       type: array
+	//       items:
+	//         "$ref": '#/definitions/GroupInviteDTO'
 	//   '401':
 	//     description: Authentication required
-	//   '422':
-	//     description: Invalid request body
-	api.Post("/groups", groupController.Create)
+	//   '403':
+	//     description: Only the group owner can manage invites
+	//   '404':
+	//     description: Group not found
+	api.Get("/groups/:groupID/invites", groupInviteController.List)
 
-	// swagger:operation GET /api/v1/groups/{groupID} GetGroupByID
+	// swagger:operation POST /api/v1/groups/{groupID}/invites/rotate RotateGroupInvite
 	//
-	// Get group by ID
+	// Rotate the invite link
 	//
-	// This endpoint retrieves a specific group by its ID.
-	// Requires authentication. The authenticated user must be a member of the group.
+	// This endpoint revokes the active invite link and issues a fresh one in a single operation.
+	// The new link keeps the approval setting of the previous one.
+	// Only the group owner can rotate the link, and the group must be in OPEN status.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - invites
 	// produces:
 	// - application/json
 	// security:
@@ -247,79 +1957,74 @@ func CreateRoutes(router fiber.Router, authMiddleware fiber.Handler, userControl
 	// parameters:
 	// - name: groupID
 	//   in: path
-	//   description: Unique group identifier
+	//   description: Group ID
 	//   required: true
 	//   type: string
 	// responses:
-	//   '200':
-	//     description: Group found successfully
+	//   '201':
+	//     description: New invite created successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupDTO'
+	//       "$ref": '#/definitions/GroupInviteDTO'
 	//   '401':
 	//     description: Authentication required
 	//   '403':
-	//     description: User is not a member of this group
+	//     description: Only the group owner can create invites
 	//   '404':
 	//     description: Group not found
-	api.Get("/groups/:groupID", groupController.GetByID)
+	//   '409':
+	//     description: Group is not in OPEN status
+	api.Post("/groups/:groupID/invites/rotate", groupInviteController.Rotate)
 
-	// swagger:operation POST /api/v1/groups/{groupID}/users AddUserToGroup
+	// swagger:operation POST /api/v1/groups/{groupID}/invites/{inviteID}/revoke RevokeGroupInvite
 	//
-	// Add user to group
+	// Revoke an invite
 	//
-	// This endpoint adds a user to an existing group.
-	// Only the group owner can add users. Self-join is not supported via this endpoint — use the invite flow instead.
+	// This endpoint revokes an invite before it expires, so it can no longer be used to join the group.
+	// Only the group owner can revoke invites.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - invites
 	// produces:
 	// - application/json
-	// consumes:
-	// - application/json
 	// security:
 	// - Bearer: []
 	// parameters:
 	// - name: groupID
 	//   in: path
-	//   description: Unique group identifier
+	//   description: Group ID
 	//   required: true
 	//   type: string
-	// - name: AddUserDTO
-	//   in: body
-	//   description: User information to add to group
+	// - name: inviteID
+	//   in: path
+	//   description: Invite ID
 	//   required: true
-	//   schema:
-	//     "$ref": '#/definitions/AddUserDTO'
+	//   type: string
 	// responses:
 	//   '200':
-	//     description: User added successfully
+	//     description: Invite revoked successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupDTO'
-	//   '400':
-	//     description: Invalid request data
+	//       "$ref": '#/definitions/GroupInviteDTO'
 	//   '401':
 	//     description: Authentication required
 	//   '403':
-	//     description: Only the group owner can add users
+	//     description: Only the group owner can manage invites
 	//   '404':
-	//     description: Group or user not found
+	//     description: Group or invite not found
 	//   '409':
-	//     description: Group is not open
-	//   '422':
-	//     description: Invalid request body
-	api.Post("/groups/:groupID/users", groupController.AddUser)
+	//     description: Invite is already revoked
+	api.Post("/groups/:groupID/invites/:inviteID/revoke", groupInviteController.Revoke)
 
-	// swagger:operation DELETE /api/v1/groups/{groupID}/users/{userID} RemoveUserFromGroup
+	// swagger:operation GET /api/v1/groups/{groupID}/invites/{inviteID}/redemptions ListGroupInviteRedemptions
 	//
-	// Remove user from group
+	// List who joined through an invite
 	//
-	// This endpoint removes a user from a group.
-	// Only the group owner can remove users.
+	// This endpoint lists the users who joined, or asked to join, the group through the given invite,
+	// in the order they used it. Only the group owner can list redemptions.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - invites
 	// produces:
 	// - application/json
 	// security:
@@ -327,72 +2032,87 @@ func CreateRoutes(router fiber.Router, authMiddleware fiber.Handler, userControl
 	// parameters:
 	// - name: groupID
 	//   in: path
-	//   description: Unique group identifier
+	//   description: Group ID
 	//   required: true
 	//   type: string
-	// - name: userID
+	// - name: inviteID
 	//   in: path
-	//   description: Unique user identifier
+	//   description: Invite ID
 	//   required: true
 	//   type: string
 	// responses:
 	//   '200':
-	//     description: User removed successfully
+	//     description: Redemptions retrieved successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupDTO'
+	//       type: array
+	//       items:
+	//         "$ref": '#/definitions/GroupInviteRedemptionDTO'
 	//   '401':
 	//     description: Authentication required
 	//   '403':
-	//     description: Insufficient permissions
+	//     description: Only the group owner can manage invites
 	//   '404':
-	//     description: Group or user not found
-	api.Delete("/groups/:groupID/users/:userID", groupController.RemoveUser)
+	//     description: Group or invite not found
+	api.Get("/groups/:groupID/invites/:inviteID/redemptions", groupInviteController.ListRedemptions)
 
-	// swagger:operation POST /api/v1/groups/{groupID}/matches GenerateMatches
+	// swagger:operation POST /api/v1/groups/{groupID}/personal-invites CreatePersonalInvite
 	//
-	// Generate matches for the group
+	// Invite a person by email
 	//
-	// This endpoint generates random matches between users in the group.
-	// Only the group owner can generate matches.
+	// This endpoint creates a single-use invite for a specific email address and emails its link and code to it.
+	// Only a user whose account email matches can join the group through it.
+	// Only the group owner can create personal invites, and the group must be in OPEN status.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - invites
 	// produces:
 	// - application/json
+	// consumes:
+	// - application/json
 	// security:
 	// - Bearer: []
 	// parameters:
 	// - name: groupID
 	//   in: path
-	//   description: Unique group identifier
+	//   description: Group ID
 	//   required: true
 	//   type: string
+	// - name: CreatePersonalInviteDTO
+	//   in: body
+	//   description: Email of the person being invited
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/CreatePersonalInviteDTO'
 	// responses:
-	//   '200':
-	//     description: Matches generated successfully
+	//   '201':
+	//     description: Personal invite created successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupDTO'
+	//       "$ref": '#/definitions/GroupInviteDTO'
+	//   '400':
+	//     description: Invalid email
 	//   '401':
 	//     description: Authentication required
 	//   '403':
-	//     description: Insufficient permissions
+	//     description: Only the group owner can create invites
 	//   '404':
 	//     description: Group not found
 	//   '409':
-	//     description: Cannot generate matches (insufficient users)
-	api.Post("/groups/:groupID/matches", groupController.GenerateMatches)
+	//     description: Group is not open, the email already belongs to a participant or has a pending invite
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/groups/:groupID/personal-invites", groupInviteController.CreatePersonal)
 
-	// swagger:operation POST /api/v1/groups/{groupID}/reopen ReopenGroup
+	// swagger:operation GET /api/v1/groups/{groupID}/personal-invites ListPersonalInvites
 	//
-	// Reopen a group with MATCHED status
+	// List pending personal invites
 	//
-	// This endpoint reopens a group with MATCHED status, clearing all draw results and returning the group to OPEN status.
-	// Only the group owner can reopen groups.
+	// This endpoint lists the personal invites of the group that have not been used yet.
+	// Only the group owner can list them.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - invites
 	// produces:
 	// - application/json
 	// security:
@@ -400,34 +2120,34 @@ func CreateRoutes(router fiber.Router, authMiddleware fiber.Handler, userControl
 	// parameters:
 	// - name: groupID
 	//   in: path
-	//   description: Unique group identifier
+	//   description: Group ID
 	//   required: true
 	//   type: string
 	// responses:
 	//   '200':
-	//     description: Group reopened successfully
+	//     description: Pending personal invites retrieved successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupDTO'
+	//       type: array
+	//       items:
+	//         "$ref": '#/definitions/GroupInviteDTO'
 	//   '401':
 	//     description: Authentication required
 	//   '403':
-	//     description: Insufficient permissions
+	//     description: Only the group owner can manage invites
 	//   '404':
 	//     description: Group not found
-	//   '409':
-	//     description: Group cannot be reopened
-	api.Post("/groups/:groupID/reopen", groupController.Reopen)
+	api.Get("/groups/:groupID/personal-invites", groupInviteController.ListPersonal)
 
-	// swagger:operation POST /api/v1/groups/{groupID}/archive ArchiveGroup
+	// swagger:operation POST /api/v1/groups/{groupID}/personal-invites/{inviteID}/resend ResendPersonalInvite
 	//
-	// Archive a group
+	// Resend a personal invite
 	//
-	// This endpoint archives a group.
-	// Only the group owner can archive groups.
+	// This endpoint renews the expiration of a pending personal invite and emails it to the address again.
+	// Only the group owner can resend invites.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - invites
 	// produces:
 	// - application/json
 	// security:
@@ -435,156 +2155,232 @@ func CreateRoutes(router fiber.Router, authMiddleware fiber.Handler, userControl
 	// parameters:
 	// - name: groupID
 	//   in: path
-	//   description: Unique group identifier
+	//   description: Group ID
+	//   required: true
+	//   type: string
+	// - name: inviteID
+	//   in: path
+	//   description: Invite ID
 	//   required: true
 	//   type: string
 	// responses:
 	//   '200':
-	//     description: Group archived successfully
+	//     description: Invite resent successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupDTO'
+	//       "$ref": '#/definitions/GroupInviteDTO'
 	//   '401':
 	//     description: Authentication required
 	//   '403':
-	//     description: Insufficient permissions
+	//     description: Only the group owner can manage invites
 	//   '404':
-	//     description: Group not found
+	//     description: Group or invite not found
 	//   '409':
-	//     description: Group cannot be archived
-	api.Post("/groups/:groupID/archive", groupController.Archive)
+	//     description: Invite is not a pending personal invite
+	api.Post("/groups/:groupID/personal-invites/:inviteID/resend", groupInviteController.ResendPersonal)
 
-	// swagger:operation GET /api/v1/groups/{groupID}/matches/user GetUserMatch
+	// swagger:operation DELETE /api/v1/groups/{groupID}/personal-invites/{inviteID} CancelPersonalInvite
 	//
-	// Get user's match in the group
+	// Cancel a personal invite
 	//
-	// This endpoint returns the user that the authenticated user should give a gift to.
-	// Requires authentication and the user must be a member of the group.
+	// This endpoint cancels a pending personal invite, so it can no longer be used.
+	// Only the group owner can cancel invites.
 	//
 	// ---
 	// tags:
-	// - groups
-	// produces:
-	// - application/json
+	// - invites
 	// security:
 	// - Bearer: []
 	// parameters:
 	// - name: groupID
 	//   in: path
-	//   description: Unique group identifier
+	//   description: Group ID
+	//   required: true
+	//   type: string
+	// - name: inviteID
+	//   in: path
+	//   description: Invite ID
 	//   required: true
 	//   type: string
 	// responses:
-	//   '200':
-	//     description: User match found successfully
-	//     schema:
-	//       "$ref": '#/definitions/UserDTO'
+	//   '204':
+	//     description: Invite cancelled successfully
 	//   '401':
 	//     description: Authentication required
 	//   '403':
-	//     description: User not a member of group
+	//     description: Only the group owner can manage invites
 	//   '404':
-	//     description: Group not found or no match available
-	api.Get("/groups/:groupID/matches/user", groupController.GetUserMatch)
+	//     description: Group or invite not found
+	//   '409':
+	//     description: Invite is not a pending personal invite
+	api.Delete("/groups/:groupID/personal-invites/:inviteID", groupInviteController.CancelPersonal)
 
-	// swagger:operation GET /api/v1/groups/{groupID}/invites/active GetActiveGroupInvite
+	// swagger:operation POST /api/v1/invites/{inviteID}/join JoinGroupViaInvite
 	//
-	// Get the active invite link for a group
+	// Join a group via invite link
 	//
-	// This endpoint retrieves the current active (non-expired) invite link for the group.
-	// Requires authentication. The authenticated user must be a member of the group.
+	// This endpoint allows an authenticated user to join a group using a valid invite ID.
+	// The invite must not be expired. The group must be in OPEN status.
+	// If the user is already a member, the request succeeds with the current group data.
+	// If the group has reached its member limit, the user is placed on the waitlist instead.
+	// If the invite requires approval, a join request is created and the user is only added, and a use of
+	// the invite only counted, once the group owner approves it.
+	// Personal invites can only be used once, by the user whose verified email the invite was sent to.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - invites
 	// produces:
 	// - application/json
 	// security:
 	// - Bearer: []
 	// parameters:
-	// - name: groupID
+	// - name: inviteID
 	//   in: path
-	//   description: Unique group identifier
+	//   description: Invite ID received from the group owner
 	//   required: true
 	//   type: string
 	// responses:
 	//   '200':
-	//     description: Active invite found successfully
+	//     description: Joined group successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupInviteDTO'
+	//       "$ref": '#/definitions/GroupDTO'
 	//   '401':
 	//     description: Authentication required
 	//   '403':
-	//     description: User is not a member of this group
+	//     description: Personal invite was sent to a different email address, or the user has not verified their email
 	//   '404':
-	//     description: Group not found or no active invite exists
-	api.Get("/groups/:groupID/invites/active", groupInviteController.GetActive)
+	//     description: Invite not found
+	//   '409':
+	//     description: Invite has expired, was revoked, already used or has no uses left, group is not in OPEN status, or the group changed while joining
+	//   '429':
+	//     description: Too many attempts with unknown invites, retry after the number of seconds in the Retry-After header
+	api.Post("/invites/:inviteID/join", groupInviteController.Join)
 
-	// swagger:operation POST /api/v1/groups/{groupID}/invites CreateGroupInvite
+	// swagger:operation POST /api/v1/invites/code/{code}/join JoinGroupViaInviteCode
 	//
-	// Create a group invite link
+	// Join a group via invite code
 	//
-	// This endpoint creates a time-limited invite link for the group.
-	// Only the group owner can create invites. The group must be in OPEN status.
+	// This endpoint works like joining via invite link, but takes the short code shown with the invite
+	// instead of its ID, which is easier to read aloud or type on a phone.
+	// Codes are case-insensitive and spaces or dashes are ignored. Attempts with unknown codes are slowed down per
+	// address, account and code, so codes cannot be guessed.
 	//
 	// ---
 	// tags:
-	// - groups
+	// - invites
 	// produces:
 	// - application/json
 	// security:
 	// - Bearer: []
 	// parameters:
-	// - name: groupID
+	// - name: code
 	//   in: path
-	//   description: Unique group identifier
+	//   description: 8-character invite code received from the group owner
 	//   required: true
 	//   type: string
 	// responses:
-	//   '201':
-	//     description: Invite created successfully
+	//   '200':
+	//     description: Joined group successfully
 	//     schema:
-	//       "$ref": '#/definitions/GroupInviteDTO'
+	//       "$ref": '#/definitions/GroupDTO'
+	//   '400':
+	//     description: Code is not 8 characters long or uses characters that codes never contain
 	//   '401':
 	//     description: Authentication required
 	//   '403':
-	//     description: Only the group owner can create invites
+	//     description: Personal invite was sent to a different email address, or the user has not verified their email
 	//   '404':
-	//     description: Group not found
+	//     description: No active invite found for this code
 	//   '409':
-	//     description: Group is not in OPEN status
-	api.Post("/groups/:groupID/invites", groupInviteController.Create)
+	//     description: Invite was already used or has no uses left, group is not in OPEN status, or the group changed while joining
+	//   '429':
+	//     description: Too many attempts with unknown codes, retry after the number of seconds in the Retry-After header
+	api.Post("/invites/code/:code/join", groupInviteController.JoinByCode)
 
-	// swagger:operation POST /api/v1/invites/{inviteID}/join JoinGroupViaInvite
+	// swagger:operation GET /api/v1/group-templates ListGroupTemplates
 	//
-	// Join a group via invite link
+	// List group templates
 	//
-	// This endpoint allows an authenticated user to join a group using a valid invite ID.
-	// The invite must not be expired. The group must be in OPEN status.
-	// If the user is already a member, the request succeeds with the current group data.
+	// This endpoint lists the built-in templates followed by the templates created by the authenticated user.
 	//
 	// ---
 	// tags:
-	// - invites
+	// - group-templates
 	// produces:
 	// - application/json
 	// security:
 	// - Bearer: []
+	// responses:
+	//   '200':
+	//     description: Templates retrieved successfully
+	//     schema:
+	//       type: array
+	//       items:
+	//         "$ref": '#/definitions/GroupTemplateDTO'
+	//   '401':
+	//     description: Authentication required
+	api.Get("/group-templates", groupTemplateController.List)
+
+	// swagger:operation POST /api/v1/group-templates CreateGroupTemplate
+	//
+	// Create a group template
+	//
+	// This endpoint saves a user-defined template that only the authenticated user can see and use.
+	//
+	// ---
+	// tags:
+	// - group-templates
+	// produces:
+	// - application/json
+	// consumes:
+	// - application/json
+	// security:
+	// - Bearer: []
 	// parameters:
-	// - name: inviteID
+	// - name: CreateGroupTemplateDTO
+	//   in: body
+	//   description: Template settings
+	//   required: true
+	//   schema:
+	//     "$ref": '#/definitions/CreateGroupTemplateDTO'
+	// responses:
+	//   '201':
+	//     description: Template created successfully
+	//     schema:
+	//       "$ref": '#/definitions/GroupTemplateDTO'
+	//   '400':
+	//     description: Invalid template data
+	//   '401':
+	//     description: Authentication required
+	//   '422':
+	//     description: Invalid request body
+	api.Post("/group-templates", groupTemplateController.Create)
+
+	// swagger:operation DELETE /api/v1/group-templates/{templateID} DeleteGroupTemplate
+	//
+	// Delete a group template
+	//
+	// This endpoint deletes a user-defined template. Built-in templates cannot be deleted.
+	//
+	// ---
+	// tags:
+	// - group-templates
+	// security:
+	// - Bearer: []
+	// parameters:
+	// - name: templateID
 	//   in: path
-	//   description: Invite ID received from the group owner
+	//   description: Template ID
 	//   required: true
 	//   type: string
 	// responses:
-	//   '200':
-	//     description: Joined group successfully
-	//     schema:
-	//       "$ref": '#/definitions/GroupDTO'
+	//   '204':
+	//     description: Template deleted successfully
 	//   '401':
 	//     description: Authentication required
+	//   '403':
+	//     description: Template is built-in or belongs to another user
 	//   '404':
-	//     description: Invite not found
-	//   '409':
-	//     description: Invite has expired or group is not in OPEN status
-	api.Post("/invites/:inviteID/join", groupInviteController.Join)
+	//     description: Template not found
+	api.Delete("/group-templates/:templateID", groupTemplateController.Delete)
 }
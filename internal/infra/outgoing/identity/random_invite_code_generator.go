@@ -0,0 +1,34 @@
+package identity
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type RandomInviteCodeGenerator struct {
+	random io.Reader
+}
+
+// NewRandomInviteCodeGenerator creates a generator that reads its randomness from random, usually crypto/rand.Reader.
+func NewRandomInviteCodeGenerator(random io.Reader) domain.InviteCodeGenerator {
+	return &RandomInviteCodeGenerator{
+		random: random,
+	}
+}
+
+func (g *RandomInviteCodeGenerator) Generate() (string, error) {
+	randomBytes := make([]byte, domain.InviteCodeLength)
+	if _, err := io.ReadFull(g.random, randomBytes); err != nil {
+		return "", fmt.Errorf("error generating invite code: %w", err)
+	}
+
+	// the alphabet has 32 characters, which divides 256 evenly, so every character is equally likely
+	code := make([]byte, domain.InviteCodeLength)
+	for i, randomByte := range randomBytes {
+		code[i] = domain.InviteCodeAlphabet[int(randomByte)%len(domain.InviteCodeAlphabet)]
+	}
+
+	return string(code), nil
+}
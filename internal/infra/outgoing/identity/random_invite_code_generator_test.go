@@ -0,0 +1,58 @@
+package identity_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/identity"
+)
+
+func Test_RandomInviteCodeGenerator_Generate(t *testing.T) {
+	t.Run("should map the random bytes to the invite code alphabet", func(t *testing.T) {
+		// given
+		random := bytes.NewReader([]byte{0, 1, 31, 32, 33, 255, 8, 40})
+		generator := identity.NewRandomInviteCodeGenerator(random)
+
+		// when
+		code, err := generator.Generate()
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "23Z23ZAA", code)
+	})
+
+	t.Run("should only use unambiguous characters", func(t *testing.T) {
+		// given
+		allBytes := make([]byte, 256)
+		for i := range allBytes {
+			allBytes[i] = byte(i)
+		}
+		generator := identity.NewRandomInviteCodeGenerator(bytes.NewReader(allBytes))
+
+		for range len(allBytes) / domain.InviteCodeLength {
+			// when
+			code, err := generator.Generate()
+
+			// then
+			assert.NoError(t, err)
+			assert.Len(t, code, domain.InviteCodeLength)
+			assert.False(t, strings.ContainsAny(code, "0O1I"))
+		}
+	})
+
+	t.Run("should return an error when reading randomness fails", func(t *testing.T) {
+		// given
+		generator := identity.NewRandomInviteCodeGenerator(iotest.ErrReader(assert.AnError))
+
+		// when
+		code, err := generator.Generate()
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, "", code)
+	})
+}
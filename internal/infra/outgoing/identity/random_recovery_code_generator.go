@@ -0,0 +1,45 @@
+package identity
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+const (
+	// recoveryCodeLength gives codes 80 bits of entropy, as they are kept as a fast hash like secret tokens.
+	recoveryCodeLength    = 16
+	recoveryCodeGroupSize = 4
+)
+
+type RandomRecoveryCodeGenerator struct {
+	random io.Reader
+}
+
+// NewRandomRecoveryCodeGenerator creates a generator that reads its randomness from random, usually crypto/rand.Reader.
+func NewRandomRecoveryCodeGenerator(random io.Reader) domain.RecoveryCodeGenerator {
+	return &RandomRecoveryCodeGenerator{
+		random: random,
+	}
+}
+
+// Generate draws from the invite code alphabet, which leaves out characters that are easily mistaken when the
+// code is written down, and groups the characters with dashes.
+func (g *RandomRecoveryCodeGenerator) Generate() (string, error) {
+	randomBytes := make([]byte, recoveryCodeLength)
+	if _, err := io.ReadFull(g.random, randomBytes); err != nil {
+		return "", fmt.Errorf("error generating recovery code: %w", err)
+	}
+
+	var code strings.Builder
+	for i, randomByte := range randomBytes {
+		if i > 0 && i%recoveryCodeGroupSize == 0 {
+			code.WriteByte('-')
+		}
+		code.WriteByte(domain.InviteCodeAlphabet[int(randomByte)%len(domain.InviteCodeAlphabet)])
+	}
+
+	return code.String(), nil
+}
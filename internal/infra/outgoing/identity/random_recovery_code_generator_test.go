@@ -0,0 +1,37 @@
+package identity_test
+
+import (
+	"bytes"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/identity"
+)
+
+func Test_RandomRecoveryCodeGenerator_Generate(t *testing.T) {
+	t.Run("should map the random bytes to groups of unambiguous characters", func(t *testing.T) {
+		// given
+		random := bytes.NewReader([]byte{0, 1, 31, 32, 33, 255, 8, 40, 0, 0, 0, 0, 1, 1, 1, 1})
+		generator := identity.NewRandomRecoveryCodeGenerator(random)
+
+		// when
+		code, err := generator.Generate()
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "23Z2-3ZAA-2222-3333", code)
+	})
+
+	t.Run("should return an error when reading randomness fails", func(t *testing.T) {
+		// given
+		generator := identity.NewRandomRecoveryCodeGenerator(iotest.ErrReader(assert.AnError))
+
+		// when
+		code, err := generator.Generate()
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, "", code)
+	})
+}
@@ -0,0 +1,33 @@
+package identity
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// secretTokenBytes gives tokens 256 bits of entropy, far beyond what can be guessed online.
+const secretTokenBytes = 32
+
+type RandomSecretTokenGenerator struct {
+	random io.Reader
+}
+
+// NewRandomSecretTokenGenerator creates a generator that reads its randomness from random, usually crypto/rand.Reader.
+func NewRandomSecretTokenGenerator(random io.Reader) domain.SecretTokenGenerator {
+	return &RandomSecretTokenGenerator{
+		random: random,
+	}
+}
+
+// Generate returns the random bytes encoded as URL safe base64, so tokens can be placed in links as they are.
+func (g *RandomSecretTokenGenerator) Generate() (string, error) {
+	randomBytes := make([]byte, secretTokenBytes)
+	if _, err := io.ReadFull(g.random, randomBytes); err != nil {
+		return "", fmt.Errorf("error generating secret token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
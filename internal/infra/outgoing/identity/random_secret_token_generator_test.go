@@ -0,0 +1,38 @@
+package identity_test
+
+import (
+	"bytes"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/identity"
+)
+
+func Test_RandomSecretTokenGenerator_Generate(t *testing.T) {
+	t.Run("should encode 32 random bytes as URL safe base64", func(t *testing.T) {
+		// given
+		random := bytes.NewReader(bytes.Repeat([]byte{0xff}, 32))
+		generator := identity.NewRandomSecretTokenGenerator(random)
+
+		// when
+		token, err := generator.Generate()
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, token, 43)
+		assert.Equal(t, "__________________________________________8", token)
+	})
+
+	t.Run("should return an error when reading randomness fails", func(t *testing.T) {
+		// given
+		generator := identity.NewRandomSecretTokenGenerator(iotest.ErrReader(assert.AnError))
+
+		// when
+		token, err := generator.Generate()
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, "", token)
+	})
+}
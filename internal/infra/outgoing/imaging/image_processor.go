@@ -0,0 +1,110 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+type ImageProcessor struct {
+	maxPixels int
+}
+
+// NewImageProcessor creates a processor that refuses pictures with more than maxPixels pixels, since a small
+// compressed file can still decode into a huge image.
+func NewImageProcessor(maxPixels int) domain.ImageProcessor {
+	return &ImageProcessor{
+		maxPixels: maxPixels,
+	}
+}
+
+func (p *ImageProcessor) Thumbnails(content []byte, sizes []int) ([][]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, invalidImageError("Avatar must be a valid image")
+	}
+
+	if config.Width*config.Height > p.maxPixels {
+		return nil, invalidImageError(fmt.Sprintf("Avatar must have at most %d pixels", p.maxPixels))
+	}
+
+	picture, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, invalidImageError("Avatar must be a valid image")
+	}
+
+	region := centerSquare(picture.Bounds())
+
+	thumbnails := make([][]byte, 0, len(sizes))
+	for _, size := range sizes {
+		var thumbnail bytes.Buffer
+		if err := png.Encode(&thumbnail, scale(picture, region, size)); err != nil {
+			return nil, fmt.Errorf("error encoding thumbnail: %w", err)
+		}
+
+		thumbnails = append(thumbnails, thumbnail.Bytes())
+	}
+
+	return thumbnails, nil
+}
+
+// centerSquare is the largest square in the middle of the bounds, so thumbnails are cropped instead of stretched.
+func centerSquare(bounds image.Rectangle) image.Rectangle {
+	side := min(bounds.Dx(), bounds.Dy())
+	x := bounds.Min.X + (bounds.Dx()-side)/2
+	y := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	return image.Rect(x, y, x+side, y+side)
+}
+
+// scale resizes the square region to size x size pixels. Each target pixel is the average of the source pixels it
+// covers, which keeps downscaled photos smooth; when upscaling, each target pixel takes the nearest source pixel.
+func scale(picture image.Image, region image.Rectangle, size int) *image.NRGBA {
+	thumbnail := image.NewNRGBA(image.Rect(0, 0, size, size))
+	side := region.Dx()
+
+	for y := range size {
+		minY, maxY := sourceSpan(region.Min.Y, side, size, y)
+
+		for x := range size {
+			minX, maxX := sourceSpan(region.Min.X, side, size, x)
+
+			var r, g, b, a, count uint64
+			for sourceY := minY; sourceY < maxY; sourceY++ {
+				for sourceX := minX; sourceX < maxX; sourceX++ {
+					pixelR, pixelG, pixelB, pixelA := picture.At(sourceX, sourceY).RGBA()
+					r, g, b, a = r+uint64(pixelR), g+uint64(pixelG), b+uint64(pixelB), a+uint64(pixelA)
+					count++
+				}
+			}
+
+			thumbnail.Set(x, y, color.RGBA64{
+				R: uint16(r / count),
+				G: uint16(g / count),
+				B: uint16(b / count),
+				A: uint16(a / count),
+			})
+		}
+	}
+
+	return thumbnail
+}
+
+// sourceSpan is the range of source coordinates covered by the target coordinate, never empty.
+func sourceSpan(origin, side, size, target int) (int, int) {
+	start := origin + target*side/size
+	end := origin + (target+1)*side/size
+
+	return start, max(end, start+1)
+}
+
+func invalidImageError(message string) error {
+	return domain.NewValidationError(validator.ValidationErrors{{Field: "Avatar", Error: message}})
+}
@@ -0,0 +1,118 @@
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/imaging"
+	"github.com/waliqueiroz/mystery-gifter-api/pkg/validator"
+)
+
+func Test_ImageProcessor_Thumbnails(t *testing.T) {
+	t.Run("should crop the center square and scale it down to a PNG thumbnail", func(t *testing.T) {
+		// given
+		picture := image.NewRGBA(image.Rect(0, 0, 300, 100))
+		for y := range 100 {
+			for x := range 300 {
+				// only the middle third is red, the sides are cropped away
+				if x >= 100 && x < 200 {
+					picture.Set(x, y, color.RGBA{R: 255, A: 255})
+				} else {
+					picture.Set(x, y, color.RGBA{B: 255, A: 255})
+				}
+			}
+		}
+
+		var content bytes.Buffer
+		assert.NoError(t, jpeg.Encode(&content, picture, &jpeg.Options{Quality: 100}))
+
+		imageProcessor := imaging.NewImageProcessor(1_000_000)
+
+		// when
+		result, err := imageProcessor.Thumbnails(content.Bytes(), []int{32})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		thumbnail, err := png.Decode(bytes.NewReader(result[0]))
+		assert.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 32, 32), thumbnail.Bounds())
+		r, _, b, _ := thumbnail.At(16, 16).RGBA()
+		assert.Greater(t, r, b)
+	})
+
+	t.Run("should render a thumbnail for every size, in the order of the sizes", func(t *testing.T) {
+		// given
+		var content bytes.Buffer
+		assert.NoError(t, png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 40, 30))))
+
+		imageProcessor := imaging.NewImageProcessor(1_000_000)
+
+		// when
+		result, err := imageProcessor.Thumbnails(content.Bytes(), []int{16, 8, 24})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 3)
+		for i, size := range []int{16, 8, 24} {
+			thumbnail, err := png.Decode(bytes.NewReader(result[i]))
+			assert.NoError(t, err)
+			assert.Equal(t, image.Rect(0, 0, size, size), thumbnail.Bounds())
+		}
+	})
+
+	t.Run("should upscale pictures smaller than the thumbnail", func(t *testing.T) {
+		// given
+		var content bytes.Buffer
+		assert.NoError(t, png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 4, 4))))
+
+		imageProcessor := imaging.NewImageProcessor(1_000_000)
+
+		// when
+		result, err := imageProcessor.Thumbnails(content.Bytes(), []int{64})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		thumbnail, err := png.Decode(bytes.NewReader(result[0]))
+		assert.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 64, 64), thumbnail.Bounds())
+	})
+
+	t.Run("should return validation error when the picture has too many pixels", func(t *testing.T) {
+		// given
+		var content bytes.Buffer
+		assert.NoError(t, png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 20, 20))))
+
+		imageProcessor := imaging.NewImageProcessor(100)
+
+		// when
+		result, err := imageProcessor.Thumbnails(content.Bytes(), []int{64})
+
+		// then
+		assert.Nil(t, result)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Avatar", Error: "Avatar must have at most 100 pixels"})
+	})
+
+	t.Run("should return validation error when the content is not an image", func(t *testing.T) {
+		// given
+		imageProcessor := imaging.NewImageProcessor(1_000_000)
+
+		// when
+		result, err := imageProcessor.Thumbnails([]byte("not an image"), []int{64})
+
+		// then
+		assert.Nil(t, result)
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.Details(), validator.FieldError{Field: "Avatar", Error: "Avatar must be a valid image"})
+	})
+}
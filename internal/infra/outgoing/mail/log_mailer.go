@@ -0,0 +1,38 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// LogMailer writes emails to a writer instead of delivering them, so links sent to users can be followed locally.
+type LogMailer struct {
+	mu     sync.Mutex
+	writer io.Writer
+	from   string
+}
+
+func NewLogMailer(writer io.Writer, from string) domain.Mailer {
+	return &LogMailer{
+		writer: writer,
+		from:   from,
+	}
+}
+
+func (m *LogMailer) Send(ctx context.Context, email domain.Email) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, err := fmt.Fprintf(m.writer, "----- email sent at %s -----\nFrom: %s\nTo: %s\nSubject: %s\n\n%s\n",
+		time.Now().Format(time.RFC3339), m.from, email.To, email.Subject, email.Body)
+	if err != nil {
+		return fmt.Errorf("error writing email: %w", err)
+	}
+
+	return nil
+}
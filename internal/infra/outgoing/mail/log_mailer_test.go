@@ -0,0 +1,47 @@
+package mail_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/mail"
+)
+
+func Test_LogMailer_Send(t *testing.T) {
+	t.Run("should write the email to the writer", func(t *testing.T) {
+		// given
+		var output bytes.Buffer
+		mailer := mail.NewLogMailer(&output, "no-reply@example.com")
+		email := domain.Email{To: "john@example.com", Subject: "Hello", Body: "Some body"}
+
+		// when
+		err := mailer.Send(context.Background(), email)
+
+		// then
+		assert.NoError(t, err)
+		assert.Contains(t, output.String(), "From: no-reply@example.com\n")
+		assert.Contains(t, output.String(), "To: john@example.com\n")
+		assert.Contains(t, output.String(), "Subject: Hello\n")
+		assert.Contains(t, output.String(), "Some body")
+	})
+
+	t.Run("should return an error when writing fails", func(t *testing.T) {
+		// given
+		mailer := mail.NewLogMailer(errWriter{}, "no-reply@example.com")
+
+		// when
+		err := mailer.Send(context.Background(), domain.Email{To: "john@example.com", Subject: "Hello", Body: "Some body"})
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+type errWriter struct{}
+
+func (errWriter) Write(p []byte) (int, error) {
+	return 0, assert.AnError
+}
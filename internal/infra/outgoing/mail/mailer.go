@@ -0,0 +1,36 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+)
+
+const (
+	DriverSMTP = "smtp"
+	DriverLog  = "log"
+)
+
+// NewMailer creates the mailer selected by the configured driver. The log driver writes to the configured file,
+// or to the standard output when no file is given, and is meant for local development.
+func NewMailer(mailConfig config.MailConfig) (domain.Mailer, error) {
+	switch mailConfig.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(mailConfig.SMTPHost, mailConfig.SMTPPort, mailConfig.SMTPUsername, mailConfig.SMTPPassword, mailConfig.From), nil
+	case DriverLog:
+		if mailConfig.LogPath == "" {
+			return NewLogMailer(os.Stdout, mailConfig.From), nil
+		}
+
+		file, err := os.OpenFile(mailConfig.LogPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("error opening mail log file: %w", err)
+		}
+
+		return NewLogMailer(file, mailConfig.From), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", mailConfig.Driver)
+	}
+}
@@ -0,0 +1,49 @@
+package mail_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/mail"
+)
+
+func Test_NewMailer(t *testing.T) {
+	t.Run("should append emails to the log file when the log driver is used", func(t *testing.T) {
+		// given
+		logPath := filepath.Join(t.TempDir(), "mail.log")
+		mailer, err := mail.NewMailer(config.MailConfig{Driver: mail.DriverLog, From: "no-reply@example.com", LogPath: logPath})
+		assert.NoError(t, err)
+
+		// when
+		err = mailer.Send(context.Background(), domain.Email{To: "john@example.com", Subject: "Hello", Body: "Some body"})
+
+		// then
+		assert.NoError(t, err)
+		content, err := os.ReadFile(logPath)
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "To: john@example.com")
+	})
+
+	t.Run("should create an SMTP mailer when the smtp driver is used", func(t *testing.T) {
+		// when
+		mailer, err := mail.NewMailer(config.MailConfig{Driver: mail.DriverSMTP, SMTPHost: "localhost", SMTPPort: 587})
+
+		// then
+		assert.NoError(t, err)
+		assert.IsType(t, &mail.SMTPMailer{}, mailer)
+	})
+
+	t.Run("should return an error for unknown drivers", func(t *testing.T) {
+		// when
+		mailer, err := mail.NewMailer(config.MailConfig{Driver: "carrier-pigeon"})
+
+		// then
+		assert.Nil(t, mailer)
+		assert.EqualError(t, err, `unknown mail driver "carrier-pigeon"`)
+	})
+}
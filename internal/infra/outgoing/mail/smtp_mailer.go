@@ -0,0 +1,61 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type SMTPMailer struct {
+	address string
+	auth    smtp.Auth
+	from    string
+}
+
+// NewSMTPMailer creates a mailer that delivers through an SMTP server. Authentication is skipped when no username is given,
+// and STARTTLS is used whenever the server offers it.
+func NewSMTPMailer(host string, port int, username, password, from string) domain.Mailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPMailer{
+		address: net.JoinHostPort(host, strconv.Itoa(port)),
+		auth:    auth,
+		from:    from,
+	}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, email domain.Email) error {
+	if err := smtp.SendMail(m.address, m.auth, m.from, []string{email.To}, m.buildMessage(email)); err != nil {
+		return fmt.Errorf("error sending email: %w", err)
+	}
+
+	return nil
+}
+
+func (m *SMTPMailer) buildMessage(email domain.Email) []byte {
+	var message strings.Builder
+
+	message.WriteString("From: " + sanitizeHeader(m.from) + "\r\n")
+	message.WriteString("To: " + sanitizeHeader(email.To) + "\r\n")
+	message.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", sanitizeHeader(email.Subject)) + "\r\n")
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	message.WriteString("\r\n")
+	message.WriteString(strings.ReplaceAll(strings.ReplaceAll(email.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return []byte(message.String())
+}
+
+// sanitizeHeader drops line breaks so header values cannot inject extra headers.
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
@@ -0,0 +1,128 @@
+package mail_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/mail"
+)
+
+// startFakeSMTPServer accepts a single connection, answers every command with success and
+// returns the received message data through the channel.
+func startFakeSMTPServer(t *testing.T) (string, int, <-chan string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			command := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case command == "DATA":
+				reply("354 end data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				received <- data.String()
+				reply("250 OK")
+			case command == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	portNumber, _ := strconv.Atoi(port)
+
+	return host, portNumber, received
+}
+
+func Test_SMTPMailer_Send(t *testing.T) {
+	t.Run("should deliver the email through the SMTP server", func(t *testing.T) {
+		// given
+		host, port, received := startFakeSMTPServer(t)
+		mailer := mail.NewSMTPMailer(host, port, "", "", "no-reply@example.com")
+		email := domain.Email{To: "john@example.com", Subject: "Hello", Body: "First line\nSecond line"}
+
+		// when
+		err := mailer.Send(context.Background(), email)
+
+		// then
+		assert.NoError(t, err)
+		message := <-received
+		assert.Contains(t, message, "From: no-reply@example.com\r\n")
+		assert.Contains(t, message, "To: john@example.com\r\n")
+		assert.Contains(t, message, "Subject: Hello\r\n")
+		assert.Contains(t, message, "First line\r\nSecond line")
+	})
+
+	t.Run("should not let the subject inject headers", func(t *testing.T) {
+		// given
+		host, port, received := startFakeSMTPServer(t)
+		mailer := mail.NewSMTPMailer(host, port, "", "", "no-reply@example.com")
+		email := domain.Email{To: "john@example.com", Subject: "Hello\r\nBcc: victim@example.com", Body: "Body"}
+
+		// when
+		err := mailer.Send(context.Background(), email)
+
+		// then
+		assert.NoError(t, err)
+		assert.NotContains(t, <-received, "\r\nBcc:")
+	})
+
+	t.Run("should return an error when the server cannot be reached", func(t *testing.T) {
+		// given
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		address := listener.Addr().(*net.TCPAddr)
+		listener.Close()
+
+		mailer := mail.NewSMTPMailer("127.0.0.1", address.Port, "", "", "no-reply@example.com")
+
+		// when
+		err = mailer.Send(context.Background(), domain.Email{To: "john@example.com", Subject: "Hello", Body: "Body"})
+
+		// then
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,22 @@
+package oidc
+
+import (
+	"context"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// DisabledOIDCProvider is used when no identity provider is configured.
+type DisabledOIDCProvider struct{}
+
+func NewDisabledOIDCProvider() domain.OIDCProvider {
+	return &DisabledOIDCProvider{}
+}
+
+func (p *DisabledOIDCProvider) AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	return "", domain.NewResourceNotFoundError("single sign-on is not configured")
+}
+
+func (p *DisabledOIDCProvider) Exchange(ctx context.Context, code, codeVerifier string) (*domain.ExternalIdentity, error) {
+	return nil, domain.NewResourceNotFoundError("single sign-on is not configured")
+}
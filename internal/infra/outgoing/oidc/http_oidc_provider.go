@@ -0,0 +1,308 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+)
+
+// maxResponseSize bounds what is read from the identity provider, whose documents are a few kilobytes at most.
+const maxResponseSize = 1 << 20
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+}
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Nonce         string `json:"nonce"`
+}
+
+// HTTPOIDCProvider talks to an OpenID Connect provider over HTTP. Its endpoints are read from the discovery document
+// of the issuer on first use, and its signing keys are fetched again whenever an ID token is signed with an unknown
+// key, so keys can be rotated without a restart. Only RS256 ID tokens, the algorithm every provider supports, are
+// accepted.
+type HTTPOIDCProvider struct {
+	config     config.OIDCConfig
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+	keys      map[string]*rsa.PublicKey
+}
+
+func NewHTTPOIDCProvider(oidcConfig config.OIDCConfig, httpClient *http.Client) domain.OIDCProvider {
+	return &HTTPOIDCProvider{
+		config:     oidcConfig,
+		httpClient: httpClient,
+	}
+}
+
+func (p *HTTPOIDCProvider) AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authorizationURL, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("error parsing authorization endpoint: %w", err)
+	}
+
+	query := authorizationURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(p.config.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authorizationURL.RawQuery = query.Encode()
+
+	return authorizationURL.String(), nil
+}
+
+func (p *HTTPOIDCProvider) Exchange(ctx context.Context, code, codeVerifier string) (*domain.ExternalIdentity, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("client_id", p.config.ClientID)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error building token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		log.Println("error requesting token:", err)
+		return nil, fmt.Errorf("error requesting token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading token response: %w", err)
+	}
+
+	// the provider answers 400, or 401 for client authentication, when the code or the code verifier is wrong
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+		log.Println("error exchanging authorization code:", string(body))
+		return nil, domain.NewUnauthorizedError("the identity provider rejected the authorization code")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected token response status %d", resp.StatusCode)
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("error decoding token response: %w", err)
+	}
+
+	return p.verifyIDToken(ctx, token.IDToken)
+}
+
+func (p *HTTPOIDCProvider) verifyIDToken(ctx context.Context, idToken string) (*domain.ExternalIdentity, error) {
+	var claims idTokenClaims
+
+	_, err := jwt.ParseWithClaims(idToken, &claims, func(token *jwt.Token) (any, error) {
+		keyID, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, keyID)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(p.config.IssuerURL),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		log.Println("error verifying ID token:", err)
+		return nil, domain.NewUnauthorizedError("invalid ID token")
+	}
+
+	if claims.Subject == "" {
+		return nil, domain.NewUnauthorizedError("invalid ID token")
+	}
+
+	return &domain.ExternalIdentity{
+		Provider:      p.config.IssuerURL,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Nonce:         claims.Nonce,
+	}, nil
+}
+
+// getDiscovery fetches the discovery document once. A failed fetch is not cached, so the provider can be down when
+// the API starts.
+func (p *HTTPOIDCProvider) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var discovery discoveryDocument
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.config.IssuerURL, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, fmt.Errorf("error getting discovery document: %w", err)
+	}
+
+	// the issuer must be the one configured, as required by OpenID Connect Discovery, otherwise another provider
+	// could hand out tokens in its name
+	if discovery.Issuer != p.config.IssuerURL {
+		return nil, fmt.Errorf("discovery document issuer %q does not match %q", discovery.Issuer, p.config.IssuerURL)
+	}
+
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing endpoints")
+	}
+
+	p.discovery = &discovery
+
+	return p.discovery, nil
+}
+
+// getKey returns the signing key with the given ID. Tokens without a key ID are accepted when the provider publishes
+// a single key.
+func (p *HTTPOIDCProvider) getKey(ctx context.Context, keyID string) (*rsa.PublicKey, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key := findKey(p.keys, keyID); key != nil {
+		return key, nil
+	}
+
+	var keySet jsonWebKeySet
+	if err := p.getJSON(ctx, discovery.JWKSURI, &keySet); err != nil {
+		return nil, fmt.Errorf("error getting signing keys: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.KeyType != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		key, err := parseRSAKey(jwk)
+		if err != nil {
+			log.Println("error parsing signing key:", err)
+			continue
+		}
+
+		keys[jwk.KeyID] = key
+	}
+	p.keys = keys
+
+	if key := findKey(p.keys, keyID); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("signing key %q not found", keyID)
+}
+
+func (p *HTTPOIDCProvider) getJSON(ctx context.Context, endpoint string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		log.Println("error requesting identity provider:", err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(target)
+}
+
+func findKey(keys map[string]*rsa.PublicKey, keyID string) *rsa.PublicKey {
+	if keyID == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key
+		}
+	}
+
+	return keys[keyID]
+}
+
+func parseRSAKey(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	modulus, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding modulus: %w", err)
+	}
+
+	exponent, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding exponent: %w", err)
+	}
+
+	e := new(big.Int).SetBytes(exponent)
+	if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(e.Int64()),
+	}, nil
+}
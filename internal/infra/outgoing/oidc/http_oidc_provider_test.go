@@ -0,0 +1,281 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/oidc"
+)
+
+const (
+	clientID     = "mystery-gifter"
+	clientSecret = "some-client-secret"
+	redirectURL  = "http://localhost:3000/auth/oidc/callback"
+	codeVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+)
+
+// stubIdP is a minimal OpenID Connect provider that issues an ID token for a single authorization code, and only
+// when the code verifier matches the code challenge it was given.
+type stubIdP struct {
+	server        *httptest.Server
+	key           *rsa.PrivateKey
+	signingKey    *rsa.PrivateKey
+	codeChallenge string
+	claims        func(issuer string) jwt.MapClaims
+}
+
+func newStubIdP(t *testing.T) *stubIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate signing key: %v", err)
+	}
+
+	idp := &stubIdP{key: key, signingKey: key}
+	idp.claims = func(issuer string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            issuer,
+			"sub":            "248289761001",
+			"aud":            clientID,
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"iat":            time.Now().Unix(),
+			"nonce":          "some-nonce",
+			"email":          "user@example.com",
+			"email_verified": true,
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "key-1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		if username != clientID || password != clientSecret {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+			return
+		}
+
+		if r.FormValue("grant_type") != "authorization_code" || r.FormValue("code") != "some-code" ||
+			r.FormValue("redirect_uri") != redirectURL || domain.PKCEChallenge(r.FormValue("code_verifier")) != idp.codeChallenge {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.claims(idp.server.URL))
+		token.Header["kid"] = "key-1"
+		idToken, err := token.SignedString(idp.signingKey)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]string{"access_token": "some-access-token", "token_type": "Bearer", "id_token": idToken})
+	})
+
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+func (idp *stubIdP) provider() domain.OIDCProvider {
+	return oidc.NewHTTPOIDCProvider(config.OIDCConfig{
+		IssuerURL:    idp.server.URL,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "email"},
+	}, idp.server.Client())
+}
+
+// authorize plays the part of the user signing in, handing the code challenge to the stub.
+func (idp *stubIdP) authorize(t *testing.T, provider domain.OIDCProvider) url.Values {
+	t.Helper()
+
+	authorizationURL, err := provider.AuthorizationURL(context.Background(), "some-state", "some-nonce", domain.PKCEChallenge(codeVerifier))
+	if err != nil {
+		t.Fatalf("failed to get authorization URL: %v", err)
+	}
+
+	parsedURL, err := url.Parse(authorizationURL)
+	if err != nil {
+		t.Fatalf("failed to parse authorization URL: %v", err)
+	}
+
+	idp.codeChallenge = parsedURL.Query().Get("code_challenge")
+
+	return parsedURL.Query()
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func Test_HTTPOIDCProvider_AuthorizationURL(t *testing.T) {
+	t.Run("should send the user to the authorization endpoint with PKCE", func(t *testing.T) {
+		// given
+		idp := newStubIdP(t)
+		provider := idp.provider()
+
+		// when
+		query := idp.authorize(t, provider)
+
+		// then
+		assert.Equal(t, "code", query.Get("response_type"))
+		assert.Equal(t, clientID, query.Get("client_id"))
+		assert.Equal(t, redirectURL, query.Get("redirect_uri"))
+		assert.Equal(t, "openid email", query.Get("scope"))
+		assert.Equal(t, "some-state", query.Get("state"))
+		assert.Equal(t, "some-nonce", query.Get("nonce"))
+		assert.Equal(t, domain.PKCEChallenge(codeVerifier), query.Get("code_challenge"))
+		assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	})
+
+	t.Run("should return an error when the discovery document names another issuer", func(t *testing.T) {
+		// given
+		idp := newStubIdP(t)
+		provider := oidc.NewHTTPOIDCProvider(config.OIDCConfig{IssuerURL: idp.server.URL + "/", ClientID: clientID}, idp.server.Client())
+
+		// when
+		authorizationURL, err := provider.AuthorizationURL(context.Background(), "some-state", "some-nonce", "some-challenge")
+
+		// then
+		assert.Empty(t, authorizationURL)
+		assert.ErrorContains(t, err, "does not match")
+	})
+}
+
+func Test_HTTPOIDCProvider_Exchange(t *testing.T) {
+	t.Run("should return the identity from the verified ID token", func(t *testing.T) {
+		// given
+		idp := newStubIdP(t)
+		provider := idp.provider()
+		idp.authorize(t, provider)
+
+		// when
+		identity, err := provider.Exchange(context.Background(), "some-code", codeVerifier)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.ExternalIdentity{
+			Provider:      idp.server.URL,
+			Subject:       "248289761001",
+			Email:         "user@example.com",
+			EmailVerified: true,
+			Nonce:         "some-nonce",
+		}, identity)
+	})
+
+	t.Run("should return unauthorized error when the code verifier does not match", func(t *testing.T) {
+		// given
+		idp := newStubIdP(t)
+		provider := idp.provider()
+		idp.authorize(t, provider)
+
+		// when
+		identity, err := provider.Exchange(context.Background(), "some-code", "another-verifier-that-is-long-enough-for-pkce-rules")
+
+		// then
+		assert.Nil(t, identity)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "the identity provider rejected the authorization code")
+	})
+
+	t.Run("should return unauthorized error when the ID token was issued to another client", func(t *testing.T) {
+		// given
+		idp := newStubIdP(t)
+		claims := idp.claims
+		idp.claims = func(issuer string) jwt.MapClaims {
+			tokenClaims := claims(issuer)
+			tokenClaims["aud"] = "another-client"
+			return tokenClaims
+		}
+		provider := idp.provider()
+		idp.authorize(t, provider)
+
+		// when
+		identity, err := provider.Exchange(context.Background(), "some-code", codeVerifier)
+
+		// then
+		assert.Nil(t, identity)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid ID token")
+	})
+
+	t.Run("should return unauthorized error when the ID token has expired", func(t *testing.T) {
+		// given
+		idp := newStubIdP(t)
+		claims := idp.claims
+		idp.claims = func(issuer string) jwt.MapClaims {
+			tokenClaims := claims(issuer)
+			tokenClaims["exp"] = time.Now().Add(-time.Minute).Unix()
+			return tokenClaims
+		}
+		provider := idp.provider()
+		idp.authorize(t, provider)
+
+		// when
+		identity, err := provider.Exchange(context.Background(), "some-code", codeVerifier)
+
+		// then
+		assert.Nil(t, identity)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+
+	t.Run("should return unauthorized error when the ID token is signed by another key", func(t *testing.T) {
+		// given
+		idp := newStubIdP(t)
+		provider := idp.provider()
+		idp.authorize(t, provider)
+
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("failed to generate signing key: %v", err)
+		}
+		idp.signingKey = otherKey
+
+		// when
+		identity, err := provider.Exchange(context.Background(), "some-code", codeVerifier)
+
+		// then
+		assert.Nil(t, identity)
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+}
@@ -0,0 +1,18 @@
+package oidc
+
+import (
+	"net/http"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+)
+
+// NewOIDCProvider creates the provider described by the configuration. Single sign-on stays off while no issuer is
+// configured, and every attempt to use it fails with a not found error.
+func NewOIDCProvider(oidcConfig config.OIDCConfig, httpClient *http.Client) domain.OIDCProvider {
+	if oidcConfig.IssuerURL == "" {
+		return NewDisabledOIDCProvider()
+	}
+
+	return NewHTTPOIDCProvider(oidcConfig, httpClient)
+}
@@ -0,0 +1,36 @@
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/oidc"
+)
+
+func Test_NewOIDCProvider(t *testing.T) {
+	t.Run("should create an HTTP provider when an issuer is configured", func(t *testing.T) {
+		// when
+		provider := oidc.NewOIDCProvider(config.OIDCConfig{IssuerURL: "https://idp.example.com"}, http.DefaultClient)
+
+		// then
+		assert.IsType(t, &oidc.HTTPOIDCProvider{}, provider)
+	})
+
+	t.Run("should disable single sign-on when no issuer is configured", func(t *testing.T) {
+		// given
+		provider := oidc.NewOIDCProvider(config.OIDCConfig{}, http.DefaultClient)
+
+		// when
+		authorizationURL, err := provider.AuthorizationURL(context.Background(), "some-state", "some-nonce", "some-challenge")
+
+		// then
+		assert.Empty(t, authorizationURL)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "single sign-on is not configured")
+	})
+}
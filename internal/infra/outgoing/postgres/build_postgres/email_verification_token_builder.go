@@ -0,0 +1,37 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type EmailVerificationTokenBuilder struct {
+	emailVerificationToken postgres.EmailVerificationToken
+}
+
+func NewEmailVerificationTokenBuilder() *EmailVerificationTokenBuilder {
+	now := time.Now().UTC()
+
+	return &EmailVerificationTokenBuilder{
+		emailVerificationToken: postgres.EmailVerificationToken{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			Email:     "default@example.com",
+			TokenHash: domain.HashSecretToken("some-secret-token"),
+			ExpiresAt: now.Add(48 * time.Hour),
+			CreatedAt: now,
+		},
+	}
+}
+
+func (b *EmailVerificationTokenBuilder) WithTokenHash(tokenHash string) *EmailVerificationTokenBuilder {
+	b.emailVerificationToken.TokenHash = tokenHash
+	return b
+}
+
+func (b *EmailVerificationTokenBuilder) Build() postgres.EmailVerificationToken {
+	return b.emailVerificationToken
+}
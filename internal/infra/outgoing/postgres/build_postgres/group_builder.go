@@ -16,13 +16,14 @@ func NewGroupBuilder() *GroupBuilder {
 	now := time.Now().UTC()
 	return &GroupBuilder{
 		group: postgres.Group{
-			ID:          uuid.New().String(),
-			Name:        "Test Group",
-			Description: "Test Group Description",
-			Status:      string(domain.GroupStatusOpen),
-			OwnerID:     uuid.New().String(),
-			CreatedAt:   now,
-			UpdatedAt:   now,
+			ID:            uuid.New().String(),
+			Name:          "Test Group",
+			Description:   "Test Group Description",
+			Status:        string(domain.GroupStatusOpen),
+			MatchStrategy: string(domain.MatchStrategyCycle),
+			OwnerID:       uuid.New().String(),
+			CreatedAt:     now,
+			UpdatedAt:     now,
 		},
 	}
 }
@@ -62,6 +63,16 @@ func (b *GroupBuilder) WithUpdatedAt(updatedAt time.Time) *GroupBuilder {
 	return b
 }
 
+func (b *GroupBuilder) WithMaxMembers(maxMembers int) *GroupBuilder {
+	b.group.MaxMembers = maxMembers
+	return b
+}
+
+func (b *GroupBuilder) WithVersion(version int) *GroupBuilder {
+	b.group.Version = version
+	return b
+}
+
 func (b *GroupBuilder) Build() postgres.Group {
 	return b.group
 }
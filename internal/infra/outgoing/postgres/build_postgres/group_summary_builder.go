@@ -56,6 +56,11 @@ func (b *GroupSummaryBuilder) WithUserCount(userCount int) *GroupSummaryBuilder
 	return b
 }
 
+func (b *GroupSummaryBuilder) WithGuestCount(guestCount int) *GroupSummaryBuilder {
+	b.groupSummary.GuestCount = guestCount
+	return b
+}
+
 func (b *GroupSummaryBuilder) WithCreatedAt(createdAt time.Time) *GroupSummaryBuilder {
 	b.groupSummary.CreatedAt = createdAt
 	return b
@@ -66,6 +71,11 @@ func (b *GroupSummaryBuilder) WithUpdatedAt(updatedAt time.Time) *GroupSummaryBu
 	return b
 }
 
+func (b *GroupSummaryBuilder) WithMaxMembers(maxMembers int) *GroupSummaryBuilder {
+	b.groupSummary.MaxMembers = maxMembers
+	return b
+}
+
 func (b *GroupSummaryBuilder) Build() postgres.GroupSummary {
 	return b.groupSummary
 }
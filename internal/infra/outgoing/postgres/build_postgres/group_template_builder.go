@@ -0,0 +1,52 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type GroupTemplateBuilder struct {
+	groupTemplate postgres.GroupTemplate
+}
+
+func NewGroupTemplateBuilder() *GroupTemplateBuilder {
+	now := time.Now().UTC()
+
+	return &GroupTemplateBuilder{
+		groupTemplate: postgres.GroupTemplate{
+			ID:             uuid.New().String(),
+			OwnerID:        uuid.New().String(),
+			Name:           "Book club exchange",
+			Description:    "Everyone gives a book",
+			MaxMembers:     10,
+			Budget:         3000,
+			Rules:          "Gift a book you loved",
+			ExchangeInDays: 15,
+			MatchStrategy:  string(domain.MatchStrategyRandom),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		},
+	}
+}
+
+func (b *GroupTemplateBuilder) WithID(id string) *GroupTemplateBuilder {
+	b.groupTemplate.ID = id
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithOwnerID(ownerID string) *GroupTemplateBuilder {
+	b.groupTemplate.OwnerID = ownerID
+	return b
+}
+
+func (b *GroupTemplateBuilder) WithName(name string) *GroupTemplateBuilder {
+	b.groupTemplate.Name = name
+	return b
+}
+
+func (b *GroupTemplateBuilder) Build() postgres.GroupTemplate {
+	return b.groupTemplate
+}
@@ -0,0 +1,61 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type GuestBuilder struct {
+	guest postgres.Guest
+}
+
+func NewGuestBuilder() *GuestBuilder {
+	now := time.Now().UTC()
+
+	return &GuestBuilder{
+		guest: postgres.Guest{
+			ID:        uuid.New().String(),
+			GroupID:   uuid.New().String(),
+			Name:      "Grandma",
+			Email:     "grandma@example.com",
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+}
+
+func (b *GuestBuilder) WithID(id string) *GuestBuilder {
+	b.guest.ID = id
+	return b
+}
+
+func (b *GuestBuilder) WithGroupID(groupID string) *GuestBuilder {
+	b.guest.GroupID = groupID
+	return b
+}
+
+func (b *GuestBuilder) WithName(name string) *GuestBuilder {
+	b.guest.Name = name
+	return b
+}
+
+func (b *GuestBuilder) WithEmail(email string) *GuestBuilder {
+	b.guest.Email = email
+	return b
+}
+
+func (b *GuestBuilder) WithCreatedAt(createdAt time.Time) *GuestBuilder {
+	b.guest.CreatedAt = createdAt
+	return b
+}
+
+func (b *GuestBuilder) WithUpdatedAt(updatedAt time.Time) *GuestBuilder {
+	b.guest.UpdatedAt = updatedAt
+	return b
+}
+
+func (b *GuestBuilder) Build() postgres.Guest {
+	return b.guest
+}
@@ -0,0 +1,32 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type OIDCLoginRequestBuilder struct {
+	loginRequest postgres.OIDCLoginRequest
+}
+
+func NewOIDCLoginRequestBuilder() *OIDCLoginRequestBuilder {
+	now := time.Now().UTC()
+
+	return &OIDCLoginRequestBuilder{
+		loginRequest: postgres.OIDCLoginRequest{
+			ID:           uuid.New().String(),
+			StateHash:    domain.HashSecretToken("some-state"),
+			Nonce:        "some-nonce",
+			CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+			ExpiresAt:    now.Add(domain.OIDCLoginRequestDuration),
+			CreatedAt:    now,
+		},
+	}
+}
+
+func (b *OIDCLoginRequestBuilder) Build() postgres.OIDCLoginRequest {
+	return b.loginRequest
+}
@@ -0,0 +1,41 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type PasswordResetTokenBuilder struct {
+	passwordResetToken postgres.PasswordResetToken
+}
+
+func NewPasswordResetTokenBuilder() *PasswordResetTokenBuilder {
+	now := time.Now().UTC()
+
+	return &PasswordResetTokenBuilder{
+		passwordResetToken: postgres.PasswordResetToken{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			TokenHash: domain.HashSecretToken("some-secret-token"),
+			ExpiresAt: now.Add(time.Hour),
+			CreatedAt: now,
+		},
+	}
+}
+
+func (b *PasswordResetTokenBuilder) WithTokenHash(tokenHash string) *PasswordResetTokenBuilder {
+	b.passwordResetToken.TokenHash = tokenHash
+	return b
+}
+
+func (b *PasswordResetTokenBuilder) WithUsedAt(usedAt *time.Time) *PasswordResetTokenBuilder {
+	b.passwordResetToken.UsedAt = usedAt
+	return b
+}
+
+func (b *PasswordResetTokenBuilder) Build() postgres.PasswordResetToken {
+	return b.passwordResetToken
+}
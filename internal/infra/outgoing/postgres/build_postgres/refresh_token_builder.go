@@ -0,0 +1,42 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type RefreshTokenBuilder struct {
+	refreshToken postgres.RefreshToken
+}
+
+func NewRefreshTokenBuilder() *RefreshTokenBuilder {
+	now := time.Now().UTC()
+
+	return &RefreshTokenBuilder{
+		refreshToken: postgres.RefreshToken{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			SessionID: uuid.New().String(),
+			TokenHash: domain.HashSecretToken("some-refresh-token"),
+			ExpiresAt: now.Add(time.Hour * 24 * 30),
+			CreatedAt: now,
+		},
+	}
+}
+
+func (b *RefreshTokenBuilder) WithTokenHash(tokenHash string) *RefreshTokenBuilder {
+	b.refreshToken.TokenHash = tokenHash
+	return b
+}
+
+func (b *RefreshTokenBuilder) WithUsedAt(usedAt *time.Time) *RefreshTokenBuilder {
+	b.refreshToken.UsedAt = usedAt
+	return b
+}
+
+func (b *RefreshTokenBuilder) Build() postgres.RefreshToken {
+	return b.refreshToken
+}
@@ -0,0 +1,42 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type SessionBuilder struct {
+	session postgres.Session
+}
+
+func NewSessionBuilder() *SessionBuilder {
+	now := time.Now().UTC()
+
+	return &SessionBuilder{
+		session: postgres.Session{
+			ID:         uuid.New().String(),
+			UserID:     uuid.New().String(),
+			UserAgent:  "Mozilla/5.0 (X11; Linux x86_64) Firefox/128.0",
+			IPAddress:  "203.0.113.10",
+			LastSeenAt: now,
+			ExpiresAt:  now.Add(time.Hour * 24 * 30),
+			CreatedAt:  now,
+		},
+	}
+}
+
+func (b *SessionBuilder) WithUserID(userID string) *SessionBuilder {
+	b.session.UserID = userID
+	return b
+}
+
+func (b *SessionBuilder) WithRevokedAt(revokedAt *time.Time) *SessionBuilder {
+	b.session.RevokedAt = revokedAt
+	return b
+}
+
+func (b *SessionBuilder) Build() postgres.Session {
+	return b.session
+}
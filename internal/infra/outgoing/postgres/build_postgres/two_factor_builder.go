@@ -0,0 +1,36 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type TwoFactorBuilder struct {
+	twoFactor postgres.TwoFactor
+}
+
+func NewTwoFactorBuilder() *TwoFactorBuilder {
+	return &TwoFactorBuilder{
+		twoFactor: postgres.TwoFactor{
+			UserID:    uuid.New().String(),
+			Secret:    "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+			CreatedAt: time.Now().UTC(),
+		},
+	}
+}
+
+func (b *TwoFactorBuilder) WithEnabledAt(enabledAt *time.Time) *TwoFactorBuilder {
+	b.twoFactor.EnabledAt = enabledAt
+	return b
+}
+
+func (b *TwoFactorBuilder) WithLastUsedStep(lastUsedStep int64) *TwoFactorBuilder {
+	b.twoFactor.LastUsedStep = lastUsedStep
+	return b
+}
+
+func (b *TwoFactorBuilder) Build() postgres.TwoFactor {
+	return b.twoFactor
+}
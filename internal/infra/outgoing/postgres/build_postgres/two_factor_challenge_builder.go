@@ -0,0 +1,36 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type TwoFactorChallengeBuilder struct {
+	challenge postgres.TwoFactorChallenge
+}
+
+func NewTwoFactorChallengeBuilder() *TwoFactorChallengeBuilder {
+	now := time.Now().UTC()
+
+	return &TwoFactorChallengeBuilder{
+		challenge: postgres.TwoFactorChallenge{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			TokenHash: domain.HashSecretToken("some-challenge-token"),
+			ExpiresAt: now.Add(domain.TwoFactorChallengeDuration),
+			CreatedAt: now,
+		},
+	}
+}
+
+func (b *TwoFactorChallengeBuilder) WithUsedAt(usedAt *time.Time) *TwoFactorChallengeBuilder {
+	b.challenge.UsedAt = usedAt
+	return b
+}
+
+func (b *TwoFactorChallengeBuilder) Build() postgres.TwoFactorChallenge {
+	return b.challenge
+}
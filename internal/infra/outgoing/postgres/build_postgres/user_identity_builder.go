@@ -0,0 +1,33 @@
+package build_postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+)
+
+type UserIdentityBuilder struct {
+	userIdentity postgres.UserIdentity
+}
+
+func NewUserIdentityBuilder() *UserIdentityBuilder {
+	return &UserIdentityBuilder{
+		userIdentity: postgres.UserIdentity{
+			ID:        uuid.New().String(),
+			UserID:    uuid.New().String(),
+			Provider:  "https://idp.example.com",
+			Subject:   "248289761001",
+			CreatedAt: time.Now().UTC(),
+		},
+	}
+}
+
+func (b *UserIdentityBuilder) WithSubject(subject string) *UserIdentityBuilder {
+	b.userIdentity.Subject = subject
+	return b
+}
+
+func (b *UserIdentityBuilder) Build() postgres.UserIdentity {
+	return b.userIdentity
+}
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type EmailVerificationToken struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	Email     string     `db:"email"`
+	TokenHash string     `db:"token_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func mapEmailVerificationTokenToDomain(emailVerificationToken EmailVerificationToken) (*domain.EmailVerificationToken, error) {
+	domainEmailVerificationToken := domain.EmailVerificationToken{
+		ID:        emailVerificationToken.ID,
+		UserID:    emailVerificationToken.UserID,
+		Email:     emailVerificationToken.Email,
+		TokenHash: emailVerificationToken.TokenHash,
+		UsedAt:    emailVerificationToken.UsedAt,
+		ExpiresAt: emailVerificationToken.ExpiresAt,
+		CreatedAt: emailVerificationToken.CreatedAt,
+	}
+
+	if err := domainEmailVerificationToken.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainEmailVerificationToken, nil
+}
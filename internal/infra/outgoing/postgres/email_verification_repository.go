@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type emailVerificationRepository struct {
+	db DB
+}
+
+func NewEmailVerificationRepository(db DB) domain.EmailVerificationRepository {
+	return &emailVerificationRepository{
+		db: db,
+	}
+}
+
+func (r *emailVerificationRepository) Create(ctx context.Context, emailVerificationToken domain.EmailVerificationToken) error {
+	query, args, err := squirrel.Insert("email_verification_tokens").
+		Columns("id", "user_id", "email", "token_hash", "expires_at", "created_at").
+		Values(emailVerificationToken.ID, emailVerificationToken.UserID, emailVerificationToken.Email, emailVerificationToken.TokenHash, emailVerificationToken.ExpiresAt, emailVerificationToken.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building email verification token insert query: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting email verification token:", err)
+		return fmt.Errorf("error inserting email verification token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *emailVerificationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error) {
+	query, args, err := squirrel.Select("*").
+		From("email_verification_tokens").
+		Where(squirrel.Eq{"token_hash": tokenHash}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building email verification token select query: %w", err)
+	}
+
+	var emailVerificationToken EmailVerificationToken
+	err = r.db.GetContext(ctx, &emailVerificationToken, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("email verification token not found")
+		}
+		return nil, fmt.Errorf("error getting email verification token: %w", err)
+	}
+
+	return mapEmailVerificationTokenToDomain(emailVerificationToken)
+}
+
+func (r *emailVerificationRepository) Redeem(ctx context.Context, tokenID string, user domain.User) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// the token state is checked in the update itself so concurrent requests cannot use it twice
+	query, args, err := squirrel.Update("email_verification_tokens").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.And{
+			squirrel.Eq{"id": tokenID},
+			squirrel.Eq{"used_at": nil},
+			squirrel.Expr("expires_at > NOW()"),
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building email verification token update query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating email verification token:", err)
+		return fmt.Errorf("error updating email verification token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewConflictError("email verification token has already been used or has expired")
+	}
+
+	// the email is part of the condition so a concurrent email change is not marked as verified
+	query, args, err = squirrel.Update("users").
+		Set("email_verified_at", user.EmailVerifiedAt).
+		Set("updated_at", user.UpdatedAt).
+		Where(squirrel.Eq{"id": user.ID, "email": user.Email}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building users update query: %w", err)
+	}
+
+	result, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating user email verification:", err)
+		return fmt.Errorf("error updating user email verification: %w", err)
+	}
+
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewConflictError("email address has changed since the verification was sent")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/build_postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/mock_postgres"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_emailVerificationRepository_Create(t *testing.T) {
+	t.Run("should create email verification token successfully", func(t *testing.T) {
+		// given
+		emailVerificationToken := build_domain.NewEmailVerificationTokenBuilder().Build()
+		insertQuery := "INSERT INTO email_verification_tokens (id,user_id,email,token_hash,expires_at,created_at) VALUES ($1,$2,$3,$4,$5,$6)"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, emailVerificationToken.ID, emailVerificationToken.UserID, emailVerificationToken.Email, emailVerificationToken.TokenHash, emailVerificationToken.ExpiresAt, emailVerificationToken.CreatedAt).Return(nil, nil)
+
+		emailVerificationRepository := postgres.NewEmailVerificationRepository(mockedDB)
+
+		// when
+		err := emailVerificationRepository.Create(context.Background(), emailVerificationToken)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func Test_emailVerificationRepository_GetByTokenHash(t *testing.T) {
+	selectQuery := "SELECT * FROM email_verification_tokens WHERE token_hash = $1"
+
+	t.Run("should get email verification token by hash successfully", func(t *testing.T) {
+		// given
+		pgEmailVerificationToken := build_postgres.NewEmailVerificationTokenBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgEmailVerificationToken.TokenHash).SetArg(1, pgEmailVerificationToken).Return(nil)
+
+		emailVerificationRepository := postgres.NewEmailVerificationRepository(mockedDB)
+
+		// when
+		result, err := emailVerificationRepository.GetByTokenHash(context.Background(), pgEmailVerificationToken.TokenHash)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgEmailVerificationToken.ID, result.ID)
+		assert.Equal(t, pgEmailVerificationToken.Email, result.Email)
+	})
+
+	t.Run("should return not found error when token does not exist", func(t *testing.T) {
+		// given
+		tokenHash := domain.HashSecretToken("unknown")
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, tokenHash).Return(sql.ErrNoRows)
+
+		emailVerificationRepository := postgres.NewEmailVerificationRepository(mockedDB)
+
+		// when
+		result, err := emailVerificationRepository.GetByTokenHash(context.Background(), tokenHash)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_emailVerificationRepository_Redeem(t *testing.T) {
+	tokenQuery := "UPDATE email_verification_tokens SET used_at = NOW() WHERE (id = $1 AND used_at IS NULL AND expires_at > NOW())"
+	userQuery := "UPDATE users SET email_verified_at = $1, updated_at = $2 WHERE email = $3 AND id = $4"
+
+	t.Run("should mark the token as used and the email as verified", func(t *testing.T) {
+		// given
+		verifiedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithEmailVerifiedAt(&verifiedAt).Build()
+		tokenID := build_domain.NewEmailVerificationTokenBuilder().Build().ID
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), tokenQuery, tokenID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), userQuery, user.EmailVerifiedAt, user.UpdatedAt, user.Email, user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		emailVerificationRepository := postgres.NewEmailVerificationRepository(mockedDB)
+
+		// when
+		err := emailVerificationRepository.Redeem(context.Background(), tokenID, user)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when the token can no longer be used", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		tokenID := build_domain.NewEmailVerificationTokenBuilder().Build().ID
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), tokenQuery, tokenID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		emailVerificationRepository := postgres.NewEmailVerificationRepository(mockedDB)
+
+		// when
+		err := emailVerificationRepository.Redeem(context.Background(), tokenID, user)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "email verification token has already been used or has expired")
+	})
+
+	t.Run("should return conflict error when the email changed in the meantime", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		tokenID := build_domain.NewEmailVerificationTokenBuilder().Build().ID
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), tokenQuery, tokenID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), userQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		emailVerificationRepository := postgres.NewEmailVerificationRepository(mockedDB)
+
+		// when
+		err := emailVerificationRepository.Redeem(context.Background(), tokenID, user)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "email address has changed since the verification was sent")
+	})
+}
@@ -7,47 +7,84 @@ import (
 )
 
 type Group struct {
-	ID          string    `db:"id"`
-	Name        string    `db:"name"`
-	Description string    `db:"description"`
-	OwnerID     string    `db:"owner_id"`
-	Status      string    `db:"status"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID            string     `db:"id"`
+	Name          string     `db:"name"`
+	Description   string     `db:"description"`
+	OwnerID       string     `db:"owner_id"`
+	Status        string     `db:"status"`
+	MaxMembers    int        `db:"max_members"`
+	Budget        int        `db:"budget"`
+	Rules         string     `db:"rules"`
+	ExchangeDate  *time.Time `db:"exchange_date"`
+	MatchStrategy string     `db:"match_strategy"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+	Version       int        `db:"version"`
 }
 
 type GroupSummary struct {
-	ID          string    `db:"id"`
-	Name        string    `db:"name"`
-	Description string    `db:"description"`
-	OwnerID     string    `db:"owner_id"`
-	Status      string    `db:"status"`
-	UserCount   int       `db:"user_count"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID            string     `db:"id"`
+	Name          string     `db:"name"`
+	Description   string     `db:"description"`
+	OwnerID       string     `db:"owner_id"`
+	Status        string     `db:"status"`
+	MaxMembers    int        `db:"max_members"`
+	Budget        int        `db:"budget"`
+	Rules         string     `db:"rules"`
+	ExchangeDate  *time.Time `db:"exchange_date"`
+	MatchStrategy string     `db:"match_strategy"`
+	UserCount     int        `db:"user_count"`
+	GuestCount    int        `db:"guest_count"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+	Version       int        `db:"version"`
 }
 
-func mapGroupToDomain(group Group, groupUsers []User, matches []Match) (*domain.Group, error) {
+func mapGroupToDomain(group Group, groupUsers []User, waitlist []User, joinRequests []JoinRequest, guests []Guest, matches []Match) (*domain.Group, error) {
 	domainUsers, err := mapUsersToDomain(groupUsers)
 	if err != nil {
 		return nil, err
 	}
 
+	domainWaitlist, err := mapUsersToDomain(waitlist)
+	if err != nil {
+		return nil, err
+	}
+
+	domainJoinRequests, err := mapJoinRequestsToDomain(joinRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	domainGuests, err := mapGuestsToDomain(guests)
+	if err != nil {
+		return nil, err
+	}
+
 	domainMatches, err := mapMatchesToDomain(matches)
 	if err != nil {
 		return nil, err
 	}
 
 	domainGroup := domain.Group{
-		ID:          group.ID,
-		Name:        group.Name,
-		Description: group.Description,
-		OwnerID:     group.OwnerID,
-		Users:       domainUsers,
-		Status:      domain.GroupStatus(group.Status),
-		Matches:     domainMatches,
-		CreatedAt:   group.CreatedAt,
-		UpdatedAt:   group.UpdatedAt,
+		ID:            group.ID,
+		Name:          group.Name,
+		Description:   group.Description,
+		OwnerID:       group.OwnerID,
+		Users:         domainUsers,
+		Guests:        domainGuests,
+		Waitlist:      domainWaitlist,
+		JoinRequests:  domainJoinRequests,
+		MaxMembers:    group.MaxMembers,
+		Budget:        group.Budget,
+		Rules:         group.Rules,
+		ExchangeDate:  group.ExchangeDate,
+		MatchStrategy: domain.MatchStrategy(group.MatchStrategy),
+		Status:        domain.GroupStatus(group.Status),
+		Matches:       domainMatches,
+		CreatedAt:     group.CreatedAt,
+		UpdatedAt:     group.UpdatedAt,
+		Version:       group.Version,
 	}
 
 	if err := domainGroup.Validate(); err != nil {
@@ -79,6 +116,8 @@ func mapGroupSummaryToDomain(groupSummary GroupSummary) (*domain.GroupSummary, e
 		OwnerID:     groupSummary.OwnerID,
 		Status:      domain.GroupStatus(groupSummary.Status),
 		UserCount:   groupSummary.UserCount,
+		GuestCount:  groupSummary.GuestCount,
+		MaxMembers:  groupSummary.MaxMembers,
 		CreatedAt:   groupSummary.CreatedAt,
 		UpdatedAt:   groupSummary.UpdatedAt,
 	}
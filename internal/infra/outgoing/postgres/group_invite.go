@@ -7,18 +7,32 @@ import (
 )
 
 type GroupInvite struct {
-	ID        string    `db:"id"`
-	GroupID   string    `db:"group_id"`
-	ExpiresAt time.Time `db:"expires_at"`
-	CreatedAt time.Time `db:"created_at"`
+	ID               string     `db:"id"`
+	GroupID          string     `db:"group_id"`
+	Code             string     `db:"code"`
+	Email            string     `db:"email"`
+	RequiresApproval bool       `db:"requires_approval"`
+	MaxUses          int        `db:"max_uses"`
+	Uses             int        `db:"uses"`
+	UsedAt           *time.Time `db:"used_at"`
+	RevokedAt        *time.Time `db:"revoked_at"`
+	ExpiresAt        time.Time  `db:"expires_at"`
+	CreatedAt        time.Time  `db:"created_at"`
 }
 
 func mapGroupInviteToDomain(groupInvite GroupInvite) (*domain.GroupInvite, error) {
 	domainGroupInvite := domain.GroupInvite{
-		ID:        groupInvite.ID,
-		GroupID:   groupInvite.GroupID,
-		ExpiresAt: groupInvite.ExpiresAt,
-		CreatedAt: groupInvite.CreatedAt,
+		ID:               groupInvite.ID,
+		GroupID:          groupInvite.GroupID,
+		Code:             groupInvite.Code,
+		Email:            groupInvite.Email,
+		RequiresApproval: groupInvite.RequiresApproval,
+		MaxUses:          groupInvite.MaxUses,
+		Uses:             groupInvite.Uses,
+		UsedAt:           groupInvite.UsedAt,
+		RevokedAt:        groupInvite.RevokedAt,
+		ExpiresAt:        groupInvite.ExpiresAt,
+		CreatedAt:        groupInvite.CreatedAt,
 	}
 
 	if err := domainGroupInvite.Validate(); err != nil {
@@ -27,3 +41,41 @@ func mapGroupInviteToDomain(groupInvite GroupInvite) (*domain.GroupInvite, error
 
 	return &domainGroupInvite, nil
 }
+
+func mapGroupInvitesToDomain(groupInvites []GroupInvite) ([]domain.GroupInvite, error) {
+	domainGroupInvites := make([]domain.GroupInvite, 0, len(groupInvites))
+
+	for _, groupInvite := range groupInvites {
+		domainGroupInvite, err := mapGroupInviteToDomain(groupInvite)
+		if err != nil {
+			return nil, err
+		}
+		domainGroupInvites = append(domainGroupInvites, *domainGroupInvite)
+	}
+
+	return domainGroupInvites, nil
+}
+
+type GroupInviteRedemption struct {
+	User
+	RedeemedAt time.Time `db:"redeemed_at"`
+}
+
+func mapGroupInviteRedemptionsToDomain(inviteID string, redemptions []GroupInviteRedemption) ([]domain.GroupInviteRedemption, error) {
+	domainRedemptions := make([]domain.GroupInviteRedemption, 0, len(redemptions))
+
+	for _, redemption := range redemptions {
+		domainUser, err := mapUserToDomain(redemption.User)
+		if err != nil {
+			return nil, err
+		}
+
+		domainRedemptions = append(domainRedemptions, domain.GroupInviteRedemption{
+			InviteID:   inviteID,
+			User:       *domainUser,
+			RedeemedAt: redemption.RedeemedAt,
+		})
+	}
+
+	return domainRedemptions, nil
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
@@ -24,8 +26,8 @@ func NewGroupInviteRepository(db DB) domain.GroupInviteRepository {
 
 func (r *groupInviteRepository) Create(ctx context.Context, groupInvite domain.GroupInvite) error {
 	query, args, err := squirrel.Insert("group_invites").
-		Columns("id", "group_id", "expires_at", "created_at").
-		Values(groupInvite.ID, groupInvite.GroupID, groupInvite.ExpiresAt, groupInvite.CreatedAt).
+		Columns("id", "group_id", "code", "email", "requires_approval", "max_uses", "used_at", "expires_at", "created_at").
+		Values(groupInvite.ID, groupInvite.GroupID, groupInvite.Code, groupInvite.Email, groupInvite.RequiresApproval, groupInvite.MaxUses, groupInvite.UsedAt, groupInvite.ExpiresAt, groupInvite.CreatedAt).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -34,6 +36,10 @@ func (r *groupInviteRepository) Create(ctx context.Context, groupInvite domain.G
 
 	_, err = r.db.ExecContext(ctx, query, args...)
 	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == POSTGRES_UNIQUE_VIOLATION {
+			return domain.NewConflictError("invite code is already in use")
+		}
 		log.Println("error inserting group invite:", err)
 		return fmt.Errorf("error inserting group invite: %w", err)
 	}
@@ -46,7 +52,10 @@ func (r *groupInviteRepository) GetActiveByGroupID(ctx context.Context, groupID
 		From("group_invites").
 		Where(squirrel.And{
 			squirrel.Eq{"group_id": groupID},
+			squirrel.Eq{"email": ""},
+			squirrel.Eq{"revoked_at": nil},
 			squirrel.Expr("expires_at > NOW()"),
+			squirrel.Expr("(max_uses = 0 OR uses < max_uses)"),
 		}).
 		OrderBy("created_at DESC").
 		Limit(1).
@@ -72,6 +81,35 @@ func (r *groupInviteRepository) GetActiveByGroupID(ctx context.Context, groupID
 	return mapGroupInviteToDomain(groupInvite)
 }
 
+func (r *groupInviteRepository) GetActiveByCode(ctx context.Context, code string) (*domain.GroupInvite, error) {
+	query, args, err := squirrel.Select("*").
+		From("group_invites").
+		Where(squirrel.And{
+			squirrel.Eq{"code": code},
+			squirrel.NotEq{"code": ""},
+			squirrel.Eq{"revoked_at": nil},
+			squirrel.Expr("expires_at > NOW()"),
+		}).
+		OrderBy("created_at DESC").
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group invite code select query: %w", err)
+	}
+
+	var groupInvite GroupInvite
+	err = r.db.GetContext(ctx, &groupInvite, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("no active invite found for this code")
+		}
+		return nil, fmt.Errorf("error getting group invite by code: %w", err)
+	}
+
+	return mapGroupInviteToDomain(groupInvite)
+}
+
 func (r *groupInviteRepository) GetByID(ctx context.Context, id string) (*domain.GroupInvite, error) {
 	query, args, err := squirrel.Select("*").
 		From("group_invites").
@@ -97,3 +135,262 @@ func (r *groupInviteRepository) GetByID(ctx context.Context, id string) (*domain
 
 	return mapGroupInviteToDomain(groupInvite)
 }
+
+// ListPendingPersonalByGroupID returns the personal invites of a group that have not been used yet, including expired ones.
+func (r *groupInviteRepository) ListPendingPersonalByGroupID(ctx context.Context, groupID string) ([]domain.GroupInvite, error) {
+	query, args, err := squirrel.Select("*").
+		From("group_invites").
+		Where(squirrel.And{
+			squirrel.Eq{"group_id": groupID},
+			squirrel.NotEq{"email": ""},
+			squirrel.Eq{"used_at": nil},
+			squirrel.Eq{"revoked_at": nil},
+		}).
+		OrderBy("created_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group invites select query: %w", err)
+	}
+
+	var groupInvites []GroupInvite
+	err = r.db.SelectContext(ctx, &groupInvites, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing pending group invites: %w", err)
+	}
+
+	return mapGroupInvitesToDomain(groupInvites)
+}
+
+func (r *groupInviteRepository) ListPendingPersonalByEmail(ctx context.Context, email string) ([]domain.GroupInvite, error) {
+	query, args, err := squirrel.Select("*").
+		From("group_invites").
+		Where(squirrel.And{
+			squirrel.Eq{"email": strings.ToLower(email)},
+			squirrel.Eq{"used_at": nil},
+			squirrel.Eq{"revoked_at": nil},
+		}).
+		OrderBy("created_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group invites select query: %w", err)
+	}
+
+	var groupInvites []GroupInvite
+	err = r.db.SelectContext(ctx, &groupInvites, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing pending group invites: %w", err)
+	}
+
+	return mapGroupInvitesToDomain(groupInvites)
+}
+
+func (r *groupInviteRepository) Update(ctx context.Context, groupInvite domain.GroupInvite) error {
+	query, args, err := squirrel.Update("group_invites").
+		Set("used_at", groupInvite.UsedAt).
+		Set("revoked_at", groupInvite.RevokedAt).
+		Set("expires_at", groupInvite.ExpiresAt).
+		Where(squirrel.Eq{"id": groupInvite.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group invite update query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating group invite:", err)
+		return fmt.Errorf("error updating group invite: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewResourceNotFoundError("group invite not found")
+	}
+
+	return nil
+}
+
+func (r *groupInviteRepository) Delete(ctx context.Context, id string) error {
+	query, args, err := squirrel.Delete("group_invites").
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group invite delete query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error deleting group invite:", err)
+		return fmt.Errorf("error deleting group invite: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewResourceNotFoundError("group invite not found")
+	}
+
+	return nil
+}
+
+// ListByGroupID returns every invite of a group, newest first, regardless of its state.
+func (r *groupInviteRepository) ListByGroupID(ctx context.Context, groupID string) ([]domain.GroupInvite, error) {
+	query, args, err := squirrel.Select("*").
+		From("group_invites").
+		Where(squirrel.Eq{"group_id": groupID}).
+		OrderBy("created_at DESC").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group invites select query: %w", err)
+	}
+
+	var groupInvites []GroupInvite
+	err = r.db.SelectContext(ctx, &groupInvites, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing group invites: %w", err)
+	}
+
+	return mapGroupInvitesToDomain(groupInvites)
+}
+
+func (r *groupInviteRepository) Rotate(ctx context.Context, groupInvite domain.GroupInvite) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := squirrel.Update("group_invites").
+		Set("revoked_at", groupInvite.CreatedAt).
+		Where(squirrel.And{
+			squirrel.Eq{"group_id": groupInvite.GroupID},
+			squirrel.Eq{"email": ""},
+			squirrel.Eq{"revoked_at": nil},
+			squirrel.Expr("expires_at > NOW()"),
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group invite revoke query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error revoking group invites:", err)
+		return fmt.Errorf("error revoking group invites: %w", err)
+	}
+
+	query, args, err = squirrel.Insert("group_invites").
+		Columns("id", "group_id", "code", "email", "requires_approval", "max_uses", "used_at", "expires_at", "created_at").
+		Values(groupInvite.ID, groupInvite.GroupID, groupInvite.Code, groupInvite.Email, groupInvite.RequiresApproval, groupInvite.MaxUses, groupInvite.UsedAt, groupInvite.ExpiresAt, groupInvite.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group invite insert query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == POSTGRES_UNIQUE_VIOLATION {
+			return domain.NewConflictError("invite code is already in use")
+		}
+		log.Println("error inserting group invite:", err)
+		return fmt.Errorf("error inserting group invite: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *groupInviteRepository) Redeem(ctx context.Context, inviteID, userID string, redeemedAt time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// the usage limit is checked in the update itself so concurrent joins cannot exceed it
+	query, args, err := squirrel.Update("group_invites").
+		Set("uses", squirrel.Expr("uses + 1")).
+		Where(squirrel.And{
+			squirrel.Eq{"id": inviteID},
+			squirrel.Expr("(max_uses = 0 OR uses < max_uses)"),
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group invite uses update query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating group invite uses:", err)
+		return fmt.Errorf("error updating group invite uses: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewConflictError("invite has reached its maximum number of uses")
+	}
+
+	query, args, err = squirrel.Insert("group_invite_redemptions").
+		Columns("invite_id", "user_id", "redeemed_at").
+		Values(inviteID, userID, redeemedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group invite redemption insert query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting group invite redemption:", err)
+		return fmt.Errorf("error inserting group invite redemption: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *groupInviteRepository) ListRedemptions(ctx context.Context, inviteID string) ([]domain.GroupInviteRedemption, error) {
+	query, args, err := squirrel.Select("u.*", "gir.redeemed_at").
+		From("users u").
+		Join("group_invite_redemptions gir ON gir.user_id = u.id").
+		Where(squirrel.Eq{"gir.invite_id": inviteID}).
+		OrderBy("gir.redeemed_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group invite redemptions select query: %w", err)
+	}
+
+	var redemptions []GroupInviteRedemption
+	err = r.db.SelectContext(ctx, &redemptions, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing group invite redemptions: %w", err)
+	}
+
+	return mapGroupInviteRedemptionsToDomain(inviteID, redemptions)
+}
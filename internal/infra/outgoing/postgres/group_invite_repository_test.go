@@ -3,8 +3,11 @@ package postgres_test
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
@@ -19,11 +22,11 @@ func Test_groupInviteRepository_Create(t *testing.T) {
 	t.Run("should create group invite successfully", func(t *testing.T) {
 		// given
 		groupInvite := build_domain.NewGroupInviteBuilder().Build()
-		insertQuery := "INSERT INTO group_invites (id,group_id,expires_at,created_at) VALUES ($1,$2,$3,$4)"
+		insertQuery := "INSERT INTO group_invites (id,group_id,code,email,requires_approval,max_uses,used_at,expires_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)"
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
-		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, groupInvite.ID, groupInvite.GroupID, groupInvite.ExpiresAt, groupInvite.CreatedAt).Return(nil, nil)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, groupInvite.ID, groupInvite.GroupID, groupInvite.Code, groupInvite.Email, groupInvite.RequiresApproval, groupInvite.MaxUses, groupInvite.UsedAt, groupInvite.ExpiresAt, groupInvite.CreatedAt).Return(nil, nil)
 
 		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
 
@@ -37,11 +40,11 @@ func Test_groupInviteRepository_Create(t *testing.T) {
 	t.Run("should return error when exec fails", func(t *testing.T) {
 		// given
 		groupInvite := build_domain.NewGroupInviteBuilder().Build()
-		insertQuery := "INSERT INTO group_invites (id,group_id,expires_at,created_at) VALUES ($1,$2,$3,$4)"
+		insertQuery := "INSERT INTO group_invites (id,group_id,code,email,requires_approval,max_uses,used_at,expires_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)"
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
-		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, groupInvite.ID, groupInvite.GroupID, groupInvite.ExpiresAt, groupInvite.CreatedAt).Return(nil, assert.AnError)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, groupInvite.ID, groupInvite.GroupID, groupInvite.Code, groupInvite.Email, groupInvite.RequiresApproval, groupInvite.MaxUses, groupInvite.UsedAt, groupInvite.ExpiresAt, groupInvite.CreatedAt).Return(nil, assert.AnError)
 
 		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
 
@@ -52,17 +55,37 @@ func Test_groupInviteRepository_Create(t *testing.T) {
 		assert.Error(t, err)
 		assert.ErrorContains(t, err, "error inserting group invite")
 	})
+
+	t.Run("should return conflict error when the code is already in use", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+		insertQuery := "INSERT INTO group_invites (id,group_id,code,email,requires_approval,max_uses,used_at,expires_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, groupInvite.ID, groupInvite.GroupID, groupInvite.Code, groupInvite.Email, groupInvite.RequiresApproval, groupInvite.MaxUses, groupInvite.UsedAt, groupInvite.ExpiresAt, groupInvite.CreatedAt).Return(nil, &pq.Error{Code: pq.ErrorCode("23505")})
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Create(context.Background(), groupInvite)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "invite code is already in use")
+	})
 }
 
 func Test_groupInviteRepository_GetActiveByGroupID(t *testing.T) {
 	t.Run("should get active group invite by group id successfully", func(t *testing.T) {
 		// given
 		pgGroupInvite := build_postgres.NewGroupInviteBuilder().Build()
-		selectQuery := "SELECT * FROM group_invites WHERE (group_id = $1 AND expires_at > NOW()) ORDER BY created_at DESC LIMIT 1"
+		selectQuery := "SELECT * FROM group_invites WHERE (group_id = $1 AND email = $2 AND revoked_at IS NULL AND expires_at > NOW() AND (max_uses = 0 OR uses < max_uses)) ORDER BY created_at DESC LIMIT 1"
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
-		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgGroupInvite.GroupID).SetArg(1, pgGroupInvite).Return(nil)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgGroupInvite.GroupID, "").SetArg(1, pgGroupInvite).Return(nil)
 
 		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
 
@@ -80,11 +103,11 @@ func Test_groupInviteRepository_GetActiveByGroupID(t *testing.T) {
 	t.Run("should return not found error when no active invite exists", func(t *testing.T) {
 		// given
 		groupID := "some-group-id"
-		selectQuery := "SELECT * FROM group_invites WHERE (group_id = $1 AND expires_at > NOW()) ORDER BY created_at DESC LIMIT 1"
+		selectQuery := "SELECT * FROM group_invites WHERE (group_id = $1 AND email = $2 AND revoked_at IS NULL AND expires_at > NOW() AND (max_uses = 0 OR uses < max_uses)) ORDER BY created_at DESC LIMIT 1"
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
-		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, groupID).Return(sql.ErrNoRows)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, groupID, "").Return(sql.ErrNoRows)
 
 		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
 
@@ -102,12 +125,12 @@ func Test_groupInviteRepository_GetActiveByGroupID(t *testing.T) {
 	t.Run("should return not found error when group ID has invalid UUID syntax", func(t *testing.T) {
 		// given
 		groupID := "invalid-uuid"
-		selectQuery := "SELECT * FROM group_invites WHERE (group_id = $1 AND expires_at > NOW()) ORDER BY created_at DESC LIMIT 1"
+		selectQuery := "SELECT * FROM group_invites WHERE (group_id = $1 AND email = $2 AND revoked_at IS NULL AND expires_at > NOW() AND (max_uses = 0 OR uses < max_uses)) ORDER BY created_at DESC LIMIT 1"
 		invalidUUIDError := &pq.Error{Code: pq.ErrorCode("22P02")}
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
-		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, groupID).Return(invalidUUIDError)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, groupID, "").Return(invalidUUIDError)
 
 		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
 
@@ -125,11 +148,11 @@ func Test_groupInviteRepository_GetActiveByGroupID(t *testing.T) {
 	t.Run("should return error when get fails", func(t *testing.T) {
 		// given
 		groupID := "some-group-id"
-		selectQuery := "SELECT * FROM group_invites WHERE (group_id = $1 AND expires_at > NOW()) ORDER BY created_at DESC LIMIT 1"
+		selectQuery := "SELECT * FROM group_invites WHERE (group_id = $1 AND email = $2 AND revoked_at IS NULL AND expires_at > NOW() AND (max_uses = 0 OR uses < max_uses)) ORDER BY created_at DESC LIMIT 1"
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
-		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, groupID).Return(assert.AnError)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, groupID, "").Return(assert.AnError)
 
 		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
 
@@ -143,6 +166,63 @@ func Test_groupInviteRepository_GetActiveByGroupID(t *testing.T) {
 	})
 }
 
+func Test_groupInviteRepository_GetActiveByCode(t *testing.T) {
+	selectQuery := "SELECT * FROM group_invites WHERE (code = $1 AND code <> $2 AND revoked_at IS NULL AND expires_at > NOW()) ORDER BY created_at DESC LIMIT 1"
+
+	t.Run("should get active group invite by code successfully", func(t *testing.T) {
+		// given
+		pgGroupInvite := build_postgres.NewGroupInviteBuilder().WithCode("XYZW6789").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, "XYZW6789", "").SetArg(1, pgGroupInvite).Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.GetActiveByCode(context.Background(), "XYZW6789")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgGroupInvite.ID, result.ID)
+		assert.Equal(t, "XYZW6789", result.Code)
+	})
+
+	t.Run("should return not found error when no active invite has the code", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, "XYZW6789", "").Return(sql.ErrNoRows)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.GetActiveByCode(context.Background(), "XYZW6789")
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "no active invite found for this code")
+	})
+
+	t.Run("should return error when get fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, "XYZW6789", "").Return(assert.AnError)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.GetActiveByCode(context.Background(), "XYZW6789")
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error getting group invite by code")
+	})
+}
+
 func Test_groupInviteRepository_GetByID(t *testing.T) {
 	t.Run("should get group invite by id successfully", func(t *testing.T) {
 		// given
@@ -231,3 +311,454 @@ func Test_groupInviteRepository_GetByID(t *testing.T) {
 		assert.ErrorContains(t, err, "error getting group invite")
 	})
 }
+
+func Test_groupInviteRepository_ListPendingPersonalByGroupID(t *testing.T) {
+	selectQuery := "SELECT * FROM group_invites WHERE (group_id = $1 AND email <> $2 AND used_at IS NULL AND revoked_at IS NULL) ORDER BY created_at"
+
+	t.Run("should list pending personal invites successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		pgGroupInvites := []postgres.GroupInvite{
+			build_postgres.NewGroupInviteBuilder().WithGroupID(groupID).WithEmail("first@example.com").Build(),
+			build_postgres.NewGroupInviteBuilder().WithGroupID(groupID).WithEmail("second@example.com").Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, groupID, "").SetArg(1, pgGroupInvites).Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.ListPendingPersonalByGroupID(context.Background(), groupID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "first@example.com", result[0].Email)
+		assert.Equal(t, "second@example.com", result[1].Email)
+	})
+
+	t.Run("should return error when select fails", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, groupID, "").Return(assert.AnError)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.ListPendingPersonalByGroupID(context.Background(), groupID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error listing pending group invites")
+	})
+}
+
+func Test_groupInviteRepository_ListPendingPersonalByEmail(t *testing.T) {
+	selectQuery := "SELECT * FROM group_invites WHERE (email = $1 AND used_at IS NULL AND revoked_at IS NULL) ORDER BY created_at"
+
+	t.Run("should list pending personal invites matching the email in lower case", func(t *testing.T) {
+		// given
+		pgGroupInvites := []postgres.GroupInvite{
+			build_postgres.NewGroupInviteBuilder().WithEmail("friend@example.com").Build(),
+			build_postgres.NewGroupInviteBuilder().WithEmail("friend@example.com").Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, "friend@example.com").SetArg(1, pgGroupInvites).Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.ListPendingPersonalByEmail(context.Background(), "Friend@Example.com")
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, pgGroupInvites[0].GroupID, result[0].GroupID)
+		assert.Equal(t, pgGroupInvites[1].GroupID, result[1].GroupID)
+	})
+
+	t.Run("should return error when select fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, "friend@example.com").Return(assert.AnError)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.ListPendingPersonalByEmail(context.Background(), "friend@example.com")
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error listing pending group invites")
+	})
+}
+
+func Test_groupInviteRepository_Update(t *testing.T) {
+	updateQuery := "UPDATE group_invites SET used_at = $1, revoked_at = $2, expires_at = $3 WHERE id = $4"
+
+	t.Run("should update group invite successfully", func(t *testing.T) {
+		// given
+		usedAt := time.Now()
+		groupInvite := build_domain.NewGroupInviteBuilder().WithEmail("friend@example.com").WithUsedAt(&usedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, groupInvite.UsedAt, groupInvite.RevokedAt, groupInvite.ExpiresAt, groupInvite.ID).Return(driver.RowsAffected(1), nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Update(context.Background(), groupInvite)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return not found error when no invite was updated", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, groupInvite.UsedAt, groupInvite.RevokedAt, groupInvite.ExpiresAt, groupInvite.ID).Return(driver.RowsAffected(0), nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Update(context.Background(), groupInvite)
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("should return error when exec fails", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, groupInvite.UsedAt, groupInvite.RevokedAt, groupInvite.ExpiresAt, groupInvite.ID).Return(nil, assert.AnError)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Update(context.Background(), groupInvite)
+
+		// then
+		assert.ErrorContains(t, err, "error updating group invite")
+	})
+}
+
+func Test_groupInviteRepository_Delete(t *testing.T) {
+	deleteQuery := "DELETE FROM group_invites WHERE id = $1"
+
+	t.Run("should delete group invite successfully", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), deleteQuery, inviteID).Return(driver.RowsAffected(1), nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Delete(context.Background(), inviteID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return not found error when no invite was deleted", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), deleteQuery, inviteID).Return(driver.RowsAffected(0), nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Delete(context.Background(), inviteID)
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "group invite not found")
+	})
+}
+
+func Test_groupInviteRepository_ListByGroupID(t *testing.T) {
+	selectQuery := "SELECT * FROM group_invites WHERE group_id = $1 ORDER BY created_at DESC"
+
+	t.Run("should list all invites of the group successfully", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+		revokedAt := time.Now().UTC()
+		pgGroupInvites := []postgres.GroupInvite{
+			build_postgres.NewGroupInviteBuilder().WithGroupID(groupID).Build(),
+			build_postgres.NewGroupInviteBuilder().WithGroupID(groupID).WithRevokedAt(&revokedAt).Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, groupID).SetArg(1, pgGroupInvites).Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.ListByGroupID(context.Background(), groupID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, domain.GroupInviteStateActive, result[0].State())
+		assert.Equal(t, domain.GroupInviteStateRevoked, result[1].State())
+	})
+
+	t.Run("should return error when select fails", func(t *testing.T) {
+		// given
+		groupID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, groupID).Return(assert.AnError)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.ListByGroupID(context.Background(), groupID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error listing group invites")
+	})
+}
+
+func Test_groupInviteRepository_Rotate(t *testing.T) {
+	revokeQuery := "UPDATE group_invites SET revoked_at = $1 WHERE (group_id = $2 AND email = $3 AND revoked_at IS NULL AND expires_at > NOW())"
+	insertQuery := "INSERT INTO group_invites (id,group_id,code,email,requires_approval,max_uses,used_at,expires_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)"
+
+	t.Run("should revoke the active invites and insert the new one in a transaction", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), revokeQuery, groupInvite.CreatedAt, groupInvite.GroupID, "").Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, groupInvite.ID, groupInvite.GroupID, groupInvite.Code, groupInvite.Email, groupInvite.RequiresApproval, groupInvite.MaxUses, groupInvite.UsedAt, groupInvite.ExpiresAt, groupInvite.CreatedAt).Return(nil, nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Rotate(context.Background(), groupInvite)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not insert the new invite when revoking fails", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), revokeQuery, groupInvite.CreatedAt, groupInvite.GroupID, "").Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Rotate(context.Background(), groupInvite)
+
+		// then
+		assert.ErrorContains(t, err, "error revoking group invites")
+	})
+
+	t.Run("should return error when inserting the new invite fails", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), revokeQuery, groupInvite.CreatedAt, groupInvite.GroupID, "").Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, groupInvite.ID, groupInvite.GroupID, groupInvite.Code, groupInvite.Email, groupInvite.RequiresApproval, groupInvite.MaxUses, groupInvite.UsedAt, groupInvite.ExpiresAt, groupInvite.CreatedAt).Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Rotate(context.Background(), groupInvite)
+
+		// then
+		assert.ErrorContains(t, err, "error inserting group invite")
+	})
+
+	t.Run("should return conflict error when the code of the new invite is already in use", func(t *testing.T) {
+		// given
+		groupInvite := build_domain.NewGroupInviteBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), revokeQuery, groupInvite.CreatedAt, groupInvite.GroupID, "").Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, groupInvite.ID, groupInvite.GroupID, groupInvite.Code, groupInvite.Email, groupInvite.RequiresApproval, groupInvite.MaxUses, groupInvite.UsedAt, groupInvite.ExpiresAt, groupInvite.CreatedAt).Return(nil, &pq.Error{Code: pq.ErrorCode("23505")})
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Rotate(context.Background(), groupInvite)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func Test_groupInviteRepository_Redeem(t *testing.T) {
+	usesQuery := "UPDATE group_invites SET uses = uses + 1 WHERE (id = $1 AND (max_uses = 0 OR uses < max_uses))"
+	insertQuery := "INSERT INTO group_invite_redemptions (invite_id,user_id,redeemed_at) VALUES ($1,$2,$3)"
+
+	t.Run("should count the use and record the redemption", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+		userID := uuid.New().String()
+		redeemedAt := time.Now()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), usesQuery, inviteID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, inviteID, userID, redeemedAt).Return(nil, nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Redeem(context.Background(), inviteID, userID, redeemedAt)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when the invite has no uses left", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), usesQuery, inviteID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Redeem(context.Background(), inviteID, uuid.New().String(), time.Now())
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "invite has reached its maximum number of uses")
+	})
+
+	t.Run("should return error when recording the redemption fails", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+		userID := uuid.New().String()
+		redeemedAt := time.Now()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), usesQuery, inviteID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, inviteID, userID, redeemedAt).Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		err := groupInviteRepository.Redeem(context.Background(), inviteID, userID, redeemedAt)
+
+		// then
+		assert.ErrorContains(t, err, "error inserting group invite redemption")
+	})
+}
+
+func Test_groupInviteRepository_ListRedemptions(t *testing.T) {
+	selectQuery := "SELECT u.*, gir.redeemed_at FROM users u JOIN group_invite_redemptions gir ON gir.user_id = u.id WHERE gir.invite_id = $1 ORDER BY gir.redeemed_at"
+
+	t.Run("should list the users who joined through the invite", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+		redeemedAt := time.Now().UTC()
+		pgRedemptions := []postgres.GroupInviteRedemption{
+			{User: build_postgres.NewUserBuilder().Build(), RedeemedAt: redeemedAt},
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, inviteID).SetArg(1, pgRedemptions).Return(nil)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.ListRedemptions(context.Background(), inviteID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, inviteID, result[0].InviteID)
+		assert.Equal(t, pgRedemptions[0].ID, result[0].User.ID)
+		assert.Equal(t, redeemedAt, result[0].RedeemedAt)
+	})
+
+	t.Run("should return error when select fails", func(t *testing.T) {
+		// given
+		inviteID := uuid.New().String()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, inviteID).Return(assert.AnError)
+
+		groupInviteRepository := postgres.NewGroupInviteRepository(mockedDB)
+
+		// when
+		result, err := groupInviteRepository.ListRedemptions(context.Background(), inviteID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error listing group invite redemptions")
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
@@ -30,8 +31,8 @@ func (r *groupRepository) Create(ctx context.Context, group domain.Group) error
 	defer tx.Rollback()
 
 	query, args, err := squirrel.Insert("groups").
-		Columns("id", "name", "description", "status", "owner_id", "created_at", "updated_at").
-		Values(group.ID, group.Name, group.Description, group.Status, group.OwnerID, group.CreatedAt, group.UpdatedAt).
+		Columns("id", "name", "description", "status", "max_members", "budget", "rules", "exchange_date", "match_strategy", "owner_id", "created_at", "updated_at").
+		Values(group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -69,6 +70,18 @@ func (r *groupRepository) Create(ctx context.Context, group domain.Group) error
 		return fmt.Errorf("error inserting group users: %w", err)
 	}
 
+	if err := r.insertGuests(ctx, tx, group); err != nil {
+		return err
+	}
+
+	if err := r.insertWaitlist(ctx, tx, group, group.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := r.insertJoinRequests(ctx, tx, group); err != nil {
+		return err
+	}
+
 	if len(group.Matches) > 0 {
 		groupMatchesInsert := squirrel.Insert("group_matches").
 			Columns("group_id", "giver_id", "receiver_id", "created_at").
@@ -110,8 +123,15 @@ func (r *groupRepository) Update(ctx context.Context, group domain.Group) error
 		Set("name", group.Name).
 		Set("description", group.Description).
 		Set("status", group.Status).
+		Set("max_members", group.MaxMembers).
+		Set("budget", group.Budget).
+		Set("rules", group.Rules).
+		Set("exchange_date", group.ExchangeDate).
+		Set("match_strategy", group.MatchStrategy).
+		Set("owner_id", group.OwnerID).
 		Set("updated_at", group.UpdatedAt).
-		Where(squirrel.Eq{"id": group.ID}).
+		Set("version", squirrel.Expr("version + 1")).
+		Where(squirrel.Eq{"id": group.ID, "version": group.Version}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -135,8 +155,9 @@ func (r *groupRepository) Update(ctx context.Context, group domain.Group) error
 		return fmt.Errorf("error getting rows affected: %w", err)
 	}
 
+	// groups are never deleted, so no row means another request saved the group after it was read
 	if rowsAffected == 0 {
-		return domain.NewResourceNotFoundError("group not found")
+		return domain.NewConflictError("the group was changed by someone else, please try again")
 	}
 
 	// Remove existing group users
@@ -173,6 +194,60 @@ func (r *groupRepository) Update(ctx context.Context, group domain.Group) error
 		return fmt.Errorf("error inserting group users: %w", err)
 	}
 
+	// Remove existing group guests
+	query, args, err = squirrel.Delete("group_guests").
+		Where(squirrel.Eq{"group_id": group.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group_guests delete query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error deleting group guests: %w", err)
+	}
+
+	if err := r.insertGuests(ctx, tx, group); err != nil {
+		return err
+	}
+
+	// Remove existing waitlist entries
+	query, args, err = squirrel.Delete("group_waitlist").
+		Where(squirrel.Eq{"group_id": group.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group_waitlist delete query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error deleting group waitlist: %w", err)
+	}
+
+	if err := r.insertWaitlist(ctx, tx, group, group.UpdatedAt); err != nil {
+		return err
+	}
+
+	// Remove existing join requests
+	query, args, err = squirrel.Delete("group_join_requests").
+		Where(squirrel.Eq{"group_id": group.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group_join_requests delete query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error deleting group join requests: %w", err)
+	}
+
+	if err := r.insertJoinRequests(ctx, tx, group); err != nil {
+		return err
+	}
+
 	// Remove existing group matches
 	query, args, err = squirrel.Delete("group_matches").
 		Where(squirrel.Eq{"group_id": group.ID}).
@@ -218,6 +293,92 @@ func (r *groupRepository) Update(ctx context.Context, group domain.Group) error
 	return nil
 }
 
+func (r *groupRepository) insertGuests(ctx context.Context, tx TX, group domain.Group) error {
+	if len(group.Guests) == 0 {
+		return nil
+	}
+
+	groupGuestsInsert := squirrel.Insert("group_guests").
+		Columns("id", "group_id", "name", "email", "created_at", "updated_at").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, guest := range group.Guests {
+		groupGuestsInsert = groupGuestsInsert.Values(guest.ID, group.ID, guest.Name, guest.Email, guest.CreatedAt, guest.UpdatedAt)
+	}
+
+	query, args, err := groupGuestsInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group_guests insert query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting group guests:", err)
+		return fmt.Errorf("error inserting group guests: %w", err)
+	}
+
+	return nil
+}
+
+func (r *groupRepository) insertWaitlist(ctx context.Context, tx TX, group domain.Group, createdAt time.Time) error {
+	if len(group.Waitlist) == 0 {
+		return nil
+	}
+
+	groupWaitlistInsert := squirrel.Insert("group_waitlist").
+		Columns("group_id", "user_id", "position", "created_at").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for position, user := range group.Waitlist {
+		groupWaitlistInsert = groupWaitlistInsert.Values(group.ID, user.ID, position, createdAt)
+	}
+
+	query, args, err := groupWaitlistInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group_waitlist insert query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting group waitlist:", err)
+		return fmt.Errorf("error inserting group waitlist: %w", err)
+	}
+
+	return nil
+}
+
+func (r *groupRepository) insertJoinRequests(ctx context.Context, tx TX, group domain.Group) error {
+	if len(group.JoinRequests) == 0 {
+		return nil
+	}
+
+	groupJoinRequestsInsert := squirrel.Insert("group_join_requests").
+		Columns("group_id", "user_id", "invite_id", "created_at").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, joinRequest := range group.JoinRequests {
+		var inviteID *string
+		if joinRequest.InviteID != "" {
+			inviteID = &joinRequest.InviteID
+		}
+
+		groupJoinRequestsInsert = groupJoinRequestsInsert.Values(group.ID, joinRequest.User.ID, inviteID, joinRequest.CreatedAt)
+	}
+
+	query, args, err := groupJoinRequestsInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group_join_requests insert query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting group join requests:", err)
+		return fmt.Errorf("error inserting group join requests: %w", err)
+	}
+
+	return nil
+}
+
 func (r *groupRepository) GetByID(ctx context.Context, groupID string) (*domain.Group, error) {
 	query, args, err := squirrel.Select("g.*").
 		From("groups g").
@@ -258,6 +419,59 @@ func (r *groupRepository) GetByID(ctx context.Context, groupID string) (*domain.
 		return nil, fmt.Errorf("error getting group users: %w", err)
 	}
 
+	// Get waitlisted users
+	query, args, err = squirrel.Select("u.*").
+		From("users u").
+		Join("group_waitlist gw ON gw.user_id = u.id").
+		Where(squirrel.Eq{"gw.group_id": groupID}).
+		OrderBy("gw.position").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group waitlist select query: %w", err)
+	}
+
+	var waitlist []User
+	err = r.db.SelectContext(ctx, &waitlist, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting group waitlist: %w", err)
+	}
+
+	// Get pending join requests
+	query, args, err = squirrel.Select("u.*", "gjr.created_at AS requested_at", "gjr.invite_id AS request_invite_id").
+		From("users u").
+		Join("group_join_requests gjr ON gjr.user_id = u.id").
+		Where(squirrel.Eq{"gjr.group_id": groupID}).
+		OrderBy("gjr.created_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group join requests select query: %w", err)
+	}
+
+	var joinRequests []JoinRequest
+	err = r.db.SelectContext(ctx, &joinRequests, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting group join requests: %w", err)
+	}
+
+	// Get group guests
+	query, args, err = squirrel.Select("*").
+		From("group_guests").
+		Where(squirrel.Eq{"group_id": groupID}).
+		OrderBy("created_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group guests select query: %w", err)
+	}
+
+	var guests []Guest
+	err = r.db.SelectContext(ctx, &guests, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting group guests: %w", err)
+	}
+
 	// Get group matches
 	query, args, err = squirrel.Select("giver_id", "receiver_id").
 		From("group_matches").
@@ -274,7 +488,7 @@ func (r *groupRepository) GetByID(ctx context.Context, groupID string) (*domain.
 		return nil, fmt.Errorf("error getting group matches: %w", err)
 	}
 
-	domainGroup, err := mapGroupToDomain(group, users, matches)
+	domainGroup, err := mapGroupToDomain(group, users, waitlist, joinRequests, guests, matches)
 	if err != nil {
 		return nil, err
 	}
@@ -282,6 +496,28 @@ func (r *groupRepository) GetByID(ctx context.Context, groupID string) (*domain.
 	return domainGroup, nil
 }
 
+func (r *groupRepository) ListClaimableGuests(ctx context.Context, email string) ([]domain.ClaimableGuest, error) {
+	query, args, err := squirrel.Select("gg.*", "g.name AS group_name").
+		From("group_guests gg").
+		Join("groups g ON g.id = gg.group_id").
+		Where("LOWER(gg.email) = LOWER(?)", email).
+		Where(squirrel.NotEq{"g.status": domain.GroupStatusArchived}).
+		OrderBy("gg.created_at").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building claimable guests select query: %w", err)
+	}
+
+	var guests []ClaimableGuest
+	err = r.db.SelectContext(ctx, &guests, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing claimable guests: %w", err)
+	}
+
+	return mapClaimableGuestsToDomain(guests)
+}
+
 func (r *groupRepository) Search(ctx context.Context, filters domain.GroupFilters) (*domain.SearchResult[domain.GroupSummary], error) {
 	// Subconsulta para contar usuários do grupo
 	userCountSubquery := squirrel.Select("COUNT(*)").
@@ -289,8 +525,15 @@ func (r *groupRepository) Search(ctx context.Context, filters domain.GroupFilter
 		Where("group_id = g.id").
 		PlaceholderFormat(squirrel.Dollar)
 
+	// Subconsulta para contar convidados sem conta, que também ocupam vagas do grupo
+	guestCountSubquery := squirrel.Select("COUNT(*)").
+		From("group_guests").
+		Where("group_id = g.id").
+		PlaceholderFormat(squirrel.Dollar)
+
 	baseQuery := squirrel.Select("g.*").
 		Column(squirrel.Alias(userCountSubquery, "user_count")).
+		Column(squirrel.Alias(guestCountSubquery, "guest_count")).
 		From("groups g").
 		PlaceholderFormat(squirrel.Dollar)
 
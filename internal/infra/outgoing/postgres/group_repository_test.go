@@ -21,14 +21,14 @@ func Test_groupRepository_Create(t *testing.T) {
 	t.Run("should create group with one user successfully", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		groupInsertQuery := "INSERT INTO groups (id,name,description,status,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+		groupInsertQuery := "INSERT INTO groups (id,name,description,status,max_members,budget,rules,exchange_date,match_strategy,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)"
 		groupUsersInsertQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), groupUsersInsertQuery, group.ID, group.Users[0].ID, group.CreatedAt).Return(nil, nil)
 		mockedTx.EXPECT().Commit().Return(nil)
 		mockedTx.EXPECT().Rollback().Return(nil)
@@ -48,14 +48,14 @@ func Test_groupRepository_Create(t *testing.T) {
 		user2 := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().WithUsers([]domain.User{user1, user2}).Build()
 
-		groupInsertQuery := "INSERT INTO groups (id,name,description,status,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+		groupInsertQuery := "INSERT INTO groups (id,name,description,status,max_members,budget,rules,exchange_date,match_strategy,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)"
 		groupUsersInsertQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3),($4,$5,$6)"
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(
 			gomock.Any(),
 			groupUsersInsertQuery,
@@ -80,7 +80,7 @@ func Test_groupRepository_Create(t *testing.T) {
 		match2 := build_domain.NewMatchBuilder().Build()
 		group := build_domain.NewGroupBuilder().WithMatches([]domain.Match{match1, match2}).Build()
 
-		groupInsertQuery := "INSERT INTO groups (id,name,description,status,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+		groupInsertQuery := "INSERT INTO groups (id,name,description,status,max_members,budget,rules,exchange_date,match_strategy,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)"
 		groupUsersInsertQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
 		groupMatchesInsertQuery := "INSERT INTO group_matches (group_id,giver_id,receiver_id,created_at) VALUES ($1,$2,$3,$4),($5,$6,$7,$8)"
 
@@ -88,7 +88,7 @@ func Test_groupRepository_Create(t *testing.T) {
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), groupUsersInsertQuery, group.ID, group.Users[0].ID, group.CreatedAt).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(
 			gomock.Any(),
@@ -113,7 +113,7 @@ func Test_groupRepository_Create(t *testing.T) {
 		match1 := build_domain.NewMatchBuilder().Build()
 		group := build_domain.NewGroupBuilder().WithMatches([]domain.Match{match1}).Build()
 
-		groupInsertQuery := "INSERT INTO groups (id,name,description,status,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+		groupInsertQuery := "INSERT INTO groups (id,name,description,status,max_members,budget,rules,exchange_date,match_strategy,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)"
 		groupUsersInsertQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
 		groupMatchesInsertQuery := "INSERT INTO group_matches (group_id,giver_id,receiver_id,created_at) VALUES ($1,$2,$3,$4)"
 
@@ -122,7 +122,7 @@ func Test_groupRepository_Create(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), groupUsersInsertQuery, group.ID, group.Users[0].ID, group.CreatedAt).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(
 			gomock.Any(),
@@ -165,14 +165,14 @@ func Test_groupRepository_Create(t *testing.T) {
 		postgresUniqueViolationError := &pq.Error{Code: pq.ErrorCode("23505")}
 
 		group := build_domain.NewGroupBuilder().Build()
-		groupInsertQuery := "INSERT INTO groups (id,name,description,status,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+		groupInsertQuery := "INSERT INTO groups (id,name,description,status,max_members,budget,rules,exchange_date,match_strategy,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)"
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, postgresUniqueViolationError)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, postgresUniqueViolationError)
 		mockedTx.EXPECT().Rollback().Return(nil)
 
 		groupRepository := postgres.NewGroupRepository(mockedDB)
@@ -190,7 +190,7 @@ func Test_groupRepository_Create(t *testing.T) {
 	t.Run("should return error when fail to insert group users", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		groupInsertQuery := "INSERT INTO groups (id,name,description,status,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+		groupInsertQuery := "INSERT INTO groups (id,name,description,status,max_members,budget,rules,exchange_date,match_strategy,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)"
 		groupUsersInsertQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
 
 		mockCtrl := gomock.NewController(t)
@@ -198,7 +198,7 @@ func Test_groupRepository_Create(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), groupUsersInsertQuery, group.ID, group.Users[0].ID, group.CreatedAt).Return(nil, assert.AnError)
 		mockedTx.EXPECT().Rollback().Return(nil)
 
@@ -215,7 +215,7 @@ func Test_groupRepository_Create(t *testing.T) {
 	t.Run("should return error when fail to commit transaction", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		groupInsertQuery := "INSERT INTO groups (id,name,description,status,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+		groupInsertQuery := "INSERT INTO groups (id,name,description,status,max_members,budget,rules,exchange_date,match_strategy,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)"
 		groupUsersInsertQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
 
 		mockCtrl := gomock.NewController(t)
@@ -223,7 +223,7 @@ func Test_groupRepository_Create(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), groupUsersInsertQuery, group.ID, group.Users[0].ID, group.CreatedAt).Return(nil, nil)
 		mockedTx.EXPECT().Commit().Return(assert.AnError)
 		mockedTx.EXPECT().Rollback().Return(nil)
@@ -237,15 +237,74 @@ func Test_groupRepository_Create(t *testing.T) {
 		assert.Error(t, err)
 		assert.ErrorContains(t, err, "error committing transaction")
 	})
+
+	t.Run("should create group with guests successfully", func(t *testing.T) {
+		// given
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithGuests([]domain.Guest{guest}).Build()
+
+		groupInsertQuery := "INSERT INTO groups (id,name,description,status,max_members,budget,rules,exchange_date,match_strategy,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)"
+		groupUsersInsertQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		groupGuestsInsertQuery := "INSERT INTO group_guests (id,group_id,name,email,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6)"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupUsersInsertQuery, group.ID, group.Users[0].ID, group.CreatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupGuestsInsertQuery, guest.ID, group.ID, guest.Name, guest.Email, guest.CreatedAt, guest.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		err := groupRepository.Create(context.Background(), group)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when fail to insert group guests", func(t *testing.T) {
+		// given
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithGuests([]domain.Guest{guest}).Build()
+
+		groupInsertQuery := "INSERT INTO groups (id,name,description,status,max_members,budget,rules,exchange_date,match_strategy,owner_id,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)"
+		groupUsersInsertQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		groupGuestsInsertQuery := "INSERT INTO group_guests (id,group_id,name,email,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6)"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupInsertQuery, group.ID, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.CreatedAt, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupUsersInsertQuery, group.ID, group.Users[0].ID, group.CreatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), groupGuestsInsertQuery, guest.ID, group.ID, guest.Name, guest.Email, guest.CreatedAt, guest.UpdatedAt).Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		err := groupRepository.Create(context.Background(), group)
+
+		// then
+		assert.Error(t, err)
+		assert.ErrorContains(t, err, "error inserting group guests")
+	})
 }
 
 func Test_groupRepository_Update(t *testing.T) {
 	t.Run("should update group with one user successfully", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
 		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		deleteJoinRequestsQuery := "DELETE FROM group_join_requests WHERE group_id = $1"
 		deleteMatchesQuery := "DELETE FROM group_matches WHERE group_id = $1"
 		result := driver.RowsAffected(1)
 
@@ -254,9 +313,12 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteJoinRequestsQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteMatchesQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().Commit().Return(nil)
 		mockedTx.EXPECT().Rollback().Return(nil)
@@ -276,9 +338,12 @@ func Test_groupRepository_Update(t *testing.T) {
 		user2 := build_domain.NewUserBuilder().Build()
 		group := build_domain.NewGroupBuilder().WithUsers([]domain.User{user1, user2}).Build()
 
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
 		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3),($4,$5,$6)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		deleteJoinRequestsQuery := "DELETE FROM group_join_requests WHERE group_id = $1"
 		deleteMatchesQuery := "DELETE FROM group_matches WHERE group_id = $1"
 		result := driver.RowsAffected(1)
 
@@ -287,7 +352,7 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(
 			gomock.Any(),
@@ -295,6 +360,9 @@ func Test_groupRepository_Update(t *testing.T) {
 			group.ID, group.Users[0].ID, group.UpdatedAt,
 			group.ID, group.Users[1].ID, group.UpdatedAt,
 		).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteJoinRequestsQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteMatchesQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().Commit().Return(nil)
 		mockedTx.EXPECT().Rollback().Return(nil)
@@ -314,9 +382,12 @@ func Test_groupRepository_Update(t *testing.T) {
 		match2 := build_domain.NewMatchBuilder().Build()
 		group := build_domain.NewGroupBuilder().WithMatches([]domain.Match{match1, match2}).Build()
 
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
 		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		deleteJoinRequestsQuery := "DELETE FROM group_join_requests WHERE group_id = $1"
 		deleteMatchesQuery := "DELETE FROM group_matches WHERE group_id = $1"
 		insertMatchesQuery := "INSERT INTO group_matches (group_id,giver_id,receiver_id,created_at) VALUES ($1,$2,$3,$4),($5,$6,$7,$8)"
 		result := driver.RowsAffected(1)
@@ -326,9 +397,12 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteJoinRequestsQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteMatchesQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(
 			gomock.Any(),
@@ -367,10 +441,10 @@ func Test_groupRepository_Update(t *testing.T) {
 		assert.ErrorContains(t, err, "error beginning transaction")
 	})
 
-	t.Run("should return not found error when group does not exist", func(t *testing.T) {
+	t.Run("should return conflict error when the group was changed since it was read", func(t *testing.T) {
 		// given
-		group := build_domain.NewGroupBuilder().Build()
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		group := build_domain.NewGroupBuilder().WithVersion(3).Build()
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		result := driver.RowsAffected(0)
 
 		mockCtrl := gomock.NewController(t)
@@ -378,7 +452,7 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
 		mockedTx.EXPECT().Rollback().Return(nil)
 
 		groupRepository := postgres.NewGroupRepository(mockedDB)
@@ -388,15 +462,15 @@ func Test_groupRepository_Update(t *testing.T) {
 
 		// then
 		assert.Error(t, err)
-		var expectedError *domain.ResourceNotFoundError
+		var expectedError *domain.ConflictError
 		assert.ErrorAs(t, err, &expectedError)
-		assert.EqualError(t, err, "group not found")
+		assert.EqualError(t, err, "the group was changed by someone else, please try again")
 	})
 
 	t.Run("should return conflict error when group name already exists", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		postgresUniqueViolationError := &pq.Error{Code: pq.ErrorCode("23505")}
 
 		mockCtrl := gomock.NewController(t)
@@ -404,7 +478,7 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(nil, postgresUniqueViolationError)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(nil, postgresUniqueViolationError)
 		mockedTx.EXPECT().Rollback().Return(nil)
 
 		groupRepository := postgres.NewGroupRepository(mockedDB)
@@ -422,14 +496,14 @@ func Test_groupRepository_Update(t *testing.T) {
 	t.Run("should return error when fail to update group", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(nil, assert.AnError)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(nil, assert.AnError)
 		mockedTx.EXPECT().Rollback().Return(nil)
 
 		groupRepository := postgres.NewGroupRepository(mockedDB)
@@ -445,7 +519,7 @@ func Test_groupRepository_Update(t *testing.T) {
 	t.Run("should return error when fail to delete group users", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
 		result := driver.RowsAffected(1)
 
@@ -454,7 +528,7 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, assert.AnError)
 		mockedTx.EXPECT().Rollback().Return(nil)
 
@@ -471,7 +545,7 @@ func Test_groupRepository_Update(t *testing.T) {
 	t.Run("should return error when fail to insert group users", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
 		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
 		result := driver.RowsAffected(1)
@@ -481,7 +555,7 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, assert.AnError)
 		mockedTx.EXPECT().Rollback().Return(nil)
@@ -499,9 +573,12 @@ func Test_groupRepository_Update(t *testing.T) {
 	t.Run("should return error when fail to commit transaction", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
 		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		deleteJoinRequestsQuery := "DELETE FROM group_join_requests WHERE group_id = $1"
 		deleteMatchesQuery := "DELETE FROM group_matches WHERE group_id = $1"
 		result := driver.RowsAffected(1)
 
@@ -510,9 +587,12 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteJoinRequestsQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteMatchesQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().Commit().Return(assert.AnError)
 		mockedTx.EXPECT().Rollback().Return(nil)
@@ -530,9 +610,12 @@ func Test_groupRepository_Update(t *testing.T) {
 	t.Run("should return error when fail to delete group matches", func(t *testing.T) {
 		// given
 		group := build_domain.NewGroupBuilder().Build()
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
 		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		deleteJoinRequestsQuery := "DELETE FROM group_join_requests WHERE group_id = $1"
 		deleteMatchesQuery := "DELETE FROM group_matches WHERE group_id = $1"
 		result := driver.RowsAffected(1)
 
@@ -541,9 +624,12 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteJoinRequestsQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteMatchesQuery, group.ID).Return(nil, assert.AnError)
 		mockedTx.EXPECT().Rollback().Return(nil)
 
@@ -562,9 +648,12 @@ func Test_groupRepository_Update(t *testing.T) {
 		match1 := build_domain.NewMatchBuilder().Build()
 		group := build_domain.NewGroupBuilder().WithMatches([]domain.Match{match1}).Build()
 
-		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, updated_at = $4 WHERE id = $5"
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
 		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
 		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		deleteJoinRequestsQuery := "DELETE FROM group_join_requests WHERE group_id = $1"
 		deleteMatchesQuery := "DELETE FROM group_matches WHERE group_id = $1"
 		insertMatchesQuery := "INSERT INTO group_matches (group_id,giver_id,receiver_id,created_at) VALUES ($1,$2,$3,$4)"
 		result := driver.RowsAffected(1)
@@ -574,9 +663,12 @@ func Test_groupRepository_Update(t *testing.T) {
 		mockedTx := mock_postgres.NewMockTX(mockCtrl)
 
 		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
-		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.UpdatedAt, group.ID).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteJoinRequestsQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteMatchesQuery, group.ID).Return(nil, nil)
 		mockedTx.EXPECT().ExecContext(
 			gomock.Any(),
@@ -594,6 +686,195 @@ func Test_groupRepository_Update(t *testing.T) {
 		assert.Error(t, err)
 		assert.ErrorContains(t, err, "error inserting group matches")
 	})
+
+	t.Run("should update group with guests successfully", func(t *testing.T) {
+		// given
+		guest := build_domain.NewGuestBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithGuests([]domain.Guest{guest}).Build()
+
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
+		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
+		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		deleteJoinRequestsQuery := "DELETE FROM group_join_requests WHERE group_id = $1"
+		insertGuestsQuery := "INSERT INTO group_guests (id,group_id,name,email,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6)"
+		deleteMatchesQuery := "DELETE FROM group_matches WHERE group_id = $1"
+		result := driver.RowsAffected(1)
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertGuestsQuery, guest.ID, group.ID, guest.Name, guest.Email, guest.CreatedAt, guest.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteJoinRequestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteMatchesQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		err := groupRepository.Update(context.Background(), group)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when fail to delete group guests", func(t *testing.T) {
+		// given
+		group := build_domain.NewGroupBuilder().Build()
+
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
+		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
+		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		result := driver.RowsAffected(1)
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		err := groupRepository.Update(context.Background(), group)
+
+		// then
+		assert.Error(t, err)
+		assert.ErrorContains(t, err, "error deleting group guests")
+	})
+
+	t.Run("should update group with waitlist successfully", func(t *testing.T) {
+		// given
+		waitlistedUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithMaxMembers(1).WithWaitlist([]domain.User{waitlistedUser}).Build()
+
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
+		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
+		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		deleteJoinRequestsQuery := "DELETE FROM group_join_requests WHERE group_id = $1"
+		insertWaitlistQuery := "INSERT INTO group_waitlist (group_id,user_id,position,created_at) VALUES ($1,$2,$3,$4)"
+		deleteMatchesQuery := "DELETE FROM group_matches WHERE group_id = $1"
+		result := driver.RowsAffected(1)
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertWaitlistQuery, group.ID, waitlistedUser.ID, 0, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteJoinRequestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteMatchesQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		err := groupRepository.Update(context.Background(), group)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when fail to insert group waitlist", func(t *testing.T) {
+		// given
+		waitlistedUser := build_domain.NewUserBuilder().Build()
+		group := build_domain.NewGroupBuilder().WithMaxMembers(1).WithWaitlist([]domain.User{waitlistedUser}).Build()
+
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
+		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
+		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		insertWaitlistQuery := "INSERT INTO group_waitlist (group_id,user_id,position,created_at) VALUES ($1,$2,$3,$4)"
+		result := driver.RowsAffected(1)
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertWaitlistQuery, group.ID, waitlistedUser.ID, 0, group.UpdatedAt).Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		err := groupRepository.Update(context.Background(), group)
+
+		// then
+		assert.Error(t, err)
+		assert.ErrorContains(t, err, "error inserting group waitlist")
+	})
+
+	t.Run("should update group with join requests successfully", func(t *testing.T) {
+		// given
+		requester := build_domain.NewUserBuilder().Build()
+		inviteID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+		joinRequest := domain.JoinRequest{User: requester, InviteID: inviteID, CreatedAt: time.Now()}
+		group := build_domain.NewGroupBuilder().WithJoinRequests([]domain.JoinRequest{joinRequest}).Build()
+
+		updateGroupQuery := "UPDATE groups SET name = $1, description = $2, status = $3, max_members = $4, budget = $5, rules = $6, exchange_date = $7, match_strategy = $8, owner_id = $9, updated_at = $10, version = version + 1 WHERE id = $11 AND version = $12"
+		deleteUsersQuery := "DELETE FROM group_users WHERE group_id = $1"
+		insertUsersQuery := "INSERT INTO group_users (group_id,user_id,created_at) VALUES ($1,$2,$3)"
+		deleteGuestsQuery := "DELETE FROM group_guests WHERE group_id = $1"
+		deleteWaitlistQuery := "DELETE FROM group_waitlist WHERE group_id = $1"
+		deleteJoinRequestsQuery := "DELETE FROM group_join_requests WHERE group_id = $1"
+		insertJoinRequestsQuery := "INSERT INTO group_join_requests (group_id,user_id,invite_id,created_at) VALUES ($1,$2,$3,$4)"
+		deleteMatchesQuery := "DELETE FROM group_matches WHERE group_id = $1"
+		result := driver.RowsAffected(1)
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateGroupQuery, group.Name, group.Description, group.Status, group.MaxMembers, group.Budget, group.Rules, group.ExchangeDate, group.MatchStrategy, group.OwnerID, group.UpdatedAt, group.ID, group.Version).Return(result, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteUsersQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertUsersQuery, group.ID, group.Users[0].ID, group.UpdatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteGuestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteWaitlistQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteJoinRequestsQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertJoinRequestsQuery, group.ID, requester.ID, &inviteID, joinRequest.CreatedAt).Return(nil, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteMatchesQuery, group.ID).Return(nil, nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		err := groupRepository.Update(context.Background(), group)
+
+		// then
+		assert.NoError(t, err)
+	})
 }
 
 func Test_groupRepository_GetByID(t *testing.T) {
@@ -601,9 +882,12 @@ func Test_groupRepository_GetByID(t *testing.T) {
 		// given
 		expectedUser1 := build_domain.NewUserBuilder().Build()
 		expectedUser2 := build_domain.NewUserBuilder().Build()
-		expectedGroup := build_domain.NewGroupBuilder().WithUsers([]domain.User{expectedUser1, expectedUser2}).WithMatches([]domain.Match{}).Build()
+		expectedGroup := build_domain.NewGroupBuilder().WithUsers([]domain.User{expectedUser1, expectedUser2}).WithGuests([]domain.Guest{}).WithWaitlist([]domain.User{}).WithJoinRequests([]domain.JoinRequest{}).WithMatches([]domain.Match{}).WithVersion(2).Build()
 		selectGroupQuery := "SELECT g.* FROM groups g WHERE g.id = $1"
 		selectUsersQuery := "SELECT u.* FROM users u JOIN group_users gu ON gu.user_id = u.id WHERE gu.group_id = $1"
+		selectWaitlistQuery := "SELECT u.* FROM users u JOIN group_waitlist gw ON gw.user_id = u.id WHERE gw.group_id = $1 ORDER BY gw.position"
+		selectJoinRequestsQuery := "SELECT u.*, gjr.created_at AS requested_at, gjr.invite_id AS request_invite_id FROM users u JOIN group_join_requests gjr ON gjr.user_id = u.id WHERE gjr.group_id = $1 ORDER BY gjr.created_at"
+		selectGuestsQuery := "SELECT * FROM group_guests WHERE group_id = $1 ORDER BY created_at"
 		selectMatchesQuery := "SELECT giver_id, receiver_id FROM group_matches WHERE group_id = $1"
 
 		group := build_postgres.NewGroupBuilder().
@@ -613,6 +897,7 @@ func Test_groupRepository_GetByID(t *testing.T) {
 			WithOwnerID(expectedGroup.OwnerID).
 			WithCreatedAt(expectedGroup.CreatedAt).
 			WithUpdatedAt(expectedGroup.UpdatedAt).
+			WithVersion(expectedGroup.Version).
 			Build()
 
 		user1 := build_postgres.NewUserBuilder().
@@ -642,6 +927,9 @@ func Test_groupRepository_GetByID(t *testing.T) {
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
 		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectGroupQuery, expectedGroup.ID).SetArg(1, group).Return(nil)
 		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectUsersQuery, expectedGroup.ID).SetArg(1, users).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectWaitlistQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectJoinRequestsQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectGuestsQuery, expectedGroup.ID).Return(nil)
 		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectMatchesQuery, expectedGroup.ID).SetArg(1, matches).Return(nil)
 
 		groupRepository := postgres.NewGroupRepository(mockedDB)
@@ -659,10 +947,13 @@ func Test_groupRepository_GetByID(t *testing.T) {
 		expectedUser1 := build_domain.NewUserBuilder().Build()
 		expectedMatch1 := build_domain.NewMatchBuilder().Build()
 		expectedMatch2 := build_domain.NewMatchBuilder().Build()
-		expectedGroup := build_domain.NewGroupBuilder().WithUsers([]domain.User{expectedUser1}).WithMatches([]domain.Match{expectedMatch1, expectedMatch2}).Build()
+		expectedGroup := build_domain.NewGroupBuilder().WithUsers([]domain.User{expectedUser1}).WithGuests([]domain.Guest{}).WithWaitlist([]domain.User{}).WithJoinRequests([]domain.JoinRequest{}).WithMatches([]domain.Match{expectedMatch1, expectedMatch2}).Build()
 
 		selectGroupQuery := "SELECT g.* FROM groups g WHERE g.id = $1"
 		selectUsersQuery := "SELECT u.* FROM users u JOIN group_users gu ON gu.user_id = u.id WHERE gu.group_id = $1"
+		selectWaitlistQuery := "SELECT u.* FROM users u JOIN group_waitlist gw ON gw.user_id = u.id WHERE gw.group_id = $1 ORDER BY gw.position"
+		selectJoinRequestsQuery := "SELECT u.*, gjr.created_at AS requested_at, gjr.invite_id AS request_invite_id FROM users u JOIN group_join_requests gjr ON gjr.user_id = u.id WHERE gjr.group_id = $1 ORDER BY gjr.created_at"
+		selectGuestsQuery := "SELECT * FROM group_guests WHERE group_id = $1 ORDER BY created_at"
 		selectMatchesQuery := "SELECT giver_id, receiver_id FROM group_matches WHERE group_id = $1"
 
 		group := build_postgres.NewGroupBuilder().
@@ -700,6 +991,9 @@ func Test_groupRepository_GetByID(t *testing.T) {
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
 		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectGroupQuery, expectedGroup.ID).SetArg(1, group).Return(nil)
 		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectUsersQuery, expectedGroup.ID).SetArg(1, users).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectWaitlistQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectJoinRequestsQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectGuestsQuery, expectedGroup.ID).Return(nil)
 		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectMatchesQuery, expectedGroup.ID).SetArg(1, matches).Return(nil)
 
 		groupRepository := postgres.NewGroupRepository(mockedDB)
@@ -813,6 +1107,9 @@ func Test_groupRepository_GetByID(t *testing.T) {
 		expectedGroup := build_domain.NewGroupBuilder().Build()
 		selectGroupQuery := "SELECT g.* FROM groups g WHERE g.id = $1"
 		selectUsersQuery := "SELECT u.* FROM users u JOIN group_users gu ON gu.user_id = u.id WHERE gu.group_id = $1"
+		selectWaitlistQuery := "SELECT u.* FROM users u JOIN group_waitlist gw ON gw.user_id = u.id WHERE gw.group_id = $1 ORDER BY gw.position"
+		selectJoinRequestsQuery := "SELECT u.*, gjr.created_at AS requested_at, gjr.invite_id AS request_invite_id FROM users u JOIN group_join_requests gjr ON gjr.user_id = u.id WHERE gjr.group_id = $1 ORDER BY gjr.created_at"
+		selectGuestsQuery := "SELECT * FROM group_guests WHERE group_id = $1 ORDER BY created_at"
 		selectMatchesQuery := "SELECT giver_id, receiver_id FROM group_matches WHERE group_id = $1"
 
 		group := build_postgres.NewGroupBuilder().
@@ -827,6 +1124,9 @@ func Test_groupRepository_GetByID(t *testing.T) {
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
 		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectGroupQuery, expectedGroup.ID).SetArg(1, group).Return(nil)
 		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectUsersQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectWaitlistQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectJoinRequestsQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectGuestsQuery, expectedGroup.ID).Return(nil)
 		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectMatchesQuery, expectedGroup.ID).Return(assert.AnError)
 
 		groupRepository := postgres.NewGroupRepository(mockedDB)
@@ -839,6 +1139,148 @@ func Test_groupRepository_GetByID(t *testing.T) {
 		assert.Nil(t, result)
 		assert.ErrorContains(t, err, "error getting group matches")
 	})
+
+	t.Run("should get group by id with guests successfully", func(t *testing.T) {
+		// given
+		expectedUser := build_domain.NewUserBuilder().Build()
+		expectedGuest := build_domain.NewGuestBuilder().Build()
+		expectedGroup := build_domain.NewGroupBuilder().WithUsers([]domain.User{expectedUser}).WithGuests([]domain.Guest{expectedGuest}).WithWaitlist([]domain.User{}).WithJoinRequests([]domain.JoinRequest{}).WithMatches([]domain.Match{}).Build()
+		selectGroupQuery := "SELECT g.* FROM groups g WHERE g.id = $1"
+		selectUsersQuery := "SELECT u.* FROM users u JOIN group_users gu ON gu.user_id = u.id WHERE gu.group_id = $1"
+		selectWaitlistQuery := "SELECT u.* FROM users u JOIN group_waitlist gw ON gw.user_id = u.id WHERE gw.group_id = $1 ORDER BY gw.position"
+		selectJoinRequestsQuery := "SELECT u.*, gjr.created_at AS requested_at, gjr.invite_id AS request_invite_id FROM users u JOIN group_join_requests gjr ON gjr.user_id = u.id WHERE gjr.group_id = $1 ORDER BY gjr.created_at"
+		selectGuestsQuery := "SELECT * FROM group_guests WHERE group_id = $1 ORDER BY created_at"
+		selectMatchesQuery := "SELECT giver_id, receiver_id FROM group_matches WHERE group_id = $1"
+
+		group := build_postgres.NewGroupBuilder().
+			WithID(expectedGroup.ID).
+			WithName(expectedGroup.Name).
+			WithOwnerID(expectedGroup.OwnerID).
+			WithCreatedAt(expectedGroup.CreatedAt).
+			WithUpdatedAt(expectedGroup.UpdatedAt).
+			Build()
+
+		user := build_postgres.NewUserBuilder().
+			WithID(expectedUser.ID).
+			WithName(expectedUser.Name).
+			WithSurname(expectedUser.Surname).
+			WithEmail(expectedUser.Email).
+			WithPassword(expectedUser.Password).
+			WithCreatedAt(expectedUser.CreatedAt).
+			WithUpdatedAt(expectedUser.UpdatedAt).
+			Build()
+
+		guest := build_postgres.NewGuestBuilder().
+			WithID(expectedGuest.ID).
+			WithGroupID(expectedGroup.ID).
+			WithName(expectedGuest.Name).
+			WithEmail(expectedGuest.Email).
+			WithCreatedAt(expectedGuest.CreatedAt).
+			WithUpdatedAt(expectedGuest.UpdatedAt).
+			Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectGroupQuery, expectedGroup.ID).SetArg(1, group).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectUsersQuery, expectedGroup.ID).SetArg(1, []postgres.User{user}).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectWaitlistQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectJoinRequestsQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectGuestsQuery, expectedGroup.ID).SetArg(1, []postgres.Guest{guest}).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectMatchesQuery, expectedGroup.ID).Return(nil)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		result, err := groupRepository.GetByID(context.Background(), expectedGroup.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, &expectedGroup, result)
+	})
+
+	t.Run("should return error when fail to get group guests", func(t *testing.T) {
+		// given
+		expectedGroup := build_domain.NewGroupBuilder().Build()
+		selectGroupQuery := "SELECT g.* FROM groups g WHERE g.id = $1"
+		selectUsersQuery := "SELECT u.* FROM users u JOIN group_users gu ON gu.user_id = u.id WHERE gu.group_id = $1"
+		selectWaitlistQuery := "SELECT u.* FROM users u JOIN group_waitlist gw ON gw.user_id = u.id WHERE gw.group_id = $1 ORDER BY gw.position"
+		selectJoinRequestsQuery := "SELECT u.*, gjr.created_at AS requested_at, gjr.invite_id AS request_invite_id FROM users u JOIN group_join_requests gjr ON gjr.user_id = u.id WHERE gjr.group_id = $1 ORDER BY gjr.created_at"
+		selectGuestsQuery := "SELECT * FROM group_guests WHERE group_id = $1 ORDER BY created_at"
+
+		group := build_postgres.NewGroupBuilder().WithID(expectedGroup.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectGroupQuery, expectedGroup.ID).SetArg(1, group).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectUsersQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectWaitlistQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectJoinRequestsQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectGuestsQuery, expectedGroup.ID).Return(assert.AnError)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		result, err := groupRepository.GetByID(context.Background(), expectedGroup.ID)
+
+		// then
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error getting group guests")
+	})
+
+	t.Run("should return error when fail to get group waitlist", func(t *testing.T) {
+		// given
+		expectedGroup := build_domain.NewGroupBuilder().Build()
+		selectGroupQuery := "SELECT g.* FROM groups g WHERE g.id = $1"
+		selectUsersQuery := "SELECT u.* FROM users u JOIN group_users gu ON gu.user_id = u.id WHERE gu.group_id = $1"
+		selectWaitlistQuery := "SELECT u.* FROM users u JOIN group_waitlist gw ON gw.user_id = u.id WHERE gw.group_id = $1 ORDER BY gw.position"
+
+		group := build_postgres.NewGroupBuilder().WithID(expectedGroup.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectGroupQuery, expectedGroup.ID).SetArg(1, group).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectUsersQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectWaitlistQuery, expectedGroup.ID).Return(assert.AnError)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		result, err := groupRepository.GetByID(context.Background(), expectedGroup.ID)
+
+		// then
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error getting group waitlist")
+	})
+
+	t.Run("should return error when fail to get group join requests", func(t *testing.T) {
+		// given
+		expectedGroup := build_domain.NewGroupBuilder().Build()
+		selectGroupQuery := "SELECT g.* FROM groups g WHERE g.id = $1"
+		selectUsersQuery := "SELECT u.* FROM users u JOIN group_users gu ON gu.user_id = u.id WHERE gu.group_id = $1"
+		selectWaitlistQuery := "SELECT u.* FROM users u JOIN group_waitlist gw ON gw.user_id = u.id WHERE gw.group_id = $1 ORDER BY gw.position"
+		selectJoinRequestsQuery := "SELECT u.*, gjr.created_at AS requested_at, gjr.invite_id AS request_invite_id FROM users u JOIN group_join_requests gjr ON gjr.user_id = u.id WHERE gjr.group_id = $1 ORDER BY gjr.created_at"
+
+		group := build_postgres.NewGroupBuilder().WithID(expectedGroup.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectGroupQuery, expectedGroup.ID).SetArg(1, group).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectUsersQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectWaitlistQuery, expectedGroup.ID).Return(nil)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectJoinRequestsQuery, expectedGroup.ID).Return(assert.AnError)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		result, err := groupRepository.GetByID(context.Background(), expectedGroup.ID)
+
+		// then
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error getting group join requests")
+	})
 }
 
 func Test_groupRepository_Search(t *testing.T) {
@@ -866,6 +1308,7 @@ func Test_groupRepository_Search(t *testing.T) {
 
 		groupID := "550e8400-e29b-41d4-a716-446655440002"
 		userCount := 5
+		guestCount := 2
 		now := time.Now().UTC()
 
 		dbGroupSummaries := []postgres.GroupSummary{
@@ -875,6 +1318,7 @@ func Test_groupRepository_Search(t *testing.T) {
 				WithStatus(string(status)).
 				WithOwnerID(ownerID).
 				WithUserCount(userCount).
+				WithGuestCount(guestCount).
 				WithCreatedAt(now).
 				WithUpdatedAt(now).
 				Build(),
@@ -887,6 +1331,7 @@ func Test_groupRepository_Search(t *testing.T) {
 				WithStatus(status).
 				WithOwnerID(ownerID).
 				WithUserCount(userCount).
+				WithGuestCount(guestCount).
 				WithCreatedAt(now).
 				WithUpdatedAt(now).
 				Build(),
@@ -960,7 +1405,7 @@ func Test_groupRepository_Search(t *testing.T) {
 			WithTotal(1).
 			Build()
 
-		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count FROM groups g ORDER BY g.created_at ASC LIMIT 15 OFFSET 0`
+		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count, (SELECT COUNT(*) FROM group_guests WHERE group_id = g.id) AS guest_count FROM groups g ORDER BY g.created_at ASC LIMIT 15 OFFSET 0`
 		countQuery := `SELECT COUNT(*) FROM groups g`
 
 		mockCtrl := gomock.NewController(t)
@@ -1026,7 +1471,7 @@ func Test_groupRepository_Search(t *testing.T) {
 			WithTotal(1).
 			Build()
 
-		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count FROM groups g JOIN group_users gu ON gu.group_id = g.id WHERE gu.user_id = $1 ORDER BY g.name DESC LIMIT 10 OFFSET 0`
+		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count, (SELECT COUNT(*) FROM group_guests WHERE group_id = g.id) AS guest_count FROM groups g JOIN group_users gu ON gu.group_id = g.id WHERE gu.user_id = $1 ORDER BY g.name DESC LIMIT 10 OFFSET 0`
 		countQuery := `SELECT COUNT(*) FROM groups g JOIN group_users gu ON gu.group_id = g.id WHERE gu.user_id = $1`
 
 		mockCtrl := gomock.NewController(t)
@@ -1122,7 +1567,7 @@ func Test_groupRepository_Search(t *testing.T) {
 			WithSortDirection(sortDirection).
 			Build()
 
-		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count FROM groups g ORDER BY g.name ASC LIMIT 10 OFFSET 0`
+		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count, (SELECT COUNT(*) FROM group_guests WHERE group_id = g.id) AS guest_count FROM groups g ORDER BY g.name ASC LIMIT 10 OFFSET 0`
 
 		mockCtrl := gomock.NewController(t)
 		mockedDB := mock_postgres.NewMockDB(mockCtrl)
@@ -1157,7 +1602,7 @@ func Test_groupRepository_Search(t *testing.T) {
 			build_postgres.NewGroupSummaryBuilder().Build(),
 		}
 
-		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count FROM groups g ORDER BY g.name ASC LIMIT 10 OFFSET 0`
+		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count, (SELECT COUNT(*) FROM group_guests WHERE group_id = g.id) AS guest_count FROM groups g ORDER BY g.name ASC LIMIT 10 OFFSET 0`
 		countQuery := `SELECT COUNT(*) FROM groups g`
 
 		mockCtrl := gomock.NewController(t)
@@ -1201,7 +1646,7 @@ func Test_groupRepository_Search(t *testing.T) {
 			},
 		}
 
-		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count FROM groups g ORDER BY g.name ASC LIMIT 10 OFFSET 0`
+		searchQuery := `SELECT g.*, (SELECT COUNT(*) FROM group_users WHERE group_id = g.id) AS user_count, (SELECT COUNT(*) FROM group_guests WHERE group_id = g.id) AS guest_count FROM groups g ORDER BY g.name ASC LIMIT 10 OFFSET 0`
 		countQuery := `SELECT COUNT(*) FROM groups g`
 
 		mockCtrl := gomock.NewController(t)
@@ -1220,3 +1665,71 @@ func Test_groupRepository_Search(t *testing.T) {
 		assert.ErrorContains(t, err, "error mapping groups to domain")
 	})
 }
+
+func Test_groupRepository_ListClaimableGuests(t *testing.T) {
+	email := "grandma@example.com"
+	listQuery := `SELECT gg.*, g.name AS group_name FROM group_guests gg JOIN groups g ON g.id = gg.group_id WHERE LOWER(gg.email) = LOWER($1) AND g.status <> $2 ORDER BY gg.created_at`
+
+	t.Run("should list the guests matching the email with their groups", func(t *testing.T) {
+		// given
+		groupID := "550e8400-e29b-41d4-a716-446655440000"
+		guestID := "550e8400-e29b-41d4-a716-446655440001"
+		now := time.Now().UTC()
+
+		dbGuests := []postgres.ClaimableGuest{
+			{
+				Guest: build_postgres.NewGuestBuilder().
+					WithID(guestID).
+					WithGroupID(groupID).
+					WithEmail(email).
+					WithCreatedAt(now).
+					WithUpdatedAt(now).
+					Build(),
+				GroupName: "Secret Santa",
+			},
+		}
+
+		expectedGuests := []domain.ClaimableGuest{
+			{
+				GroupID:   groupID,
+				GroupName: "Secret Santa",
+				Guest: build_domain.NewGuestBuilder().
+					WithID(guestID).
+					WithEmail(email).
+					WithCreatedAt(now).
+					WithUpdatedAt(now).
+					Build(),
+			},
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), listQuery, email, domain.GroupStatusArchived).SetArg(1, dbGuests).Return(nil)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		result, err := groupRepository.ListClaimableGuests(context.Background(), email)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedGuests, result)
+	})
+
+	t.Run("should return error when listing the guests fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), listQuery, email, domain.GroupStatusArchived).Return(assert.AnError)
+
+		groupRepository := postgres.NewGroupRepository(mockedDB)
+
+		// when
+		result, err := groupRepository.ListClaimableGuests(context.Background(), email)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "error listing claimable guests")
+	})
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type GroupTemplate struct {
+	ID             string    `db:"id"`
+	OwnerID        string    `db:"owner_id"`
+	Name           string    `db:"name"`
+	Description    string    `db:"description"`
+	MaxMembers     int       `db:"max_members"`
+	Budget         int       `db:"budget"`
+	Rules          string    `db:"rules"`
+	ExchangeInDays int       `db:"exchange_in_days"`
+	MatchStrategy  string    `db:"match_strategy"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+func mapGroupTemplateToDomain(groupTemplate GroupTemplate) (*domain.GroupTemplate, error) {
+	domainGroupTemplate := domain.GroupTemplate{
+		ID:             groupTemplate.ID,
+		Name:           groupTemplate.Name,
+		Description:    groupTemplate.Description,
+		MaxMembers:     groupTemplate.MaxMembers,
+		Budget:         groupTemplate.Budget,
+		Rules:          groupTemplate.Rules,
+		ExchangeInDays: groupTemplate.ExchangeInDays,
+		MatchStrategy:  domain.MatchStrategy(groupTemplate.MatchStrategy),
+		OwnerID:        groupTemplate.OwnerID,
+		CreatedAt:      groupTemplate.CreatedAt,
+		UpdatedAt:      groupTemplate.UpdatedAt,
+	}
+
+	if err := domainGroupTemplate.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainGroupTemplate, nil
+}
+
+func mapGroupTemplatesToDomain(groupTemplates []GroupTemplate) ([]domain.GroupTemplate, error) {
+	domainGroupTemplates := make([]domain.GroupTemplate, 0, len(groupTemplates))
+
+	for _, model := range groupTemplates {
+		groupTemplate, err := mapGroupTemplateToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+
+		domainGroupTemplates = append(domainGroupTemplates, *groupTemplate)
+	}
+
+	return domainGroupTemplates, nil
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type groupTemplateRepository struct {
+	db DB
+}
+
+func NewGroupTemplateRepository(db DB) domain.GroupTemplateRepository {
+	return &groupTemplateRepository{
+		db: db,
+	}
+}
+
+func (r *groupTemplateRepository) Create(ctx context.Context, groupTemplate domain.GroupTemplate) error {
+	query, args, err := squirrel.Insert("group_templates").
+		Columns("id", "owner_id", "name", "description", "max_members", "budget", "rules", "exchange_in_days", "match_strategy", "created_at", "updated_at").
+		Values(groupTemplate.ID, groupTemplate.OwnerID, groupTemplate.Name, groupTemplate.Description, groupTemplate.MaxMembers, groupTemplate.Budget, groupTemplate.Rules, groupTemplate.ExchangeInDays, groupTemplate.MatchStrategy, groupTemplate.CreatedAt, groupTemplate.UpdatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group template insert query: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting group template:", err)
+		return fmt.Errorf("error inserting group template: %w", err)
+	}
+
+	return nil
+}
+
+func (r *groupTemplateRepository) GetByID(ctx context.Context, id string) (*domain.GroupTemplate, error) {
+	query, args, err := squirrel.Select("*").
+		From("group_templates").
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group template select query: %w", err)
+	}
+
+	var groupTemplate GroupTemplate
+	err = r.db.GetContext(ctx, &groupTemplate, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("group template not found")
+		}
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == POSTGRES_INVALID_TEXT_REPRESENTATION {
+			return nil, domain.NewResourceNotFoundError("group template not found")
+		}
+		return nil, fmt.Errorf("error getting group template: %w", err)
+	}
+
+	return mapGroupTemplateToDomain(groupTemplate)
+}
+
+func (r *groupTemplateRepository) ListByOwnerID(ctx context.Context, ownerID string) ([]domain.GroupTemplate, error) {
+	query, args, err := squirrel.Select("*").
+		From("group_templates").
+		Where(squirrel.Eq{"owner_id": ownerID}).
+		OrderBy("name").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building group templates select query: %w", err)
+	}
+
+	var groupTemplates []GroupTemplate
+	err = r.db.SelectContext(ctx, &groupTemplates, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing group templates: %w", err)
+	}
+
+	return mapGroupTemplatesToDomain(groupTemplates)
+}
+
+func (r *groupTemplateRepository) Delete(ctx context.Context, id string) error {
+	query, args, err := squirrel.Delete("group_templates").
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group template delete query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error deleting group template:", err)
+		return fmt.Errorf("error deleting group template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewResourceNotFoundError("group template not found")
+	}
+
+	return nil
+}
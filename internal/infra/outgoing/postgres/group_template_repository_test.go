@@ -0,0 +1,241 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/build_postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/mock_postgres"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_groupTemplateRepository_Create(t *testing.T) {
+	insertQuery := "INSERT INTO group_templates (id,owner_id,name,description,max_members,budget,rules,exchange_in_days,match_strategy,created_at,updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)"
+
+	t.Run("should create group template successfully", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, groupTemplate.ID, groupTemplate.OwnerID, groupTemplate.Name, groupTemplate.Description, groupTemplate.MaxMembers, groupTemplate.Budget, groupTemplate.Rules, groupTemplate.ExchangeInDays, groupTemplate.MatchStrategy, groupTemplate.CreatedAt, groupTemplate.UpdatedAt).Return(nil, nil)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		err := groupTemplateRepository.Create(context.Background(), groupTemplate)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when exec fails", func(t *testing.T) {
+		// given
+		groupTemplate := build_domain.NewGroupTemplateBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		err := groupTemplateRepository.Create(context.Background(), groupTemplate)
+
+		// then
+		assert.ErrorContains(t, err, "error inserting group template")
+	})
+}
+
+func Test_groupTemplateRepository_GetByID(t *testing.T) {
+	selectQuery := "SELECT * FROM group_templates WHERE id = $1"
+
+	t.Run("should get group template successfully", func(t *testing.T) {
+		// given
+		pgGroupTemplate := build_postgres.NewGroupTemplateBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgGroupTemplate.ID).SetArg(1, pgGroupTemplate).Return(nil)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		result, err := groupTemplateRepository.GetByID(context.Background(), pgGroupTemplate.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgGroupTemplate.ID, result.ID)
+		assert.Equal(t, pgGroupTemplate.OwnerID, result.OwnerID)
+		assert.Equal(t, pgGroupTemplate.Budget, result.Budget)
+		assert.Equal(t, pgGroupTemplate.ExchangeInDays, result.ExchangeInDays)
+	})
+
+	t.Run("should return not found error when template does not exist", func(t *testing.T) {
+		// given
+		templateID := "some-template-id"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, templateID).Return(sql.ErrNoRows)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		result, err := groupTemplateRepository.GetByID(context.Background(), templateID)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "group template not found")
+	})
+
+	t.Run("should return not found error when template ID has invalid UUID syntax", func(t *testing.T) {
+		// given
+		templateID := "invalid-uuid"
+		invalidUUIDError := &pq.Error{Code: pq.ErrorCode("22P02")}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, templateID).Return(invalidUUIDError)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		result, err := groupTemplateRepository.GetByID(context.Background(), templateID)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+
+	t.Run("should return error when get fails", func(t *testing.T) {
+		// given
+		templateID := "some-template-id"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, templateID).Return(assert.AnError)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		result, err := groupTemplateRepository.GetByID(context.Background(), templateID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error getting group template")
+	})
+}
+
+func Test_groupTemplateRepository_ListByOwnerID(t *testing.T) {
+	selectQuery := "SELECT * FROM group_templates WHERE owner_id = $1 ORDER BY name"
+
+	t.Run("should list the owner templates successfully", func(t *testing.T) {
+		// given
+		ownerID := "7d1f3d9e-4c53-4bb4-9a68-4c2c3e6d2c55"
+		pgGroupTemplates := []postgres.GroupTemplate{
+			build_postgres.NewGroupTemplateBuilder().WithOwnerID(ownerID).WithName("Book club").Build(),
+			build_postgres.NewGroupTemplateBuilder().WithOwnerID(ownerID).WithName("Team lunch").Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, ownerID).SetArg(1, pgGroupTemplates).Return(nil)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		result, err := groupTemplateRepository.ListByOwnerID(context.Background(), ownerID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "Book club", result[0].Name)
+		assert.Equal(t, "Team lunch", result[1].Name)
+	})
+
+	t.Run("should return error when select fails", func(t *testing.T) {
+		// given
+		ownerID := "7d1f3d9e-4c53-4bb4-9a68-4c2c3e6d2c55"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, ownerID).Return(assert.AnError)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		result, err := groupTemplateRepository.ListByOwnerID(context.Background(), ownerID)
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error listing group templates")
+	})
+}
+
+func Test_groupTemplateRepository_Delete(t *testing.T) {
+	deleteQuery := "DELETE FROM group_templates WHERE id = $1"
+
+	t.Run("should delete group template successfully", func(t *testing.T) {
+		// given
+		templateID := "some-template-id"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), deleteQuery, templateID).Return(driver.RowsAffected(1), nil)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		err := groupTemplateRepository.Delete(context.Background(), templateID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return not found error when no template was deleted", func(t *testing.T) {
+		// given
+		templateID := "some-template-id"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), deleteQuery, templateID).Return(driver.RowsAffected(0), nil)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		err := groupTemplateRepository.Delete(context.Background(), templateID)
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "group template not found")
+	})
+
+	t.Run("should return error when exec fails", func(t *testing.T) {
+		// given
+		templateID := "some-template-id"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), deleteQuery, templateID).Return(nil, assert.AnError)
+
+		groupTemplateRepository := postgres.NewGroupTemplateRepository(mockedDB)
+
+		// when
+		err := groupTemplateRepository.Delete(context.Background(), templateID)
+
+		// then
+		assert.ErrorContains(t, err, "error deleting group template")
+	})
+}
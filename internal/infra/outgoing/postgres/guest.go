@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type Guest struct {
+	ID        string    `db:"id"`
+	GroupID   string    `db:"group_id"`
+	Name      string    `db:"name"`
+	Email     string    `db:"email"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type ClaimableGuest struct {
+	Guest
+	GroupName string `db:"group_name"`
+}
+
+func mapGuestToDomain(guest Guest) (*domain.Guest, error) {
+	domainGuest := domain.Guest{
+		ID:        guest.ID,
+		Name:      guest.Name,
+		Email:     guest.Email,
+		CreatedAt: guest.CreatedAt,
+		UpdatedAt: guest.UpdatedAt,
+	}
+
+	if err := domainGuest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainGuest, nil
+}
+
+func mapGuestsToDomain(guests []Guest) ([]domain.Guest, error) {
+	domainGuests := make([]domain.Guest, 0, len(guests))
+
+	for _, model := range guests {
+		guest, err := mapGuestToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+
+		domainGuests = append(domainGuests, *guest)
+	}
+
+	return domainGuests, nil
+}
+
+func mapClaimableGuestsToDomain(guests []ClaimableGuest) ([]domain.ClaimableGuest, error) {
+	claimableGuests := make([]domain.ClaimableGuest, 0, len(guests))
+
+	for _, model := range guests {
+		guest, err := mapGuestToDomain(model.Guest)
+		if err != nil {
+			return nil, err
+		}
+
+		claimableGuests = append(claimableGuests, domain.ClaimableGuest{
+			GroupID:   model.GroupID,
+			GroupName: model.GroupName,
+			Guest:     *guest,
+		})
+	}
+
+	return claimableGuests, nil
+}
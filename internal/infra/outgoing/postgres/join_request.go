@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type JoinRequest struct {
+	User
+	RequestedAt     time.Time `db:"requested_at"`
+	RequestInviteID *string   `db:"request_invite_id"`
+}
+
+func mapJoinRequestsToDomain(joinRequests []JoinRequest) ([]domain.JoinRequest, error) {
+	domainJoinRequests := make([]domain.JoinRequest, 0, len(joinRequests))
+
+	for _, joinRequest := range joinRequests {
+		domainUser, err := mapUserToDomain(joinRequest.User)
+		if err != nil {
+			return nil, err
+		}
+
+		domainJoinRequest := domain.JoinRequest{
+			User:      *domainUser,
+			CreatedAt: joinRequest.RequestedAt,
+		}
+
+		if joinRequest.RequestInviteID != nil {
+			domainJoinRequest.InviteID = *joinRequest.RequestInviteID
+		}
+
+		domainJoinRequests = append(domainJoinRequests, domainJoinRequest)
+	}
+
+	return domainJoinRequests, nil
+}
@@ -0,0 +1,8 @@
+DELETE FROM group_matches
+WHERE giver_id NOT IN (SELECT id FROM users)
+   OR receiver_id NOT IN (SELECT id FROM users);
+
+ALTER TABLE group_matches ADD CONSTRAINT group_matches_giver_id_fkey FOREIGN KEY (giver_id) REFERENCES users(id);
+ALTER TABLE group_matches ADD CONSTRAINT group_matches_receiver_id_fkey FOREIGN KEY (receiver_id) REFERENCES users(id);
+
+DROP TABLE IF EXISTS group_guests;
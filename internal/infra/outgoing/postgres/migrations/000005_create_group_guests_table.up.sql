@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS group_guests (
+    id         UUID         NOT NULL PRIMARY KEY,
+    group_id   UUID         NOT NULL REFERENCES groups(id),
+    name       VARCHAR(255) NOT NULL,
+    email      VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_guests_group_id ON group_guests(group_id);
+
+-- matches may now reference guests as well as users
+ALTER TABLE group_matches DROP CONSTRAINT IF EXISTS group_matches_giver_id_fkey;
+ALTER TABLE group_matches DROP CONSTRAINT IF EXISTS group_matches_receiver_id_fkey;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS group_waitlist;
+
+ALTER TABLE groups DROP COLUMN IF EXISTS max_members;
@@ -0,0 +1,9 @@
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS max_members INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS group_waitlist (
+    group_id   UUID        NOT NULL REFERENCES groups(id),
+    user_id    UUID        NOT NULL REFERENCES users(id),
+    position   INT         NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (group_id, user_id)
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS group_join_requests;
+
+ALTER TABLE group_invites DROP COLUMN IF EXISTS requires_approval;
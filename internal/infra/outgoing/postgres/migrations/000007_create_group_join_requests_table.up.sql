@@ -0,0 +1,8 @@
+ALTER TABLE group_invites ADD COLUMN IF NOT EXISTS requires_approval BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS group_join_requests (
+    group_id   UUID        NOT NULL REFERENCES groups(id),
+    user_id    UUID        NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (group_id, user_id)
+);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS group_templates;
+
+ALTER TABLE groups DROP COLUMN IF EXISTS exchange_date;
+ALTER TABLE groups DROP COLUMN IF EXISTS rules;
+ALTER TABLE groups DROP COLUMN IF EXISTS budget;
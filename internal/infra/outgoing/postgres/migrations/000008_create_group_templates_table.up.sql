@@ -0,0 +1,18 @@
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS budget INT NOT NULL DEFAULT 0;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS rules TEXT NOT NULL DEFAULT '';
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS exchange_date TIMESTAMPTZ NULL;
+
+CREATE TABLE IF NOT EXISTS group_templates (
+    id               UUID         NOT NULL PRIMARY KEY,
+    owner_id         UUID         NOT NULL REFERENCES users(id),
+    name             VARCHAR(255) NOT NULL,
+    description      VARCHAR(255) NOT NULL DEFAULT '',
+    max_members      INT          NOT NULL DEFAULT 0,
+    budget           INT          NOT NULL DEFAULT 0,
+    rules            TEXT         NOT NULL DEFAULT '',
+    exchange_in_days INT          NOT NULL DEFAULT 0,
+    created_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_templates_owner_id ON group_templates(owner_id);
@@ -0,0 +1,2 @@
+ALTER TABLE group_invites DROP COLUMN IF EXISTS used_at;
+ALTER TABLE group_invites DROP COLUMN IF EXISTS email;
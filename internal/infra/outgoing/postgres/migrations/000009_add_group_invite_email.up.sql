@@ -0,0 +1,2 @@
+ALTER TABLE group_invites ADD COLUMN IF NOT EXISTS email VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE group_invites ADD COLUMN IF NOT EXISTS used_at TIMESTAMPTZ NULL;
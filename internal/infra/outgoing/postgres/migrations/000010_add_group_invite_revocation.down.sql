@@ -0,0 +1 @@
+ALTER TABLE group_invites DROP COLUMN IF EXISTS revoked_at;
@@ -0,0 +1 @@
+ALTER TABLE group_invites ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMPTZ NULL;
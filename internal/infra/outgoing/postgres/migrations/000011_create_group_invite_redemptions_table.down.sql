@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS group_invite_redemptions;
+
+ALTER TABLE group_invites DROP COLUMN IF EXISTS uses;
+ALTER TABLE group_invites DROP COLUMN IF EXISTS max_uses;
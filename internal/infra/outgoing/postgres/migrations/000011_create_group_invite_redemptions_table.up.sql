@@ -0,0 +1,10 @@
+ALTER TABLE group_invites ADD COLUMN IF NOT EXISTS max_uses INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE group_invites ADD COLUMN IF NOT EXISTS uses INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS group_invite_redemptions (
+    invite_id   UUID        NOT NULL REFERENCES group_invites(id) ON DELETE CASCADE,
+    user_id     UUID        NOT NULL REFERENCES users(id),
+    redeemed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_invite_redemptions_invite_id ON group_invite_redemptions(invite_id);
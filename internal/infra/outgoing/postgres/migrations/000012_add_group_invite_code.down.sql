@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_group_invites_code;
+
+ALTER TABLE group_invites DROP COLUMN IF EXISTS code;
@@ -0,0 +1,3 @@
+ALTER TABLE group_invites ADD COLUMN IF NOT EXISTS code VARCHAR(8) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_group_invites_code ON group_invites(code);
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar_id VARCHAR(36) NOT NULL DEFAULT '';
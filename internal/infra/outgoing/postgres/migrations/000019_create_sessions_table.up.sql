@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS sessions (
+    id         UUID        NOT NULL PRIMARY KEY,
+    user_id    UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    revoked_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id);
+
+-- every refresh token family becomes a session
+INSERT INTO sessions (id, user_id, revoked_at, expires_at, created_at)
+SELECT family_id, user_id, MAX(revoked_at), MAX(expires_at), MIN(created_at)
+FROM refresh_tokens
+GROUP BY family_id, user_id;
+
+ALTER TABLE refresh_tokens RENAME COLUMN family_id TO session_id;
+ALTER INDEX IF EXISTS idx_refresh_tokens_family_id RENAME TO idx_refresh_tokens_session_id;
+ALTER TABLE refresh_tokens
+    ADD CONSTRAINT fk_refresh_tokens_session_id FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE;
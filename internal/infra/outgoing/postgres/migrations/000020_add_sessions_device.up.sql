@@ -0,0 +1,8 @@
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS user_agent VARCHAR(512) NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS ip_address VARCHAR(45) NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ;
+
+UPDATE sessions SET last_seen_at = created_at WHERE last_seen_at IS NULL;
+
+ALTER TABLE sessions ALTER COLUMN last_seen_at SET NOT NULL;
+ALTER TABLE sessions ALTER COLUMN last_seen_at SET DEFAULT NOW();
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS oidc_login_requests (
+    id            UUID         NOT NULL PRIMARY KEY,
+    state_hash    VARCHAR(64)  NOT NULL UNIQUE,
+    nonce         VARCHAR(255) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    used_at       TIMESTAMPTZ,
+    expires_at    TIMESTAMPTZ  NOT NULL,
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS user_identities (
+    id         UUID         NOT NULL PRIMARY KEY,
+    user_id    UUID         NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider   VARCHAR(255) NOT NULL,
+    subject    VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id);
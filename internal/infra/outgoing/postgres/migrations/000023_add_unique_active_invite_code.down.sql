@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_group_invites_active_code;
+
+CREATE INDEX IF NOT EXISTS idx_group_invites_code ON group_invites(code);
@@ -0,0 +1,13 @@
+-- keeps the newest invite of any code shared by invites that were not revoked; the older ones can still be joined
+-- through their link
+UPDATE group_invites SET code = ''
+WHERE code <> '' AND revoked_at IS NULL AND EXISTS (
+    SELECT 1 FROM group_invites newer
+    WHERE newer.code = group_invites.code
+        AND newer.revoked_at IS NULL
+        AND (newer.created_at, newer.id) > (group_invites.created_at, group_invites.id)
+);
+
+DROP INDEX IF EXISTS idx_group_invites_code;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_group_invites_active_code ON group_invites(code) WHERE revoked_at IS NULL AND code <> '';
@@ -0,0 +1 @@
+ALTER TABLE group_join_requests DROP COLUMN IF EXISTS invite_id;
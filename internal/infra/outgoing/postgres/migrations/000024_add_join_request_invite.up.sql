@@ -0,0 +1 @@
+ALTER TABLE group_join_requests ADD COLUMN IF NOT EXISTS invite_id UUID NULL REFERENCES group_invites(id) ON DELETE SET NULL;
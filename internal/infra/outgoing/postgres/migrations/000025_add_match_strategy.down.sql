@@ -0,0 +1,2 @@
+ALTER TABLE group_templates DROP COLUMN IF EXISTS match_strategy;
+ALTER TABLE groups DROP COLUMN IF EXISTS match_strategy;
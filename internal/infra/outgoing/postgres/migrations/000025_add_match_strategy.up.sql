@@ -0,0 +1,2 @@
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS match_strategy VARCHAR(20) NOT NULL DEFAULT 'CYCLE';
+ALTER TABLE group_templates ADD COLUMN IF NOT EXISTS match_strategy VARCHAR(20) NOT NULL DEFAULT '';
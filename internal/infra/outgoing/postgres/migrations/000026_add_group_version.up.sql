@@ -0,0 +1 @@
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type OIDCLoginRequest struct {
+	ID           string     `db:"id"`
+	StateHash    string     `db:"state_hash"`
+	Nonce        string     `db:"nonce"`
+	CodeVerifier string     `db:"code_verifier"`
+	UsedAt       *time.Time `db:"used_at"`
+	ExpiresAt    time.Time  `db:"expires_at"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+func mapOIDCLoginRequestToDomain(loginRequest OIDCLoginRequest) (*domain.OIDCLoginRequest, error) {
+	domainLoginRequest := domain.OIDCLoginRequest{
+		ID:           loginRequest.ID,
+		StateHash:    loginRequest.StateHash,
+		Nonce:        loginRequest.Nonce,
+		CodeVerifier: loginRequest.CodeVerifier,
+		UsedAt:       loginRequest.UsedAt,
+		ExpiresAt:    loginRequest.ExpiresAt,
+		CreatedAt:    loginRequest.CreatedAt,
+	}
+
+	if err := domainLoginRequest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainLoginRequest, nil
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type oidcLoginRequestRepository struct {
+	db DB
+}
+
+func NewOIDCLoginRequestRepository(db DB) domain.OIDCLoginRequestRepository {
+	return &oidcLoginRequestRepository{
+		db: db,
+	}
+}
+
+func (r *oidcLoginRequestRepository) Create(ctx context.Context, loginRequest domain.OIDCLoginRequest) error {
+	query, args, err := squirrel.Insert("oidc_login_requests").
+		Columns("id", "state_hash", "nonce", "code_verifier", "expires_at", "created_at").
+		Values(loginRequest.ID, loginRequest.StateHash, loginRequest.Nonce, loginRequest.CodeVerifier, loginRequest.ExpiresAt, loginRequest.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building OIDC login request insert query: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting OIDC login request:", err)
+		return fmt.Errorf("error inserting OIDC login request: %w", err)
+	}
+
+	return nil
+}
+
+func (r *oidcLoginRequestRepository) GetByStateHash(ctx context.Context, stateHash string) (*domain.OIDCLoginRequest, error) {
+	query, args, err := squirrel.Select("*").
+		From("oidc_login_requests").
+		Where(squirrel.Eq{"state_hash": stateHash}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building OIDC login request select query: %w", err)
+	}
+
+	var loginRequest OIDCLoginRequest
+	err = r.db.GetContext(ctx, &loginRequest, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("OIDC login request not found")
+		}
+		return nil, fmt.Errorf("error getting OIDC login request: %w", err)
+	}
+
+	return mapOIDCLoginRequestToDomain(loginRequest)
+}
+
+func (r *oidcLoginRequestRepository) Redeem(ctx context.Context, loginRequestID string) error {
+	// the login request state is checked in the update itself so concurrent callbacks cannot use it twice
+	query, args, err := squirrel.Update("oidc_login_requests").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.And{
+			squirrel.Eq{"id": loginRequestID},
+			squirrel.Eq{"used_at": nil},
+			squirrel.Expr("expires_at > NOW()"),
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building OIDC login request update query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating OIDC login request:", err)
+		return fmt.Errorf("error updating OIDC login request: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewUnauthorizedError("invalid or expired login request")
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/build_postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/mock_postgres"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_oidcLoginRequestRepository_Create(t *testing.T) {
+	t.Run("should create OIDC login request successfully", func(t *testing.T) {
+		// given
+		loginRequest := build_domain.NewOIDCLoginRequestBuilder().Build()
+		insertQuery := "INSERT INTO oidc_login_requests (id,state_hash,nonce,code_verifier,expires_at,created_at) VALUES ($1,$2,$3,$4,$5,$6)"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, loginRequest.ID, loginRequest.StateHash, loginRequest.Nonce, loginRequest.CodeVerifier, loginRequest.ExpiresAt, loginRequest.CreatedAt).Return(nil, nil)
+
+		loginRequestRepository := postgres.NewOIDCLoginRequestRepository(mockedDB)
+
+		// when
+		err := loginRequestRepository.Create(context.Background(), loginRequest)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func Test_oidcLoginRequestRepository_GetByStateHash(t *testing.T) {
+	selectQuery := "SELECT * FROM oidc_login_requests WHERE state_hash = $1"
+
+	t.Run("should get OIDC login request by state hash successfully", func(t *testing.T) {
+		// given
+		pgLoginRequest := build_postgres.NewOIDCLoginRequestBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgLoginRequest.StateHash).SetArg(1, pgLoginRequest).Return(nil)
+
+		loginRequestRepository := postgres.NewOIDCLoginRequestRepository(mockedDB)
+
+		// when
+		result, err := loginRequestRepository.GetByStateHash(context.Background(), pgLoginRequest.StateHash)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgLoginRequest.ID, result.ID)
+		assert.Equal(t, pgLoginRequest.Nonce, result.Nonce)
+		assert.Equal(t, pgLoginRequest.CodeVerifier, result.CodeVerifier)
+	})
+
+	t.Run("should return not found error when the login request does not exist", func(t *testing.T) {
+		// given
+		stateHash := domain.HashSecretToken("unknown")
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, stateHash).Return(sql.ErrNoRows)
+
+		loginRequestRepository := postgres.NewOIDCLoginRequestRepository(mockedDB)
+
+		// when
+		result, err := loginRequestRepository.GetByStateHash(context.Background(), stateHash)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_oidcLoginRequestRepository_Redeem(t *testing.T) {
+	updateQuery := "UPDATE oidc_login_requests SET used_at = NOW() WHERE (id = $1 AND used_at IS NULL AND expires_at > NOW())"
+	loginRequestID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+
+	t.Run("should mark the login request as used", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, loginRequestID).Return(driver.RowsAffected(1), nil)
+
+		loginRequestRepository := postgres.NewOIDCLoginRequestRepository(mockedDB)
+
+		// when
+		err := loginRequestRepository.Redeem(context.Background(), loginRequestID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return unauthorized error when the login request can no longer be used", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, loginRequestID).Return(driver.RowsAffected(0), nil)
+
+		loginRequestRepository := postgres.NewOIDCLoginRequestRepository(mockedDB)
+
+		// when
+		err := loginRequestRepository.Redeem(context.Background(), loginRequestID)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid or expired login request")
+	})
+}
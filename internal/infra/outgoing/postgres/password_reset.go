@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type PasswordResetToken struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func mapPasswordResetTokenToDomain(passwordResetToken PasswordResetToken) (*domain.PasswordResetToken, error) {
+	domainPasswordResetToken := domain.PasswordResetToken{
+		ID:        passwordResetToken.ID,
+		UserID:    passwordResetToken.UserID,
+		TokenHash: passwordResetToken.TokenHash,
+		UsedAt:    passwordResetToken.UsedAt,
+		ExpiresAt: passwordResetToken.ExpiresAt,
+		CreatedAt: passwordResetToken.CreatedAt,
+	}
+
+	if err := domainPasswordResetToken.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainPasswordResetToken, nil
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type passwordResetRepository struct {
+	db DB
+}
+
+func NewPasswordResetRepository(db DB) domain.PasswordResetRepository {
+	return &passwordResetRepository{
+		db: db,
+	}
+}
+
+func (r *passwordResetRepository) Create(ctx context.Context, passwordResetToken domain.PasswordResetToken) error {
+	query, args, err := squirrel.Insert("password_reset_tokens").
+		Columns("id", "user_id", "token_hash", "expires_at", "created_at").
+		Values(passwordResetToken.ID, passwordResetToken.UserID, passwordResetToken.TokenHash, passwordResetToken.ExpiresAt, passwordResetToken.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building password reset token insert query: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting password reset token:", err)
+		return fmt.Errorf("error inserting password reset token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *passwordResetRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	query, args, err := squirrel.Select("*").
+		From("password_reset_tokens").
+		Where(squirrel.Eq{"token_hash": tokenHash}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building password reset token select query: %w", err)
+	}
+
+	var passwordResetToken PasswordResetToken
+	err = r.db.GetContext(ctx, &passwordResetToken, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("password reset token not found")
+		}
+		return nil, fmt.Errorf("error getting password reset token: %w", err)
+	}
+
+	return mapPasswordResetTokenToDomain(passwordResetToken)
+}
+
+func (r *passwordResetRepository) Redeem(ctx context.Context, tokenID string, user domain.User) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// the token state is checked in the update itself so concurrent requests cannot use it twice
+	query, args, err := squirrel.Update("password_reset_tokens").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.And{
+			squirrel.Eq{"id": tokenID},
+			squirrel.Eq{"used_at": nil},
+			squirrel.Expr("expires_at > NOW()"),
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building password reset token update query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating password reset token:", err)
+		return fmt.Errorf("error updating password reset token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewConflictError("password reset token has already been used or has expired")
+	}
+
+	query, args, err = squirrel.Update("users").
+		Set("password", user.Password).
+		Set("password_changed_at", user.PasswordChangedAt).
+		Set("updated_at", user.UpdatedAt).
+		Where(squirrel.Eq{"id": user.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building users update query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating user password:", err)
+		return fmt.Errorf("error updating user password: %w", err)
+	}
+
+	if err := revokeSessions(ctx, tx, squirrel.Eq{"user_id": user.ID}, squirrel.Eq{"user_id": user.ID}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
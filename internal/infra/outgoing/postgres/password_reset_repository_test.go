@@ -0,0 +1,178 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/build_postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/mock_postgres"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_passwordResetRepository_Create(t *testing.T) {
+	insertQuery := "INSERT INTO password_reset_tokens (id,user_id,token_hash,expires_at,created_at) VALUES ($1,$2,$3,$4,$5)"
+
+	t.Run("should create password reset token successfully", func(t *testing.T) {
+		// given
+		passwordResetToken := build_domain.NewPasswordResetTokenBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, passwordResetToken.ID, passwordResetToken.UserID, passwordResetToken.TokenHash, passwordResetToken.ExpiresAt, passwordResetToken.CreatedAt).Return(nil, nil)
+
+		passwordResetRepository := postgres.NewPasswordResetRepository(mockedDB)
+
+		// when
+		err := passwordResetRepository.Create(context.Background(), passwordResetToken)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when insert fails", func(t *testing.T) {
+		// given
+		passwordResetToken := build_domain.NewPasswordResetTokenBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+		passwordResetRepository := postgres.NewPasswordResetRepository(mockedDB)
+
+		// when
+		err := passwordResetRepository.Create(context.Background(), passwordResetToken)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_passwordResetRepository_GetByTokenHash(t *testing.T) {
+	selectQuery := "SELECT * FROM password_reset_tokens WHERE token_hash = $1"
+
+	t.Run("should get password reset token by hash successfully", func(t *testing.T) {
+		// given
+		pgPasswordResetToken := build_postgres.NewPasswordResetTokenBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgPasswordResetToken.TokenHash).SetArg(1, pgPasswordResetToken).Return(nil)
+
+		passwordResetRepository := postgres.NewPasswordResetRepository(mockedDB)
+
+		// when
+		result, err := passwordResetRepository.GetByTokenHash(context.Background(), pgPasswordResetToken.TokenHash)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgPasswordResetToken.ID, result.ID)
+		assert.Equal(t, pgPasswordResetToken.UserID, result.UserID)
+		assert.Equal(t, pgPasswordResetToken.ExpiresAt, result.ExpiresAt)
+	})
+
+	t.Run("should return not found error when token does not exist", func(t *testing.T) {
+		// given
+		tokenHash := domain.HashSecretToken("unknown")
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, tokenHash).Return(sql.ErrNoRows)
+
+		passwordResetRepository := postgres.NewPasswordResetRepository(mockedDB)
+
+		// when
+		result, err := passwordResetRepository.GetByTokenHash(context.Background(), tokenHash)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "password reset token not found")
+	})
+}
+
+func Test_passwordResetRepository_Redeem(t *testing.T) {
+	tokenQuery := "UPDATE password_reset_tokens SET used_at = NOW() WHERE (id = $1 AND used_at IS NULL AND expires_at > NOW())"
+	userQuery := "UPDATE users SET password = $1, password_changed_at = $2, updated_at = $3 WHERE id = $4"
+
+	t.Run("should mark the token as used, store the new password and revoke every session", func(t *testing.T) {
+		// given
+		changedAt := time.Now()
+		user := build_domain.NewUserBuilder().WithPassword("new_hash").WithPasswordChangedAt(&changedAt).Build()
+		tokenID := build_domain.NewPasswordResetTokenBuilder().Build().ID
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), tokenQuery, tokenID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), userQuery, user.Password, user.PasswordChangedAt, user.UpdatedAt, user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), revokeUserSessionsQuery, user.ID).Return(driver.RowsAffected(2), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), revokeUserRefreshTokensQuery, user.ID).Return(driver.RowsAffected(3), nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		passwordResetRepository := postgres.NewPasswordResetRepository(mockedDB)
+
+		// when
+		err := passwordResetRepository.Redeem(context.Background(), tokenID, user)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when the token can no longer be used", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		tokenID := build_domain.NewPasswordResetTokenBuilder().Build().ID
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), tokenQuery, tokenID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		passwordResetRepository := postgres.NewPasswordResetRepository(mockedDB)
+
+		// when
+		err := passwordResetRepository.Redeem(context.Background(), tokenID, user)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+		assert.EqualError(t, conflictErr, "password reset token has already been used or has expired")
+	})
+
+	t.Run("should return error when updating the user fails", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		tokenID := build_domain.NewPasswordResetTokenBuilder().Build().ID
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), tokenQuery, tokenID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), userQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		passwordResetRepository := postgres.NewPasswordResetRepository(mockedDB)
+
+		// when
+		err := passwordResetRepository.Redeem(context.Background(), tokenID, user)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
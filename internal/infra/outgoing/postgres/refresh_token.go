@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type RefreshToken struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	SessionID string     `db:"session_id"`
+	TokenHash string     `db:"token_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func mapRefreshTokenToDomain(refreshToken RefreshToken) (*domain.RefreshToken, error) {
+	domainRefreshToken := domain.RefreshToken{
+		ID:        refreshToken.ID,
+		UserID:    refreshToken.UserID,
+		SessionID: refreshToken.SessionID,
+		TokenHash: refreshToken.TokenHash,
+		UsedAt:    refreshToken.UsedAt,
+		RevokedAt: refreshToken.RevokedAt,
+		ExpiresAt: refreshToken.ExpiresAt,
+		CreatedAt: refreshToken.CreatedAt,
+	}
+
+	if err := domainRefreshToken.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainRefreshToken, nil
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type refreshTokenRepository struct {
+	db DB
+}
+
+func NewRefreshTokenRepository(db DB) domain.RefreshTokenRepository {
+	return &refreshTokenRepository{
+		db: db,
+	}
+}
+
+func (r *refreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	query, args, err := squirrel.Select("*").
+		From("refresh_tokens").
+		Where(squirrel.Eq{"token_hash": tokenHash}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building refresh token select query: %w", err)
+	}
+
+	var refreshToken RefreshToken
+	err = r.db.GetContext(ctx, &refreshToken, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("refresh token not found")
+		}
+		return nil, fmt.Errorf("error getting refresh token: %w", err)
+	}
+
+	return mapRefreshTokenToDomain(refreshToken)
+}
+
+func (r *refreshTokenRepository) Rotate(ctx context.Context, tokenID string, replacement domain.RefreshToken) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// the token state is checked in the update itself so concurrent requests cannot exchange it twice
+	query, args, err := squirrel.Update("refresh_tokens").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.And{
+			squirrel.Eq{"id": tokenID},
+			squirrel.Eq{"used_at": nil},
+			squirrel.Eq{"revoked_at": nil},
+			squirrel.Expr("expires_at > NOW()"),
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building refresh token update query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating refresh token:", err)
+		return fmt.Errorf("error updating refresh token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewConflictError("refresh token has already been used, has been revoked or has expired")
+	}
+
+	query, args, err = buildRefreshTokenInsertQuery(replacement)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting refresh token:", err)
+		return fmt.Errorf("error inserting refresh token: %w", err)
+	}
+
+	query, args, err = squirrel.Update("sessions").
+		Set("expires_at", replacement.ExpiresAt).
+		Where(squirrel.Eq{"id": replacement.SessionID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building session update query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error extending session:", err)
+		return fmt.Errorf("error extending session: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func buildRefreshTokenInsertQuery(refreshToken domain.RefreshToken) (string, []any, error) {
+	query, args, err := squirrel.Insert("refresh_tokens").
+		Columns("id", "user_id", "session_id", "token_hash", "expires_at", "created_at").
+		Values(refreshToken.ID, refreshToken.UserID, refreshToken.SessionID, refreshToken.TokenHash, refreshToken.ExpiresAt, refreshToken.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return "", nil, fmt.Errorf("error building refresh token insert query: %w", err)
+	}
+
+	return query, args, nil
+}
@@ -0,0 +1,117 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/build_postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/mock_postgres"
+	"go.uber.org/mock/gomock"
+)
+
+const refreshTokenInsertQuery = "INSERT INTO refresh_tokens (id,user_id,session_id,token_hash,expires_at,created_at) VALUES ($1,$2,$3,$4,$5,$6)"
+
+func Test_refreshTokenRepository_GetByTokenHash(t *testing.T) {
+	selectQuery := "SELECT * FROM refresh_tokens WHERE token_hash = $1"
+
+	t.Run("should get refresh token by hash successfully", func(t *testing.T) {
+		// given
+		usedAt := time.Now().UTC()
+		pgRefreshToken := build_postgres.NewRefreshTokenBuilder().WithUsedAt(&usedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgRefreshToken.TokenHash).SetArg(1, pgRefreshToken).Return(nil)
+
+		refreshTokenRepository := postgres.NewRefreshTokenRepository(mockedDB)
+
+		// when
+		result, err := refreshTokenRepository.GetByTokenHash(context.Background(), pgRefreshToken.TokenHash)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgRefreshToken.ID, result.ID)
+		assert.Equal(t, pgRefreshToken.SessionID, result.SessionID)
+		assert.Equal(t, pgRefreshToken.UsedAt, result.UsedAt)
+	})
+
+	t.Run("should return not found error when token does not exist", func(t *testing.T) {
+		// given
+		tokenHash := domain.HashSecretToken("unknown")
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, tokenHash).Return(sql.ErrNoRows)
+
+		refreshTokenRepository := postgres.NewRefreshTokenRepository(mockedDB)
+
+		// when
+		result, err := refreshTokenRepository.GetByTokenHash(context.Background(), tokenHash)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "refresh token not found")
+	})
+}
+
+func Test_refreshTokenRepository_Rotate(t *testing.T) {
+	updateQuery := "UPDATE refresh_tokens SET used_at = NOW() WHERE (id = $1 AND used_at IS NULL AND revoked_at IS NULL AND expires_at > NOW())"
+	sessionQuery := "UPDATE sessions SET expires_at = $1 WHERE id = $2"
+
+	t.Run("should mark the token as used, store its replacement and extend the session", func(t *testing.T) {
+		// given
+		tokenID := build_domain.NewRefreshTokenBuilder().Build().ID
+		replacement := build_domain.NewRefreshTokenBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateQuery, tokenID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), refreshTokenInsertQuery, replacement.ID, replacement.UserID, replacement.SessionID, replacement.TokenHash, replacement.ExpiresAt, replacement.CreatedAt).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), sessionQuery, replacement.ExpiresAt, replacement.SessionID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		refreshTokenRepository := postgres.NewRefreshTokenRepository(mockedDB)
+
+		// when
+		err := refreshTokenRepository.Rotate(context.Background(), tokenID, replacement)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when the token can no longer be exchanged", func(t *testing.T) {
+		// given
+		tokenID := build_domain.NewRefreshTokenBuilder().Build().ID
+		replacement := build_domain.NewRefreshTokenBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateQuery, tokenID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		refreshTokenRepository := postgres.NewRefreshTokenRepository(mockedDB)
+
+		// when
+		err := refreshTokenRepository.Rotate(context.Background(), tokenID, replacement)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
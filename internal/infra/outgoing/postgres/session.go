@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type Session struct {
+	ID         string     `db:"id"`
+	UserID     string     `db:"user_id"`
+	UserAgent  string     `db:"user_agent"`
+	IPAddress  string     `db:"ip_address"`
+	LastSeenAt time.Time  `db:"last_seen_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+func mapSessionToDomain(session Session) (*domain.Session, error) {
+	domainSession := domain.Session{
+		ID:         session.ID,
+		UserID:     session.UserID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		LastSeenAt: session.LastSeenAt,
+		RevokedAt:  session.RevokedAt,
+		ExpiresAt:  session.ExpiresAt,
+		CreatedAt:  session.CreatedAt,
+	}
+
+	if err := domainSession.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainSession, nil
+}
+
+func mapSessionsToDomain(sessions []Session) ([]domain.Session, error) {
+	domainSessions := make([]domain.Session, 0, len(sessions))
+
+	for _, model := range sessions {
+		session, err := mapSessionToDomain(model)
+		if err != nil {
+			return nil, err
+		}
+
+		domainSessions = append(domainSessions, *session)
+	}
+
+	return domainSessions, nil
+}
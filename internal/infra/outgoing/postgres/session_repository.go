@@ -0,0 +1,221 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type sessionRepository struct {
+	db DB
+}
+
+func NewSessionRepository(db DB) domain.SessionRepository {
+	return &sessionRepository{
+		db: db,
+	}
+}
+
+func (r *sessionRepository) Create(ctx context.Context, session domain.Session, refreshToken domain.RefreshToken) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := squirrel.Insert("sessions").
+		Columns("id", "user_id", "user_agent", "ip_address", "last_seen_at", "expires_at", "created_at").
+		Values(session.ID, session.UserID, session.UserAgent, session.IPAddress, session.LastSeenAt, session.ExpiresAt, session.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building session insert query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting session:", err)
+		return fmt.Errorf("error inserting session: %w", err)
+	}
+
+	query, args, err = buildRefreshTokenInsertQuery(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting refresh token:", err)
+		return fmt.Errorf("error inserting refresh token: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) GetByID(ctx context.Context, id string) (*domain.Session, error) {
+	query, args, err := squirrel.Select("*").
+		From("sessions").
+		Where(squirrel.Eq{"id": id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building session select query: %w", err)
+	}
+
+	var session Session
+	err = r.db.GetContext(ctx, &session, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("session not found")
+		}
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code.Name() == POSTGRES_INVALID_TEXT_REPRESENTATION {
+			return nil, domain.NewResourceNotFoundError("session not found")
+		}
+		return nil, fmt.Errorf("error getting session: %w", err)
+	}
+
+	return mapSessionToDomain(session)
+}
+
+func (r *sessionRepository) ListActiveByUserID(ctx context.Context, userID string) ([]domain.Session, error) {
+	query, args, err := squirrel.Select("*").
+		From("sessions").
+		Where(squirrel.And{
+			squirrel.Eq{"user_id": userID},
+			squirrel.Eq{"revoked_at": nil},
+			squirrel.Expr("expires_at > NOW()"),
+		}).
+		OrderBy("last_seen_at DESC").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building sessions select query: %w", err)
+	}
+
+	var sessions []Session
+	err = r.db.SelectContext(ctx, &sessions, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sessions: %w", err)
+	}
+
+	return mapSessionsToDomain(sessions)
+}
+
+func (r *sessionRepository) ListByUserID(ctx context.Context, userID string) ([]domain.Session, error) {
+	query, args, err := squirrel.Select("*").
+		From("sessions").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("created_at DESC").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building sessions select query: %w", err)
+	}
+
+	var sessions []Session
+	err = r.db.SelectContext(ctx, &sessions, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sessions: %w", err)
+	}
+
+	return mapSessionsToDomain(sessions)
+}
+
+func (r *sessionRepository) UpdateActivity(ctx context.Context, session domain.Session) error {
+	query, args, err := squirrel.Update("sessions").
+		Set("user_agent", session.UserAgent).
+		Set("ip_address", session.IPAddress).
+		Set("last_seen_at", session.LastSeenAt).
+		Where(squirrel.Eq{"id": session.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building session update query: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating session activity:", err)
+		return fmt.Errorf("error updating session activity: %w", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) Revoke(ctx context.Context, id string) error {
+	return r.revoke(ctx, squirrel.Eq{"id": id}, squirrel.Eq{"session_id": id})
+}
+
+func (r *sessionRepository) RevokeAllByUserID(ctx context.Context, userID string) error {
+	return r.revoke(ctx, squirrel.Eq{"user_id": userID}, squirrel.Eq{"user_id": userID})
+}
+
+func (r *sessionRepository) revoke(ctx context.Context, sessionFilter, refreshTokenFilter squirrel.Eq) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := revokeSessions(ctx, tx, sessionFilter, refreshTokenFilter); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// revokeSessions ends the matching sessions and revokes their refresh tokens, so they can no longer be renewed either.
+func revokeSessions(ctx context.Context, executor QueryExecutor, sessionFilter, refreshTokenFilter squirrel.Eq) error {
+	query, args, err := squirrel.Update("sessions").
+		Set("revoked_at", squirrel.Expr("NOW()")).
+		Where(squirrel.And{
+			sessionFilter,
+			squirrel.Eq{"revoked_at": nil},
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building session update query: %w", err)
+	}
+
+	_, err = executor.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error revoking sessions:", err)
+		return fmt.Errorf("error revoking sessions: %w", err)
+	}
+
+	query, args, err = squirrel.Update("refresh_tokens").
+		Set("revoked_at", squirrel.Expr("NOW()")).
+		Where(squirrel.And{
+			refreshTokenFilter,
+			squirrel.Eq{"revoked_at": nil},
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building refresh token update query: %w", err)
+	}
+
+	_, err = executor.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error revoking refresh tokens:", err)
+		return fmt.Errorf("error revoking refresh tokens: %w", err)
+	}
+
+	return nil
+}
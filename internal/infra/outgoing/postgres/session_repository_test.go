@@ -0,0 +1,318 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/build_postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/mock_postgres"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	revokeUserSessionsQuery      = "UPDATE sessions SET revoked_at = NOW() WHERE (user_id = $1 AND revoked_at IS NULL)"
+	revokeUserRefreshTokensQuery = "UPDATE refresh_tokens SET revoked_at = NOW() WHERE (user_id = $1 AND revoked_at IS NULL)"
+)
+
+func Test_sessionRepository_Create(t *testing.T) {
+	insertQuery := "INSERT INTO sessions (id,user_id,user_agent,ip_address,last_seen_at,expires_at,created_at) VALUES ($1,$2,$3,$4,$5,$6,$7)"
+
+	t.Run("should create the session with its first refresh token", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().Build()
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithSessionID(session.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, session.ID, session.UserID, session.UserAgent, session.IPAddress, session.LastSeenAt, session.ExpiresAt, session.CreatedAt).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), refreshTokenInsertQuery, refreshToken.ID, refreshToken.UserID, session.ID, refreshToken.TokenHash, refreshToken.ExpiresAt, refreshToken.CreatedAt).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		err := sessionRepository.Create(context.Background(), session, refreshToken)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when inserting the refresh token fails", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().Build()
+		refreshToken := build_domain.NewRefreshTokenBuilder().WithSessionID(session.ID).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), refreshTokenInsertQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		err := sessionRepository.Create(context.Background(), session, refreshToken)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_sessionRepository_GetByID(t *testing.T) {
+	selectQuery := "SELECT * FROM sessions WHERE id = $1"
+
+	t.Run("should get session by id successfully", func(t *testing.T) {
+		// given
+		revokedAt := time.Now().UTC()
+		pgSession := build_postgres.NewSessionBuilder().WithRevokedAt(&revokedAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgSession.ID).SetArg(1, pgSession).Return(nil)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		result, err := sessionRepository.GetByID(context.Background(), pgSession.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgSession.ID, result.ID)
+		assert.Equal(t, pgSession.UserID, result.UserID)
+		assert.Equal(t, pgSession.UserAgent, result.UserAgent)
+		assert.Equal(t, pgSession.IPAddress, result.IPAddress)
+		assert.Equal(t, pgSession.RevokedAt, result.RevokedAt)
+	})
+
+	t.Run("should return not found error when session does not exist", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, "some-session-id").Return(sql.ErrNoRows)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		result, err := sessionRepository.GetByID(context.Background(), "some-session-id")
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "session not found")
+	})
+
+	t.Run("should return not found error when id is not a valid uuid", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, "invalid").Return(&pq.Error{Code: pq.ErrorCode("22P02")})
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		result, err := sessionRepository.GetByID(context.Background(), "invalid")
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_sessionRepository_ListActiveByUserID(t *testing.T) {
+	selectQuery := "SELECT * FROM sessions WHERE (user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()) ORDER BY last_seen_at DESC"
+
+	t.Run("should list the active sessions of the user", func(t *testing.T) {
+		// given
+		userID := build_domain.NewUserBuilder().Build().ID
+		pgSessions := []postgres.Session{
+			build_postgres.NewSessionBuilder().WithUserID(userID).Build(),
+			build_postgres.NewSessionBuilder().WithUserID(userID).Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, userID).SetArg(1, pgSessions).Return(nil)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		result, err := sessionRepository.ListActiveByUserID(context.Background(), userID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, pgSessions[0].ID, result[0].ID)
+		assert.Equal(t, pgSessions[1].ID, result[1].ID)
+	})
+
+	t.Run("should return error when select fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, "some-user-id").Return(assert.AnError)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		result, err := sessionRepository.ListActiveByUserID(context.Background(), "some-user-id")
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_sessionRepository_ListByUserID(t *testing.T) {
+	selectQuery := "SELECT * FROM sessions WHERE user_id = $1 ORDER BY created_at DESC"
+
+	t.Run("should list every session of the user", func(t *testing.T) {
+		// given
+		userID := build_domain.NewUserBuilder().Build().ID
+		revokedAt := time.Now()
+		pgSessions := []postgres.Session{
+			build_postgres.NewSessionBuilder().WithUserID(userID).Build(),
+			build_postgres.NewSessionBuilder().WithUserID(userID).WithRevokedAt(&revokedAt).Build(),
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, userID).SetArg(1, pgSessions).Return(nil)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		result, err := sessionRepository.ListByUserID(context.Background(), userID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, pgSessions[0].ID, result[0].ID)
+		assert.NotNil(t, result[1].RevokedAt)
+	})
+
+	t.Run("should return error when select fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().SelectContext(gomock.Any(), gomock.Any(), selectQuery, "some-user-id").Return(assert.AnError)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		result, err := sessionRepository.ListByUserID(context.Background(), "some-user-id")
+
+		// then
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_sessionRepository_UpdateActivity(t *testing.T) {
+	updateQuery := "UPDATE sessions SET user_agent = $1, ip_address = $2, last_seen_at = $3 WHERE id = $4"
+
+	t.Run("should store the device and last-seen time", func(t *testing.T) {
+		// given
+		session := build_domain.NewSessionBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, session.UserAgent, session.IPAddress, session.LastSeenAt, session.ID).Return(driver.RowsAffected(1), nil)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		err := sessionRepository.UpdateActivity(context.Background(), session)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func Test_sessionRepository_Revoke(t *testing.T) {
+	sessionQuery := "UPDATE sessions SET revoked_at = NOW() WHERE (id = $1 AND revoked_at IS NULL)"
+	refreshTokenQuery := "UPDATE refresh_tokens SET revoked_at = NOW() WHERE (session_id = $1 AND revoked_at IS NULL)"
+
+	t.Run("should revoke the session and its refresh tokens", func(t *testing.T) {
+		// given
+		sessionID := build_domain.NewSessionBuilder().Build().ID
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), sessionQuery, sessionID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), refreshTokenQuery, sessionID).Return(driver.RowsAffected(2), nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		err := sessionRepository.Revoke(context.Background(), sessionID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when revoking the session fails", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), sessionQuery, "some-session-id").Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		err := sessionRepository.Revoke(context.Background(), "some-session-id")
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func Test_sessionRepository_RevokeAllByUserID(t *testing.T) {
+	t.Run("should revoke every session of the user and their refresh tokens", func(t *testing.T) {
+		// given
+		userID := build_domain.NewUserBuilder().Build().ID
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), revokeUserSessionsQuery, userID).Return(driver.RowsAffected(2), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), revokeUserRefreshTokensQuery, userID).Return(driver.RowsAffected(3), nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		sessionRepository := postgres.NewSessionRepository(mockedDB)
+
+		// when
+		err := sessionRepository.RevokeAllByUserID(context.Background(), userID)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
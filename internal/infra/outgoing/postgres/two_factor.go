@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type TwoFactor struct {
+	UserID       string     `db:"user_id"`
+	Secret       string     `db:"secret"`
+	EnabledAt    *time.Time `db:"enabled_at"`
+	LastUsedStep int64      `db:"last_used_step"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+func mapTwoFactorToDomain(twoFactor TwoFactor) (*domain.TwoFactor, error) {
+	domainTwoFactor := domain.TwoFactor{
+		UserID:       twoFactor.UserID,
+		Secret:       twoFactor.Secret,
+		EnabledAt:    twoFactor.EnabledAt,
+		LastUsedStep: twoFactor.LastUsedStep,
+		CreatedAt:    twoFactor.CreatedAt,
+	}
+
+	if err := domainTwoFactor.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainTwoFactor, nil
+}
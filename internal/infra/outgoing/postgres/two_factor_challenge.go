@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type TwoFactorChallenge struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+func mapTwoFactorChallengeToDomain(challenge TwoFactorChallenge) (*domain.TwoFactorChallenge, error) {
+	domainChallenge := domain.TwoFactorChallenge{
+		ID:        challenge.ID,
+		UserID:    challenge.UserID,
+		TokenHash: challenge.TokenHash,
+		UsedAt:    challenge.UsedAt,
+		ExpiresAt: challenge.ExpiresAt,
+		CreatedAt: challenge.CreatedAt,
+	}
+
+	if err := domainChallenge.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainChallenge, nil
+}
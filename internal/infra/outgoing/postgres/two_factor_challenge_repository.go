@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type twoFactorChallengeRepository struct {
+	db DB
+}
+
+func NewTwoFactorChallengeRepository(db DB) domain.TwoFactorChallengeRepository {
+	return &twoFactorChallengeRepository{
+		db: db,
+	}
+}
+
+func (r *twoFactorChallengeRepository) Create(ctx context.Context, challenge domain.TwoFactorChallenge) error {
+	query, args, err := squirrel.Insert("two_factor_challenges").
+		Columns("id", "user_id", "token_hash", "expires_at", "created_at").
+		Values(challenge.ID, challenge.UserID, challenge.TokenHash, challenge.ExpiresAt, challenge.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building two-factor challenge insert query: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting two-factor challenge:", err)
+		return fmt.Errorf("error inserting two-factor challenge: %w", err)
+	}
+
+	return nil
+}
+
+func (r *twoFactorChallengeRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.TwoFactorChallenge, error) {
+	query, args, err := squirrel.Select("*").
+		From("two_factor_challenges").
+		Where(squirrel.Eq{"token_hash": tokenHash}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building two-factor challenge select query: %w", err)
+	}
+
+	var challenge TwoFactorChallenge
+	err = r.db.GetContext(ctx, &challenge, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("two-factor challenge not found")
+		}
+		return nil, fmt.Errorf("error getting two-factor challenge: %w", err)
+	}
+
+	return mapTwoFactorChallengeToDomain(challenge)
+}
+
+func (r *twoFactorChallengeRepository) Redeem(ctx context.Context, challengeID string) error {
+	// the challenge state is checked in the update itself so concurrent requests cannot use it twice
+	query, args, err := squirrel.Update("two_factor_challenges").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.And{
+			squirrel.Eq{"id": challengeID},
+			squirrel.Eq{"used_at": nil},
+			squirrel.Expr("expires_at > NOW()"),
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building two-factor challenge update query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating two-factor challenge:", err)
+		return fmt.Errorf("error updating two-factor challenge: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewUnauthorizedError("invalid or expired two-factor challenge")
+	}
+
+	return nil
+}
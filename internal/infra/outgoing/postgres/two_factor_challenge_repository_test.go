@@ -0,0 +1,115 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/build_postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/mock_postgres"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_twoFactorChallengeRepository_Create(t *testing.T) {
+	t.Run("should create two-factor challenge successfully", func(t *testing.T) {
+		// given
+		challenge := build_domain.NewTwoFactorChallengeBuilder().Build()
+		insertQuery := "INSERT INTO two_factor_challenges (id,user_id,token_hash,expires_at,created_at) VALUES ($1,$2,$3,$4,$5)"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, challenge.ID, challenge.UserID, challenge.TokenHash, challenge.ExpiresAt, challenge.CreatedAt).Return(nil, nil)
+
+		challengeRepository := postgres.NewTwoFactorChallengeRepository(mockedDB)
+
+		// when
+		err := challengeRepository.Create(context.Background(), challenge)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func Test_twoFactorChallengeRepository_GetByTokenHash(t *testing.T) {
+	selectQuery := "SELECT * FROM two_factor_challenges WHERE token_hash = $1"
+
+	t.Run("should get two-factor challenge by hash successfully", func(t *testing.T) {
+		// given
+		pgChallenge := build_postgres.NewTwoFactorChallengeBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgChallenge.TokenHash).SetArg(1, pgChallenge).Return(nil)
+
+		challengeRepository := postgres.NewTwoFactorChallengeRepository(mockedDB)
+
+		// when
+		result, err := challengeRepository.GetByTokenHash(context.Background(), pgChallenge.TokenHash)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgChallenge.ID, result.ID)
+		assert.Equal(t, pgChallenge.UserID, result.UserID)
+	})
+
+	t.Run("should return not found error when the challenge does not exist", func(t *testing.T) {
+		// given
+		tokenHash := domain.HashSecretToken("unknown")
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, tokenHash).Return(sql.ErrNoRows)
+
+		challengeRepository := postgres.NewTwoFactorChallengeRepository(mockedDB)
+
+		// when
+		result, err := challengeRepository.GetByTokenHash(context.Background(), tokenHash)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_twoFactorChallengeRepository_Redeem(t *testing.T) {
+	updateQuery := "UPDATE two_factor_challenges SET used_at = NOW() WHERE (id = $1 AND used_at IS NULL AND expires_at > NOW())"
+	challengeID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d"
+
+	t.Run("should mark the challenge as used", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, challengeID).Return(driver.RowsAffected(1), nil)
+
+		challengeRepository := postgres.NewTwoFactorChallengeRepository(mockedDB)
+
+		// when
+		err := challengeRepository.Redeem(context.Background(), challengeID)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return unauthorized error when the challenge can no longer be used", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, challengeID).Return(driver.RowsAffected(0), nil)
+
+		challengeRepository := postgres.NewTwoFactorChallengeRepository(mockedDB)
+
+		// when
+		err := challengeRepository.Redeem(context.Background(), challengeID)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+		assert.EqualError(t, unauthorizedErr, "invalid or expired two-factor challenge")
+	})
+}
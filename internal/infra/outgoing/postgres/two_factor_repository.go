@@ -0,0 +1,256 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type twoFactorRepository struct {
+	db DB
+}
+
+func NewTwoFactorRepository(db DB) domain.TwoFactorRepository {
+	return &twoFactorRepository{
+		db: db,
+	}
+}
+
+func (r *twoFactorRepository) GetByUserID(ctx context.Context, userID string) (*domain.TwoFactor, error) {
+	query, args, err := squirrel.Select("*").
+		From("two_factor_secrets").
+		Where(squirrel.Eq{"user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building two-factor select query: %w", err)
+	}
+
+	var twoFactor TwoFactor
+	err = r.db.GetContext(ctx, &twoFactor, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("two-factor authentication not found")
+		}
+		return nil, fmt.Errorf("error getting two-factor authentication: %w", err)
+	}
+
+	return mapTwoFactorToDomain(twoFactor)
+}
+
+func (r *twoFactorRepository) Save(ctx context.Context, twoFactor domain.TwoFactor) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// only a pending enrollment is replaced, an enabled one makes the insert below fail
+	query, args, err := squirrel.Delete("two_factor_secrets").
+		Where(squirrel.And{
+			squirrel.Eq{"user_id": twoFactor.UserID},
+			squirrel.Eq{"enabled_at": nil},
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building two-factor delete query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error deleting pending two-factor enrollment:", err)
+		return fmt.Errorf("error deleting pending two-factor enrollment: %w", err)
+	}
+
+	query, args, err = squirrel.Insert("two_factor_secrets").
+		Columns("user_id", "secret", "enabled_at", "last_used_step", "created_at").
+		Values(twoFactor.UserID, twoFactor.Secret, twoFactor.EnabledAt, twoFactor.LastUsedStep, twoFactor.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building two-factor insert query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting two-factor enrollment:", err)
+
+		var currentError *pq.Error
+		if errors.As(err, &currentError) && currentError.Code.Name() == POSTGRES_UNIQUE_VIOLATION {
+			return domain.NewConflictError("two-factor authentication is already enabled")
+		}
+
+		return fmt.Errorf("error inserting two-factor enrollment: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) Enable(ctx context.Context, twoFactor domain.TwoFactor, recoveryCodes []domain.RecoveryCode) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := squirrel.Update("two_factor_secrets").
+		Set("enabled_at", twoFactor.EnabledAt).
+		Set("last_used_step", twoFactor.LastUsedStep).
+		Where(squirrel.And{
+			squirrel.Eq{"user_id": twoFactor.UserID},
+			squirrel.Eq{"enabled_at": nil},
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building two-factor update query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error enabling two-factor authentication:", err)
+		return fmt.Errorf("error enabling two-factor authentication: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewConflictError("two-factor authentication is already enabled")
+	}
+
+	query, args, err = squirrel.Delete("two_factor_recovery_codes").
+		Where(squirrel.Eq{"user_id": twoFactor.UserID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building recovery codes delete query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error deleting recovery codes:", err)
+		return fmt.Errorf("error deleting recovery codes: %w", err)
+	}
+
+	recoveryCodesInsert := squirrel.Insert("two_factor_recovery_codes").
+		Columns("id", "user_id", "code_hash", "created_at").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, recoveryCode := range recoveryCodes {
+		recoveryCodesInsert = recoveryCodesInsert.Values(recoveryCode.ID, recoveryCode.UserID, recoveryCode.CodeHash, recoveryCode.CreatedAt)
+	}
+
+	query, args, err = recoveryCodesInsert.ToSql()
+	if err != nil {
+		return fmt.Errorf("error building recovery codes insert query: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting recovery codes:", err)
+		return fmt.Errorf("error inserting recovery codes: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) UseStep(ctx context.Context, userID string, step int64) error {
+	// the step is compared in the update itself so concurrent requests cannot use the same code twice
+	query, args, err := squirrel.Update("two_factor_secrets").
+		Set("last_used_step", step).
+		Where(squirrel.And{
+			squirrel.Eq{"user_id": userID},
+			squirrel.Lt{"last_used_step": step},
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building two-factor update query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating two-factor last used step:", err)
+		return fmt.Errorf("error updating two-factor last used step: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewUnauthorizedError("code has already been used")
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) UseRecoveryCode(ctx context.Context, userID, codeHash string) error {
+	query, args, err := squirrel.Update("two_factor_recovery_codes").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.And{
+			squirrel.Eq{"user_id": userID},
+			squirrel.Eq{"code_hash": codeHash},
+			squirrel.Eq{"used_at": nil},
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building recovery code update query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating recovery code:", err)
+		return fmt.Errorf("error updating recovery code: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewUnauthorizedError("recovery code is invalid or has already been used")
+	}
+
+	return nil
+}
+
+// Delete relies on the recovery codes being removed along with the secret they reference.
+func (r *twoFactorRepository) Delete(ctx context.Context, userID string) error {
+	query, args, err := squirrel.Delete("two_factor_secrets").
+		Where(squirrel.Eq{"user_id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building two-factor delete query: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error deleting two-factor authentication:", err)
+		return fmt.Errorf("error deleting two-factor authentication: %w", err)
+	}
+
+	return nil
+}
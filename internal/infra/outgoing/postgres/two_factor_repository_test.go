@@ -0,0 +1,265 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/build_postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/mock_postgres"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_twoFactorRepository_GetByUserID(t *testing.T) {
+	selectQuery := "SELECT * FROM two_factor_secrets WHERE user_id = $1"
+
+	t.Run("should get two-factor authentication by user ID successfully", func(t *testing.T) {
+		// given
+		enabledAt := time.Now().UTC()
+		pgTwoFactor := build_postgres.NewTwoFactorBuilder().WithEnabledAt(&enabledAt).WithLastUsedStep(42).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgTwoFactor.UserID).SetArg(1, pgTwoFactor).Return(nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		result, err := twoFactorRepository.GetByUserID(context.Background(), pgTwoFactor.UserID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgTwoFactor.Secret, result.Secret)
+		assert.True(t, result.IsEnabled())
+		assert.Equal(t, int64(42), result.LastUsedStep)
+	})
+
+	t.Run("should return not found error when the user never enrolled", func(t *testing.T) {
+		// given
+		userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, userID).Return(sql.ErrNoRows)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		result, err := twoFactorRepository.GetByUserID(context.Background(), userID)
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_twoFactorRepository_Save(t *testing.T) {
+	deleteQuery := "DELETE FROM two_factor_secrets WHERE (user_id = $1 AND enabled_at IS NULL)"
+	insertQuery := "INSERT INTO two_factor_secrets (user_id,secret,enabled_at,last_used_step,created_at) VALUES ($1,$2,$3,$4,$5)"
+
+	t.Run("should replace the pending enrollment", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteQuery, twoFactor.UserID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, twoFactor.UserID, twoFactor.Secret, twoFactor.EnabledAt, twoFactor.LastUsedStep, twoFactor.CreatedAt).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		err := twoFactorRepository.Save(context.Background(), twoFactor)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when two-factor authentication is already enabled", func(t *testing.T) {
+		// given
+		twoFactor := build_domain.NewTwoFactorBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteQuery, twoFactor.UserID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &pq.Error{Code: pq.ErrorCode("23505")})
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		err := twoFactorRepository.Save(context.Background(), twoFactor)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func Test_twoFactorRepository_Enable(t *testing.T) {
+	updateQuery := "UPDATE two_factor_secrets SET enabled_at = $1, last_used_step = $2 WHERE (user_id = $3 AND enabled_at IS NULL)"
+	deleteQuery := "DELETE FROM two_factor_recovery_codes WHERE user_id = $1"
+	insertQuery := "INSERT INTO two_factor_recovery_codes (id,user_id,code_hash,created_at) VALUES ($1,$2,$3,$4)"
+
+	t.Run("should enable the enrollment and replace the recovery codes", func(t *testing.T) {
+		// given
+		enabledAt := time.Now()
+		twoFactor := build_domain.NewTwoFactorBuilder().WithEnabledAt(&enabledAt).WithLastUsedStep(42).Build()
+		recoveryCode := domain.RecoveryCode{
+			ID:        "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9d",
+			UserID:    twoFactor.UserID,
+			CodeHash:  domain.HashRecoveryCode("7KQ2-MX9D-4HRT-ZP3W"),
+			CreatedAt: enabledAt,
+		}
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateQuery, twoFactor.EnabledAt, twoFactor.LastUsedStep, twoFactor.UserID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteQuery, twoFactor.UserID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), insertQuery, recoveryCode.ID, recoveryCode.UserID, recoveryCode.CodeHash, recoveryCode.CreatedAt).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		err := twoFactorRepository.Enable(context.Background(), twoFactor, []domain.RecoveryCode{recoveryCode})
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when it was enabled meanwhile", func(t *testing.T) {
+		// given
+		enabledAt := time.Now()
+		twoFactor := build_domain.NewTwoFactorBuilder().WithEnabledAt(&enabledAt).Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateQuery, gomock.Any(), gomock.Any(), gomock.Any()).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		err := twoFactorRepository.Enable(context.Background(), twoFactor, nil)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
+
+func Test_twoFactorRepository_UseStep(t *testing.T) {
+	updateQuery := "UPDATE two_factor_secrets SET last_used_step = $1 WHERE (user_id = $2 AND last_used_step < $3)"
+	userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+
+	t.Run("should record the step of the accepted code", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, int64(42), userID, int64(42)).Return(driver.RowsAffected(1), nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		err := twoFactorRepository.UseStep(context.Background(), userID, 42)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return unauthorized error when the code is replayed", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, int64(42), userID, int64(42)).Return(driver.RowsAffected(0), nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		err := twoFactorRepository.UseStep(context.Background(), userID, 42)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+}
+
+func Test_twoFactorRepository_UseRecoveryCode(t *testing.T) {
+	updateQuery := "UPDATE two_factor_recovery_codes SET used_at = NOW() WHERE (user_id = $1 AND code_hash = $2 AND used_at IS NULL)"
+	userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+	codeHash := domain.HashRecoveryCode("7KQ2-MX9D-4HRT-ZP3W")
+
+	t.Run("should mark the recovery code as used", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, userID, codeHash).Return(driver.RowsAffected(1), nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		err := twoFactorRepository.UseRecoveryCode(context.Background(), userID, codeHash)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return unauthorized error when there is no unused recovery code", func(t *testing.T) {
+		// given
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), updateQuery, userID, codeHash).Return(driver.RowsAffected(0), nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		err := twoFactorRepository.UseRecoveryCode(context.Background(), userID, codeHash)
+
+		// then
+		var unauthorizedErr *domain.UnauthorizedError
+		assert.ErrorAs(t, err, &unauthorizedErr)
+	})
+}
+
+func Test_twoFactorRepository_Delete(t *testing.T) {
+	t.Run("should delete two-factor authentication of the user", func(t *testing.T) {
+		// given
+		userID := "0195c1a4-8f3e-7b8a-9c2d-4e5f6a7b8c9e"
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), "DELETE FROM two_factor_secrets WHERE user_id = $1", userID).Return(driver.RowsAffected(1), nil)
+
+		twoFactorRepository := postgres.NewTwoFactorRepository(mockedDB)
+
+		// when
+		err := twoFactorRepository.Delete(context.Background(), userID)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
@@ -7,24 +7,32 @@ import (
 )
 
 type User struct {
-	ID        string    `db:"id"`
-	Name      string    `db:"name"`
-	Surname   string    `db:"surname"`
-	Email     string    `db:"email"`
-	Password  string    `db:"password"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID                string     `db:"id"`
+	Name              string     `db:"name"`
+	Surname           string     `db:"surname"`
+	Email             string     `db:"email"`
+	Password          string     `db:"password"`
+	PasswordChangedAt *time.Time `db:"password_changed_at"`
+	EmailVerifiedAt   *time.Time `db:"email_verified_at"`
+	DeletedAt         *time.Time `db:"deleted_at"`
+	AvatarID          string     `db:"avatar_id"`
+	CreatedAt         time.Time  `db:"created_at"`
+	UpdatedAt         time.Time  `db:"updated_at"`
 }
 
 func mapUserToDomain(user User) (*domain.User, error) {
 	domainUser := domain.User{
-		ID:        user.ID,
-		Name:      user.Name,
-		Surname:   user.Surname,
-		Email:     user.Email,
-		Password:  user.Password,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:                user.ID,
+		Name:              user.Name,
+		Surname:           user.Surname,
+		Email:             user.Email,
+		Password:          user.Password,
+		PasswordChangedAt: user.PasswordChangedAt,
+		EmailVerifiedAt:   user.EmailVerifiedAt,
+		DeletedAt:         user.DeletedAt,
+		AvatarID:          user.AvatarID,
+		CreatedAt:         user.CreatedAt,
+		UpdatedAt:         user.UpdatedAt,
 	}
 
 	if err := domainUser.Validate(); err != nil {
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type UserIdentity struct {
+	ID        string    `db:"id"`
+	UserID    string    `db:"user_id"`
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func mapUserIdentityToDomain(userIdentity UserIdentity) (*domain.UserIdentity, error) {
+	domainUserIdentity := domain.UserIdentity{
+		ID:        userIdentity.ID,
+		UserID:    userIdentity.UserID,
+		Provider:  userIdentity.Provider,
+		Subject:   userIdentity.Subject,
+		CreatedAt: userIdentity.CreatedAt,
+	}
+
+	if err := domainUserIdentity.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &domainUserIdentity, nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type userIdentityRepository struct {
+	db DB
+}
+
+func NewUserIdentityRepository(db DB) domain.UserIdentityRepository {
+	return &userIdentityRepository{
+		db: db,
+	}
+}
+
+func (r *userIdentityRepository) GetByProviderSubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	query, args, err := squirrel.Select("*").
+		From("user_identities").
+		Where(squirrel.Eq{"provider": provider, "subject": subject}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("error building user identity select query: %w", err)
+	}
+
+	var userIdentity UserIdentity
+	err = r.db.GetContext(ctx, &userIdentity, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.NewResourceNotFoundError("user identity not found")
+		}
+		return nil, fmt.Errorf("error getting user identity: %w", err)
+	}
+
+	return mapUserIdentityToDomain(userIdentity)
+}
+
+func (r *userIdentityRepository) Create(ctx context.Context, userIdentity domain.UserIdentity) error {
+	query, args, err := squirrel.Insert("user_identities").
+		Columns("id", "user_id", "provider", "subject", "created_at").
+		Values(userIdentity.ID, userIdentity.UserID, userIdentity.Provider, userIdentity.Subject, userIdentity.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building user identity insert query: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error inserting user identity:", err)
+
+		var currentError *pq.Error
+		if errors.As(err, &currentError) && currentError.Code.Name() == POSTGRES_UNIQUE_VIOLATION {
+			return domain.NewConflictError("account is already linked to another identity of this provider")
+		}
+
+		return fmt.Errorf("error inserting user identity: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain/build_domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/build_postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres/mock_postgres"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_userIdentityRepository_GetByProviderSubject(t *testing.T) {
+	selectQuery := "SELECT * FROM user_identities WHERE provider = $1 AND subject = $2"
+
+	t.Run("should get user identity by provider and subject successfully", func(t *testing.T) {
+		// given
+		pgUserIdentity := build_postgres.NewUserIdentityBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgUserIdentity.Provider, pgUserIdentity.Subject).SetArg(1, pgUserIdentity).Return(nil)
+
+		userIdentityRepository := postgres.NewUserIdentityRepository(mockedDB)
+
+		// when
+		result, err := userIdentityRepository.GetByProviderSubject(context.Background(), pgUserIdentity.Provider, pgUserIdentity.Subject)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, pgUserIdentity.ID, result.ID)
+		assert.Equal(t, pgUserIdentity.UserID, result.UserID)
+	})
+
+	t.Run("should return not found error when the identity was never linked", func(t *testing.T) {
+		// given
+		pgUserIdentity := build_postgres.NewUserIdentityBuilder().WithSubject("unknown").Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().GetContext(gomock.Any(), gomock.Any(), selectQuery, pgUserIdentity.Provider, "unknown").Return(sql.ErrNoRows)
+
+		userIdentityRepository := postgres.NewUserIdentityRepository(mockedDB)
+
+		// when
+		result, err := userIdentityRepository.GetByProviderSubject(context.Background(), pgUserIdentity.Provider, "unknown")
+
+		// then
+		assert.Nil(t, result)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_userIdentityRepository_Create(t *testing.T) {
+	insertQuery := "INSERT INTO user_identities (id,user_id,provider,subject,created_at) VALUES ($1,$2,$3,$4,$5)"
+
+	t.Run("should create user identity successfully", func(t *testing.T) {
+		// given
+		userIdentity := build_domain.NewUserIdentityBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, userIdentity.ID, userIdentity.UserID, userIdentity.Provider, userIdentity.Subject, userIdentity.CreatedAt).Return(nil, nil)
+
+		userIdentityRepository := postgres.NewUserIdentityRepository(mockedDB)
+
+		// when
+		err := userIdentityRepository.Create(context.Background(), userIdentity)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return conflict error when the user is already linked to the provider", func(t *testing.T) {
+		// given
+		userIdentity := build_domain.NewUserIdentityBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), insertQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &pq.Error{Code: pq.ErrorCode("23505")})
+
+		userIdentityRepository := postgres.NewUserIdentityRepository(mockedDB)
+
+		// when
+		err := userIdentityRepository.Create(context.Background(), userIdentity)
+
+		// then
+		var conflictErr *domain.ConflictError
+		assert.ErrorAs(t, err, &conflictErr)
+	})
+}
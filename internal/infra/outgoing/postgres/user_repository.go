@@ -95,3 +95,157 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.
 	return mapUserToDomain(user)
 }
 
+
+func (r *userRepository) Update(ctx context.Context, user domain.User) error {
+	query, args, err := squirrel.Update("users").
+		Set("name", user.Name).
+		Set("surname", user.Surname).
+		Set("email", user.Email).
+		Set("password", user.Password).
+		Set("password_changed_at", user.PasswordChangedAt).
+		Set("email_verified_at", user.EmailVerifiedAt).
+		Set("avatar_id", user.AvatarID).
+		Set("updated_at", user.UpdatedAt).
+		Where(squirrel.Eq{"id": user.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building users update query: %w", err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error updating user:", err)
+
+		var currentError *pq.Error
+		if errors.As(err, &currentError) && currentError.Code.Name() == POSTGRES_UNIQUE_VIOLATION {
+			return domain.NewConflictError("the email is already registered")
+		}
+
+		return fmt.Errorf("error updating user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewResourceNotFoundError("user not found")
+	}
+
+	return nil
+}
+
+// Anonymize stores the anonymised user and removes the data that only makes sense for an active account,
+// in a single transaction so a failed deletion leaves the account untouched.
+func (r *userRepository) Anonymize(ctx context.Context, user domain.User) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// personal invites and guests may still hold the address of the user; they are cleared while the address is
+	// still stored, since the anonymised user no longer carries it
+	previousEmail := "email <> '' AND LOWER(email) = (SELECT LOWER(email) FROM users WHERE id = ?)"
+
+	query, args, err := squirrel.Delete("group_invites").
+		Where(previousEmail, user.ID).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group_invites delete query: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		log.Println("error deleting personal invites:", err)
+		return fmt.Errorf("error deleting personal invites: %w", err)
+	}
+
+	query, args, err = squirrel.Update("group_guests").
+		Set("email", "").
+		Where(previousEmail, user.ID).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building group_guests update query: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		log.Println("error clearing guest emails:", err)
+		return fmt.Errorf("error clearing guest emails: %w", err)
+	}
+
+	query, args, err = squirrel.Update("users").
+		Set("name", user.Name).
+		Set("surname", user.Surname).
+		Set("email", user.Email).
+		Set("password", user.Password).
+		Set("password_changed_at", user.PasswordChangedAt).
+		Set("email_verified_at", user.EmailVerifiedAt).
+		Set("avatar_id", user.AvatarID).
+		Set("deleted_at", user.DeletedAt).
+		Set("updated_at", user.UpdatedAt).
+		Where(squirrel.Eq{"id": user.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("error building users update query: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		log.Println("error anonymizing user:", err)
+		return fmt.Errorf("error anonymizing user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error getting rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.NewResourceNotFoundError("user not found")
+	}
+
+	// pending entries, custom templates, account tokens, sessions, two-factor credentials and linked identities are
+	// not part of any group history; dependent rows go first so their foreign keys are never left dangling
+	userOwnedRows := []struct {
+		table  string
+		column string
+	}{
+		{"group_waitlist", "user_id"},
+		{"group_join_requests", "user_id"},
+		{"group_templates", "owner_id"},
+		{"password_reset_tokens", "user_id"},
+		{"email_verification_tokens", "user_id"},
+		{"refresh_tokens", "user_id"},
+		{"sessions", "user_id"},
+		{"two_factor_challenges", "user_id"},
+		{"two_factor_recovery_codes", "user_id"},
+		{"two_factor_secrets", "user_id"},
+		{"user_identities", "user_id"},
+	}
+
+	for _, rows := range userOwnedRows {
+		query, args, err = squirrel.Delete(rows.table).
+			Where(squirrel.Eq{rows.column: user.ID}).
+			PlaceholderFormat(squirrel.Dollar).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("error building %s delete query: %w", rows.table, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			log.Println("error deleting "+rows.table+":", err)
+			return fmt.Errorf("error deleting %s: %w", rows.table, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
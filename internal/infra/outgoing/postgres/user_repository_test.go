@@ -3,6 +3,8 @@ package postgres_test
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"strings"
 	"testing"
 	"time"
 
@@ -235,3 +237,217 @@ func Test_userRepository_GetByEmail(t *testing.T) {
 	})
 }
 
+
+func Test_userRepository_Update(t *testing.T) {
+	query := "UPDATE users SET name = $1, surname = $2, email = $3, password = $4, password_changed_at = $5, email_verified_at = $6, avatar_id = $7, updated_at = $8 WHERE id = $9"
+
+	t.Run("should update user successfully", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), query, user.Name, user.Surname, user.Email, user.Password, user.PasswordChangedAt, user.EmailVerifiedAt, user.AvatarID, user.UpdatedAt, user.ID).Return(driver.RowsAffected(1), nil)
+
+		userRepository := postgres.NewUserRepository(mockedDB)
+
+		// when
+		err := userRepository.Update(context.Background(), user)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return a conflict error when the new email is already registered", func(t *testing.T) {
+		// given
+		postgresUniqueViolationError := &pq.Error{Code: pq.ErrorCode("23505")}
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), query, user.Name, user.Surname, user.Email, user.Password, user.PasswordChangedAt, user.EmailVerifiedAt, user.AvatarID, user.UpdatedAt, user.ID).Return(nil, postgresUniqueViolationError)
+
+		userRepository := postgres.NewUserRepository(mockedDB)
+
+		// when
+		err := userRepository.Update(context.Background(), user)
+
+		// then
+		var expectedError *domain.ConflictError
+		assert.ErrorAs(t, err, &expectedError)
+		assert.EqualError(t, expectedError, "the email is already registered")
+	})
+
+	t.Run("should return not found error when no user was updated", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedDB.EXPECT().ExecContext(gomock.Any(), query, user.Name, user.Surname, user.Email, user.Password, user.PasswordChangedAt, user.EmailVerifiedAt, user.AvatarID, user.UpdatedAt, user.ID).Return(driver.RowsAffected(0), nil)
+
+		userRepository := postgres.NewUserRepository(mockedDB)
+
+		// when
+		err := userRepository.Update(context.Background(), user)
+
+		// then
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+		assert.EqualError(t, notFoundErr, "user not found")
+	})
+}
+
+func Test_userRepository_Anonymize(t *testing.T) {
+	updateQuery := "UPDATE users SET name = $1, surname = $2, email = $3, password = $4, password_changed_at = $5, email_verified_at = $6, avatar_id = $7, deleted_at = $8, updated_at = $9 WHERE id = $10"
+	deleteInvitesQuery := "DELETE FROM group_invites WHERE email <> '' AND LOWER(email) = (SELECT LOWER(email) FROM users WHERE id = $1)"
+	clearGuestsQuery := "UPDATE group_guests SET email = $1 WHERE email <> '' AND LOWER(email) = (SELECT LOWER(email) FROM users WHERE id = $2)"
+
+	t.Run("should anonymize the user and remove the data of the active account", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		user.Anonymize()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteInvitesQuery, user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), clearGuestsQuery, "", user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateQuery, user.Name, user.Surname, user.Email, user.Password, user.PasswordChangedAt, user.EmailVerifiedAt, user.AvatarID, user.DeletedAt, user.UpdatedAt, user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM group_waitlist WHERE user_id = $1", user.ID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM group_join_requests WHERE user_id = $1", user.ID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM group_templates WHERE owner_id = $1", user.ID).Return(driver.RowsAffected(2), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM password_reset_tokens WHERE user_id = $1", user.ID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM email_verification_tokens WHERE user_id = $1", user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM refresh_tokens WHERE user_id = $1", user.ID).Return(driver.RowsAffected(3), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM sessions WHERE user_id = $1", user.ID).Return(driver.RowsAffected(2), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM two_factor_challenges WHERE user_id = $1", user.ID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM two_factor_recovery_codes WHERE user_id = $1", user.ID).Return(driver.RowsAffected(10), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM two_factor_secrets WHERE user_id = $1", user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM user_identities WHERE user_id = $1", user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		userRepository := postgres.NewUserRepository(mockedDB)
+
+		// when
+		err := userRepository.Anonymize(context.Background(), user)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return not found error when the user does not exist", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		user.Anonymize()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteInvitesQuery, user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), clearGuestsQuery, "", user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), user.ID).Return(driver.RowsAffected(0), nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		userRepository := postgres.NewUserRepository(mockedDB)
+
+		// when
+		err := userRepository.Anonymize(context.Background(), user)
+
+		// then
+		var expectedError *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &expectedError)
+		assert.EqualError(t, expectedError, "user not found")
+	})
+
+	t.Run("should return an error and roll back when the personal invites cannot be deleted", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		user.Anonymize()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteInvitesQuery, user.ID).Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		userRepository := postgres.NewUserRepository(mockedDB)
+
+		// when
+		err := userRepository.Anonymize(context.Background(), user)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "error deleting personal invites")
+	})
+
+	t.Run("should return an error and roll back when the cleanup fails", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		user.Anonymize()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteInvitesQuery, user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), clearGuestsQuery, "", user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), "DELETE FROM group_waitlist WHERE user_id = $1", user.ID).Return(nil, assert.AnError)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		userRepository := postgres.NewUserRepository(mockedDB)
+
+		// when
+		err := userRepository.Anonymize(context.Background(), user)
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "error deleting group_waitlist")
+	})
+
+	t.Run("should leave no session, credential or linked identity of the user behind", func(t *testing.T) {
+		// given
+		user := build_domain.NewUserBuilder().Build()
+		user.Anonymize()
+
+		mockCtrl := gomock.NewController(t)
+		mockedDB := mock_postgres.NewMockDB(mockCtrl)
+		mockedTx := mock_postgres.NewMockTX(mockCtrl)
+
+		deletedTables := map[string]bool{}
+
+		mockedDB.EXPECT().BeginTxx(gomock.Any(), nil).Return(mockedTx, nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), deleteInvitesQuery, user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), clearGuestsQuery, "", user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), updateQuery, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), user.ID).Return(driver.RowsAffected(1), nil)
+		mockedTx.EXPECT().ExecContext(gomock.Any(), gomock.Any(), user.ID).DoAndReturn(func(ctx context.Context, query string, args ...any) (sql.Result, error) {
+			if !strings.HasPrefix(query, "DELETE FROM ") {
+				t.Fatalf("unexpected query %q", query)
+			}
+			deletedTables[strings.Fields(query)[2]] = true
+			return driver.RowsAffected(1), nil
+		}).AnyTimes()
+		mockedTx.EXPECT().Commit().Return(nil)
+		mockedTx.EXPECT().Rollback().Return(nil)
+
+		userRepository := postgres.NewUserRepository(mockedDB)
+
+		// when
+		err := userRepository.Anonymize(context.Background(), user)
+
+		// then
+		assert.NoError(t, err)
+		for _, table := range []string{"sessions", "refresh_tokens", "two_factor_secrets", "two_factor_recovery_codes", "two_factor_challenges", "user_identities"} {
+			assert.True(t, deletedTables[table], "expected %s to be emptied", table)
+		}
+	})
+}
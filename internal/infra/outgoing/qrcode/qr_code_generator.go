@@ -0,0 +1,60 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+
+	goqrcode "github.com/skip2/go-qrcode"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type QRCodeGenerator struct {
+	pngSize int
+}
+
+// NewQRCodeGenerator creates a generator that renders PNG images of pngSize x pngSize pixels.
+func NewQRCodeGenerator(pngSize int) domain.QRCodeGenerator {
+	return &QRCodeGenerator{
+		pngSize: pngSize,
+	}
+}
+
+func (g *QRCodeGenerator) Generate(content string, format domain.QRCodeFormat) ([]byte, error) {
+	qrCode, err := goqrcode.New(content, goqrcode.Medium)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding QR code: %w", err)
+	}
+
+	switch format {
+	case domain.QRCodeFormatPNG:
+		image, err := qrCode.PNG(g.pngSize)
+		if err != nil {
+			return nil, fmt.Errorf("error rendering QR code PNG: %w", err)
+		}
+		return image, nil
+	case domain.QRCodeFormatSVG:
+		return renderSVG(qrCode.Bitmap()), nil
+	default:
+		return nil, fmt.Errorf("unsupported QR code format: %s", format)
+	}
+}
+
+// renderSVG draws one square per dark module, so the image scales to any size without blurring.
+func renderSVG(bitmap [][]bool) []byte {
+	size := len(bitmap)
+
+	var svg bytes.Buffer
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size)
+	fmt.Fprintf(&svg, `<rect width="%d" height="%d" fill="#ffffff"/>`, size, size)
+	svg.WriteString(`<path fill="#000000" d="`)
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&svg, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	svg.WriteString(`"/></svg>`)
+
+	return svg.Bytes()
+}
@@ -0,0 +1,58 @@
+package qrcode_test
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/qrcode"
+)
+
+func Test_QRCodeGenerator_Generate(t *testing.T) {
+	content := "https://mystery-gifter.app/invites/018e1234-abcd-7000-8000-000000000001"
+
+	t.Run("should render a PNG image of the configured size", func(t *testing.T) {
+		// given
+		generator := qrcode.NewQRCodeGenerator(256)
+
+		// when
+		result, err := generator.Generate(content, domain.QRCodeFormatPNG)
+
+		// then
+		assert.NoError(t, err)
+		image, err := png.Decode(bytes.NewReader(result))
+		assert.NoError(t, err)
+		assert.Equal(t, 256, image.Bounds().Dx())
+		assert.Equal(t, 256, image.Bounds().Dy())
+	})
+
+	t.Run("should render an SVG image", func(t *testing.T) {
+		// given
+		generator := qrcode.NewQRCodeGenerator(256)
+
+		// when
+		result, err := generator.Generate(content, domain.QRCodeFormatSVG)
+
+		// then
+		assert.NoError(t, err)
+		svg := string(result)
+		assert.True(t, strings.HasPrefix(svg, "<svg "))
+		assert.True(t, strings.HasSuffix(svg, "</svg>"))
+		assert.Contains(t, svg, "h1v1h-1z")
+	})
+
+	t.Run("should return error for unsupported formats", func(t *testing.T) {
+		// given
+		generator := qrcode.NewQRCodeGenerator(256)
+
+		// when
+		result, err := generator.Generate(content, domain.QRCodeFormat("gif"))
+
+		// then
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "unsupported QR code format: gif")
+	})
+}
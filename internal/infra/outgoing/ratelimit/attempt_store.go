@@ -0,0 +1,21 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+)
+
+const DriverMemory = "memory"
+
+// NewAttemptStore creates the store selected by the configured driver. Only the in-memory store is supported for
+// now; a shared backend, needed once several instances run, only has to implement domain.AttemptStore.
+func NewAttemptStore(rateLimitConfig config.RateLimitConfig) (domain.AttemptStore, error) {
+	switch rateLimitConfig.Driver {
+	case DriverMemory:
+		return NewMemoryAttemptStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limit driver %q", rateLimitConfig.Driver)
+	}
+}
@@ -0,0 +1,29 @@
+package ratelimit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/ratelimit"
+)
+
+func Test_NewAttemptStore(t *testing.T) {
+	t.Run("should create an in-memory store when the memory driver is used", func(t *testing.T) {
+		// when
+		attemptStore, err := ratelimit.NewAttemptStore(config.RateLimitConfig{Driver: ratelimit.DriverMemory})
+
+		// then
+		assert.NoError(t, err)
+		assert.IsType(t, &ratelimit.MemoryAttemptStore{}, attemptStore)
+	})
+
+	t.Run("should return an error for unknown drivers", func(t *testing.T) {
+		// when
+		attemptStore, err := ratelimit.NewAttemptStore(config.RateLimitConfig{Driver: "carrier-pigeon"})
+
+		// then
+		assert.Nil(t, attemptStore)
+		assert.EqualError(t, err, `unknown rate limit driver "carrier-pigeon"`)
+	})
+}
@@ -0,0 +1,93 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+type memoryAttemptRecord struct {
+	attempts  domain.FailedAttempts
+	expiresAt time.Time
+}
+
+// MemoryAttemptStore keeps failed attempts in the process. Expired records are swept while attempts are reserved,
+// at most once per retention period, so keys that are never seen again do not pile up.
+type MemoryAttemptStore struct {
+	mu          sync.Mutex
+	records     map[string]memoryAttemptRecord
+	nextSweepAt time.Time
+}
+
+func NewMemoryAttemptStore() domain.AttemptStore {
+	return &MemoryAttemptStore{
+		records: make(map[string]memoryAttemptRecord),
+	}
+}
+
+func (s *MemoryAttemptStore) Reserve(ctx context.Context, key string, at time.Time, ttl time.Duration) (domain.FailedAttempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !at.Before(s.nextSweepAt) {
+		s.sweep(at)
+		s.nextSweepAt = at.Add(ttl)
+	}
+
+	record, ok := s.records[key]
+	if !ok || !at.Before(record.expiresAt) {
+		record = memoryAttemptRecord{}
+	}
+
+	previous := record.attempts
+
+	record.attempts.Count++
+	record.attempts.LastFailureAt = at
+	record.expiresAt = at.Add(ttl)
+	s.records[key] = record
+
+	return previous, nil
+}
+
+func (s *MemoryAttemptStore) Release(ctx context.Context, key string, reservedAt time.Time, previous domain.FailedAttempts) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[key]
+	if !ok || record.attempts.Count == 0 {
+		return nil
+	}
+
+	record.attempts.Count--
+	if record.attempts.LastFailureAt.Equal(reservedAt) {
+		record.attempts.LastFailureAt = previous.LastFailureAt
+	}
+
+	if record.attempts.Count == 0 {
+		delete(s.records, key)
+		return nil
+	}
+
+	s.records[key] = record
+
+	return nil
+}
+
+func (s *MemoryAttemptStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+
+	return nil
+}
+
+func (s *MemoryAttemptStore) sweep(now time.Time) {
+	for key, record := range s.records {
+		if !now.Before(record.expiresAt) {
+			delete(s.records, key)
+		}
+	}
+}
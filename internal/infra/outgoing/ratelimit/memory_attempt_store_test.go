@@ -0,0 +1,157 @@
+package ratelimit_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/ratelimit"
+)
+
+// currentAttempts reads the counter of the key by reserving an attempt and taking it back right away.
+func currentAttempts(t *testing.T, attemptStore domain.AttemptStore, key string) domain.FailedAttempts {
+	t.Helper()
+
+	now := time.Now()
+
+	attempts, err := attemptStore.Reserve(context.Background(), key, now, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to reserve attempt: %v", err)
+	}
+
+	if err := attemptStore.Release(context.Background(), key, now, attempts); err != nil {
+		t.Fatalf("failed to release attempt: %v", err)
+	}
+
+	return attempts
+}
+
+func Test_MemoryAttemptStore(t *testing.T) {
+	t.Run("should return no failures for unknown keys", func(t *testing.T) {
+		// given
+		attemptStore := ratelimit.NewMemoryAttemptStore()
+
+		// when
+		attempts, err := attemptStore.Reserve(context.Background(), "login:ip:203.0.113.10", time.Now(), time.Minute)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.FailedAttempts{}, attempts)
+	})
+
+	t.Run("should count reserved attempts per key", func(t *testing.T) {
+		// given
+		attemptStore := ratelimit.NewMemoryAttemptStore()
+		now := time.Now()
+
+		// when
+		attemptStore.Reserve(context.Background(), "login:ip:203.0.113.10", now.Add(-time.Second), time.Minute)
+		attemptStore.Reserve(context.Background(), "login:ip:203.0.113.10", now, time.Minute)
+		attemptStore.Reserve(context.Background(), "login:ip:198.51.100.7", now, time.Minute)
+
+		// then
+		attempts := currentAttempts(t, attemptStore, "login:ip:203.0.113.10")
+		assert.Equal(t, 2, attempts.Count)
+		assert.Equal(t, now, attempts.LastFailureAt)
+
+		otherAttempts := currentAttempts(t, attemptStore, "login:ip:198.51.100.7")
+		assert.Equal(t, 1, otherAttempts.Count)
+	})
+
+	t.Run("should take back a released attempt and restore the time of the last failure", func(t *testing.T) {
+		// given
+		attemptStore := ratelimit.NewMemoryAttemptStore()
+		failedAt := time.Now().Add(-time.Second)
+		attemptStore.Reserve(context.Background(), "login:ip:203.0.113.10", failedAt, time.Minute)
+
+		reservedAt := time.Now()
+		previous, _ := attemptStore.Reserve(context.Background(), "login:ip:203.0.113.10", reservedAt, time.Minute)
+
+		// when
+		err := attemptStore.Release(context.Background(), "login:ip:203.0.113.10", reservedAt, previous)
+
+		// then
+		assert.NoError(t, err)
+		attempts := currentAttempts(t, attemptStore, "login:ip:203.0.113.10")
+		assert.Equal(t, 1, attempts.Count)
+		assert.Equal(t, failedAt, attempts.LastFailureAt)
+	})
+
+	t.Run("should keep the time of an attempt reserved after the released one", func(t *testing.T) {
+		// given
+		attemptStore := ratelimit.NewMemoryAttemptStore()
+		reservedAt := time.Now().Add(-time.Second)
+		previous, _ := attemptStore.Reserve(context.Background(), "login:ip:203.0.113.10", reservedAt, time.Minute)
+
+		laterAt := time.Now()
+		attemptStore.Reserve(context.Background(), "login:ip:203.0.113.10", laterAt, time.Minute)
+
+		// when
+		err := attemptStore.Release(context.Background(), "login:ip:203.0.113.10", reservedAt, previous)
+
+		// then
+		assert.NoError(t, err)
+		attempts := currentAttempts(t, attemptStore, "login:ip:203.0.113.10")
+		assert.Equal(t, 1, attempts.Count)
+		assert.Equal(t, laterAt, attempts.LastFailureAt)
+	})
+
+	t.Run("should forget failures once they expire", func(t *testing.T) {
+		// given
+		attemptStore := ratelimit.NewMemoryAttemptStore()
+		now := time.Now()
+		attemptStore.Reserve(context.Background(), "login:ip:203.0.113.10", now.Add(-2*time.Minute), time.Minute)
+
+		// when
+		attempts, err := attemptStore.Reserve(context.Background(), "login:ip:203.0.113.10", now, time.Minute)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, domain.FailedAttempts{}, attempts)
+	})
+
+	t.Run("should forget failures when the key is reset", func(t *testing.T) {
+		// given
+		attemptStore := ratelimit.NewMemoryAttemptStore()
+		attemptStore.Reserve(context.Background(), "login:account:test@mail.com", time.Now(), time.Minute)
+
+		// when
+		err := attemptStore.Reset(context.Background(), "login:account:test@mail.com")
+
+		// then
+		assert.NoError(t, err)
+		attempts := currentAttempts(t, attemptStore, "login:account:test@mail.com")
+		assert.Equal(t, 0, attempts.Count)
+	})
+
+	t.Run("should give every concurrent attempt its own place in the counter", func(t *testing.T) {
+		// given
+		attemptStore := ratelimit.NewMemoryAttemptStore()
+		seen := make([]bool, 50)
+
+		// when
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				attempts, _ := attemptStore.Reserve(context.Background(), "join:ip:203.0.113.10", time.Now(), time.Minute)
+				mu.Lock()
+				seen[attempts.Count] = true
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		// then
+		for count, ok := range seen {
+			assert.True(t, ok, "no attempt saw %d reserved attempts before it", count)
+		}
+		attempts := currentAttempts(t, attemptStore, "join:ip:203.0.113.10")
+		assert.Equal(t, 50, attempts.Count)
+	})
+}
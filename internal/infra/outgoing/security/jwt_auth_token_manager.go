@@ -2,6 +2,7 @@ package security
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
@@ -19,10 +20,14 @@ func NewJWTAuthTokenManager(secretKey string) domain.AuthTokenManager {
 	}
 }
 
-func (t *JWTAuthTokenManager) Create(userID string, expiresIn int64) (string, error) {
+// Create signs a token for the session. The session ID goes in the standard jti claim, so the token can be
+// rejected as soon as its session is revoked.
+func (t *JWTAuthTokenManager) Create(userID, sessionID string, expiresIn int64) (string, error) {
 	claims := jwt.MapClaims{
 		"authorized": true,
 		"exp":        expiresIn,
+		"iat":        time.Now().Unix(),
+		"jti":        sessionID,
 		"userID":     userID,
 	}
 
@@ -60,3 +65,49 @@ func (t *JWTAuthTokenManager) GetAuthUserID(token any) (string, error) {
 
 	return userID, nil
 }
+
+func (t *JWTAuthTokenManager) GetSessionID(token any) (string, error) {
+	err := domain.NewUnauthorizedError("invalid token")
+
+	jwtToken, ok := token.(*jwt.Token)
+	if !ok {
+		return "", err
+	}
+
+	claims, ok := jwtToken.Claims.(jwt.MapClaims)
+	if !ok || !jwtToken.Valid {
+		return "", err
+	}
+
+	sessionID, ok := claims["jti"]
+	if !ok {
+		return "", nil
+	}
+
+	sessionIDString, ok := sessionID.(string)
+	if !ok {
+		return "", err
+	}
+
+	return sessionIDString, nil
+}
+
+func (t *JWTAuthTokenManager) GetIssuedAt(token any) (time.Time, error) {
+	err := domain.NewUnauthorizedError("invalid token")
+
+	jwtToken, ok := token.(*jwt.Token)
+	if !ok || !jwtToken.Valid {
+		return time.Time{}, err
+	}
+
+	issuedAt, claimErr := jwtToken.Claims.GetIssuedAt()
+	if claimErr != nil {
+		return time.Time{}, err
+	}
+
+	if issuedAt == nil {
+		return time.Time{}, nil
+	}
+
+	return issuedAt.Time, nil
+}
@@ -14,12 +14,13 @@ func Test_JWTAuthTokenManager_Create(t *testing.T) {
 		// given
 		secretKey := "mysecretkey"
 		userID := "some-user-id"
+		sessionID := "some-session-id"
 		expiresIn := time.Now().Add(time.Hour).Unix()
 
 		AuthTokenManager := security.NewJWTAuthTokenManager(secretKey)
 
 		// when
-		token, err := AuthTokenManager.Create(userID, expiresIn)
+		token, err := AuthTokenManager.Create(userID, sessionID, expiresIn)
 
 		// then
 		assert.NoError(t, err)
@@ -34,8 +35,10 @@ func Test_JWTAuthTokenManager_Create(t *testing.T) {
 		claims, ok := parsedToken.Claims.(jwt.MapClaims)
 		assert.True(t, ok)
 		assert.Equal(t, userID, claims["userID"])
+		assert.Equal(t, sessionID, claims["jti"])
 		assert.Equal(t, true, claims["authorized"])
 		assert.Equal(t, expiresIn, int64(claims["exp"].(float64)))
+		assert.InDelta(t, time.Now().Unix(), int64(claims["iat"].(float64)), 1)
 	})
 }
 
@@ -130,3 +133,116 @@ func Test_JWTAuthTokenManager_GetAuthUserID(t *testing.T) {
 		assert.EqualError(t, err, "invalid token")
 	})
 }
+
+func Test_JWTAuthTokenManager_GetSessionID(t *testing.T) {
+	t.Run("should extract the session ID", func(t *testing.T) {
+		// given
+		AuthTokenManager := security.NewJWTAuthTokenManager("mysecretkey")
+
+		token := jwt.New(jwt.SigningMethodHS256)
+		token.Valid = true
+		token.Claims = jwt.MapClaims{
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"jti":    "some-session-id",
+			"userID": "some-user-id",
+		}
+
+		// when
+		result, err := AuthTokenManager.GetSessionID(token)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "some-session-id", result)
+	})
+
+	t.Run("should return an empty session ID when the token has no jti claim", func(t *testing.T) {
+		// given
+		AuthTokenManager := security.NewJWTAuthTokenManager("mysecretkey")
+
+		token := jwt.New(jwt.SigningMethodHS256)
+		token.Valid = true
+		token.Claims = jwt.MapClaims{
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"userID": "some-user-id",
+		}
+
+		// when
+		result, err := AuthTokenManager.GetSessionID(token)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when token is invalid", func(t *testing.T) {
+		// given
+		AuthTokenManager := security.NewJWTAuthTokenManager("mysecretkey")
+
+		invalidToken := jwt.New(jwt.SigningMethodHS256)
+		invalidToken.Valid = false
+
+		// when
+		result, err := AuthTokenManager.GetSessionID(invalidToken)
+
+		// then
+		assert.EqualError(t, err, "invalid token")
+		assert.Empty(t, result)
+	})
+}
+
+func Test_JWTAuthTokenManager_GetIssuedAt(t *testing.T) {
+	t.Run("should extract when the token was issued", func(t *testing.T) {
+		// given
+		AuthTokenManager := security.NewJWTAuthTokenManager("mysecretkey")
+		issuedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+		token := jwt.New(jwt.SigningMethodHS256)
+		token.Valid = true
+		token.Claims = jwt.MapClaims{
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"iat":    float64(issuedAt.Unix()),
+			"userID": "some-user-id",
+		}
+
+		// when
+		result, err := AuthTokenManager.GetIssuedAt(token)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, issuedAt.Equal(result))
+	})
+
+	t.Run("should return the zero time when the token has no issued at claim", func(t *testing.T) {
+		// given
+		AuthTokenManager := security.NewJWTAuthTokenManager("mysecretkey")
+
+		token := jwt.New(jwt.SigningMethodHS256)
+		token.Valid = true
+		token.Claims = jwt.MapClaims{
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"userID": "some-user-id",
+		}
+
+		// when
+		result, err := AuthTokenManager.GetIssuedAt(token)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, result.IsZero())
+	})
+
+	t.Run("should return error when token is invalid", func(t *testing.T) {
+		// given
+		AuthTokenManager := security.NewJWTAuthTokenManager("mysecretkey")
+
+		invalidToken := jwt.New(jwt.SigningMethodHS256)
+		invalidToken.Valid = false
+
+		// when
+		result, err := AuthTokenManager.GetIssuedAt(invalidToken)
+
+		// then
+		assert.EqualError(t, err, "invalid token")
+		assert.True(t, result.IsZero())
+	})
+}
@@ -0,0 +1,103 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+const (
+	// totpSecretBytes is the 160 bit key length RFC 4226 recommends for HMAC-SHA1.
+	totpSecretBytes = 20
+	totpDigits      = 6
+	totpPeriod      = 30 * time.Second
+	// totpSkew is how many steps before and after the current one are accepted, for clocks that drift apart.
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TOTPManager implements RFC 6238 with the parameters every authenticator app supports: HMAC-SHA1, six digits and
+// a 30 second period.
+type TOTPManager struct {
+	random io.Reader
+	issuer string
+}
+
+// NewTOTPManager creates a manager that reads secrets from random, usually crypto/rand.Reader, and names the
+// service as issuer in authenticator apps.
+func NewTOTPManager(random io.Reader, issuer string) domain.TOTPManager {
+	return &TOTPManager{
+		random: random,
+		issuer: issuer,
+	}
+}
+
+// GenerateSecret returns the secret encoded as unpadded base32, the form authenticator apps accept when typed in.
+func (m *TOTPManager) GenerateSecret() (string, error) {
+	secret := make([]byte, totpSecretBytes)
+	if _, err := io.ReadFull(m.random, secret); err != nil {
+		return "", fmt.Errorf("error generating totp secret: %w", err)
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+func (m *TOTPManager) URI(secret, accountName string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", m.issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(int(totpPeriod.Seconds())))
+
+	uri := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + m.issuer + ":" + accountName,
+		RawQuery: query.Encode(),
+	}
+
+	return uri.String()
+}
+
+func (m *TOTPManager) Validate(secret, code string, at time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	code = strings.ReplaceAll(code, " ", "")
+	currentStep := at.Unix() / int64(totpPeriod.Seconds())
+
+	for step := currentStep - totpSkew; step <= currentStep+totpSkew; step++ {
+		if hmac.Equal([]byte(generateTOTPCode(key, step)), []byte(code)) {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// generateTOTPCode computes the HOTP value of RFC 4226 for the counter, which TOTP derives from the time.
+func generateTOTPCode(key []byte, counter int64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, value%1_000_000)
+}
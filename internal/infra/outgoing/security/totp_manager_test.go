@@ -0,0 +1,104 @@
+package security_test
+
+import (
+	"bytes"
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/security"
+)
+
+// rfcSecret is the key of the RFC 6238 test vectors, "12345678901234567890", encoded as base32.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func Test_TOTPManager_GenerateSecret(t *testing.T) {
+	t.Run("should encode 20 random bytes as unpadded base32", func(t *testing.T) {
+		// given
+		totpManager := security.NewTOTPManager(bytes.NewReader([]byte("12345678901234567890")), "Mystery Gifter")
+
+		// when
+		secret, err := totpManager.GenerateSecret()
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, rfcSecret, secret)
+		assert.Len(t, secret, 32)
+	})
+
+	t.Run("should return an error when reading randomness fails", func(t *testing.T) {
+		// given
+		totpManager := security.NewTOTPManager(iotest.ErrReader(assert.AnError), "Mystery Gifter")
+
+		// when
+		secret, err := totpManager.GenerateSecret()
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, secret)
+	})
+}
+
+func Test_TOTPManager_URI(t *testing.T) {
+	t.Run("should build an otpauth URI labeled with the issuer and the account", func(t *testing.T) {
+		// given
+		totpManager := security.NewTOTPManager(nil, "Mystery Gifter")
+
+		// when
+		uri := totpManager.URI(rfcSecret, "user@example.com")
+
+		// then
+		parsedURI, err := url.Parse(uri)
+		assert.NoError(t, err)
+		assert.Equal(t, "otpauth", parsedURI.Scheme)
+		assert.Equal(t, "totp", parsedURI.Host)
+		assert.Equal(t, "/Mystery Gifter:user@example.com", parsedURI.Path)
+		assert.Equal(t, rfcSecret, parsedURI.Query().Get("secret"))
+		assert.Equal(t, "Mystery Gifter", parsedURI.Query().Get("issuer"))
+		assert.Equal(t, "6", parsedURI.Query().Get("digits"))
+		assert.Equal(t, "30", parsedURI.Query().Get("period"))
+	})
+}
+
+func Test_TOTPManager_Validate(t *testing.T) {
+	totpManager := security.NewTOTPManager(nil, "Mystery Gifter")
+
+	tests := []struct {
+		name          string
+		code          string
+		at            time.Time
+		expectedStep  int64
+		expectedValid bool
+	}{
+		// the RFC 6238 vectors have eight digits, six digit codes are their last six
+		{"should accept the code of the current step", "287082", time.Unix(59, 0), 1, true},
+		{"should accept the code of another RFC vector", "081804", time.Unix(1111111109, 0), 37037036, true},
+		{"should accept the code of the previous step", "287082", time.Unix(89, 0), 1, true},
+		{"should accept the code of the next step", "081804", time.Unix(1111111109-30, 0), 37037036, true},
+		{"should ignore spaces in the code", "287 082", time.Unix(59, 0), 1, true},
+		{"should reject codes older than the tolerated drift", "287082", time.Unix(120, 0), 0, false},
+		{"should reject wrong codes", "123456", time.Unix(59, 0), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			step, valid := totpManager.Validate(rfcSecret, tt.code, tt.at)
+
+			// then
+			assert.Equal(t, tt.expectedValid, valid)
+			assert.Equal(t, tt.expectedStep, step)
+		})
+	}
+
+	t.Run("should reject secrets that are not base32", func(t *testing.T) {
+		// when
+		_, valid := totpManager.Validate("not base32!", "287082", time.Unix(59, 0))
+
+		// then
+		assert.False(t, valid)
+	})
+}
@@ -0,0 +1,21 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+)
+
+const DriverLocal = "local"
+
+// NewBlobStorage creates the storage selected by the configured driver. Only the local filesystem is supported
+// for now; other backends only need to implement domain.BlobStorage.
+func NewBlobStorage(storageConfig config.StorageConfig) (domain.BlobStorage, error) {
+	switch storageConfig.Driver {
+	case DriverLocal:
+		return NewLocalBlobStorage(storageConfig.LocalDir), nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", storageConfig.Driver)
+	}
+}
@@ -0,0 +1,29 @@
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/storage"
+)
+
+func Test_NewBlobStorage(t *testing.T) {
+	t.Run("should create a local storage when the local driver is used", func(t *testing.T) {
+		// when
+		blobStorage, err := storage.NewBlobStorage(config.StorageConfig{Driver: storage.DriverLocal, LocalDir: t.TempDir()})
+
+		// then
+		assert.NoError(t, err)
+		assert.IsType(t, &storage.LocalBlobStorage{}, blobStorage)
+	})
+
+	t.Run("should return an error for unknown drivers", func(t *testing.T) {
+		// when
+		blobStorage, err := storage.NewBlobStorage(config.StorageConfig{Driver: "floppy"})
+
+		// then
+		assert.Nil(t, blobStorage)
+		assert.EqualError(t, err, `unknown storage driver "floppy"`)
+	})
+}
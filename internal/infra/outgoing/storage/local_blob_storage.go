@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+)
+
+// LocalBlobStorage keeps blobs as files below a base directory, one file per key. The content type is not stored:
+// it is derived from the extension of the key when the blob is read.
+type LocalBlobStorage struct {
+	baseDir string
+}
+
+func NewLocalBlobStorage(baseDir string) domain.BlobStorage {
+	return &LocalBlobStorage{
+		baseDir: baseDir,
+	}
+}
+
+// Put writes to a temporary file first, so readers never see a partially written blob.
+func (s *LocalBlobStorage) Put(ctx context.Context, key string, blob domain.Blob) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return fmt.Errorf("error creating blob directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("error creating blob file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(blob.Content); err != nil {
+		file.Close()
+		return fmt.Errorf("error writing blob: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error writing blob: %w", err)
+	}
+
+	if err := os.Rename(file.Name(), filePath); err != nil {
+		return fmt.Errorf("error storing blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalBlobStorage) Get(ctx context.Context, key string) (*domain.Blob, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domain.NewResourceNotFoundError("blob not found")
+		}
+		return nil, fmt.Errorf("error reading blob: %w", err)
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return &domain.Blob{Content: content, ContentType: contentType}, nil
+}
+
+func (s *LocalBlobStorage) Delete(ctx context.Context, key string) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting blob: %w", err)
+	}
+
+	return nil
+}
+
+// filePath maps a key to a file below the base directory, refusing keys that could point anywhere else.
+func (s *LocalBlobStorage) filePath(key string) (string, error) {
+	if !fs.ValidPath(key) || key == "." {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(s.baseDir, filepath.FromSlash(key)), nil
+}
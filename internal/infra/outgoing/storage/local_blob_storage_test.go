@@ -0,0 +1,89 @@
+package storage_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/storage"
+)
+
+func Test_LocalBlobStorage_Put(t *testing.T) {
+	t.Run("should write the blob below the base directory", func(t *testing.T) {
+		// given
+		baseDir := t.TempDir()
+		blobStorage := storage.NewLocalBlobStorage(baseDir)
+
+		// when
+		err := blobStorage.Put(context.Background(), "avatars/user/avatar/small.png", domain.Blob{Content: []byte("content"), ContentType: "image/png"})
+
+		// then
+		assert.NoError(t, err)
+		content, err := os.ReadFile(filepath.Join(baseDir, "avatars", "user", "avatar", "small.png"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("content"), content)
+	})
+
+	t.Run("should refuse keys outside the base directory", func(t *testing.T) {
+		// given
+		blobStorage := storage.NewLocalBlobStorage(t.TempDir())
+
+		// when
+		err := blobStorage.Put(context.Background(), "../escape.png", domain.Blob{Content: []byte("content")})
+
+		// then
+		assert.EqualError(t, err, `invalid blob key "../escape.png"`)
+	})
+}
+
+func Test_LocalBlobStorage_Get(t *testing.T) {
+	t.Run("should read the blob with the content type of its extension", func(t *testing.T) {
+		// given
+		blobStorage := storage.NewLocalBlobStorage(t.TempDir())
+		err := blobStorage.Put(context.Background(), "avatars/small.png", domain.Blob{Content: []byte("content"), ContentType: "image/png"})
+		assert.NoError(t, err)
+
+		// when
+		blob, err := blobStorage.Get(context.Background(), "avatars/small.png")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.Blob{Content: []byte("content"), ContentType: "image/png"}, blob)
+	})
+
+	t.Run("should return not found error when nothing is stored under the key", func(t *testing.T) {
+		// given
+		blobStorage := storage.NewLocalBlobStorage(t.TempDir())
+
+		// when
+		blob, err := blobStorage.Get(context.Background(), "avatars/missing.png")
+
+		// then
+		assert.Nil(t, blob)
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func Test_LocalBlobStorage_Delete(t *testing.T) {
+	t.Run("should delete the blob and ignore missing ones", func(t *testing.T) {
+		// given
+		blobStorage := storage.NewLocalBlobStorage(t.TempDir())
+		err := blobStorage.Put(context.Background(), "avatars/small.png", domain.Blob{Content: []byte("content")})
+		assert.NoError(t, err)
+
+		// when
+		firstErr := blobStorage.Delete(context.Background(), "avatars/small.png")
+		secondErr := blobStorage.Delete(context.Background(), "avatars/small.png")
+
+		// then
+		assert.NoError(t, firstErr)
+		assert.NoError(t, secondErr)
+		_, err = blobStorage.Get(context.Background(), "avatars/small.png")
+		var notFoundErr *domain.ResourceNotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
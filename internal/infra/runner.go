@@ -1,7 +1,9 @@
 package infra
 
 import (
+	"crypto/rand"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
@@ -10,12 +12,19 @@ import (
 	"github.com/google/uuid"
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/application"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/domain"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/config"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/entrypoint/rest"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/identity"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/imaging"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/mail"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/oidc"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/postgres"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/qrcode"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/ratelimit"
 	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/security"
+	"github.com/waliqueiroz/mystery-gifter-api/internal/infra/outgoing/storage"
 )
 
 func Run() error {
@@ -40,32 +49,108 @@ func Run() error {
 
 	app := fiber.New(fiber.Config{
 		ErrorHandler: entrypoint.CustomErrorHandler,
+		ProxyHeader:  cfg.Server.ProxyHeader,
+		TrustProxy:   cfg.Server.ProxyHeader != "",
+		TrustProxyConfig: fiber.TrustProxyConfig{
+			Proxies: cfg.Server.TrustedProxies,
+		},
 	})
 	app.Use(cors.New())
 	app.Use(recover.New())
 
 	uuidIdentityGenerator := identity.NewUUIDIdentityGenerator(uuid.NewV7)
+	randomInviteCodeGenerator := identity.NewRandomInviteCodeGenerator(rand.Reader)
+	randomSecretTokenGenerator := identity.NewRandomSecretTokenGenerator(rand.Reader)
+	randomRecoveryCodeGenerator := identity.NewRandomRecoveryCodeGenerator(rand.Reader)
+	qrCodeGenerator := qrcode.NewQRCodeGenerator(cfg.Invite.QRCodeSize)
+	imageProcessor := imaging.NewImageProcessor(cfg.Avatar.MaxPixels)
 	bcryptPasswordManager := security.NewBcryptPasswordManager()
 	jwtAuthTokenManager := security.NewJWTAuthTokenManager(cfg.Auth.SecretKey)
+	totpManager := security.NewTOTPManager(rand.Reader, cfg.TwoFactor.Issuer)
+	oidcProvider := oidc.NewOIDCProvider(cfg.OIDC, &http.Client{Timeout: 10 * time.Second})
+
+	mailer, err := mail.NewMailer(cfg.Mail)
+	if err != nil {
+		return err
+	}
+
+	emailVerificationPolicy, err := domain.NewEmailVerificationPolicy(cfg.EmailVerification.RestrictedActions)
+	if err != nil {
+		return err
+	}
+
+	blobStorage, err := storage.NewBlobStorage(cfg.Storage)
+	if err != nil {
+		return err
+	}
+
+	attemptStore, err := ratelimit.NewAttemptStore(cfg.RateLimit)
+	if err != nil {
+		return err
+	}
+
+	attemptPolicy, err := domain.NewAttemptPolicy(cfg.RateLimit.FreeAttempts, cfg.RateLimit.BaseDelay, cfg.RateLimit.MaxDelay, cfg.RateLimit.LockoutThreshold, cfg.RateLimit.LockoutDuration)
+	if err != nil {
+		return err
+	}
+
+	attemptLimiter := application.NewAttemptLimiter(attemptStore, attemptPolicy)
 
 	userRepository := postgres.NewUserRepository(db)
-	userService := application.NewUserService(userRepository)
-	userController := rest.NewUserController(userService, uuidIdentityGenerator, bcryptPasswordManager, jwtAuthTokenManager)
+
+	groupTemplateRepository := postgres.NewGroupTemplateRepository(db)
+	groupTemplateService := application.NewGroupTemplateService(groupTemplateRepository, uuidIdentityGenerator)
+	groupTemplateController := rest.NewGroupTemplateController(groupTemplateService, jwtAuthTokenManager)
 
 	groupRepository := postgres.NewGroupRepository(db)
-	groupService := application.NewGroupService(groupRepository, userService, uuidIdentityGenerator)
-	groupController := rest.NewGroupController(groupService, jwtAuthTokenManager)
 
 	groupInviteRepository := postgres.NewGroupInviteRepository(db)
-	groupInviteService := application.NewGroupInviteService(groupInviteRepository, groupRepository, userService, uuidIdentityGenerator, cfg.Invite.LinkExpiration)
+	groupInviteService := application.NewGroupInviteService(groupInviteRepository, groupRepository, userRepository, uuidIdentityGenerator, randomInviteCodeGenerator, qrCodeGenerator, mailer, cfg.Invite.LinkExpiration, cfg.Invite.JoinBaseURL, emailVerificationPolicy, attemptLimiter)
 	groupInviteController := rest.NewGroupInviteController(groupInviteService, jwtAuthTokenManager)
 
-	authService := application.NewAuthService(cfg.Auth.SessionDuration, userRepository, bcryptPasswordManager, jwtAuthTokenManager)
-	authController := rest.NewAuthController(authService, cfg.Auth.CookieSecure)
+	emailVerificationRepository := postgres.NewEmailVerificationRepository(db)
+	emailVerificationService := application.NewEmailVerificationService(emailVerificationRepository, userRepository, groupInviteService, uuidIdentityGenerator, randomSecretTokenGenerator, mailer, attemptLimiter, cfg.EmailVerification.TokenExpiration, cfg.EmailVerification.BaseURL)
+	emailVerificationController := rest.NewEmailVerificationController(emailVerificationService, jwtAuthTokenManager)
+
+	userService := application.NewUserService(userRepository, emailVerificationService)
+	userController := rest.NewUserController(userService, uuidIdentityGenerator, bcryptPasswordManager, jwtAuthTokenManager)
+
+	groupService := application.NewGroupService(groupRepository, userService, groupTemplateService, groupInviteService, uuidIdentityGenerator, emailVerificationPolicy)
+	groupController := rest.NewGroupController(groupService, jwtAuthTokenManager)
+
+	sessionRepository := postgres.NewSessionRepository(db)
+	sessionService := application.NewSessionService(sessionRepository)
+	sessionController := rest.NewSessionController(sessionService, jwtAuthTokenManager)
+
+	twoFactorRepository := postgres.NewTwoFactorRepository(db)
+	twoFactorService := application.NewTwoFactorService(twoFactorRepository, userRepository, totpManager, uuidIdentityGenerator, randomRecoveryCodeGenerator, attemptLimiter)
+	twoFactorController := rest.NewTwoFactorController(twoFactorService, jwtAuthTokenManager)
+
+	refreshTokenRepository := postgres.NewRefreshTokenRepository(db)
+	twoFactorChallengeRepository := postgres.NewTwoFactorChallengeRepository(db)
+	authService := application.NewAuthService(cfg.Auth.SessionDuration, cfg.Auth.RefreshTokenDuration, userRepository, sessionRepository, refreshTokenRepository, bcryptPasswordManager, jwtAuthTokenManager, uuidIdentityGenerator, randomSecretTokenGenerator, attemptLimiter, twoFactorService, twoFactorChallengeRepository)
+	authController := rest.NewAuthController(authService, jwtAuthTokenManager, cfg.Auth.CookieSecure)
+
+	oidcLoginRequestRepository := postgres.NewOIDCLoginRequestRepository(db)
+	userIdentityRepository := postgres.NewUserIdentityRepository(db)
+	oidcService := application.NewOIDCService(oidcProvider, oidcLoginRequestRepository, userIdentityRepository, userRepository, uuidIdentityGenerator, randomSecretTokenGenerator, authService)
+	oidcController := rest.NewOIDCController(oidcService, cfg.Auth.CookieSecure)
+
+	passwordResetRepository := postgres.NewPasswordResetRepository(db)
+	passwordResetService := application.NewPasswordResetService(passwordResetRepository, userRepository, uuidIdentityGenerator, randomSecretTokenGenerator, bcryptPasswordManager, mailer, attemptLimiter, cfg.PasswordReset.TokenExpiration, cfg.PasswordReset.BaseURL)
+	passwordResetController := rest.NewPasswordResetController(passwordResetService)
+
+	accountService := application.NewAccountService(userRepository, groupRepository, groupInviteRepository, sessionRepository, bcryptPasswordManager, blobStorage)
+	accountController := rest.NewAccountController(accountService, jwtAuthTokenManager)
+
+	avatarService := application.NewAvatarService(userRepository, blobStorage, imageProcessor, uuidIdentityGenerator, cfg.Avatar.MaxUploadSize)
+	avatarController := rest.NewAvatarController(avatarService, jwtAuthTokenManager)
 
 	authMiddleware := entrypoint.NewAuthMiddleware(cfg.Auth.SecretKey)
+	optionalAuthMiddleware := entrypoint.NewOptionalAuthMiddleware(cfg.Auth.SecretKey)
+	sessionMiddleware := entrypoint.NewSessionMiddleware(jwtAuthTokenManager, authService)
 
-	entrypoint.CreateRoutes(app, authMiddleware, userController, authController, groupController, groupInviteController)
+	entrypoint.CreateRoutes(app, authMiddleware, optionalAuthMiddleware, sessionMiddleware, userController, authController, passwordResetController, emailVerificationController, accountController, avatarController, sessionController, twoFactorController, oidcController, groupController, groupInviteController, groupTemplateController)
 
 	return app.Listen(fmt.Sprintf(":%d", 8080))
 }